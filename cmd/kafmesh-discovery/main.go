@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/syncromatics/kafmesh/internal/graph"
+	"github.com/syncromatics/kafmesh/internal/lag"
 	"github.com/syncromatics/kafmesh/internal/scraper"
 	"github.com/syncromatics/kafmesh/internal/services"
 	"github.com/syncromatics/kafmesh/internal/storage"
@@ -64,9 +65,21 @@ func main() {
 		group.Go(scraperService.Run(ctx))
 	}
 
+	if len(settings.KafkaBrokers) > 0 {
+		lagReader, err := lag.NewReader(settings.KafkaBrokers)
+		if err != nil {
+			log.Fatal("failed to connect to kafka", "error", err)
+		}
+		defer lagReader.Close()
+
+		log.Info("starting lag service")
+		lagService := services.NewLagService(lagReader, retriever, updater, 30*time.Second)
+		group.Go(lagService.Run(ctx))
+	}
+
 	group.Go(graphService.Run(ctx))
 
-	eventChan := make(chan os.Signal)
+	eventChan := make(chan os.Signal, 1)
 	signal.Notify(eventChan, syscall.SIGINT, syscall.SIGTERM)
 
 	select {
@@ -17,6 +17,7 @@ type settings struct {
 	KubernetesConfig *rest.Config
 	DatabaseSettings *database.PostgresDatabaseSettings
 	ShouldScan       bool
+	KafkaBrokers     []string
 }
 
 func getSettings() (*settings, error) {
@@ -42,6 +43,12 @@ func getSettings() (*settings, error) {
 		shouldScan = false
 	}
 
+	kafkaBrokers := []string{}
+	brokers, ok := os.LookupEnv("KAFKA_BROKERS")
+	if ok && brokers != "" {
+		kafkaBrokers = strings.Split(brokers, ",")
+	}
+
 	errors := []string{}
 
 	ds := &database.PostgresDatabaseSettings{}
@@ -73,6 +80,7 @@ func getSettings() (*settings, error) {
 		KubernetesConfig: config,
 		DatabaseSettings: ds,
 		ShouldScan:       shouldScan,
+		KafkaBrokers:     kafkaBrokers,
 	}, nil
 }
 
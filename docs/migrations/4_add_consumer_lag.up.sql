@@ -0,0 +1,32 @@
+ALTER TABLE sinks ADD COLUMN group_name VARCHAR NOT NULL DEFAULT '';
+
+CREATE TABLE consumer_lags (
+    id                  SERIAL PRIMARY KEY,
+    group_name          VARCHAR NOT NULL,
+    topic               INT REFERENCES topics(id) NOT NULL,
+    partition           INT NOT NULL,
+    committed_offset    BIGINT NOT NULL,
+    high_watermark      BIGINT NOT NULL,
+    lag                 BIGINT NOT NULL,
+    updated             TIMESTAMP NOT NULL,
+    UNIQUE(group_name, topic, partition)
+);
+
+CREATE VIEW consumer_group_topics AS
+    select
+        processors.group_name,
+        processor_inputs.topic
+    from
+        processors
+    inner join
+        processor_inputs on processor_inputs.processor=processors.id
+
+    union
+
+    select
+        sinks.group_name,
+        sinks.topic
+    from
+        sinks
+    where
+        sinks.group_name != '';
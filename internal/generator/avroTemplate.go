@@ -0,0 +1,307 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/hamba/avro"
+	"github.com/iancoleman/strcase"
+	"github.com/pkg/errors"
+)
+
+var (
+	avroTemplate = template.Must(template.New("").Parse(`// Code generated by kafmesh-gen. DO NOT EDIT.
+// source: {{ .Source }}
+
+package {{ .Package }}
+{{- if .Imports }}
+
+import (
+{{- range .Imports }}
+	"{{ . }}"
+{{- end }}
+)
+{{- end }}
+{{ range .Records }}
+// {{ .Name }} is the avro record '{{ .FullName }}'
+type {{ .Name }} struct {
+{{- range .Fields }}
+	{{ .Name }} {{ .Type }} ` + "`" + `avro:"{{ .AvroName }}" json:"{{ .AvroName }}"` + "`" + `
+{{- end }}
+}
+{{ end }}
+// AvroSchema returns the avro schema of the record
+func (*{{ .Root }}) AvroSchema() string {
+	return {{ printf "%q" .Schema }}
+}
+`))
+)
+
+type avroField struct {
+	Name     string
+	AvroName string
+	Type     string
+}
+
+type avroRecord struct {
+	Name     string
+	FullName string
+	Fields   []avroField
+}
+
+type avroOptions struct {
+	Source  string
+	Package string
+	Imports []string
+	Root    string
+	Schema  string
+	Records []avroRecord
+}
+
+func generateAvroModel(writer io.Writer, options *avroOptions) error {
+	var buf bytes.Buffer
+	err := avroTemplate.Execute(&buf, options)
+	if err != nil {
+		return errors.Wrap(err, "failed to execute avro template")
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return errors.Wrap(err, "failed to format avro model")
+	}
+
+	_, err = writer.Write(src)
+	if err != nil {
+		return errors.Wrap(err, "failed to write avro model")
+	}
+	return nil
+}
+
+// Avro generates go types for the avro schema files
+func Avro(files []file, output string) error {
+	for _, f := range files {
+		r, err := filepath.Rel(f.root, f.path)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get relative path for '%s'", f.path)
+		}
+
+		contents, err := ioutil.ReadFile(f.path)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read avro schema '%s'", f.path)
+		}
+
+		options, err := buildAvroOptions(filepath.ToSlash(r), string(contents))
+		if err != nil {
+			return errors.Wrapf(err, "failed to build avro options for '%s'", f.path)
+		}
+
+		outputDir := filepath.Join(output, filepath.Dir(r))
+		err = os.MkdirAll(outputDir, os.ModePerm)
+		if err != nil {
+			return errors.Wrap(err, "failed to create avro output path")
+		}
+
+		name := strings.TrimSuffix(filepath.Base(r), filepath.Ext(r))
+		out, err := os.Create(filepath.Join(outputDir, fmt.Sprintf("%s.avro.go", name)))
+		if err != nil {
+			return errors.Wrap(err, "failed to open avro model file")
+		}
+
+		err = generateAvroModel(out, options)
+		if err != nil {
+			out.Close()
+			return errors.Wrapf(err, "failed to generate avro model for '%s'", f.path)
+		}
+
+		err = out.Close()
+		if err != nil {
+			return errors.Wrap(err, "failed to close avro model file")
+		}
+	}
+
+	return nil
+}
+
+func buildAvroOptions(source string, contents string) (*avroOptions, error) {
+	schema, err := avro.Parse(contents)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse avro schema")
+	}
+
+	root, ok := schema.(*avro.RecordSchema)
+	if !ok {
+		return nil, errors.Errorf("avro schema must be a record but is '%s'", schema.Type())
+	}
+
+	pkg := filepath.Base(filepath.Dir(source))
+	if pkg == "." {
+		return nil, errors.Errorf("avro schema '%s' must be in a package directory", source)
+	}
+
+	b := &avroTypeBuilder{
+		seen:    map[string]struct{}{},
+		imports: map[string]struct{}{},
+	}
+
+	rootName, err := b.record(root)
+	if err != nil {
+		return nil, err
+	}
+
+	// components refer to the message by the schema file name so the record must have the same name
+	message := strings.TrimSuffix(filepath.Base(source), filepath.Ext(source))
+	if rootName != strcase.ToCamel(message) {
+		return nil, errors.Errorf("avro record '%s' must be named after its schema file '%s'", root.FullName(), filepath.Base(source))
+	}
+
+	var compacted bytes.Buffer
+	err = json.Compact(&compacted, []byte(contents))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compact avro schema")
+	}
+
+	options := &avroOptions{
+		Source:  source,
+		Package: pkg,
+		Root:    rootName,
+		Schema:  compacted.String(),
+		Records: b.records,
+	}
+
+	for i := range b.imports {
+		options.Imports = append(options.Imports, i)
+	}
+	sort.Strings(options.Imports)
+
+	return options, nil
+}
+
+type avroTypeBuilder struct {
+	records []avroRecord
+	seen    map[string]struct{}
+	imports map[string]struct{}
+}
+
+func (b *avroTypeBuilder) record(schema *avro.RecordSchema) (string, error) {
+	name := strcase.ToCamel(schema.Name())
+	_, ok := b.seen[schema.FullName()]
+	if ok {
+		return name, nil
+	}
+	b.seen[schema.FullName()] = struct{}{}
+
+	index := len(b.records)
+	b.records = append(b.records, avroRecord{
+		Name:     name,
+		FullName: schema.FullName(),
+	})
+
+	fields := []avroField{}
+	for _, f := range schema.Fields() {
+		t, err := b.goType(f.Type())
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to get type of field '%s' in '%s'", f.Name(), schema.FullName())
+		}
+
+		fields = append(fields, avroField{
+			Name:     strcase.ToCamel(f.Name()),
+			AvroName: f.Name(),
+			Type:     t,
+		})
+	}
+	b.records[index].Fields = fields
+
+	return name, nil
+}
+
+func (b *avroTypeBuilder) goType(schema avro.Schema) (string, error) {
+	switch s := schema.(type) {
+	case *avro.PrimitiveSchema:
+		return b.primitive(s)
+
+	case *avro.RecordSchema:
+		return b.record(s)
+
+	case *avro.RefSchema:
+		return b.goType(s.Schema())
+
+	case *avro.EnumSchema:
+		return "string", nil
+
+	case *avro.FixedSchema:
+		return fmt.Sprintf("[%d]byte", s.Size()), nil
+
+	case *avro.ArraySchema:
+		t, err := b.goType(s.Items())
+		if err != nil {
+			return "", err
+		}
+		return "[]" + t, nil
+
+	case *avro.MapSchema:
+		t, err := b.goType(s.Values())
+		if err != nil {
+			return "", err
+		}
+		return "map[string]" + t, nil
+
+	case *avro.UnionSchema:
+		if !s.Nullable() {
+			return "", errors.Errorf("only unions of null and a single type are supported")
+		}
+
+		_, typ := s.Indices()
+		t, err := b.goType(s.Types()[typ])
+		if err != nil {
+			return "", err
+		}
+
+		if strings.HasPrefix(t, "[]") || strings.HasPrefix(t, "map[") {
+			return t, nil
+		}
+		return "*" + t, nil
+	}
+
+	return "", errors.Errorf("unsupported avro type '%s'", schema.Type())
+}
+
+func (b *avroTypeBuilder) primitive(schema *avro.PrimitiveSchema) (string, error) {
+	if l := schema.Logical(); l != nil {
+		switch l.Type() {
+		case avro.TimestampMillis, avro.TimestampMicros, avro.Date:
+			b.imports["time"] = struct{}{}
+			return "time.Time", nil
+		case avro.TimeMillis, avro.TimeMicros:
+			b.imports["time"] = struct{}{}
+			return "time.Duration", nil
+		}
+	}
+
+	switch schema.Type() {
+	case avro.Boolean:
+		return "bool", nil
+	case avro.Int:
+		return "int32", nil
+	case avro.Long:
+		return "int64", nil
+	case avro.Float:
+		return "float32", nil
+	case avro.Double:
+		return "float64", nil
+	case avro.Bytes:
+		return "[]byte", nil
+	case avro.String:
+		return "string", nil
+	}
+
+	return "", errors.Errorf("unsupported avro primitive '%s'", schema.Type())
+}
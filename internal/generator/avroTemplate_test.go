@@ -0,0 +1,309 @@
+package generator_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/syncromatics/kafmesh/internal/generator"
+	"github.com/syncromatics/kafmesh/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Generator_Avro(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "Test_Generator_Avro")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	ioutil.WriteFile(path.Join(tmpDir, "go.mod"), []byte(`module test
+
+go 1.16`), os.ModePerm)
+
+	schemaDir := path.Join(tmpDir, "avro", "testMesh", "testSerial")
+	err = os.MkdirAll(schemaDir, os.ModePerm)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ioutil.WriteFile(path.Join(schemaDir, "position.avsc"), []byte(`{
+	"type": "record",
+	"name": "Position",
+	"namespace": "testMesh.testSerial",
+	"fields": [
+		{ "name": "serial", "type": "string" },
+		{ "name": "time", "type": { "type": "long", "logicalType": "timestamp-millis" } },
+		{ "name": "speed", "type": ["null", "double"], "default": null },
+		{ "name": "tags", "type": { "type": "array", "items": "string" } },
+		{ "name": "location", "type": {
+			"type": "record",
+			"name": "Location",
+			"fields": [
+				{ "name": "latitude", "type": "double" },
+				{ "name": "longitude", "type": "double" }
+			]
+		} }
+	]
+}`), os.ModePerm)
+
+	newPath := path.Join(tmpDir, "defin")
+	options := generator.Options{
+		Service: &models.Service{
+			Name: "testMesh",
+			Output: models.OutputSettings{
+				Path:    "internal/kafmesh",
+				Package: "kafmesh",
+				Module:  "test",
+			},
+			Messages: models.MessageDefinitions{
+				Avro: []string{
+					"../avro",
+				},
+			},
+			Defaults: models.TopicDefaults{
+				Partition:   10,
+				Replication: 1,
+				Retention:   24 * time.Hour,
+				Segment:     12 * time.Hour,
+				Type:        "avro",
+			},
+		},
+		RootPath:        newPath,
+		DefinitionsPath: newPath,
+		Components: []*models.Component{
+			&models.Component{
+				Name: "positions",
+				Sinks: []models.Sink{
+					models.Sink{
+						Name: "Position Warehouse",
+						TopicDefinition: models.TopicDefinition{
+							Message: "testSerial.position",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	err = generator.Generate(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := ioutil.ReadFile(path.Join(newPath, "internal", "kafmesh", "models", "testMesh", "testSerial", "position.avro.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, expectedAvroModel, string(s))
+
+	s, err = ioutil.ReadFile(path.Join(newPath, "internal", "kafmesh", "positions", "position_warehouse_sink.km.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, expectedAvroSink, string(s))
+}
+
+func Test_Generator_AvroRecordNamedAfterFile(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "Test_Generator_AvroRecordNamedAfterFile")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	schemaDir := path.Join(tmpDir, "avro", "testMesh", "testSerial")
+	err = os.MkdirAll(schemaDir, os.ModePerm)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ioutil.WriteFile(path.Join(schemaDir, "position.avsc"), []byte(`{
+	"type": "record",
+	"name": "VehiclePosition",
+	"namespace": "testMesh.testSerial",
+	"fields": [
+		{ "name": "serial", "type": "string" }
+	]
+}`), os.ModePerm)
+
+	newPath := path.Join(tmpDir, "defin")
+	err = generator.Generate(generator.Options{
+		Service: &models.Service{
+			Name: "testMesh",
+			Output: models.OutputSettings{
+				Path:    "internal/kafmesh",
+				Package: "kafmesh",
+				Module:  "test",
+			},
+			Messages: models.MessageDefinitions{
+				Avro: []string{
+					"../avro",
+				},
+			},
+		},
+		RootPath:        newPath,
+		DefinitionsPath: newPath,
+	})
+
+	assert.EqualError(t, err, "failed to generate avro models: failed to build avro options for '"+
+		path.Join(schemaDir, "position.avsc")+"': avro record 'testMesh.testSerial.VehiclePosition' must be named after its schema file 'position.avsc'")
+}
+
+var (
+	expectedAvroModel = `// Code generated by kafmesh-gen. DO NOT EDIT.
+// source: testMesh/testSerial/position.avsc
+
+package testSerial
+
+import (
+	"time"
+)
+
+// Position is the avro record 'testMesh.testSerial.Position'
+type Position struct {
+	Serial   string    ` + "`" + `avro:"serial" json:"serial"` + "`" + `
+	Time     time.Time ` + "`" + `avro:"time" json:"time"` + "`" + `
+	Speed    *float64  ` + "`" + `avro:"speed" json:"speed"` + "`" + `
+	Tags     []string  ` + "`" + `avro:"tags" json:"tags"` + "`" + `
+	Location Location  ` + "`" + `avro:"location" json:"location"` + "`" + `
+}
+
+// Location is the avro record 'testMesh.testSerial.Location'
+type Location struct {
+	Latitude  float64 ` + "`" + `avro:"latitude" json:"latitude"` + "`" + `
+	Longitude float64 ` + "`" + `avro:"longitude" json:"longitude"` + "`" + `
+}
+
+// AvroSchema returns the avro schema of the record
+func (*Position) AvroSchema() string {
+	return "{\"type\":\"record\",\"name\":\"Position\",\"namespace\":\"testMesh.testSerial\",\"fields\":[{\"name\":\"serial\",\"type\":\"string\"},{\"name\":\"time\",\"type\":{\"type\":\"long\",\"logicalType\":\"timestamp-millis\"}},{\"name\":\"speed\",\"type\":[\"null\",\"double\"],\"default\":null},{\"name\":\"tags\",\"type\":{\"type\":\"array\",\"items\":\"string\"}},{\"name\":\"location\",\"type\":{\"type\":\"record\",\"name\":\"Location\",\"fields\":[{\"name\":\"latitude\",\"type\":\"double\"},{\"name\":\"longitude\",\"type\":\"double\"}]}}]}"
+}
+`
+
+	expectedAvroSink = `// Code generated by kafmesh-gen. DO NOT EDIT.
+
+package positions
+
+import (
+	"context"
+	"time"
+
+	"github.com/lovoo/goka"
+	"github.com/pkg/errors"
+
+	"github.com/syncromatics/kafmesh/pkg/runner"
+
+	"test/internal/kafmesh/models/testMesh/testSerial"
+)
+
+type PositionWarehouse_Sink interface {
+	Flush() error
+	Collect(ctx runner.MessageContext, key string, msg *testSerial.Position) error
+}
+
+type impl_PositionWarehouse_Sink struct {
+	sink PositionWarehouse_Sink
+	codec goka.Codec
+	group string
+	topic string
+	maxBufferSize int
+	interval time.Duration
+	retryPolicy runner.RetryPolicy
+	readCommitted bool
+}
+
+func (s *impl_PositionWarehouse_Sink) Codec() goka.Codec {
+	return s.codec
+}
+
+func (s *impl_PositionWarehouse_Sink) Group() string {
+	return s.group
+}
+
+func (s *impl_PositionWarehouse_Sink) Topic() string {
+	return s.topic
+}
+
+func (s *impl_PositionWarehouse_Sink) MaxBufferSize() int {
+	return s.maxBufferSize
+}
+
+func (s *impl_PositionWarehouse_Sink) Interval() time.Duration {
+	return s.interval
+}
+
+func (s *impl_PositionWarehouse_Sink) RetryPolicy() runner.RetryPolicy {
+	return s.retryPolicy
+}
+
+func (s *impl_PositionWarehouse_Sink) ReadCommitted() bool {
+	return s.readCommitted
+}
+
+func (s *impl_PositionWarehouse_Sink) Flush() error {
+	return s.sink.Flush()
+}
+
+func (s *impl_PositionWarehouse_Sink) Collect(ctx runner.MessageContext, key string, msg interface{}) error {
+	m, ok := msg.(*testSerial.Position)
+	if !ok {
+		return errors.Errorf("expecting message of type '*testSerial.Position' got type '%t'", msg)
+	}
+
+	return s.sink.Collect(ctx, key, m)
+}
+
+func (s *impl_PositionWarehouse_Sink) PartitionsAssigned(partitions []int32) error {
+	listener, ok := s.sink.(runner.SinkPartitionListener)
+	if !ok {
+		return nil
+	}
+
+	return listener.PartitionsAssigned(partitions)
+}
+
+func (s *impl_PositionWarehouse_Sink) PartitionsRevoked(partitions []int32) error {
+	listener, ok := s.sink.(runner.SinkPartitionListener)
+	if !ok {
+		return nil
+	}
+
+	return listener.PartitionsRevoked(partitions)
+}
+
+func Register_PositionWarehouse_Sink(options runner.ServiceOptions, sink PositionWarehouse_Sink, interval time.Duration, maxBufferSize int, retryPolicy runner.RetryPolicy) (func(ctx context.Context) func() error, error) {
+	brokers := options.Brokers
+	avroWrapper := options.AvroWrapper
+
+	codec, err := avroWrapper.Codec("testMesh.testSerial.position", &testSerial.Position{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create codec")
+	}
+
+	d := &impl_PositionWarehouse_Sink{
+		sink: sink,
+		codec: codec,
+		group: "testMesh.positions.positionwarehouse-sink",
+		topic: "testMesh.testSerial.position",
+		maxBufferSize: maxBufferSize,
+		interval: interval,
+		retryPolicy: retryPolicy,
+		readCommitted: false,
+	}
+
+	s := runner.NewSinkRunner(d, brokers,
+		runner.WithSinkWatch(options.SinkWatch("positions", "Position Warehouse")),
+		runner.WithSinkTracing(options.SinkTracing("positions", "Position Warehouse")),
+		runner.WithSinkMetrics(options.Metrics.Sink("testMesh", "positions", "Position Warehouse")),
+		runner.WithSinkTester(options.Tester()),
+	)
+
+	return func(ctx context.Context) func() error {
+		return s.Run(ctx)
+	}, nil
+}
+`
+)
@@ -0,0 +1,37 @@
+package generator
+
+import (
+	"github.com/pkg/errors"
+	"github.com/syncromatics/kafmesh/internal/models"
+)
+
+type codecWrapper struct {
+	Name   string
+	Option string
+	Kind   string
+}
+
+var (
+	protoCodecWrapper = codecWrapper{
+		Name:   "protoWrapper",
+		Option: "ProtoWrapper",
+		Kind:   "Proto",
+	}
+	avroCodecWrapper = codecWrapper{
+		Name:   "avroWrapper",
+		Option: "AvroWrapper",
+		Kind:   "Avro",
+	}
+)
+
+func buildCodecWrapper(service *models.Service, topic models.TopicDefinition) (codecWrapper, error) {
+	messageType := topic.ToSerializationType(service)
+	switch messageType {
+	case "protobuf":
+		return protoCodecWrapper, nil
+	case "avro":
+		return avroCodecWrapper, nil
+	default:
+		return codecWrapper{}, errors.Errorf("unknown message type '%s'", messageType)
+	}
+}
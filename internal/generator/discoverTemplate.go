@@ -86,6 +86,29 @@ func discover_{{ .MethodName }}(service *runner.Service) error {
 				Type: {{ .Persistence.Type }},
 			},
 		},
+{{- end }}
+{{- if .DeadLetter }}
+		DeadLetter: &runner.DeadLetterDiscovery{
+			TopicDiscovery: runner.TopicDiscovery{
+				Message: "{{ .DeadLetter.Message }}",
+				Topic: "{{ .DeadLetter.Topic }}",
+				Type: {{ .DeadLetter.Type }},
+			},
+		},
+{{- end }}
+{{- if .StreamJoins }}
+		StreamJoins: []runner.StreamJoinDiscovery{
+{{- range .StreamJoins }}
+			{
+				TopicDiscovery: runner.TopicDiscovery{
+					Message: "{{ .Message }}",
+					Topic: "{{ .Topic }}",
+					Type: {{ .Type }},
+				},
+				Changelog: "{{ .Changelog }}",
+			},
+{{- end }}
+		},
 {{- end }}
 	}
 
@@ -135,6 +158,7 @@ func discover_{{ .MethodName }}(service *runner.Service) error {
 		},
 		Name: "{{ .Name }}",
 		Description: "{{ .Description }}",
+		GroupName: "{{ .GroupName }}",
 	}
 
 	return service.RegisterSink(sink)
@@ -237,6 +261,7 @@ type sinkDiscoveryOptions struct {
 	MethodName  string
 	Name        string
 	Description string
+	GroupName   string
 }
 
 type processorDiscoveryOptions struct {
@@ -251,6 +276,8 @@ type processorDiscoveryOptions struct {
 	Lookups     []runner.LookupDiscovery
 	Outputs     []runner.OutputDiscovery
 	Persistence *runner.PersistentDiscovery
+	DeadLetter  *runner.DeadLetterDiscovery
+	StreamJoins []runner.StreamJoinDiscovery
 }
 
 type viewDiscoveryOptions struct {
@@ -347,6 +374,29 @@ func generateDiscover(writer io.Writer, service *models.Service, components []*m
 				})
 			}
 
+			for _, join := range processor.StreamJoins {
+				t, err := getDiscoveryTopicType(service, join.Type)
+				if err != nil {
+					return errors.Wrapf(err, "failed getting message type for stream join '%s'", join.Message)
+				}
+				proc.Joins = append(proc.Joins, runner.JoinDiscovery{
+					TopicDiscovery: runner.TopicDiscovery{
+						Message: join.ToFullMessageType(service),
+						Topic:   join.ToTopicName(service),
+						Type:    t,
+					},
+				})
+
+				proc.StreamJoins = append(proc.StreamJoins, runner.StreamJoinDiscovery{
+					TopicDiscovery: runner.TopicDiscovery{
+						Message: join.ToFullMessageType(service),
+						Topic:   join.ToTopicName(service),
+						Type:    t,
+					},
+					Changelog: join.ChangelogName(service, component, &processor),
+				})
+			}
+
 			for _, lookup := range processor.Lookups {
 				t, err := getDiscoveryTopicType(service, lookup.Type)
 				if err != nil {
@@ -390,8 +440,105 @@ func generateDiscover(writer io.Writer, service *models.Service, components []*m
 				}
 			}
 
+			if processor.DeadLetter != nil {
+				deadLetter, err := getDeadLetterDiscovery(service, component, &processor)
+				if err != nil {
+					return errors.Wrapf(err, "failed getting dead letter of processor '%s'", processor.Name)
+				}
+
+				proc.DeadLetter = deadLetter
+			}
+
+			c.Processors = append(c.Processors, proc)
+
+		}
+
+		for _, window := range component.Windows {
+			proc := processorDiscoveryOptions{
+				Service:     s,
+				Component:   com,
+				Name:        window.Name,
+				Description: window.Description,
+				GroupName:   window.GroupName(service, component),
+				MethodName:  fmt.Sprintf("%s_%s_WindowedProcessor", component.ToSafeName(), window.ToSafeName()),
+			}
+
+			for _, input := range window.Inputs {
+				t, err := getDiscoveryTopicType(service, input.Type)
+				if err != nil {
+					return errors.Wrapf(err, "failed getting message type for input '%s'", input.Message)
+				}
+				proc.Inputs = append(proc.Inputs, runner.InputDiscovery{
+					TopicDiscovery: runner.TopicDiscovery{
+						Message: input.ToFullMessageType(service),
+						Topic:   input.ToTopicName(service),
+						Type:    t,
+					},
+				})
+			}
+
+			for _, output := range window.Outputs {
+				t, err := getDiscoveryTopicType(service, output.Type)
+				if err != nil {
+					return errors.Wrapf(err, "failed getting message type for output '%s'", output.Message)
+				}
+				proc.Outputs = append(proc.Outputs, runner.OutputDiscovery{
+					TopicDiscovery: runner.TopicDiscovery{
+						Message: output.ToFullMessageType(service),
+						Topic:   output.ToTopicName(service),
+						Type:    t,
+					},
+				})
+			}
+
+			t, err := getDiscoveryTopicType(service, window.Aggregate.Type)
+			if err != nil {
+				return errors.Wrapf(err, "failed getting message type for aggregate '%s'", window.Aggregate.Message)
+			}
+
+			proc.Persistence = &runner.PersistentDiscovery{
+				TopicDiscovery: runner.TopicDiscovery{
+					Message: window.Aggregate.ToFullMessageType(service),
+					Topic:   window.TableName(service, component),
+					Type:    t,
+				},
+			}
+
 			c.Processors = append(c.Processors, proc)
+		}
 
+		for _, repartition := range component.Repartition {
+			t, err := getDiscoveryTopicType(service, repartition.Type)
+			if err != nil {
+				return errors.Wrapf(err, "failed getting message type for repartition '%s'", repartition.Message)
+			}
+
+			c.Processors = append(c.Processors, processorDiscoveryOptions{
+				Service:     s,
+				Component:   com,
+				Name:        repartition.Name,
+				Description: repartition.Description,
+				GroupName:   repartition.GroupName(service, component),
+				MethodName:  fmt.Sprintf("%s_%s_Repartition", component.ToSafeName(), repartition.ToSafeName()),
+				Inputs: []runner.InputDiscovery{
+					{
+						TopicDiscovery: runner.TopicDiscovery{
+							Message: repartition.ToFullMessageType(service),
+							Topic:   repartition.ToTopicName(service),
+							Type:    t,
+						},
+					},
+				},
+				Outputs: []runner.OutputDiscovery{
+					{
+						TopicDiscovery: runner.TopicDiscovery{
+							Message: repartition.ToFullMessageType(service),
+							Topic:   repartition.RepartitionTopicName(service, component),
+							Type:    t,
+						},
+					},
+				},
+			})
 		}
 
 		for _, source := range component.Sources {
@@ -429,6 +576,7 @@ func generateDiscover(writer io.Writer, service *models.Service, components []*m
 				MethodName:  fmt.Sprintf("%s_%s_Sink", component.ToSafeName(), sink.ToSafeName()),
 				Name:        sink.Name,
 				Description: sink.Description,
+				GroupName:   sink.GroupName(service, component),
 			})
 		}
 
@@ -499,6 +647,39 @@ func generateDiscover(writer io.Writer, service *models.Service, components []*m
 	return nil
 }
 
+// getDeadLetterDiscovery describes the dead letter topic with the inputs of the processor, since failed
+// messages are sent to it as they were received. The message is only set if all inputs have the same one.
+func getDeadLetterDiscovery(service *models.Service, component *models.Component, processor *models.Processor) (*runner.DeadLetterDiscovery, error) {
+	deadLetter := &runner.DeadLetterDiscovery{
+		TopicDiscovery: runner.TopicDiscovery{
+			Topic: processor.DeadLetterTopicName(service, component),
+		},
+	}
+
+	for i, input := range processor.Inputs {
+		t, err := getDiscoveryTopicType(service, input.Type)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed getting message type for input '%s'", input.Message)
+		}
+
+		message := input.ToFullMessageType(service)
+		if i == 0 {
+			deadLetter.Type = t
+			deadLetter.Message = message
+			continue
+		}
+
+		if t != deadLetter.Type {
+			return nil, errors.Errorf("inputs of dead letter topic '%s' must have the same message type", deadLetter.Topic)
+		}
+		if message != deadLetter.Message {
+			deadLetter.Message = ""
+		}
+	}
+
+	return deadLetter, nil
+}
+
 func getDiscoveryTopicType(service *models.Service, t *string) (runner.MessageType, error) {
 	messageType := service.Defaults.Type
 	if t != nil {
@@ -511,6 +692,8 @@ func getDiscoveryTopicType(service *models.Service, t *string) (runner.MessageTy
 	switch messageType {
 	case "protobuf":
 		return runner.MessageTypeProtobuf, nil
+	case "avro":
+		return runner.MessageTypeAvro, nil
 	default:
 		return -1, errors.Errorf("unknown message type '%s'", messageType)
 
@@ -0,0 +1,539 @@
+package generator
+
+import (
+	"bytes"
+	"go/format"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/iancoleman/strcase"
+	"github.com/pkg/errors"
+)
+
+var (
+	fakeFuncs = template.FuncMap{
+		"lowerCamel": strcase.ToLowerCamel,
+	}
+
+	processorFakeTemplate = template.Must(template.New("").Funcs(fakeFuncs).Parse(`// Code generated by kafmesh-gen. DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+	"time"
+
+	"github.com/syncromatics/kafmesh/pkg/runner"
+{{ range .Imports }}
+	{{ . }}
+{{- end }}
+)
+
+{{ with .Context -}}
+{{ $c := .Name -}}
+// {{ $c }}_ProcessorContext_Fake records what the processor does with its context
+type {{ $c }}_ProcessorContext_Fake struct {
+	key       string
+	timestamp time.Time
+	outputs   []runner.FakeOutput
+{{- if $.Timers }}
+	timers    []runner.FakeTimer
+{{- end }}
+{{- range .Methods }}
+{{- if eq .Type "lookup" }}
+	{{ lowerCamel .Name }} map[string]*{{ .MessageType }}
+{{- else if eq .Type "join" }}
+	{{ lowerCamel .Name }} *{{ .MessageType }}
+{{- else if eq .Type "streamJoin" }}
+	{{ lowerCamel .Name }} map[string][]*{{ .MessageType }}
+{{- else if eq .Type "state" }}
+	state *{{ .MessageType }}
+{{- end }}
+{{- end }}
+}
+
+// New_{{ $c }}_ProcessorContext_Fake creates a fake context for a message with the key and timestamp
+func New_{{ $c }}_ProcessorContext_Fake(key string, timestamp time.Time) *{{ $c }}_ProcessorContext_Fake {
+	return &{{ $c }}_ProcessorContext_Fake{
+		key:       key,
+		timestamp: timestamp,
+{{- range .Methods }}
+{{- if eq .Type "lookup" }}
+		{{ lowerCamel .Name }}: map[string]*{{ .MessageType }}{},
+{{- else if eq .Type "streamJoin" }}
+		{{ lowerCamel .Name }}: map[string][]*{{ .MessageType }}{},
+{{- end }}
+{{- end }}
+	}
+}
+
+func (c *{{ $c }}_ProcessorContext_Fake) Key() string {
+	return c.key
+}
+
+func (c *{{ $c }}_ProcessorContext_Fake) Timestamp() time.Time {
+	return c.timestamp
+}
+
+// SetKey sets the key of the next message handled with the context
+func (c *{{ $c }}_ProcessorContext_Fake) SetKey(key string) {
+	c.key = key
+}
+
+// SetTimestamp sets the timestamp of the next message handled with the context
+func (c *{{ $c }}_ProcessorContext_Fake) SetTimestamp(timestamp time.Time) {
+	c.timestamp = timestamp
+}
+{{ range .Methods }}
+{{- if eq .Type "lookup" }}
+func (c *{{ $c }}_ProcessorContext_Fake) {{ .Name }}(key string) *{{ .MessageType }} {
+	return c.{{ lowerCamel .Name }}[key]
+}
+
+// Set{{ .Name }} sets the message {{ .Name }} returns for the key
+func (c *{{ $c }}_ProcessorContext_Fake) Set{{ .Name }}(key string, message *{{ .MessageType }}) {
+	c.{{ lowerCamel .Name }}[key] = message
+}
+{{ else if eq .Type "join" }}
+func (c *{{ $c }}_ProcessorContext_Fake) {{ .Name }}() *{{ .MessageType }} {
+	return c.{{ lowerCamel .Name }}
+}
+
+// Set{{ .Name }} sets the message {{ .Name }} returns
+func (c *{{ $c }}_ProcessorContext_Fake) Set{{ .Name }}(message *{{ .MessageType }}) {
+	c.{{ lowerCamel .Name }} = message
+}
+{{ else if eq .Type "streamJoin" }}
+func (c *{{ $c }}_ProcessorContext_Fake) {{ .Name }}(key string) []*{{ .MessageType }} {
+	return c.{{ lowerCamel .Name }}[key]
+}
+
+// Set{{ .Name }} sets the messages {{ .Name }} returns for the key
+func (c *{{ $c }}_ProcessorContext_Fake) Set{{ .Name }}(key string, messages []*{{ .MessageType }}) {
+	c.{{ lowerCamel .Name }}[key] = messages
+}
+{{ else if eq .Type "output" }}
+func (c *{{ $c }}_ProcessorContext_Fake) {{ .Name }}(key string, message *{{ .MessageType }}) {
+	c.outputs = append(c.outputs, runner.FakeOutput{Topic: "{{ .Topic }}", Key: key, Message: message})
+}
+{{ else if eq .Type "save" }}
+func (c *{{ $c }}_ProcessorContext_Fake) SaveState(state *{{ .MessageType }}) {
+	c.state = state
+}
+{{ else if eq .Type "state" }}
+func (c *{{ $c }}_ProcessorContext_Fake) State() *{{ .MessageType }} {
+	if c.state == nil {
+		return &{{ .MessageType }}{}
+	}
+
+	return c.state
+}
+
+// SetState sets the state State returns until the processor saves another
+func (c *{{ $c }}_ProcessorContext_Fake) SetState(state *{{ .MessageType }}) {
+	c.state = state
+}
+{{ end }}
+{{- end }}
+{{- if $.Timers }}
+func (c *{{ $c }}_ProcessorContext_Fake) Schedule(key string, at time.Time) {
+	c.timers = append(c.timers, runner.FakeTimer{Key: key, At: at})
+}
+
+// Timers gets the timers the processor scheduled in order
+func (c *{{ $c }}_ProcessorContext_Fake) Timers() []runner.FakeTimer {
+	return c.timers
+}
+{{ end }}
+// Outputs gets the messages the processor output in order
+func (c *{{ $c }}_ProcessorContext_Fake) Outputs() []runner.FakeOutput {
+	return c.outputs
+}
+
+var _ {{ $c }}_ProcessorContext = &{{ $c }}_ProcessorContext_Fake{}
+{{- end }}
+`))
+
+	windowFakeTemplate = template.Must(template.New("").Parse(`// Code generated by kafmesh-gen. DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+	"time"
+
+	"github.com/syncromatics/kafmesh/pkg/runner"
+{{ range .Imports }}
+	{{ . }}
+{{- end }}
+)
+
+{{ $c := .Name -}}
+// {{ $c }}_WindowContext_Fake records what the windowed processor outputs with its context
+type {{ $c }}_WindowContext_Fake struct {
+	key       string
+	timestamp time.Time
+	outputs   []runner.FakeOutput
+}
+
+// New_{{ $c }}_WindowContext_Fake creates a fake context for a message with the key and timestamp
+func New_{{ $c }}_WindowContext_Fake(key string, timestamp time.Time) *{{ $c }}_WindowContext_Fake {
+	return &{{ $c }}_WindowContext_Fake{
+		key:       key,
+		timestamp: timestamp,
+	}
+}
+
+func (c *{{ $c }}_WindowContext_Fake) Key() string {
+	return c.key
+}
+
+func (c *{{ $c }}_WindowContext_Fake) Timestamp() time.Time {
+	return c.timestamp
+}
+
+// SetKey sets the key of the next message handled with the context
+func (c *{{ $c }}_WindowContext_Fake) SetKey(key string) {
+	c.key = key
+}
+
+// SetTimestamp sets the timestamp of the next message handled with the context
+func (c *{{ $c }}_WindowContext_Fake) SetTimestamp(timestamp time.Time) {
+	c.timestamp = timestamp
+}
+{{ range .Outputs }}
+func (c *{{ $c }}_WindowContext_Fake) {{ .Func }}(key string, message *{{ .Message }}) {
+	c.outputs = append(c.outputs, runner.FakeOutput{Topic: "{{ .Topic }}", Key: key, Message: message})
+}
+{{ end }}
+// Outputs gets the messages the windowed processor output in order
+func (c *{{ $c }}_WindowContext_Fake) Outputs() []runner.FakeOutput {
+	return c.outputs
+}
+
+var _ {{ $c }}_WindowContext = &{{ $c }}_WindowContext_Fake{}
+`))
+
+	sourceFakeTemplate = template.Must(template.New("").Parse(`// Code generated by kafmesh-gen. DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+	"context"
+	"sync"
+)
+
+// {{ .Name }}_Source_Fake records the messages emitted to the source
+type {{ .Name }}_Source_Fake struct {
+	mtx      sync.Mutex
+	err      error
+	messages []{{ .Name }}_Source_Message
+}
+
+// New_{{ .Name }}_Source_Fake creates a fake source
+func New_{{ .Name }}_Source_Fake() *{{ .Name }}_Source_Fake {
+	return &{{ .Name }}_Source_Fake{}
+}
+
+func (s *{{ .Name }}_Source_Fake) Emit(message {{ .Name }}_Source_Message) error {
+	return s.EmitContext(context.Background(), message)
+}
+
+func (s *{{ .Name }}_Source_Fake) EmitContext(ctx context.Context, message {{ .Name }}_Source_Message) error {
+	return s.EmitBulk(ctx, []{{ .Name }}_Source_Message{message})
+}
+
+func (s *{{ .Name }}_Source_Fake) EmitBulk(ctx context.Context, messages []{{ .Name }}_Source_Message) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.err != nil {
+		return s.err
+	}
+
+	s.messages = append(s.messages, messages...)
+	return nil
+}
+
+func (s *{{ .Name }}_Source_Fake) Delete(key string) error {
+	return s.DeleteContext(context.Background(), key)
+}
+
+func (s *{{ .Name }}_Source_Fake) DeleteContext(ctx context.Context, key string) error {
+	return s.EmitContext(ctx, {{ .Name }}_Source_Message{Key: key})
+}
+
+// SetError makes the source fail to emit with the error until it is set to nil
+func (s *{{ .Name }}_Source_Fake) SetError(err error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.err = err
+}
+
+// Messages gets the messages emitted in order. Deletes are messages without a value.
+func (s *{{ .Name }}_Source_Fake) Messages() []{{ .Name }}_Source_Message {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return append([]{{ .Name }}_Source_Message{}, s.messages...)
+}
+
+var _ {{ .Name }}_Source = &{{ .Name }}_Source_Fake{}
+`))
+
+	viewFakeTemplate = template.Must(template.New("").Parse(`// Code generated by kafmesh-gen. DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+	"sort"
+	"sync"
+
+	"{{ .Import }}"
+)
+
+// {{ .Name }}_View_Fake serves the messages set on it as the view
+type {{ .Name }}_View_Fake struct {
+	mtx    sync.Mutex
+	values map[string]*{{ .MessageType }}
+}
+
+// New_{{ .Name }}_View_Fake creates an empty fake view
+func New_{{ .Name }}_View_Fake() *{{ .Name }}_View_Fake {
+	return &{{ .Name }}_View_Fake{
+		values: map[string]*{{ .MessageType }}{},
+	}
+}
+
+func (v *{{ .Name }}_View_Fake) Keys() ([]string, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	keys := []string{}
+	for k := range v.values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys, nil
+}
+
+func (v *{{ .Name }}_View_Fake) Get(key string) (*{{ .MessageType }}, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	return v.values[key], nil
+}
+
+// Set sets the message of the key in the view. A nil message removes the key.
+func (v *{{ .Name }}_View_Fake) Set(key string, message *{{ .MessageType }}) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	if message == nil {
+		delete(v.values, key)
+		return
+	}
+
+	v.values[key] = message
+}
+
+var _ {{ .Name }}_View = &{{ .Name }}_View_Fake{}
+`))
+
+	viewSourceFakeTemplate = template.Must(template.New("").Parse(`// Code generated by kafmesh-gen. DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+	"context"
+	"sync"
+
+	"{{ .Import }}"
+)
+
+// {{ .Name }}_ViewSource_Context_Fake records the updates of a view source sync
+type {{ .Name }}_ViewSource_Context_Fake struct {
+	context.Context
+
+	mtx     sync.Mutex
+	updates map[string]*{{ .MessageType }}
+}
+
+// New_{{ .Name }}_ViewSource_Context_Fake creates a fake context for a sync
+func New_{{ .Name }}_ViewSource_Context_Fake(ctx context.Context) *{{ .Name }}_ViewSource_Context_Fake {
+	return &{{ .Name }}_ViewSource_Context_Fake{
+		Context: ctx,
+		updates: map[string]*{{ .MessageType }}{},
+	}
+}
+
+func (c *{{ .Name }}_ViewSource_Context_Fake) Update(key string, message *{{ .MessageType }}) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.updates[key] = message
+	return nil
+}
+
+// Updates gets the last message the sync updated each key with
+func (c *{{ .Name }}_ViewSource_Context_Fake) Updates() map[string]*{{ .MessageType }} {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	updates := map[string]*{{ .MessageType }}{}
+	for k, v := range c.updates {
+		updates[k] = v
+	}
+
+	return updates
+}
+
+var _ {{ .Name }}_ViewSource_Context = &{{ .Name }}_ViewSource_Context_Fake{}
+`))
+
+	viewSinkFakeTemplate = template.Must(template.New("").Parse(`// Code generated by kafmesh-gen. DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"{{ .Import }}"
+)
+
+// {{ .Name }}_ViewSink_Context_Fake serves the messages set on it to a view sink sync
+type {{ .Name }}_ViewSink_Context_Fake struct {
+	context.Context
+
+	mtx    sync.Mutex
+	values map[string]*{{ .MessageType }}
+}
+
+// New_{{ .Name }}_ViewSink_Context_Fake creates an empty fake context for a sync
+func New_{{ .Name }}_ViewSink_Context_Fake(ctx context.Context) *{{ .Name }}_ViewSink_Context_Fake {
+	return &{{ .Name }}_ViewSink_Context_Fake{
+		Context: ctx,
+		values:  map[string]*{{ .MessageType }}{},
+	}
+}
+
+func (c *{{ .Name }}_ViewSink_Context_Fake) Keys() ([]string, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	keys := []string{}
+	for k := range c.values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys, nil
+}
+
+func (c *{{ .Name }}_ViewSink_Context_Fake) Get(key string) (*{{ .MessageType }}, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return c.values[key], nil
+}
+
+// Set sets the message of the key in the view the sink syncs from. A nil message removes the key.
+func (c *{{ .Name }}_ViewSink_Context_Fake) Set(key string, message *{{ .MessageType }}) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if message == nil {
+		delete(c.values, key)
+		return
+	}
+
+	c.values[key] = message
+}
+
+var _ {{ .Name }}_ViewSink_Context = &{{ .Name }}_ViewSink_Context_Fake{}
+`))
+)
+
+// fakeFileName is the name of the file the fakes of a generated file are written to
+func fakeFileName(fileName string) string {
+	return strings.TrimSuffix(fileName, ".km.go") + "_fake.km.go"
+}
+
+// fakeImports filters the model imports down to the ones the message types use
+func fakeImports(imports []string, messageTypes []string) []string {
+	used := []string{}
+	for _, i := range imports {
+		alias := strings.SplitN(i, " ", 2)[0]
+		for _, t := range messageTypes {
+			if strings.HasPrefix(t, alias+".") {
+				used = append(used, i)
+				break
+			}
+		}
+	}
+
+	return used
+}
+
+func generateProcessorFake(writer io.Writer, processor *processorOptions) error {
+	types := []string{}
+	for _, m := range processor.Context.Methods {
+		types = append(types, m.MessageType)
+	}
+
+	options := *processor
+	options.Imports = fakeImports(processor.Imports, types)
+
+	return generateFake(writer, processorFakeTemplate, options, "processor")
+}
+
+func generateWindowFake(writer io.Writer, window *windowOptions) error {
+	types := []string{}
+	for _, o := range window.Outputs {
+		types = append(types, o.Message)
+	}
+
+	options := *window
+	options.Imports = fakeImports(window.Imports, types)
+
+	return generateFake(writer, windowFakeTemplate, options, "window")
+}
+
+func generateSourceFake(writer io.Writer, source *sourceOptions) error {
+	return generateFake(writer, sourceFakeTemplate, source, "source")
+}
+
+func generateViewFake(writer io.Writer, view *viewOptions) error {
+	return generateFake(writer, viewFakeTemplate, view, "view")
+}
+
+func generateViewSourceFake(writer io.Writer, viewSource *viewSourceOptions) error {
+	return generateFake(writer, viewSourceFakeTemplate, viewSource, "view source")
+}
+
+func generateViewSinkFake(writer io.Writer, viewSink *viewSinkOptions) error {
+	return generateFake(writer, viewSinkFakeTemplate, viewSink, "view sink")
+}
+
+// generateFake executes the fake template and formats it since the fields of the fakes depend on the definition
+func generateFake(writer io.Writer, tmpl *template.Template, options interface{}, kind string) error {
+	var buf bytes.Buffer
+	err := tmpl.Execute(&buf, options)
+	if err != nil {
+		return errors.Wrapf(err, "failed to execute %s fake template", kind)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return errors.Wrapf(err, "failed to format %s fake", kind)
+	}
+
+	_, err = writer.Write(src)
+	if err != nil {
+		return errors.Wrapf(err, "failed to write %s fake", kind)
+	}
+	return nil
+}
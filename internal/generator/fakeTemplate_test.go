@@ -0,0 +1,274 @@
+package generator_test
+
+import (
+	"io/ioutil"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func validateFakes(tmpDir string, t *testing.T) {
+	files := map[string]string{
+		"enricher_processor_fake.km.go":              expectedProcessorFake,
+		"testSerial_details_source_fake.km.go":       expectedSourceFake,
+		"testSerial_detailsEnriched_view_fake.km.go": expectedViewFake,
+	}
+
+	for file, expected := range files {
+		s, err := ioutil.ReadFile(path.Join(tmpDir, "internal", "kafmesh", "details", file))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, expected, string(s), file)
+	}
+}
+
+var (
+	expectedProcessorFake = `// Code generated by kafmesh-gen. DO NOT EDIT.
+
+package details
+
+import (
+	"time"
+
+	"github.com/syncromatics/kafmesh/pkg/runner"
+
+	m0 "test/internal/kafmesh/models/testMesh/testId"
+	m1 "test/internal/kafmesh/models/testMesh/testSerial"
+)
+
+// Enricher_ProcessorContext_Fake records what the processor does with its context
+type Enricher_ProcessorContext_Fake struct {
+	key                     string
+	timestamp               time.Time
+	outputs                 []runner.FakeOutput
+	timers                  []runner.FakeTimer
+	lookupTestSerialDetails map[string]*m1.Details
+	joinTestSerialDetails   *m1.Details
+	joinTestIDTest2         map[string][]*m0.Test2
+	state                   *m1.DetailsState
+}
+
+// New_Enricher_ProcessorContext_Fake creates a fake context for a message with the key and timestamp
+func New_Enricher_ProcessorContext_Fake(key string, timestamp time.Time) *Enricher_ProcessorContext_Fake {
+	return &Enricher_ProcessorContext_Fake{
+		key:                     key,
+		timestamp:               timestamp,
+		lookupTestSerialDetails: map[string]*m1.Details{},
+		joinTestIDTest2:         map[string][]*m0.Test2{},
+	}
+}
+
+func (c *Enricher_ProcessorContext_Fake) Key() string {
+	return c.key
+}
+
+func (c *Enricher_ProcessorContext_Fake) Timestamp() time.Time {
+	return c.timestamp
+}
+
+// SetKey sets the key of the next message handled with the context
+func (c *Enricher_ProcessorContext_Fake) SetKey(key string) {
+	c.key = key
+}
+
+// SetTimestamp sets the timestamp of the next message handled with the context
+func (c *Enricher_ProcessorContext_Fake) SetTimestamp(timestamp time.Time) {
+	c.timestamp = timestamp
+}
+
+func (c *Enricher_ProcessorContext_Fake) Lookup_TestSerialDetails(key string) *m1.Details {
+	return c.lookupTestSerialDetails[key]
+}
+
+// SetLookup_TestSerialDetails sets the message Lookup_TestSerialDetails returns for the key
+func (c *Enricher_ProcessorContext_Fake) SetLookup_TestSerialDetails(key string, message *m1.Details) {
+	c.lookupTestSerialDetails[key] = message
+}
+
+func (c *Enricher_ProcessorContext_Fake) Join_TestSerialDetails() *m1.Details {
+	return c.joinTestSerialDetails
+}
+
+// SetJoin_TestSerialDetails sets the message Join_TestSerialDetails returns
+func (c *Enricher_ProcessorContext_Fake) SetJoin_TestSerialDetails(message *m1.Details) {
+	c.joinTestSerialDetails = message
+}
+
+func (c *Enricher_ProcessorContext_Fake) Join_TestIDTest2(key string) []*m0.Test2 {
+	return c.joinTestIDTest2[key]
+}
+
+// SetJoin_TestIDTest2 sets the messages Join_TestIDTest2 returns for the key
+func (c *Enricher_ProcessorContext_Fake) SetJoin_TestIDTest2(key string, messages []*m0.Test2) {
+	c.joinTestIDTest2[key] = messages
+}
+
+func (c *Enricher_ProcessorContext_Fake) Output_TestSerialDetailsEnriched(key string, message *m1.DetailsEnriched) {
+	c.outputs = append(c.outputs, runner.FakeOutput{Topic: "testMesh.testSerial.detailsEnriched", Key: key, Message: message})
+}
+
+func (c *Enricher_ProcessorContext_Fake) SaveState(state *m1.DetailsState) {
+	c.state = state
+}
+
+func (c *Enricher_ProcessorContext_Fake) State() *m1.DetailsState {
+	if c.state == nil {
+		return &m1.DetailsState{}
+	}
+
+	return c.state
+}
+
+// SetState sets the state State returns until the processor saves another
+func (c *Enricher_ProcessorContext_Fake) SetState(state *m1.DetailsState) {
+	c.state = state
+}
+
+func (c *Enricher_ProcessorContext_Fake) Schedule(key string, at time.Time) {
+	c.timers = append(c.timers, runner.FakeTimer{Key: key, At: at})
+}
+
+// Timers gets the timers the processor scheduled in order
+func (c *Enricher_ProcessorContext_Fake) Timers() []runner.FakeTimer {
+	return c.timers
+}
+
+// Outputs gets the messages the processor output in order
+func (c *Enricher_ProcessorContext_Fake) Outputs() []runner.FakeOutput {
+	return c.outputs
+}
+
+var _ Enricher_ProcessorContext = &Enricher_ProcessorContext_Fake{}
+`
+
+	expectedSourceFake = `// Code generated by kafmesh-gen. DO NOT EDIT.
+
+package details
+
+import (
+	"context"
+	"sync"
+)
+
+// TestSerialDetails_Source_Fake records the messages emitted to the source
+type TestSerialDetails_Source_Fake struct {
+	mtx      sync.Mutex
+	err      error
+	messages []TestSerialDetails_Source_Message
+}
+
+// New_TestSerialDetails_Source_Fake creates a fake source
+func New_TestSerialDetails_Source_Fake() *TestSerialDetails_Source_Fake {
+	return &TestSerialDetails_Source_Fake{}
+}
+
+func (s *TestSerialDetails_Source_Fake) Emit(message TestSerialDetails_Source_Message) error {
+	return s.EmitContext(context.Background(), message)
+}
+
+func (s *TestSerialDetails_Source_Fake) EmitContext(ctx context.Context, message TestSerialDetails_Source_Message) error {
+	return s.EmitBulk(ctx, []TestSerialDetails_Source_Message{message})
+}
+
+func (s *TestSerialDetails_Source_Fake) EmitBulk(ctx context.Context, messages []TestSerialDetails_Source_Message) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.err != nil {
+		return s.err
+	}
+
+	s.messages = append(s.messages, messages...)
+	return nil
+}
+
+func (s *TestSerialDetails_Source_Fake) Delete(key string) error {
+	return s.DeleteContext(context.Background(), key)
+}
+
+func (s *TestSerialDetails_Source_Fake) DeleteContext(ctx context.Context, key string) error {
+	return s.EmitContext(ctx, TestSerialDetails_Source_Message{Key: key})
+}
+
+// SetError makes the source fail to emit with the error until it is set to nil
+func (s *TestSerialDetails_Source_Fake) SetError(err error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.err = err
+}
+
+// Messages gets the messages emitted in order. Deletes are messages without a value.
+func (s *TestSerialDetails_Source_Fake) Messages() []TestSerialDetails_Source_Message {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return append([]TestSerialDetails_Source_Message{}, s.messages...)
+}
+
+var _ TestSerialDetails_Source = &TestSerialDetails_Source_Fake{}
+`
+
+	expectedViewFake = `// Code generated by kafmesh-gen. DO NOT EDIT.
+
+package details
+
+import (
+	"sort"
+	"sync"
+
+	"test/internal/kafmesh/models/testMesh/testSerial"
+)
+
+// TestSerialDetailsEnriched_View_Fake serves the messages set on it as the view
+type TestSerialDetailsEnriched_View_Fake struct {
+	mtx    sync.Mutex
+	values map[string]*testSerial.DetailsEnriched
+}
+
+// New_TestSerialDetailsEnriched_View_Fake creates an empty fake view
+func New_TestSerialDetailsEnriched_View_Fake() *TestSerialDetailsEnriched_View_Fake {
+	return &TestSerialDetailsEnriched_View_Fake{
+		values: map[string]*testSerial.DetailsEnriched{},
+	}
+}
+
+func (v *TestSerialDetailsEnriched_View_Fake) Keys() ([]string, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	keys := []string{}
+	for k := range v.values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys, nil
+}
+
+func (v *TestSerialDetailsEnriched_View_Fake) Get(key string) (*testSerial.DetailsEnriched, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	return v.values[key], nil
+}
+
+// Set sets the message of the key in the view. A nil message removes the key.
+func (v *TestSerialDetailsEnriched_View_Fake) Set(key string, message *testSerial.DetailsEnriched) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	if message == nil {
+		delete(v.values, key)
+		return
+	}
+
+	v.values[key] = message
+}
+
+var _ TestSerialDetailsEnriched_View = &TestSerialDetailsEnriched_View_Fake{}
+`
+)
@@ -2,6 +2,7 @@ package generator
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"path"
 	"path/filepath"
@@ -31,42 +32,14 @@ func Generate(options Options) error {
 		return errors.Wrap(err, "failed to create output path")
 	}
 
-	includes := []string{}
-	files := []file{}
-	for _, p := range options.Service.Messages.Protobuf {
-		protoPath := p
-		if runtime.GOOS == "windows" {
-			protoPath = strings.ReplaceAll(protoPath, "/", "\\")
-		}
-		protoPath = path.Join(options.DefinitionsPath, protoPath)
-		if runtime.GOOS == "windows" {
-			protoPath = strings.ReplaceAll(protoPath, "/", "\\")
-		}
-		protoPath, err = filepath.Abs(protoPath)
-		if err != nil {
-			return errors.Wrapf(err, "failed to get absolute path from protopath '%s'", protoPath)
-		}
-
-		includes = append(includes, protoPath)
-
-		fs, err := filepathx.Glob(path.Join(protoPath, "**/*.proto"))
-		if err != nil {
-			return errors.Wrap(err, "failed to glob files")
-		}
-
-		if len(fs) == 0 {
-			return errors.Errorf("no proto files found in '%s'", protoPath)
-		}
+	includes, files, err := findSchemaFiles(options.DefinitionsPath, options.Service.Messages.Protobuf, "**/*.proto")
+	if err != nil {
+		return errors.Wrap(err, "failed to find proto files")
+	}
 
-		for _, f := range fs {
-			if runtime.GOOS == "windows" {
-				f = strings.ReplaceAll(f, "/", "\\")
-			}
-			files = append(files, file{
-				root: protoPath,
-				path: f,
-			})
-		}
+	_, avroFiles, err := findSchemaFiles(options.DefinitionsPath, options.Service.Messages.Avro, "**/*.avsc")
+	if err != nil {
+		return errors.Wrap(err, "failed to find avro files")
 	}
 
 	modelsPath := path.Join(options.Service.Output.Path, "models")
@@ -88,6 +61,11 @@ func Generate(options Options) error {
 		return errors.Wrapf(err, "failed to run protoc")
 	}
 
+	err = Avro(avroFiles, path.Join(options.RootPath, modelsPath))
+	if err != nil {
+		return errors.Wrapf(err, "failed to generate avro models")
+	}
+
 	file, err := os.Create(path.Join(outputPath, "service.km.go"))
 	if err != nil {
 		return errors.Wrapf(err, "failed to open service file")
@@ -146,6 +124,48 @@ func Generate(options Options) error {
 	return nil
 }
 
+func findSchemaFiles(definitionsPath string, schemaPaths []string, pattern string) ([]string, []file, error) {
+	includes := []string{}
+	files := []file{}
+	for _, p := range schemaPaths {
+		schemaPath := p
+		if runtime.GOOS == "windows" {
+			schemaPath = strings.ReplaceAll(schemaPath, "/", "\\")
+		}
+		schemaPath = path.Join(definitionsPath, schemaPath)
+		if runtime.GOOS == "windows" {
+			schemaPath = strings.ReplaceAll(schemaPath, "/", "\\")
+		}
+		schemaPath, err := filepath.Abs(schemaPath)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to get absolute path from schema path '%s'", schemaPath)
+		}
+
+		includes = append(includes, schemaPath)
+
+		fs, err := filepathx.Glob(path.Join(schemaPath, pattern))
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to glob files")
+		}
+
+		if len(fs) == 0 {
+			return nil, nil, errors.Errorf("no schema files found in '%s'", schemaPath)
+		}
+
+		for _, f := range fs {
+			if runtime.GOOS == "windows" {
+				f = strings.ReplaceAll(f, "/", "\\")
+			}
+			files = append(files, file{
+				root: schemaPath,
+				path: f,
+			})
+		}
+	}
+
+	return includes, files, nil
+}
+
 func processComponent(rootPath string, outputPath string, mod string, modelsPath string, service *models.Service, component *models.Component) error {
 	mPath := "/" + strings.TrimPrefix(modelsPath, rootPath)
 	componentPath := path.Join(outputPath, component.Name)
@@ -175,6 +195,66 @@ func processComponent(rootPath string, outputPath string, mod string, modelsPath
 		if err != nil {
 			return errors.Wrap(err, "failed to generate processor")
 		}
+
+		if service.Output.Fakes {
+			err = generateFakeFile(componentPath, fileName, func(w io.Writer) error {
+				return generateProcessorFake(w, co)
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed to generate processor fake")
+			}
+		}
+	}
+
+	for _, w := range component.Windows {
+		fileName := strings.ReplaceAll(w.Name, " ", "_")
+		fileName = fmt.Sprintf("%s_window.km.go", fileName)
+		fileName = strings.ToLower(fileName)
+		file, err := os.Create(path.Join(componentPath, fileName))
+		if err != nil {
+			return errors.Wrapf(err, "failed to open service file")
+		}
+		defer file.Close()
+
+		co, err := buildWindowOptions(component.Name, service, component, w)
+		if err != nil {
+			return errors.Wrap(err, "failed to build window options")
+		}
+
+		err = generateWindow(file, co)
+		if err != nil {
+			return errors.Wrap(err, "failed to generate window")
+		}
+
+		if service.Output.Fakes {
+			err = generateFakeFile(componentPath, fileName, func(w io.Writer) error {
+				return generateWindowFake(w, co)
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed to generate window fake")
+			}
+		}
+	}
+
+	for _, r := range component.Repartition {
+		fileName := strings.ReplaceAll(r.Name, " ", "_")
+		fileName = fmt.Sprintf("%s_repartition.km.go", fileName)
+		fileName = strings.ToLower(fileName)
+		file, err := os.Create(path.Join(componentPath, fileName))
+		if err != nil {
+			return errors.Wrapf(err, "failed to open service file")
+		}
+		defer file.Close()
+
+		co, err := buildRepartitionOptions(component.Name, service, component, r)
+		if err != nil {
+			return errors.Wrap(err, "failed to build repartition options")
+		}
+
+		err = generateRepartition(file, co)
+		if err != nil {
+			return errors.Wrap(err, "failed to generate repartition")
+		}
 	}
 
 	for _, e := range component.Sources {
@@ -195,6 +275,15 @@ func processComponent(rootPath string, outputPath string, mod string, modelsPath
 		if err != nil {
 			return errors.Wrap(err, "failed to generate source")
 		}
+
+		if service.Output.Fakes {
+			err = generateFakeFile(componentPath, fileName, func(w io.Writer) error {
+				return generateSourceFake(w, co)
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed to generate source fake")
+			}
+		}
 	}
 
 	for _, s := range component.Sinks {
@@ -227,7 +316,7 @@ func processComponent(rootPath string, outputPath string, mod string, modelsPath
 		}
 		defer file.Close()
 
-		co, err := buildViewOptions(component.Name, mod, mPath, service, v)
+		co, err := buildViewOptions(component.Name, mod, mPath, service, v, component)
 		if err != nil {
 			return errors.Wrap(err, "failed to build view options")
 		}
@@ -236,6 +325,15 @@ func processComponent(rootPath string, outputPath string, mod string, modelsPath
 		if err != nil {
 			return errors.Wrap(err, "failed to generate view")
 		}
+
+		if service.Output.Fakes {
+			err = generateFakeFile(componentPath, fileName, func(w io.Writer) error {
+				return generateViewFake(w, co)
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed to generate view fake")
+			}
+		}
 	}
 
 	for _, s := range component.ViewSources {
@@ -247,7 +345,7 @@ func processComponent(rootPath string, outputPath string, mod string, modelsPath
 		}
 		defer file.Close()
 
-		co, err := buildViewSourceOptions(component.Name, mod, mPath, service, s)
+		co, err := buildViewSourceOptions(component.Name, mod, mPath, service, s, component)
 		if err != nil {
 			return errors.Wrap(err, "failed to build viewSource options")
 		}
@@ -256,6 +354,15 @@ func processComponent(rootPath string, outputPath string, mod string, modelsPath
 		if err != nil {
 			return errors.Wrap(err, "failed to generate viewSource")
 		}
+
+		if service.Output.Fakes {
+			err = generateFakeFile(componentPath, fileName, func(w io.Writer) error {
+				return generateViewSourceFake(w, co)
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed to generate viewSource fake")
+			}
+		}
 	}
 
 	for _, s := range component.ViewSinks {
@@ -267,7 +374,7 @@ func processComponent(rootPath string, outputPath string, mod string, modelsPath
 		}
 		defer file.Close()
 
-		co, err := buildViewSinkOptions(component.Name, mod, mPath, service, s)
+		co, err := buildViewSinkOptions(component.Name, mod, mPath, service, s, component)
 		if err != nil {
 			return errors.Wrap(err, "failed to build viewSink options")
 		}
@@ -276,7 +383,26 @@ func processComponent(rootPath string, outputPath string, mod string, modelsPath
 		if err != nil {
 			return errors.Wrap(err, "failed to generate viewSink")
 		}
+
+		if service.Output.Fakes {
+			err = generateFakeFile(componentPath, fileName, func(w io.Writer) error {
+				return generateViewSinkFake(w, co)
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed to generate viewSink fake")
+			}
+		}
 	}
 
 	return nil
 }
+
+func generateFakeFile(componentPath string, fileName string, generate func(io.Writer) error) error {
+	file, err := os.Create(path.Join(componentPath, fakeFileName(fileName)))
+	if err != nil {
+		return errors.Wrap(err, "failed to open fake file")
+	}
+	defer file.Close()
+
+	return generate(file)
+}
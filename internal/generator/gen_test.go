@@ -88,6 +88,7 @@ message DetailsEnriched {
 				Path:    "internal/kafmesh",
 				Package: "kafmesh",
 				Module:  "test",
+				Fakes:   true,
 			},
 			Messages: models.MessageDefinitions{
 				Protobuf: []string{
@@ -127,6 +128,9 @@ message DetailsEnriched {
 								TopicDefinition: models.TopicDefinition{
 									Message: "testSerial.details",
 								},
+								Storage: &models.Storage{
+									Backend: "pebble",
+								},
 							},
 						},
 						Joins: []models.Join{
@@ -136,6 +140,14 @@ message DetailsEnriched {
 								},
 							},
 						},
+						StreamJoins: []models.StreamJoin{
+							models.StreamJoin{
+								TopicDefinition: models.TopicDefinition{
+									Message: "testId.test2",
+								},
+								Window: 30 * time.Second,
+							},
+						},
 						Outputs: []models.Output{
 							models.Output{
 								TopicDefinition: models.TopicDefinition{
@@ -148,6 +160,57 @@ message DetailsEnriched {
 								Message: "testSerial.detailsState",
 							},
 						},
+						DeadLetter: &models.DeadLetter{
+							Retry: models.Retry{
+								MaxAttempts: 3,
+								Backoff:     100 * time.Millisecond,
+								MaxBackoff:  time.Second,
+							},
+						},
+						IdempotentProducer: true,
+						ReadCommitted:      true,
+						Timers:             true,
+						Standby:            true,
+						StandbyReplicas:    2,
+						Storage: &models.Storage{
+							BlockCacheCapacity: 8388608,
+							WriteBuffer:        4194304,
+						},
+					},
+				},
+				Windows: []models.Window{
+					models.Window{
+						Name: "detail counts",
+						Inputs: []models.Input{
+							models.Input{
+								TopicDefinition: models.TopicDefinition{
+									Message: "testSerial.details",
+								},
+							},
+						},
+						Outputs: []models.Output{
+							models.Output{
+								TopicDefinition: models.TopicDefinition{
+									Message: "testSerial.detailsEnriched",
+								},
+							},
+						},
+						Aggregate: models.Persistence{
+							TopicDefinition: models.TopicDefinition{
+								Message: "testSerial.detailsState",
+							},
+						},
+						Size:    5 * time.Minute,
+						Advance: time.Minute,
+						Grace:   30 * time.Second,
+					},
+				},
+				Repartition: []models.Repartition{
+					models.Repartition{
+						Name: "details by customer",
+						TopicDefinition: models.TopicDefinition{
+							Message: "testSerial.details",
+						},
 					},
 				},
 				Sources: []models.Source{
@@ -163,6 +226,7 @@ message DetailsEnriched {
 						TopicDefinition: models.TopicDefinition{
 							Message: "testSerial.detailsEnriched",
 						},
+						ReadCommitted: true,
 					},
 				},
 				Views: []models.View{
@@ -170,6 +234,10 @@ message DetailsEnriched {
 						TopicDefinition: models.TopicDefinition{
 							Message: "testSerial.detailsEnriched",
 						},
+						ReadCommitted: true,
+						Storage: &models.Storage{
+							Backend: "memory",
+						},
 					},
 				},
 				ViewSources: []models.ViewSource{
@@ -198,11 +266,14 @@ message DetailsEnriched {
 	}
 
 	validateProcessors(newPath, t)
+	validateWindow(newPath, t)
+	validateRepartition(newPath, t)
 	validateEmitter(newPath, t)
 	validateSink(newPath, t)
 	validateView(newPath, t)
 	validateViewSource(newPath, t)
 	validateViewSink(newPath, t)
+	validateFakes(newPath, t)
 	validateService(newPath, t)
 	validateTopic(newPath, t)
 }
@@ -0,0 +1,279 @@
+package generator_test
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/syncromatics/kafmesh/internal/generator"
+	"github.com/syncromatics/kafmesh/internal/models"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+var update = flag.Bool("update", false, "update the golden files of the generator")
+
+const (
+	goldenService = "testdata/golden/service"
+	goldenOutput  = "testdata/golden/output"
+)
+
+// Test_Golden generates the sample service in testdata/golden/service and compares the files kafmesh-gen
+// writes with the golden files in testdata/golden/output. Run with -update to write the golden files
+// after changing a template. The protobuf models and mocks are left out since they depend on the version
+// of protoc and mockgen.
+func Test_Golden(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	err := copyDir(goldenService, tmpDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	root, err := filepath.Abs("../..")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// newer versions of mockgen write mocks that need go 1.18
+	err = ioutil.WriteFile(filepath.Join(tmpDir, "go.mod"), []byte(`module example-service
+
+go 1.18
+
+require github.com/syncromatics/kafmesh v0.0.0
+
+replace github.com/syncromatics/kafmesh => `+root+"\n"), os.ModePerm)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	options, err := loadDefinition(filepath.Join(tmpDir, "docs", "definition.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	options.RootPath = tmpDir
+
+	err = generator.Generate(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	generated, err := generatedFiles(filepath.Join(tmpDir, options.Service.Output.Path))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *update {
+		err = writeGolden(generated)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	compareGolden(t, generated)
+
+	if testing.Short() {
+		t.Skip("skipping vet of the generated service in short mode")
+	}
+
+	sum, err := ioutil.ReadFile(filepath.Join(root, "go.sum"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = ioutil.WriteFile(filepath.Join(tmpDir, "go.sum"), sum, os.ModePerm)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vet := exec.Command("go", "vet", "./...")
+	vet.Dir = tmpDir
+	vet.Env = append(os.Environ(), "GOFLAGS=-mod=mod")
+	output, err := vet.CombinedOutput()
+	if err != nil {
+		t.Fatalf("generated service failed to vet: %v\n%s", err, output)
+	}
+}
+
+// loadDefinition reads the service definition and its components the same way kafmesh-gen does
+func loadDefinition(definition string) (generator.Options, error) {
+	file, err := os.Open(definition)
+	if err != nil {
+		return generator.Options{}, err
+	}
+	defer file.Close()
+
+	service, err := models.ParseService(file)
+	if err != nil {
+		return generator.Options{}, err
+	}
+
+	definitionsPath := filepath.Dir(definition)
+
+	components := []*models.Component{}
+	for _, g := range service.Components {
+		files, err := filepath.Glob(filepath.Join(definitionsPath, g))
+		if err != nil {
+			return generator.Options{}, err
+		}
+
+		for _, f := range files {
+			componentFile, err := os.Open(f)
+			if err != nil {
+				return generator.Options{}, err
+			}
+
+			component, err := models.ParseComponent(componentFile)
+			componentFile.Close()
+			if err != nil {
+				return generator.Options{}, err
+			}
+
+			components = append(components, component)
+		}
+	}
+
+	return generator.Options{
+		Service:         service,
+		Components:      components,
+		DefinitionsPath: definitionsPath,
+	}, nil
+}
+
+// generatedFiles reads the files written by the kafmesh templates keyed by their path in the output
+func generatedFiles(outputPath string) (map[string]string, error) {
+	files := map[string]string{}
+	err := filepath.Walk(outputPath, func(p string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		if !strings.HasSuffix(p, ".km.go") && !strings.HasSuffix(p, ".avro.go") {
+			return nil
+		}
+
+		content, err := ioutil.ReadFile(p)
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(outputPath, p)
+		if err != nil {
+			return err
+		}
+
+		files[filepath.ToSlash(rel)+".golden"] = string(content)
+		return nil
+	})
+
+	return files, err
+}
+
+// writeGolden replaces the golden files with the generated files
+func writeGolden(generated map[string]string) error {
+	err := os.RemoveAll(goldenOutput)
+	if err != nil {
+		return err
+	}
+
+	for name, content := range generated {
+		p := filepath.Join(goldenOutput, filepath.FromSlash(name))
+		err = os.MkdirAll(filepath.Dir(p), os.ModePerm)
+		if err != nil {
+			return err
+		}
+
+		err = ioutil.WriteFile(p, []byte(content), 0644)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func compareGolden(t *testing.T, generated map[string]string) {
+	golden := map[string]string{}
+	err := filepath.Walk(goldenOutput, func(p string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+
+		content, err := ioutil.ReadFile(p)
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(goldenOutput, p)
+		if err != nil {
+			return err
+		}
+
+		golden[filepath.ToSlash(rel)] = string(content)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	names := []string{}
+	for name := range generated {
+		names = append(names, name)
+	}
+	for name := range golden {
+		if _, ok := generated[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		expected, hasGolden := golden[name]
+		actual, wasGenerated := generated[name]
+
+		switch {
+		case !hasGolden:
+			t.Errorf("%s was generated but has no golden file, run the test with -update", name)
+		case !wasGenerated:
+			t.Errorf("%s has a golden file but was not generated, run the test with -update", name)
+		case expected != actual:
+			diff, _ := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+				A:        difflib.SplitLines(expected),
+				B:        difflib.SplitLines(actual),
+				FromFile: "golden",
+				ToFile:   "generated",
+				Context:  3,
+			})
+			t.Errorf("%s differs from its golden file, run the test with -update if the change is expected\n%s", name, diff)
+		}
+	}
+}
+
+func copyDir(from string, to string) error {
+	return filepath.Walk(from, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(from, p)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(to, rel)
+
+		if info.IsDir() {
+			return os.MkdirAll(target, os.ModePerm)
+		}
+
+		content, err := ioutil.ReadFile(p)
+		if err != nil {
+			return err
+		}
+
+		return ioutil.WriteFile(target, content, info.Mode())
+	})
+}
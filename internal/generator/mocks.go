@@ -35,7 +35,7 @@ func generateMocks(fullOutputDir string) error {
 		}
 
 		for _, f := range files {
-			if strings.Contains(f.Name(), ".mock.go") {
+			if strings.Contains(f.Name(), ".mock.go") || strings.HasSuffix(f.Name(), "_fake.km.go") {
 				continue
 			}
 
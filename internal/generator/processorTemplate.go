@@ -6,6 +6,7 @@ import (
 	"sort"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/syncromatics/kafmesh/internal/models"
 
@@ -21,16 +22,12 @@ package {{ .Package }}
 import (
 	"context"
 	"encoding/json"
-	"os"
-	"path/filepath"
 	"time"
 
 	"github.com/Shopify/sarama"
 	"github.com/burdiyan/kafkautil"
 	"github.com/lovoo/goka"
-	"github.com/lovoo/goka/storage"
 	"github.com/pkg/errors"
-	"github.com/syndtr/goleveldb/leveldb/opt"
 
 	"github.com/syncromatics/kafmesh/pkg/runner"
 {{ range .Imports }}
@@ -45,6 +42,9 @@ type {{ .Name }}_ProcessorContext interface {
 	{{- range .Methods }}
 	{{.Name}}({{ .Args }}
 {{- end}}
+{{- if $.Timers }}
+	Schedule(key string, at time.Time)
+{{- end }}
 }
 {{- end }}
 
@@ -53,6 +53,9 @@ type {{ .Name }}_Processor interface {
 	{{- range .Methods }}
 	{{.Name}}({{ .Args }}) error
 {{- end}}
+{{- if $.Timers }}
+	HandleTimer(ctx {{ .Name }}_ProcessorContext, at time.Time) error
+{{- end }}
 }
 {{- end}}
 {{ $impl := "" }}
@@ -60,10 +63,13 @@ type {{ .Name }}_Processor interface {
 type {{ .Name }}_ProcessorContext_Impl struct {
 	ctx              goka.Context
 	processorContext *runner.ProcessorContext
+{{- range $.StreamJoins }}
+	join{{ .Index }}            *runner.StreamJoin
+{{- end }}
 }
 
-func new_{{ .Name }}_ProcessorContext_Impl(ctx goka.Context, pc *runner.ProcessorContext) *{{ .Name }}_ProcessorContext_Impl {
-	return &{{ .Name }}_ProcessorContext_Impl{ctx, pc}
+func new_{{ .Name }}_ProcessorContext_Impl(ctx goka.Context, pc *runner.ProcessorContext{{ range $.StreamJoins }}, join{{ .Index }} *runner.StreamJoin{{ end }}) *{{ .Name }}_ProcessorContext_Impl {
+	return &{{ .Name }}_ProcessorContext_Impl{ctx, pc{{ range $.StreamJoins }}, join{{ .Index }}{{ end }}}
 }
 {{$c := .Name}}
 func (c *{{$c}}_ProcessorContext_Impl) Key() string {
@@ -102,14 +108,34 @@ func (c *{{$c}}_ProcessorContext_Impl) {{.Name}}({{ .Args }} {
 
 	return m
 {{- end -}}
+{{- with (eq .Type "streamJoin" ) }}
+	v, err := c.join{{ $t.Join }}.Within(c.ctx, key)
+	if err != nil {
+		c.ctx.Fail(err)
+	}
+
+	messages := []*{{- $t.MessageType -}}{}
+	for _, m := range v {
+		messages = append(messages, m.(*{{- $t.MessageType -}}))
+	}
+
+	value, _ := json.Marshal(messages)
+	c.processorContext.Join("{{ $t.Topic }}", "{{$t.MessageTypeName}}", string(value))
+
+	return messages
+{{- end -}}
 {{- with (eq .Type "output" ) }}
 	value, _ := json.Marshal(message)
-	c.processorContext.Output("{{ $t.Topic }}", "{{$t.MessageTypeName}}", key, string(value))
-	c.ctx.Emit("{{- $t.Topic -}}", key, message)
+	runner.OnCommit(c.ctx, func() {
+		c.processorContext.Output("{{ $t.Topic }}", "{{$t.MessageTypeName}}", key, string(value))
+	})
+	c.ctx.Emit("{{- $t.Topic -}}", key, message, goka.WithCtxEmitHeaders(c.processorContext.Headers()))
 {{- end -}}
 {{- with (eq .Type "save") }}
 	value, _ := json.Marshal(state)
-	c.processorContext.SetState("{{ $t.Topic }}", "{{$t.MessageTypeName}}", string(value))
+	runner.OnCommit(c.ctx, func() {
+		c.processorContext.SetState("{{ $t.Topic }}", "{{$t.MessageTypeName}}", string(value))
+	})
 
 	c.ctx.SetValue(state)
 {{- end -}}
@@ -129,51 +155,109 @@ func (c *{{$c}}_ProcessorContext_Impl) {{.Name}}({{ .Args }} {
 {{- end }}
 }
 {{ end}}
+{{- if $.Timers }}
+func (c *{{$c}}_ProcessorContext_Impl) Schedule(key string, at time.Time) {
+	c.ctx.Emit("{{ $.TimerTopic }}", key, &runner.Timer{At: at})
+}
+{{ end }}
 {{- end}}
 {{ $c := .Context -}}
 {{- $componentName := .Component -}}
 {{- $processorName := .ProcessorName -}}
+{{- $deadLetter := .DeadLetter -}}
 {{ with .Interface -}}
 func Register_{{ .Name }}_Processor(service *runner.Service, impl {{ .Name }}_Processor) (func(context.Context) func() error, error) {
 {{- end }}
 	options := service.Options()
 	brokers := options.Brokers
-	protoWrapper := options.ProtoWrapper
+	metrics := options.Metrics.Processor("{{ .ServiceName }}", "{{ .Component }}", "{{ .ProcessorName }}")
+{{- range .Wrappers }}
+	{{ .Name }} := options.{{ .Option }}
+{{- end }}
 
 	config := sarama.NewConfig()
 	config.Version = sarama.MaxVersion
 	config.Consumer.Offsets.Initial = sarama.OffsetOldest
 	config.Consumer.Offsets.AutoCommit.Enable = true
 	config.Consumer.Offsets.CommitInterval = 1 * time.Second
+{{- if .ReadCommitted }}
+	runner.ConfigureReadCommitted(config)
+{{- end }}
 
-	opts := &opt.Options{
-		BlockCacheCapacity: opt.MiB * 1,
-		WriteBuffer:        opt.MiB * 1,
+	builder, err := options.Storage.Builder("processor", "{{ .Group }}"{{ .Storage }})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create processor storage")
 	}
+{{- range .TableStorage }}
 
-	path := filepath.Join("/tmp/storage", "processor", "{{ .Group }}")
-
-	err := os.MkdirAll(path, os.ModePerm)
+	table{{ .Index }}Builder, err := options.Storage.Builder("processor", "{{ .Name }}"{{ $.Storage }}{{ .Storage }})
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to create processor db directory")
+		return nil, errors.Wrap(err, "failed to create table storage")
 	}
+{{- end }}
+{{- if .TableStorage }}
 
-	builder := storage.BuilderWithOptions(path, opts)
+	builder = runner.TableStorageBuilder(builder,
+{{- range .TableStorage }}
+		runner.TableStorage{Table: "{{ .Table }}", Builder: table{{ .Index }}Builder},
+{{- end }}
+	)
+{{- end }}
+{{- if .Timers }}
 
+	timersBuilder, err := options.Storage.Builder("processor", "{{ .TimerTopic }}"{{ .Storage }})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create timers storage")
+	}
+
+	timers := runner.NewTimers(brokers, "{{ .Group }}", timersBuilder)
+{{- end }}
 {{ range .Codecs }}
-	c{{ .Index }}, err := protoWrapper.Codec("{{ .Topic }}", &{{ .Message }}{})
+	c{{ .Index }}, err := {{ .Wrapper.Name }}.Codec("{{ .Topic }}", &{{ .Message }}{})
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create codec")
 	}
+{{ end }}{{- range .StreamJoins }}
+	join{{ .Index }}Builder, err := options.Storage.Builder("processor", "{{ .Store }}"{{ $.Storage }})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create stream join storage")
+	}
+
+	join{{ .Index }} := runner.NewStreamJoin(brokers, "{{ .Store }}", "{{ .Topic }}", {{ .Window.Milliseconds }}*time.Millisecond, c{{ .Codec }}, join{{ .Index }}Builder)
+{{- end }}
+{{- if .StreamJoins }}
+{{ end }}
+{{- if .Standby }}
+	standby := runner.NewStandby(brokers, "{{ .Group }}", "{{ .Group }}-table", {{ .StandbyReplicas }}, builder)
+	standby.Configure(config)
+	builder = standby.Builder(builder)
+{{ end }}
+{{- with .DeadLetter }}
+	retriesBuilder, err := options.Storage.Builder("processor", "{{ .RetryGroup }}"{{ $.Storage }})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create retries storage")
+	}
+
+	deadLetter := runner.NewDeadLetter(brokers, "{{ $.Group }}", "{{ .Topic }}", runner.RetryPolicy{
+		MaxAttempts: {{ .Retry.MaxAttempts }},
+		Backoff:     {{ .Retry.Backoff.Milliseconds }} * time.Millisecond,
+		MaxBackoff:  {{ .Retry.MaxBackoff.Milliseconds }} * time.Millisecond,
+	}, retriesBuilder)
 {{ end }}
 	edges := []goka.Edge{
 {{- range .Edges -}}
 {{ $e := . }}
 {{- with (eq .Type "input" ) }}
+{{- if $deadLetter }}
+		deadLetter.Input("{{ $e.Topic }}", c{{ $e.Codec }}, func(ctx goka.Context, m interface{}) {
+{{- else }}
 		goka.Input(goka.Stream("{{ $e.Topic }}"), c{{ $e.Codec }}, func(ctx goka.Context, m interface{}) {
+{{- end }}
+			start := time.Now()
 			msg := m.(*{{ $e.Message }})
 
 			pc := service.ProcessorContext(ctx.Context(), "{{$componentName}}", "{{$processorName}}", ctx.Key())
+			pc.Trace("{{ $e.Topic }}", ctx.Headers())
 			defer pc.Finish()
 
 			v, err := json.Marshal(msg)
@@ -181,10 +265,27 @@ func Register_{{ .Name }}_Processor(service *runner.Service, impl {{ .Name }}_Pr
 				ctx.Fail(err)
 			}
 			pc.Input("{{ $e.Topic }}", "{{ $e.MessageType}}", string(v))
+{{- range $.StreamJoins }}
+{{- if eq .Topic $e.Topic }}
 
-			w := new_{{ $c.Name }}_ProcessorContext_Impl(ctx, pc)
+			err = join{{ .Index }}.Add(ctx, msg)
+			if err != nil {
+				ctx.Fail(err)
+			}
+{{- end }}
+{{- end }}
+{{ if $deadLetter }}
+			err = deadLetter.Handle(ctx, c{{ $e.Codec }}, msg, func(ctx goka.Context) error {
+				w := new_{{ $c.Name }}_ProcessorContext_Impl(ctx, pc{{ range $.StreamJoins }}, join{{ .Index }}{{ end }})
+				return impl.{{ $e.Func }}(w, msg)
+			})
+{{- else }}
+			w := new_{{ $c.Name }}_ProcessorContext_Impl(ctx, pc{{ range $.StreamJoins }}, join{{ .Index }}{{ end }})
 			err = impl.{{ $e.Func }}(w, msg)
+{{- end }}
+			metrics.Handled("{{ $e.Topic }}", time.Since(start), err)
 			if err != nil {
+				pc.Fail(err)
 				ctx.Fail(err)
 			}
 		}),
@@ -202,19 +303,105 @@ func Register_{{ .Name }}_Processor(service *runner.Service, impl {{ .Name }}_Pr
 		goka.Persist(c{{ $e.Codec }}),
 {{- end -}}
 {{ end }}
+{{- range .StreamJoins }}
+{{- if not .Input }}
+		join{{ .Index }}.Edge(),
+{{- end }}
+		join{{ .Index }}.ChangelogEdge(),
+{{- end }}
+{{- if .Timers }}
+		timers.Edge(),
+{{- if $deadLetter }}
+		deadLetter.Input(timers.FiredTopic(), new(runner.TimerCodec), func(ctx goka.Context, m interface{}) {
+{{- else }}
+		goka.Input(goka.Stream(timers.FiredTopic()), new(runner.TimerCodec), func(ctx goka.Context, m interface{}) {
+{{- end }}
+			start := time.Now()
+			timer := m.(*runner.Timer)
+
+			pc := service.ProcessorContext(ctx.Context(), "{{$componentName}}", "{{$processorName}}", ctx.Key())
+			defer pc.Finish()
+{{ if $deadLetter }}
+			err := deadLetter.Handle(ctx, new(runner.TimerCodec), timer, func(ctx goka.Context) error {
+				w := new_{{ $c.Name }}_ProcessorContext_Impl(ctx, pc{{ range $.StreamJoins }}, join{{ .Index }}{{ end }})
+				return impl.HandleTimer(w, timer.At)
+			})
+{{- else }}
+			w := new_{{ $c.Name }}_ProcessorContext_Impl(ctx, pc{{ range $.StreamJoins }}, join{{ .Index }}{{ end }})
+			err := impl.HandleTimer(w, timer.At)
+{{- end }}
+			metrics.Handled(timers.FiredTopic(), time.Since(start), err)
+			if err != nil {
+				pc.Fail(err)
+				ctx.Fail(err)
+			}
+		}),
+{{- end }}
 	}
+{{- if .DeadLetter }}
+	edges = append(edges, deadLetter.Edges()...)
+{{ end }}
 	group := goka.DefineGroup(goka.Group("{{ .Group }}"), edges...)
 
 	processor, err := goka.NewProcessor(brokers,
 		group,
-		goka.WithConsumerGroupBuilder(goka.ConsumerGroupBuilderWithConfig(config)),
-		goka.WithStorageBuilder(builder),
-		goka.WithHasher(kafkautil.MurmurHasher))
+		options.ProcessorOptions(
+			goka.WithConsumerGroupBuilder(goka.ConsumerGroupBuilderWithConfig(config)),
+			goka.WithStorageBuilder(builder),
+{{- if .ReadCommitted }}
+			goka.WithConsumerSaramaBuilder(goka.SaramaConsumerBuilderWithConfig(runner.ReadCommittedConfig())),
+{{- end }}
+{{- if .IdempotentProducer }}
+			goka.WithProducerBuilder(runner.IdempotentProducerBuilder()),
+{{- end }}
+{{- if .StreamJoins }}
+			goka.WithRebalanceCallback(func(assignment goka.Assignment) {
+{{- range .StreamJoins }}
+				join{{ .Index }}.Rebalanced(assignment)
+{{- end }}
+			}),
+{{- end }}
+			goka.WithHasher(kafkautil.MurmurHasher),
+		)...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create goka processor")
 	}
+{{- if .Timers }}
+
+	err = service.RegisterTimers(timers)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register timers")
+	}
+{{- end }}
+{{- if .DeadLetter }}
+
+	err = service.RegisterDeadLetter(deadLetter)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register dead letter")
+	}
+{{- end }}
+{{- range .StreamJoins }}
+
+	err = service.RegisterStreamJoin(join{{ .Index }})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register stream join")
+	}
+{{- end }}
+{{- if .Standby }}
+
+	err = service.RegisterStandby(standby, runner.WithRunnerName("{{ .Group }}-standby"))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register standby")
+	}
+
+	service.RegisterRecovery(standby.Recovery("{{ .ServiceName }}", "{{$componentName}}", "{{$processorName}}"))
+{{- end }}
+
+	service.RegisterRecovery(runner.ProcessorRecovery("{{ .ServiceName }}", "{{$componentName}}", "{{$processorName}}", "{{ .Group }}-table", processor))
 
 	return func(ctx context.Context) func() error {
+		runner.ReportReadiness(ctx, processor.Recovered)
+
 		return func() error {
 			err := processor.Run(ctx)
 			if err != nil {
@@ -253,6 +440,7 @@ type contextMethod struct {
 	Topic           string
 	MessageType     string
 	MessageTypeName string
+	Join            int
 }
 
 type processorContext struct {
@@ -264,19 +452,54 @@ type codec struct {
 	Index   int
 	Message string
 	Topic   string
+	Wrapper codecWrapper
 }
 
 type processorOptions struct {
-	Package       string
-	Component     string
-	ProcessorName string
-	Context       processorContext
-	Interface     processorInterface
-	Imports       []string
-	Group         string
-	Edges         []edge
-	Codecs        []codec
-	Processor     models.Processor
+	Package            string
+	ServiceName        string
+	Component          string
+	ProcessorName      string
+	Context            processorContext
+	Interface          processorInterface
+	Imports            []string
+	Group              string
+	Edges              []edge
+	Codecs             []codec
+	Wrappers           []codecWrapper
+	DeadLetter         *processorDeadLetter
+	IdempotentProducer bool
+	ReadCommitted      bool
+	Timers             bool
+	TimerTopic         string
+	Standby            bool
+	StandbyReplicas    int
+	Storage            string
+	StreamJoins        []processorStreamJoin
+	TableStorage       []processorTableStorage
+	Processor          models.Processor
+}
+
+type processorTableStorage struct {
+	Index   int
+	Name    string
+	Table   string
+	Storage string
+}
+
+type processorStreamJoin struct {
+	Index  int
+	Store  string
+	Topic  string
+	Window time.Duration
+	Codec  int
+	Input  bool
+}
+
+type processorDeadLetter struct {
+	Topic      string
+	RetryGroup string
+	Retry      models.Retry
 }
 
 func generateProcessor(writer io.Writer, processor *processorOptions) error {
@@ -288,6 +511,10 @@ func generateProcessor(writer io.Writer, processor *processorOptions) error {
 }
 
 func buildProcessorOptions(pkg string, mod string, modelsPath string, service *models.Service, component *models.Component, processor models.Processor) (*processorOptions, error) {
+	if processor.ExactlyOnce {
+		return nil, errors.Errorf("processor '%s' cannot be exactly once since kafka transactions are not supported yet, use idempotentProducer and readCommitted instead", processor.Name)
+	}
+
 	imports := map[string]int{}
 	importIndex := 0
 
@@ -296,6 +523,7 @@ func buildProcessorOptions(pkg string, mod string, modelsPath string, service *m
 
 	options := processorOptions{
 		Package:       pkg,
+		ServiceName:   service.Name,
 		Component:     component.Name,
 		ProcessorName: processor.Name,
 		Imports:       []string{},
@@ -351,12 +579,18 @@ func buildProcessorOptions(pkg string, mod string, modelsPath string, service *m
 		intr.Methods = append(intr.Methods, method)
 
 		topic := input.ToTopicName(service)
+		wrapper, err := buildCodecWrapper(service, input.TopicDefinition)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to build codec wrapper for '%s'", input.Message)
+		}
+
 		c, ok := codecs[topic]
 		if !ok {
 			c = codec{
 				Index:   codecIndex,
 				Topic:   topic,
 				Message: fmt.Sprintf("m%d.%s", i, strcase.ToCamel(message)),
+				Wrapper: wrapper,
 			}
 			codecs[topic] = c
 			codecIndex++
@@ -416,12 +650,18 @@ func buildProcessorOptions(pkg string, mod string, modelsPath string, service *m
 
 		options.Context.Methods = append(options.Context.Methods, m)
 
+		wrapper, err := buildCodecWrapper(service, lookup.TopicDefinition)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to build codec wrapper for '%s'", lookup.Message)
+		}
+
 		c, ok := codecs[m.Topic]
 		if !ok {
 			c = codec{
 				Index:   codecIndex,
 				Topic:   m.Topic,
 				Message: fmt.Sprintf("m%d.%s", i, strcase.ToCamel(message)),
+				Wrapper: wrapper,
 			}
 			codecs[m.Topic] = c
 			codecIndex++
@@ -476,12 +716,18 @@ func buildProcessorOptions(pkg string, mod string, modelsPath string, service *m
 
 		options.Context.Methods = append(options.Context.Methods, m)
 
+		wrapper, err := buildCodecWrapper(service, join.TopicDefinition)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to build codec wrapper for '%s'", join.Message)
+		}
+
 		c, ok := codecs[m.Topic]
 		if !ok {
 			c = codec{
 				Index:   codecIndex,
 				Topic:   m.Topic,
 				Message: fmt.Sprintf("m%d.%s", i, strcase.ToCamel(message)),
+				Wrapper: wrapper,
 			}
 			codecs[m.Topic] = c
 			codecIndex++
@@ -494,6 +740,73 @@ func buildProcessorOptions(pkg string, mod string, modelsPath string, service *m
 		})
 	}
 
+	for _, join := range processor.StreamJoins {
+		if join.Window <= 0 {
+			return nil, errors.Errorf("stream join '%s' must have a window greater than zero", join.Message)
+		}
+
+		modulePackage := join.ToPackage(service)
+
+		i, ok := imports[modulePackage]
+		if !ok {
+			imports[modulePackage] = importIndex
+			i = importIndex
+
+			importIndex++
+		}
+
+		nameFrags := strings.Split(join.Message, ".")
+		message := fmt.Sprintf("m%d.%s", i, strcase.ToCamel(nameFrags[len(nameFrags)-1]))
+
+		m := contextMethod{
+			interfaceMethod: interfaceMethod{
+				Name: fmt.Sprintf("Join_%s", join.ToSafeMessageTypeName()),
+				Args: fmt.Sprintf("key string) []*%s", message),
+			},
+			Type:            "streamJoin",
+			Topic:           join.ToTopicName(service),
+			MessageType:     message,
+			MessageTypeName: join.Message,
+			Join:            len(options.StreamJoins),
+		}
+
+		options.Context.Methods = append(options.Context.Methods, m)
+
+		wrapper, err := buildCodecWrapper(service, join.TopicDefinition)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to build codec wrapper for '%s'", join.Message)
+		}
+
+		topic := join.ToTopicName(service)
+		c, ok := codecs[topic]
+		if !ok {
+			c = codec{
+				Index:   codecIndex,
+				Topic:   topic,
+				Message: message,
+				Wrapper: wrapper,
+			}
+			codecs[topic] = c
+			codecIndex++
+		}
+
+		input := false
+		for _, i := range processor.Inputs {
+			if i.ToTopicName(service) == topic {
+				input = true
+			}
+		}
+
+		options.StreamJoins = append(options.StreamJoins, processorStreamJoin{
+			Index:  len(options.StreamJoins),
+			Store:  join.StoreName(service, component, &processor),
+			Topic:  topic,
+			Window: join.Window,
+			Codec:  c.Index,
+			Input:  input,
+		})
+	}
+
 	for _, output := range processor.Outputs {
 		var name strings.Builder
 		name.WriteString("Output_")
@@ -528,6 +841,7 @@ func buildProcessorOptions(pkg string, mod string, modelsPath string, service *m
 				Args: args.String(),
 			},
 			Type:            "output",
+			MessageType:     fmt.Sprintf("m%d.%s", i, strcase.ToCamel(message)),
 			MessageTypeName: output.Message,
 			Topic:           output.ToTopicName(service),
 		}
@@ -536,12 +850,18 @@ func buildProcessorOptions(pkg string, mod string, modelsPath string, service *m
 
 		options.Context.Methods = append(options.Context.Methods, m)
 
+		wrapper, err := buildCodecWrapper(service, output.TopicDefinition)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to build codec wrapper for '%s'", output.Message)
+		}
+
 		c, ok := codecs[m.Topic]
 		if !ok {
 			c = codec{
 				Index:   codecIndex,
 				Topic:   m.Topic,
 				Message: fmt.Sprintf("m%d.%s", i, strcase.ToCamel(message)),
+				Wrapper: wrapper,
 			}
 			codecs[m.Topic] = c
 			codecIndex++
@@ -580,6 +900,7 @@ func buildProcessorOptions(pkg string, mod string, modelsPath string, service *m
 				Args: fmt.Sprintf("state *m%d.%s)", i, strcase.ToCamel(message)),
 			},
 			Type:            "save",
+			MessageType:     fmt.Sprintf("m%d.%s", i, strcase.ToCamel(message)),
 			MessageTypeName: processor.Persistence.Message,
 			Topic:           options.Group + "-table",
 		})
@@ -595,12 +916,18 @@ func buildProcessorOptions(pkg string, mod string, modelsPath string, service *m
 			Topic:           options.Group + "-table",
 		})
 
+		wrapper, err := buildCodecWrapper(service, processor.Persistence.TopicDefinition)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to build codec wrapper for '%s'", processor.Persistence.Message)
+		}
+
 		c, ok := codecs[options.Group+"-table"]
 		if !ok {
 			c = codec{
 				Index:   codecIndex,
 				Topic:   options.Group + "-table",
 				Message: fmt.Sprintf("m%d.%s", i, strcase.ToCamel(message)),
+				Wrapper: wrapper,
 			}
 			codecs[options.Group+"-table"] = c
 			codecIndex++
@@ -610,6 +937,20 @@ func buildProcessorOptions(pkg string, mod string, modelsPath string, service *m
 			Type:  "state",
 			Codec: c.Index,
 		})
+
+		options.Standby = processor.Standby
+		options.StandbyReplicas = processor.StandbyReplicas
+		if options.StandbyReplicas == 0 {
+			options.StandbyReplicas = 1
+		}
+	}
+
+	if processor.Standby && processor.Persistence == nil {
+		return nil, errors.Errorf("processor '%s' must have persistence to keep standby replicas", processor.Name)
+	}
+
+	if processor.StandbyReplicas < 0 {
+		return nil, errors.Errorf("processor '%s' standby replicas must not be negative", processor.Name)
 	}
 
 	for _, c := range codecs {
@@ -620,6 +961,15 @@ func buildProcessorOptions(pkg string, mod string, modelsPath string, service *m
 		return options.Codecs[i].Index < options.Codecs[j].Index
 	})
 
+	for _, w := range []codecWrapper{protoCodecWrapper, avroCodecWrapper} {
+		for _, c := range options.Codecs {
+			if c.Wrapper == w {
+				options.Wrappers = append(options.Wrappers, w)
+				break
+			}
+		}
+	}
+
 	for k, v := range imports {
 		imp := fmt.Sprintf("m%d \"%s\"", v, k)
 		options.Imports = append(options.Imports, imp)
@@ -627,7 +977,68 @@ func buildProcessorOptions(pkg string, mod string, modelsPath string, service *m
 
 	sort.Strings(options.Imports)
 
+	if processor.DeadLetter != nil {
+		options.DeadLetter = &processorDeadLetter{
+			Topic:      processor.DeadLetterTopicName(service, component),
+			RetryGroup: processor.RetryGroupName(service, component),
+			Retry:      processor.DeadLetter.Retry,
+		}
+	}
+
+	options.IdempotentProducer = processor.IdempotentProducer
+	options.ReadCommitted = processor.ReadCommitted
+	options.Timers = processor.Timers
+	options.TimerTopic = processor.TimerGroupName(service, component)
 	options.Processor = processor
 
+	storage, err := buildStorageOverride(processor.Storage)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build storage options for processor '%s'", processor.Name)
+	}
+	options.Storage = storage
+
+	addTableStorage := func(name string, table string, s *models.Storage) error {
+		for _, t := range options.TableStorage {
+			if t.Table == table {
+				return nil
+			}
+		}
+
+		override, err := buildStorageOverride(s)
+		if err != nil {
+			return errors.Wrapf(err, "failed to build storage options for table '%s'", table)
+		}
+
+		options.TableStorage = append(options.TableStorage, processorTableStorage{
+			Index:   len(options.TableStorage),
+			Name:    name,
+			Table:   table,
+			Storage: override,
+		})
+		return nil
+	}
+
+	// lookup and join tables are stored by their topic so the service can configure them by it
+	for _, lookup := range processor.Lookups {
+		err = addTableStorage(lookup.ToTopicName(service), lookup.ToTopicName(service), lookup.Storage)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, join := range processor.Joins {
+		err = addTableStorage(join.ToTopicName(service), join.ToTopicName(service), nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if processor.Persistence != nil && processor.Persistence.Storage != nil {
+		err = addTableStorage(options.Group, options.Group+"-table", processor.Persistence.Storage)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &options, nil
 }
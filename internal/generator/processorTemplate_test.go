@@ -2,9 +2,13 @@ package generator_test
 
 import (
 	"io/ioutil"
+	"os"
 	"path"
 	"testing"
 
+	"github.com/syncromatics/kafmesh/internal/generator"
+	"github.com/syncromatics/kafmesh/internal/models"
+
 	"github.com/stretchr/testify/assert"
 )
 
@@ -17,6 +21,41 @@ func validateProcessors(tmpDir string, t *testing.T) {
 	assert.Equal(t, expectedDetailsProcessor, string(s))
 }
 
+func Test_Generator_ExactlyOnceIsNotSupported(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "Test_Generator_ExactlyOnceIsNotSupported")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	err = generator.Generate(generator.Options{
+		Service: &models.Service{
+			Name: "testMesh",
+			Output: models.OutputSettings{
+				Path:    "internal/kafmesh",
+				Package: "kafmesh",
+				Module:  "test",
+			},
+		},
+		Components: []*models.Component{
+			{
+				Name: "billing",
+				Processors: []models.Processor{
+					{
+						Name:        "invoices",
+						ExactlyOnce: true,
+					},
+				},
+			},
+		},
+		RootPath:        tmpDir,
+		DefinitionsPath: tmpDir,
+	})
+
+	assert.EqualError(t, err, "failed to process component: failed to build processor options: processor 'invoices' cannot be exactly once "+
+		"since kafka transactions are not supported yet, use idempotentProducer and readCommitted instead")
+}
+
 var (
 	expectedDetailsProcessor = `// Code generated by kafmesh-gen. DO NOT EDIT.
 
@@ -25,16 +64,12 @@ package details
 import (
 	"context"
 	"encoding/json"
-	"os"
-	"path/filepath"
 	"time"
 
 	"github.com/Shopify/sarama"
 	"github.com/burdiyan/kafkautil"
 	"github.com/lovoo/goka"
-	"github.com/lovoo/goka/storage"
 	"github.com/pkg/errors"
-	"github.com/syndtr/goleveldb/leveldb/opt"
 
 	"github.com/syncromatics/kafmesh/pkg/runner"
 
@@ -47,23 +82,27 @@ type Enricher_ProcessorContext interface {
 	Timestamp() time.Time
 	Lookup_TestSerialDetails(key string) *m1.Details
 	Join_TestSerialDetails() *m1.Details
+	Join_TestIDTest2(key string) []*m0.Test2
 	Output_TestSerialDetailsEnriched(key string, message *m1.DetailsEnriched)
 	SaveState(state *m1.DetailsState)
 	State() *m1.DetailsState
+	Schedule(key string, at time.Time)
 }
 
 type Enricher_Processor interface {
 	HandleTestIDTest(ctx Enricher_ProcessorContext, message *m0.Test) error
 	HandleTestIDTest2(ctx Enricher_ProcessorContext, message *m0.Test2) error
+	HandleTimer(ctx Enricher_ProcessorContext, at time.Time) error
 }
 
 type Enricher_ProcessorContext_Impl struct {
 	ctx              goka.Context
 	processorContext *runner.ProcessorContext
+	join0            *runner.StreamJoin
 }
 
-func new_Enricher_ProcessorContext_Impl(ctx goka.Context, pc *runner.ProcessorContext) *Enricher_ProcessorContext_Impl {
-	return &Enricher_ProcessorContext_Impl{ctx, pc}
+func new_Enricher_ProcessorContext_Impl(ctx goka.Context, pc *runner.ProcessorContext, join0 *runner.StreamJoin) *Enricher_ProcessorContext_Impl {
+	return &Enricher_ProcessorContext_Impl{ctx, pc, join0}
 }
 
 func (c *Enricher_ProcessorContext_Impl) Key() string {
@@ -102,15 +141,36 @@ func (c *Enricher_ProcessorContext_Impl) Join_TestSerialDetails() *m1.Details {
 	return m
 }
 
+func (c *Enricher_ProcessorContext_Impl) Join_TestIDTest2(key string) []*m0.Test2 {
+	v, err := c.join0.Within(c.ctx, key)
+	if err != nil {
+		c.ctx.Fail(err)
+	}
+
+	messages := []*m0.Test2{}
+	for _, m := range v {
+		messages = append(messages, m.(*m0.Test2))
+	}
+
+	value, _ := json.Marshal(messages)
+	c.processorContext.Join("testMesh.testId.test2", "testId.test2", string(value))
+
+	return messages
+}
+
 func (c *Enricher_ProcessorContext_Impl) Output_TestSerialDetailsEnriched(key string, message *m1.DetailsEnriched) {
 	value, _ := json.Marshal(message)
-	c.processorContext.Output("testMesh.testSerial.detailsEnriched", "testSerial.detailsEnriched", key, string(value))
-	c.ctx.Emit("testMesh.testSerial.detailsEnriched", key, message)
+	runner.OnCommit(c.ctx, func() {
+		c.processorContext.Output("testMesh.testSerial.detailsEnriched", "testSerial.detailsEnriched", key, string(value))
+	})
+	c.ctx.Emit("testMesh.testSerial.detailsEnriched", key, message, goka.WithCtxEmitHeaders(c.processorContext.Headers()))
 }
 
 func (c *Enricher_ProcessorContext_Impl) SaveState(state *m1.DetailsState) {
 	value, _ := json.Marshal(state)
-	c.processorContext.SetState("testMesh.details.enricher-table", "testSerial.detailsState", string(value))
+	runner.OnCommit(c.ctx, func() {
+		c.processorContext.SetState("testMesh.details.enricher-table", "testSerial.detailsState", string(value))
+	})
 
 	c.ctx.SetValue(state)
 }
@@ -130,9 +190,14 @@ func (c *Enricher_ProcessorContext_Impl) State() *m1.DetailsState {
 	return m
 }
 
+func (c *Enricher_ProcessorContext_Impl) Schedule(key string, at time.Time) {
+	c.ctx.Emit("testMesh.details.enricher-timers", key, &runner.Timer{At: at})
+}
+
 func Register_Enricher_Processor(service *runner.Service, impl Enricher_Processor) (func(context.Context) func() error, error) {
 	options := service.Options()
 	brokers := options.Brokers
+	metrics := options.Metrics.Processor("testMesh", "details", "enricher")
 	protoWrapper := options.ProtoWrapper
 
 	config := sarama.NewConfig()
@@ -140,21 +205,28 @@ func Register_Enricher_Processor(service *runner.Service, impl Enricher_Processo
 	config.Consumer.Offsets.Initial = sarama.OffsetOldest
 	config.Consumer.Offsets.AutoCommit.Enable = true
 	config.Consumer.Offsets.CommitInterval = 1 * time.Second
+	runner.ConfigureReadCommitted(config)
+
+	builder, err := options.Storage.Builder("processor", "testMesh.details.enricher", runner.StorageOptions{BlockCacheCapacity: 8388608, WriteBuffer: 4194304})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create processor storage")
+	}
 
-	opts := &opt.Options{
-		BlockCacheCapacity: opt.MiB * 1,
-		WriteBuffer:        opt.MiB * 1,
+	table0Builder, err := options.Storage.Builder("processor", "testMesh.testSerial.details", runner.StorageOptions{BlockCacheCapacity: 8388608, WriteBuffer: 4194304}, runner.StorageOptions{Backend: runner.StorageBackendPebble})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create table storage")
 	}
 
-	path := filepath.Join("/tmp/storage", "processor", "testMesh.details.enricher")
+	builder = runner.TableStorageBuilder(builder,
+		runner.TableStorage{Table: "testMesh.testSerial.details", Builder: table0Builder},
+	)
 
-	err := os.MkdirAll(path, os.ModePerm)
+	timersBuilder, err := options.Storage.Builder("processor", "testMesh.details.enricher-timers", runner.StorageOptions{BlockCacheCapacity: 8388608, WriteBuffer: 4194304})
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to create processor db directory")
+		return nil, errors.Wrap(err, "failed to create timers storage")
 	}
 
-	builder := storage.BuilderWithOptions(path, opts)
-
+	timers := runner.NewTimers(brokers, "testMesh.details.enricher", timersBuilder)
 
 	c0, err := protoWrapper.Codec("testMesh.testId.test", &m0.Test{})
 	if err != nil {
@@ -181,11 +253,35 @@ func Register_Enricher_Processor(service *runner.Service, impl Enricher_Processo
 		return nil, errors.Wrap(err, "failed to create codec")
 	}
 
+	join0Builder, err := options.Storage.Builder("processor", "testMesh.details.enricher-testIDTest2-join", runner.StorageOptions{BlockCacheCapacity: 8388608, WriteBuffer: 4194304})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create stream join storage")
+	}
+
+	join0 := runner.NewStreamJoin(brokers, "testMesh.details.enricher-testIDTest2-join", "testMesh.testId.test2", 30000*time.Millisecond, c1, join0Builder)
+
+	standby := runner.NewStandby(brokers, "testMesh.details.enricher", "testMesh.details.enricher-table", 2, builder)
+	standby.Configure(config)
+	builder = standby.Builder(builder)
+
+	retriesBuilder, err := options.Storage.Builder("processor", "testMesh.details.enricher-retries", runner.StorageOptions{BlockCacheCapacity: 8388608, WriteBuffer: 4194304})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create retries storage")
+	}
+
+	deadLetter := runner.NewDeadLetter(brokers, "testMesh.details.enricher", "testMesh.details.enricher-dlq", runner.RetryPolicy{
+		MaxAttempts: 3,
+		Backoff:     100 * time.Millisecond,
+		MaxBackoff:  1000 * time.Millisecond,
+	}, retriesBuilder)
+
 	edges := []goka.Edge{
-		goka.Input(goka.Stream("testMesh.testId.test"), c0, func(ctx goka.Context, m interface{}) {
+		deadLetter.Input("testMesh.testId.test", c0, func(ctx goka.Context, m interface{}) {
+			start := time.Now()
 			msg := m.(*m0.Test)
 
 			pc := service.ProcessorContext(ctx.Context(), "details", "enricher", ctx.Key())
+			pc.Trace("testMesh.testId.test", ctx.Headers())
 			defer pc.Finish()
 
 			v, err := json.Marshal(msg)
@@ -194,16 +290,22 @@ func Register_Enricher_Processor(service *runner.Service, impl Enricher_Processo
 			}
 			pc.Input("testMesh.testId.test", "testId.test", string(v))
 
-			w := new_Enricher_ProcessorContext_Impl(ctx, pc)
-			err = impl.HandleTestIDTest(w, msg)
+			err = deadLetter.Handle(ctx, c0, msg, func(ctx goka.Context) error {
+				w := new_Enricher_ProcessorContext_Impl(ctx, pc, join0)
+				return impl.HandleTestIDTest(w, msg)
+			})
+			metrics.Handled("testMesh.testId.test", time.Since(start), err)
 			if err != nil {
+				pc.Fail(err)
 				ctx.Fail(err)
 			}
 		}),
-		goka.Input(goka.Stream("testMesh.testId.test2"), c1, func(ctx goka.Context, m interface{}) {
+		deadLetter.Input("testMesh.testId.test2", c1, func(ctx goka.Context, m interface{}) {
+			start := time.Now()
 			msg := m.(*m0.Test2)
 
 			pc := service.ProcessorContext(ctx.Context(), "details", "enricher", ctx.Key())
+			pc.Trace("testMesh.testId.test2", ctx.Headers())
 			defer pc.Finish()
 
 			v, err := json.Marshal(msg)
@@ -212,9 +314,18 @@ func Register_Enricher_Processor(service *runner.Service, impl Enricher_Processo
 			}
 			pc.Input("testMesh.testId.test2", "testId.test2", string(v))
 
-			w := new_Enricher_ProcessorContext_Impl(ctx, pc)
-			err = impl.HandleTestIDTest2(w, msg)
+			err = join0.Add(ctx, msg)
+			if err != nil {
+				ctx.Fail(err)
+			}
+
+			err = deadLetter.Handle(ctx, c1, msg, func(ctx goka.Context) error {
+				w := new_Enricher_ProcessorContext_Impl(ctx, pc, join0)
+				return impl.HandleTestIDTest2(w, msg)
+			})
+			metrics.Handled("testMesh.testId.test2", time.Since(start), err)
 			if err != nil {
+				pc.Fail(err)
 				ctx.Fail(err)
 			}
 		}),
@@ -222,19 +333,73 @@ func Register_Enricher_Processor(service *runner.Service, impl Enricher_Processo
 		goka.Join(goka.Table("testMesh.testSerial.details"), c2),
 		goka.Output(goka.Stream("testMesh.testSerial.detailsEnriched"), c3),
 		goka.Persist(c4),
+		join0.ChangelogEdge(),
+		timers.Edge(),
+		deadLetter.Input(timers.FiredTopic(), new(runner.TimerCodec), func(ctx goka.Context, m interface{}) {
+			start := time.Now()
+			timer := m.(*runner.Timer)
+
+			pc := service.ProcessorContext(ctx.Context(), "details", "enricher", ctx.Key())
+			defer pc.Finish()
+
+			err := deadLetter.Handle(ctx, new(runner.TimerCodec), timer, func(ctx goka.Context) error {
+				w := new_Enricher_ProcessorContext_Impl(ctx, pc, join0)
+				return impl.HandleTimer(w, timer.At)
+			})
+			metrics.Handled(timers.FiredTopic(), time.Since(start), err)
+			if err != nil {
+				pc.Fail(err)
+				ctx.Fail(err)
+			}
+		}),
 	}
+	edges = append(edges, deadLetter.Edges()...)
+
 	group := goka.DefineGroup(goka.Group("testMesh.details.enricher"), edges...)
 
 	processor, err := goka.NewProcessor(brokers,
 		group,
-		goka.WithConsumerGroupBuilder(goka.ConsumerGroupBuilderWithConfig(config)),
-		goka.WithStorageBuilder(builder),
-		goka.WithHasher(kafkautil.MurmurHasher))
+		options.ProcessorOptions(
+			goka.WithConsumerGroupBuilder(goka.ConsumerGroupBuilderWithConfig(config)),
+			goka.WithStorageBuilder(builder),
+			goka.WithConsumerSaramaBuilder(goka.SaramaConsumerBuilderWithConfig(runner.ReadCommittedConfig())),
+			goka.WithProducerBuilder(runner.IdempotentProducerBuilder()),
+			goka.WithRebalanceCallback(func(assignment goka.Assignment) {
+				join0.Rebalanced(assignment)
+			}),
+			goka.WithHasher(kafkautil.MurmurHasher),
+		)...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create goka processor")
 	}
 
+	err = service.RegisterTimers(timers)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register timers")
+	}
+
+	err = service.RegisterDeadLetter(deadLetter)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register dead letter")
+	}
+
+	err = service.RegisterStreamJoin(join0)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register stream join")
+	}
+
+	err = service.RegisterStandby(standby, runner.WithRunnerName("testMesh.details.enricher-standby"))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register standby")
+	}
+
+	service.RegisterRecovery(standby.Recovery("testMesh", "details", "enricher"))
+
+	service.RegisterRecovery(runner.ProcessorRecovery("testMesh", "details", "enricher", "testMesh.details.enricher-table", processor))
+
 	return func(ctx context.Context) func() error {
+		runner.ReportReadiness(ctx, processor.Recovered)
+
 		return func() error {
 			err := processor.Run(ctx)
 			if err != nil {
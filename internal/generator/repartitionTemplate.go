@@ -0,0 +1,163 @@
+package generator
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/syncromatics/kafmesh/internal/models"
+
+	"github.com/iancoleman/strcase"
+	"github.com/pkg/errors"
+)
+
+var (
+	repartitionTemplate = template.Must(template.New("").Parse(`// Code generated by kafmesh-gen. DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/burdiyan/kafkautil"
+	"github.com/lovoo/goka"
+	"github.com/pkg/errors"
+
+	"github.com/syncromatics/kafmesh/pkg/runner"
+
+	{{ .Import }}
+)
+
+// {{ .Name }}_KeyFunc gets the key a message is repartitioned by. Messages it returns an empty key for are dropped.
+type {{ .Name }}_KeyFunc func(key string, message *{{ .Message }}) (string, error)
+
+func Register_{{ .Name }}_Repartition(service *runner.Service, keyFunc {{ .Name }}_KeyFunc) (func(context.Context) func() error, error) {
+	options := service.Options()
+	brokers := options.Brokers
+	metrics := options.Metrics.Processor("{{ .ServiceName }}", "{{ .Component }}", "{{ .RepartitionName }}")
+	{{ .Wrapper.Name }} := options.{{ .Wrapper.Option }}
+
+	config := sarama.NewConfig()
+	config.Version = sarama.MaxVersion
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	config.Consumer.Offsets.AutoCommit.Enable = true
+	config.Consumer.Offsets.CommitInterval = 1 * time.Second
+
+	c0, err := {{ .Wrapper.Name }}.Codec("{{ .Topic }}", &{{ .Message }}{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create codec")
+	}
+
+	c1, err := {{ .Wrapper.Name }}.Codec("{{ .RepartitionTopic }}", &{{ .Message }}{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create codec")
+	}
+
+	group := goka.DefineGroup(goka.Group("{{ .Group }}"),
+		goka.Input(goka.Stream("{{ .Topic }}"), c0, func(ctx goka.Context, m interface{}) {
+			start := time.Now()
+			msg := m.(*{{ .Message }})
+
+			pc := service.ProcessorContext(ctx.Context(), "{{ .Component }}", "{{ .RepartitionName }}", ctx.Key())
+			pc.Trace("{{ .Topic }}", ctx.Headers())
+			defer pc.Finish()
+
+			v, err := json.Marshal(msg)
+			if err != nil {
+				ctx.Fail(err)
+			}
+			pc.Input("{{ .Topic }}", "{{ .MessageType }}", string(v))
+
+			key, err := keyFunc(ctx.Key(), msg)
+			metrics.Handled("{{ .Topic }}", time.Since(start), err)
+			if err != nil {
+				pc.Fail(err)
+				ctx.Fail(err)
+			}
+
+			if key == "" {
+				return
+			}
+
+			pc.Output("{{ .RepartitionTopic }}", "{{ .MessageType }}", key, string(v))
+			ctx.Emit("{{ .RepartitionTopic }}", key, msg, goka.WithCtxEmitHeaders(pc.Headers()))
+		}),
+		goka.Output(goka.Stream("{{ .RepartitionTopic }}"), c1),
+	)
+
+	processor, err := goka.NewProcessor(brokers,
+		group,
+		options.ProcessorOptions(
+			goka.WithConsumerGroupBuilder(goka.ConsumerGroupBuilderWithConfig(config)),
+			goka.WithHasher(kafkautil.MurmurHasher),
+		)...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create goka processor")
+	}
+
+	return func(ctx context.Context) func() error {
+		runner.ReportReadiness(ctx, processor.Recovered)
+
+		return func() error {
+			err := processor.Run(ctx)
+			if err != nil {
+				return errors.Wrap(err, "failed to run goka repartition processor")
+			}
+
+			return nil
+		}
+	}, nil
+}
+`))
+)
+
+type repartitionOptions struct {
+	Package          string
+	ServiceName      string
+	Component        string
+	RepartitionName  string
+	Name             string
+	Group            string
+	Import           string
+	Topic            string
+	RepartitionTopic string
+	Message          string
+	MessageType      string
+	Wrapper          codecWrapper
+}
+
+func generateRepartition(writer io.Writer, repartition *repartitionOptions) error {
+	err := repartitionTemplate.Execute(writer, repartition)
+	if err != nil {
+		return errors.Wrap(err, "failed to execute repartition template")
+	}
+	return nil
+}
+
+func buildRepartitionOptions(pkg string, service *models.Service, component *models.Component, repartition models.Repartition) (*repartitionOptions, error) {
+	wrapper, err := buildCodecWrapper(service, repartition.TopicDefinition)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build codec wrapper for '%s'", repartition.Message)
+	}
+
+	nameFrags := strings.Split(repartition.Message, ".")
+
+	return &repartitionOptions{
+		Package:          pkg,
+		ServiceName:      service.Name,
+		Component:        component.Name,
+		RepartitionName:  repartition.Name,
+		Name:             repartition.ToSafeName(),
+		Group:            repartition.GroupName(service, component),
+		Import:           fmt.Sprintf("m0 \"%s\"", repartition.ToPackage(service)),
+		Topic:            repartition.ToTopicName(service),
+		RepartitionTopic: repartition.RepartitionTopicName(service, component),
+		Message:          fmt.Sprintf("m0.%s", strcase.ToCamel(nameFrags[len(nameFrags)-1])),
+		MessageType:      repartition.Message,
+		Wrapper:          wrapper,
+	}, nil
+}
@@ -0,0 +1,121 @@
+package generator_test
+
+import (
+	"io/ioutil"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func validateRepartition(tmpDir string, t *testing.T) {
+	s, err := ioutil.ReadFile(path.Join(tmpDir, "internal", "kafmesh", "details", "details_by_customer_repartition.km.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, expectedRepartition, string(s))
+}
+
+var (
+	expectedRepartition = `// Code generated by kafmesh-gen. DO NOT EDIT.
+
+package details
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/burdiyan/kafkautil"
+	"github.com/lovoo/goka"
+	"github.com/pkg/errors"
+
+	"github.com/syncromatics/kafmesh/pkg/runner"
+
+	m0 "test/internal/kafmesh/models/testMesh/testSerial"
+)
+
+// DetailsByCustomer_KeyFunc gets the key a message is repartitioned by. Messages it returns an empty key for are dropped.
+type DetailsByCustomer_KeyFunc func(key string, message *m0.Details) (string, error)
+
+func Register_DetailsByCustomer_Repartition(service *runner.Service, keyFunc DetailsByCustomer_KeyFunc) (func(context.Context) func() error, error) {
+	options := service.Options()
+	brokers := options.Brokers
+	metrics := options.Metrics.Processor("testMesh", "details", "details by customer")
+	protoWrapper := options.ProtoWrapper
+
+	config := sarama.NewConfig()
+	config.Version = sarama.MaxVersion
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	config.Consumer.Offsets.AutoCommit.Enable = true
+	config.Consumer.Offsets.CommitInterval = 1 * time.Second
+
+	c0, err := protoWrapper.Codec("testMesh.testSerial.details", &m0.Details{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create codec")
+	}
+
+	c1, err := protoWrapper.Codec("testMesh.details.detailsByCustomer-repartition", &m0.Details{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create codec")
+	}
+
+	group := goka.DefineGroup(goka.Group("testMesh.details.detailsByCustomer"),
+		goka.Input(goka.Stream("testMesh.testSerial.details"), c0, func(ctx goka.Context, m interface{}) {
+			start := time.Now()
+			msg := m.(*m0.Details)
+
+			pc := service.ProcessorContext(ctx.Context(), "details", "details by customer", ctx.Key())
+			pc.Trace("testMesh.testSerial.details", ctx.Headers())
+			defer pc.Finish()
+
+			v, err := json.Marshal(msg)
+			if err != nil {
+				ctx.Fail(err)
+			}
+			pc.Input("testMesh.testSerial.details", "testSerial.details", string(v))
+
+			key, err := keyFunc(ctx.Key(), msg)
+			metrics.Handled("testMesh.testSerial.details", time.Since(start), err)
+			if err != nil {
+				pc.Fail(err)
+				ctx.Fail(err)
+			}
+
+			if key == "" {
+				return
+			}
+
+			pc.Output("testMesh.details.detailsByCustomer-repartition", "testSerial.details", key, string(v))
+			ctx.Emit("testMesh.details.detailsByCustomer-repartition", key, msg, goka.WithCtxEmitHeaders(pc.Headers()))
+		}),
+		goka.Output(goka.Stream("testMesh.details.detailsByCustomer-repartition"), c1),
+	)
+
+	processor, err := goka.NewProcessor(brokers,
+		group,
+		options.ProcessorOptions(
+			goka.WithConsumerGroupBuilder(goka.ConsumerGroupBuilderWithConfig(config)),
+			goka.WithHasher(kafkautil.MurmurHasher),
+		)...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create goka processor")
+	}
+
+	return func(ctx context.Context) func() error {
+		runner.ReportReadiness(ctx, processor.Recovered)
+
+		return func() error {
+			err := processor.Run(ctx)
+			if err != nil {
+				return errors.Wrap(err, "failed to run goka repartition processor")
+			}
+
+			return nil
+		}
+	}, nil
+}
+`
+)
@@ -27,6 +27,19 @@ import (
 	{{ printf "%q" . }}
 {{- end }}
 )
+
+// NewSchemaRegistry creates the schema registry client the service is configured to use
+func NewSchemaRegistry(url string) (runner.SchemaRegistry, error) {
+{{- if eq .Registry "confluent" }}
+	return runner.NewConfluentRegistry(url), nil
+{{- else }}
+	registry, err := runner.NewRegistry(url)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create registry")
+	}
+	return registry, nil
+{{- end }}
+}
 {{ range .Processors }}
 func Register_{{ .ExportName }}(service *runner.Service, processor {{ .Package }}.{{ .Name }}) error {
 	r, err := {{ .Package }}.Register_{{ .Name }}(service, processor)
@@ -34,7 +47,28 @@ func Register_{{ .ExportName }}(service *runner.Service, processor {{ .Package }
 		return errors.Wrap(err, "failed to register processor")
 	}
 
-	err = service.RegisterRunner(r)
+	err = service.RegisterRunner(r, runner.WithRunnerName("{{ .ExportName }}"))
+	if err != nil {
+		return errors.Wrap(err, "failed to register runner with service")
+	}
+
+	err = discover_{{ .ExportName }}(service)
+	if err != nil {
+		return errors.Wrap(err, "failed to register with discovery")
+	}
+
+	return nil
+}
+{{ end -}}
+
+{{ range .Repartitions }}
+func Register_{{ .ExportName }}(service *runner.Service, keyFunc {{ .Package }}.{{ .Name }}_KeyFunc) error {
+	r, err := {{ .Package }}.Register_{{ .Name }}_Repartition(service, keyFunc)
+	if err != nil {
+		return errors.Wrap(err, "failed to register repartition")
+	}
+
+	err = service.RegisterRunner(r, runner.WithRunnerName("{{ .ExportName }}"))
 	if err != nil {
 		return errors.Wrap(err, "failed to register runner with service")
 	}
@@ -55,7 +89,7 @@ func New_{{ .ExportName }}_Source(service *runner.Service) ({{ .Package }}.{{ .N
 		return nil, err
 	}
 
-	err = service.RegisterRunner(r)
+	err = service.RegisterRunner(r, runner.WithRunnerName("{{ .ExportName }}_Source"), runner.WithRunnerStage(runner.StageSources))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to register runner with service")
 	}
@@ -76,7 +110,7 @@ func New_{{ .ExportName }}_View(service *runner.Service) ({{ .Package }}.{{ .Nam
 		return nil, err
 	}
 
-	err = service.RegisterRunner(r)
+	err = service.RegisterRunner(r, runner.WithRunnerName("{{ .ExportName }}_View"), runner.WithRunnerStage(runner.StageViews))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to register runner with service")
 	}
@@ -91,13 +125,13 @@ func New_{{ .ExportName }}_View(service *runner.Service) ({{ .Package }}.{{ .Nam
 {{ end -}}
 
 {{ range .Sinks }}
-func Register_{{ .Name }}_Sink(service *runner.Service, sink {{ .Package }}.{{ .Name }}_Sink, interval time.Duration, maxBufferSize int) error {
-	r, err := {{ .Package }}.Register_{{ .Name }}_Sink(service.Options(), sink, interval, maxBufferSize)
+func Register_{{ .Name }}_Sink(service *runner.Service, sink {{ .Package }}.{{ .Name }}_Sink, interval time.Duration, maxBufferSize int, retryPolicy runner.RetryPolicy) error {
+	r, err := {{ .Package }}.Register_{{ .Name }}_Sink(service.Options(), sink, interval, maxBufferSize, retryPolicy)
 	if err != nil {
 		return errors.Wrap(err, "failed to register sink")
 	}
 
-	err = service.RegisterRunner(r)
+	err = service.RegisterRunner(r, runner.WithRunnerName("{{ .ExportName }}_Sink"), runner.WithRunnerStage(runner.StageSinks))
 	if err != nil {
 		return errors.Wrap(err, "failed to register runner with service")
 	}
@@ -118,7 +152,7 @@ func Register_{{ .ExportName }}_ViewSource(service *runner.Service, viewSource {
 		return errors.Wrap(err, "failed to register viewSource")
 	}
 
-	err = service.RegisterRunner(r)
+	err = service.RegisterRunner(r, runner.WithRunnerName("{{ .ExportName }}_ViewSource"), runner.WithRunnerStage(runner.StageSources))
 	if err != nil {
 		return errors.Wrap(err, "failed to register runner with service")
 	}
@@ -139,7 +173,7 @@ func Register_{{ .ExportName }}_ViewSink(service *runner.Service, viewSink {{ .P
 		return errors.Wrap(err, "failed to register viewSink")
 	}
 
-	err = service.RegisterRunner(r)
+	err = service.RegisterRunner(r, runner.WithRunnerName("{{ .ExportName }}_ViewSink"), runner.WithRunnerStage(runner.StageSinks))
 	if err != nil {
 		return errors.Wrap(err, "failed to register runner with service")
 	}
@@ -161,6 +195,12 @@ type serviceProcessor struct {
 	Package    string
 }
 
+type serviceRepartition struct {
+	Name       string
+	ExportName string
+	Package    string
+}
+
 type serviceSource struct {
 	Name       string
 	ExportName string
@@ -192,14 +232,16 @@ type serviceViewSink struct {
 }
 
 type generateServiceOptions struct {
-	Package     string
-	Imports     []string
-	Processors  []serviceProcessor
-	Sources     []serviceSource
-	Views       []serviceView
-	Sinks       []serviceSink
-	ViewSources []serviceViewSource
-	ViewSinks   []serviceViewSink
+	Package      string
+	Registry     string
+	Imports      []string
+	Processors   []serviceProcessor
+	Repartitions []serviceRepartition
+	Sources      []serviceSource
+	Views        []serviceView
+	Sinks        []serviceSink
+	ViewSources  []serviceViewSource
+	ViewSinks    []serviceViewSink
 }
 
 func generateService(writer io.Writer, options generateServiceOptions) error {
@@ -213,7 +255,14 @@ func generateService(writer io.Writer, options generateServiceOptions) error {
 
 func buildServiceOptions(service *models.Service, components []*models.Component, mod string) (generateServiceOptions, error) {
 	options := generateServiceOptions{
-		Package: service.Output.Package,
+		Package:  service.Output.Package,
+		Registry: service.Registry.ToRegistryType(),
+	}
+
+	switch options.Registry {
+	case "protoSchemaRegistry", "confluent":
+	default:
+		return options, errors.Errorf("unknown registry type '%s'", options.Registry)
 	}
 
 	p := path.Join(mod, service.Output.Path)
@@ -230,6 +279,23 @@ func buildServiceOptions(service *models.Service, components []*models.Component
 			options.Processors = append(options.Processors, proc)
 		}
 
+		for _, w := range c.Windows {
+			proc := serviceProcessor{
+				Package:    c.Name,
+				ExportName: fmt.Sprintf("%s_%s_WindowedProcessor", c.ToSafeName(), w.ToSafeName()),
+				Name:       fmt.Sprintf("%s_WindowedProcessor", w.ToSafeName()),
+			}
+			options.Processors = append(options.Processors, proc)
+		}
+
+		for _, r := range c.Repartition {
+			options.Repartitions = append(options.Repartitions, serviceRepartition{
+				Package:    c.Name,
+				ExportName: fmt.Sprintf("%s_%s_Repartition", c.ToSafeName(), r.ToSafeName()),
+				Name:       r.ToSafeName(),
+			})
+		}
+
 		for _, e := range c.Sources {
 			proc := serviceSource{
 				Package:    c.Name,
@@ -31,13 +31,22 @@ import (
 	"test/internal/kafmesh/details"
 )
 
+// NewSchemaRegistry creates the schema registry client the service is configured to use
+func NewSchemaRegistry(url string) (runner.SchemaRegistry, error) {
+	registry, err := runner.NewRegistry(url)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create registry")
+	}
+	return registry, nil
+}
+
 func Register_Details_Enricher_Processor(service *runner.Service, processor details.Enricher_Processor) error {
 	r, err := details.Register_Enricher_Processor(service, processor)
 	if err != nil {
 		return errors.Wrap(err, "failed to register processor")
 	}
 
-	err = service.RegisterRunner(r)
+	err = service.RegisterRunner(r, runner.WithRunnerName("Details_Enricher_Processor"))
 	if err != nil {
 		return errors.Wrap(err, "failed to register runner with service")
 	}
@@ -50,13 +59,51 @@ func Register_Details_Enricher_Processor(service *runner.Service, processor deta
 	return nil
 }
 
+func Register_Details_DetailCounts_WindowedProcessor(service *runner.Service, processor details.DetailCounts_WindowedProcessor) error {
+	r, err := details.Register_DetailCounts_WindowedProcessor(service, processor)
+	if err != nil {
+		return errors.Wrap(err, "failed to register processor")
+	}
+
+	err = service.RegisterRunner(r, runner.WithRunnerName("Details_DetailCounts_WindowedProcessor"))
+	if err != nil {
+		return errors.Wrap(err, "failed to register runner with service")
+	}
+
+	err = discover_Details_DetailCounts_WindowedProcessor(service)
+	if err != nil {
+		return errors.Wrap(err, "failed to register with discovery")
+	}
+
+	return nil
+}
+
+func Register_Details_DetailsByCustomer_Repartition(service *runner.Service, keyFunc details.DetailsByCustomer_KeyFunc) error {
+	r, err := details.Register_DetailsByCustomer_Repartition(service, keyFunc)
+	if err != nil {
+		return errors.Wrap(err, "failed to register repartition")
+	}
+
+	err = service.RegisterRunner(r, runner.WithRunnerName("Details_DetailsByCustomer_Repartition"))
+	if err != nil {
+		return errors.Wrap(err, "failed to register runner with service")
+	}
+
+	err = discover_Details_DetailsByCustomer_Repartition(service)
+	if err != nil {
+		return errors.Wrap(err, "failed to register with discovery")
+	}
+
+	return nil
+}
+
 func New_Details_TestSerialDetails_Source(service *runner.Service) (details.TestSerialDetails_Source, error) {
 	e, r, err := details.New_TestSerialDetails_Source(service)
 	if err != nil {
 		return nil, err
 	}
 
-	err = service.RegisterRunner(r)
+	err = service.RegisterRunner(r, runner.WithRunnerName("Details_TestSerialDetails_Source"), runner.WithRunnerStage(runner.StageSources))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to register runner with service")
 	}
@@ -75,7 +122,7 @@ func New_Details_TestSerialDetailsEnriched_View(service *runner.Service) (detail
 		return nil, err
 	}
 
-	err = service.RegisterRunner(r)
+	err = service.RegisterRunner(r, runner.WithRunnerName("Details_TestSerialDetailsEnriched_View"), runner.WithRunnerStage(runner.StageViews))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to register runner with service")
 	}
@@ -88,13 +135,13 @@ func New_Details_TestSerialDetailsEnriched_View(service *runner.Service) (detail
 	return v, nil
 }
 
-func Register_EnrichedDataPostgres_Sink(service *runner.Service, sink details.EnrichedDataPostgres_Sink, interval time.Duration, maxBufferSize int) error {
-	r, err := details.Register_EnrichedDataPostgres_Sink(service.Options(), sink, interval, maxBufferSize)
+func Register_EnrichedDataPostgres_Sink(service *runner.Service, sink details.EnrichedDataPostgres_Sink, interval time.Duration, maxBufferSize int, retryPolicy runner.RetryPolicy) error {
+	r, err := details.Register_EnrichedDataPostgres_Sink(service.Options(), sink, interval, maxBufferSize, retryPolicy)
 	if err != nil {
 		return errors.Wrap(err, "failed to register sink")
 	}
 
-	err = service.RegisterRunner(r)
+	err = service.RegisterRunner(r, runner.WithRunnerName("Details_EnrichedDataPostgres_Sink"), runner.WithRunnerStage(runner.StageSinks))
 	if err != nil {
 		return errors.Wrap(err, "failed to register runner with service")
 	}
@@ -113,7 +160,7 @@ func Register_Details_TestToDatabase_ViewSource(service *runner.Service, viewSou
 		return errors.Wrap(err, "failed to register viewSource")
 	}
 
-	err = service.RegisterRunner(r)
+	err = service.RegisterRunner(r, runner.WithRunnerName("Details_TestToDatabase_ViewSource"), runner.WithRunnerStage(runner.StageSources))
 	if err != nil {
 		return errors.Wrap(err, "failed to register runner with service")
 	}
@@ -132,7 +179,7 @@ func Register_Details_TestToApi_ViewSink(service *runner.Service, viewSink detai
 		return errors.Wrap(err, "failed to register viewSink")
 	}
 
-	err = service.RegisterRunner(r)
+	err = service.RegisterRunner(r, runner.WithRunnerName("Details_TestToApi_ViewSink"), runner.WithRunnerStage(runner.StageSinks))
 	if err != nil {
 		return errors.Wrap(err, "failed to register runner with service")
 	}
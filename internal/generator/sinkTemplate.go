@@ -1,9 +1,7 @@
 package generator
 
 import (
-	"fmt"
 	"io"
-	"strings"
 	"text/template"
 
 	"github.com/syncromatics/kafmesh/internal/models"
@@ -40,6 +38,8 @@ type impl_{{ .Name }}_Sink struct {
 	topic string
 	maxBufferSize int
 	interval time.Duration
+	retryPolicy runner.RetryPolicy
+	readCommitted bool
 }
 
 func (s *impl_{{ .Name }}_Sink) Codec() goka.Codec {
@@ -62,6 +62,14 @@ func (s *impl_{{ .Name }}_Sink) Interval() time.Duration {
 	return s.interval
 }
 
+func (s *impl_{{ .Name }}_Sink) RetryPolicy() runner.RetryPolicy {
+	return s.retryPolicy
+}
+
+func (s *impl_{{ .Name }}_Sink) ReadCommitted() bool {
+	return s.readCommitted
+}
+
 func (s *impl_{{ .Name }}_Sink) Flush() error {
 	return s.sink.Flush()
 }
@@ -75,11 +83,29 @@ func (s *impl_{{ .Name }}_Sink) Collect(ctx runner.MessageContext, key string, m
 	return s.sink.Collect(ctx, key, m)
 }
 
-func Register_{{ .Name }}_Sink(options runner.ServiceOptions, sink {{ .Name }}_Sink, interval time.Duration, maxBufferSize int) (func(ctx context.Context) func() error, error) {
+func (s *impl_{{ .Name }}_Sink) PartitionsAssigned(partitions []int32) error {
+	listener, ok := s.sink.(runner.SinkPartitionListener)
+	if !ok {
+		return nil
+	}
+
+	return listener.PartitionsAssigned(partitions)
+}
+
+func (s *impl_{{ .Name }}_Sink) PartitionsRevoked(partitions []int32) error {
+	listener, ok := s.sink.(runner.SinkPartitionListener)
+	if !ok {
+		return nil
+	}
+
+	return listener.PartitionsRevoked(partitions)
+}
+
+func Register_{{ .Name }}_Sink(options runner.ServiceOptions, sink {{ .Name }}_Sink, interval time.Duration, maxBufferSize int, retryPolicy runner.RetryPolicy) (func(ctx context.Context) func() error, error) {
 	brokers := options.Brokers
-	protoWrapper := options.ProtoWrapper
+	{{ .Wrapper.Name }} := options.{{ .Wrapper.Option }}
 
-	codec, err := protoWrapper.Codec("{{ .TopicName }}", &{{ .MessageType }}{})
+	codec, err := {{ .Wrapper.Name }}.Codec("{{ .TopicName }}", &{{ .MessageType }}{})
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create codec")
 	}
@@ -91,9 +117,16 @@ func Register_{{ .Name }}_Sink(options runner.ServiceOptions, sink {{ .Name }}_S
 		topic: "{{ .TopicName }}",
 		maxBufferSize: maxBufferSize,
 		interval: interval,
+		retryPolicy: retryPolicy,
+		readCommitted: {{ .ReadCommitted }},
 	}
 
-	s := runner.NewSinkRunner(d, brokers)
+	s := runner.NewSinkRunner(d, brokers,
+		runner.WithSinkWatch(options.SinkWatch("{{ .ComponentName }}", "{{ .WatchName }}")),
+		runner.WithSinkTracing(options.SinkTracing("{{ .ComponentName }}", "{{ .WatchName }}")),
+		runner.WithSinkMetrics(options.Metrics.Sink("{{ .ServiceName }}", "{{ .ComponentName }}", "{{ .WatchName }}")),
+		runner.WithSinkTester(options.Tester()),
+	)
 
 	return func(ctx context.Context) func() error {
 		return s.Run(ctx)
@@ -103,12 +136,17 @@ func Register_{{ .Name }}_Sink(options runner.ServiceOptions, sink {{ .Name }}_S
 )
 
 type sinkOptions struct {
-	Package     string
-	Import      string
-	Name        string
-	TopicName   string
-	MessageType string
-	GroupName   string
+	Package       string
+	Import        string
+	Name          string
+	ServiceName   string
+	ComponentName string
+	WatchName     string
+	TopicName     string
+	MessageType   string
+	GroupName     string
+	Wrapper       codecWrapper
+	ReadCommitted bool
 }
 
 func generateSink(writer io.Writer, sink *sinkOptions) error {
@@ -126,9 +164,19 @@ func buildSinkOptions(pkg string, mod string, modelsPath string, sink models.Sin
 
 	options.TopicName = sink.ToTopicName(service)
 	options.Name = sink.ToSafeName()
-	options.GroupName = fmt.Sprintf("%s.%s.%s-sink", service.Name, component.Name, strings.ToLower(options.Name))
+	options.ServiceName = service.Name
+	options.ComponentName = component.Name
+	options.WatchName = sink.Name
+	options.GroupName = sink.GroupName(service, component)
 	options.Import = sink.ToPackage(service)
 	options.MessageType = sink.ToMessageTypeWithPackage()
 
+	wrapper, err := buildCodecWrapper(service, sink.TopicDefinition)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build codec wrapper for '%s'", sink.Message)
+	}
+	options.Wrapper = wrapper
+	options.ReadCommitted = sink.ReadCommitted
+
 	return options, nil
 }
@@ -46,6 +46,8 @@ type impl_EnrichedDataPostgres_Sink struct {
 	topic string
 	maxBufferSize int
 	interval time.Duration
+	retryPolicy runner.RetryPolicy
+	readCommitted bool
 }
 
 func (s *impl_EnrichedDataPostgres_Sink) Codec() goka.Codec {
@@ -68,6 +70,14 @@ func (s *impl_EnrichedDataPostgres_Sink) Interval() time.Duration {
 	return s.interval
 }
 
+func (s *impl_EnrichedDataPostgres_Sink) RetryPolicy() runner.RetryPolicy {
+	return s.retryPolicy
+}
+
+func (s *impl_EnrichedDataPostgres_Sink) ReadCommitted() bool {
+	return s.readCommitted
+}
+
 func (s *impl_EnrichedDataPostgres_Sink) Flush() error {
 	return s.sink.Flush()
 }
@@ -81,7 +91,25 @@ func (s *impl_EnrichedDataPostgres_Sink) Collect(ctx runner.MessageContext, key
 	return s.sink.Collect(ctx, key, m)
 }
 
-func Register_EnrichedDataPostgres_Sink(options runner.ServiceOptions, sink EnrichedDataPostgres_Sink, interval time.Duration, maxBufferSize int) (func(ctx context.Context) func() error, error) {
+func (s *impl_EnrichedDataPostgres_Sink) PartitionsAssigned(partitions []int32) error {
+	listener, ok := s.sink.(runner.SinkPartitionListener)
+	if !ok {
+		return nil
+	}
+
+	return listener.PartitionsAssigned(partitions)
+}
+
+func (s *impl_EnrichedDataPostgres_Sink) PartitionsRevoked(partitions []int32) error {
+	listener, ok := s.sink.(runner.SinkPartitionListener)
+	if !ok {
+		return nil
+	}
+
+	return listener.PartitionsRevoked(partitions)
+}
+
+func Register_EnrichedDataPostgres_Sink(options runner.ServiceOptions, sink EnrichedDataPostgres_Sink, interval time.Duration, maxBufferSize int, retryPolicy runner.RetryPolicy) (func(ctx context.Context) func() error, error) {
 	brokers := options.Brokers
 	protoWrapper := options.ProtoWrapper
 
@@ -97,9 +125,16 @@ func Register_EnrichedDataPostgres_Sink(options runner.ServiceOptions, sink Enri
 		topic: "testMesh.testSerial.detailsEnriched",
 		maxBufferSize: maxBufferSize,
 		interval: interval,
+		retryPolicy: retryPolicy,
+		readCommitted: true,
 	}
 
-	s := runner.NewSinkRunner(d, brokers)
+	s := runner.NewSinkRunner(d, brokers,
+		runner.WithSinkWatch(options.SinkWatch("details", "Enriched Data Postgres")),
+		runner.WithSinkTracing(options.SinkTracing("details", "Enriched Data Postgres")),
+		runner.WithSinkMetrics(options.Metrics.Sink("testMesh", "details", "Enriched Data Postgres")),
+		runner.WithSinkTester(options.Tester()),
+	)
 
 	return func(ctx context.Context) func() error {
 		return s.Run(ctx)
@@ -28,8 +28,10 @@ import (
 
 type {{ .Name }}_Source interface {
 	Emit(message {{ .Name }}_Source_Message) error
+	EmitContext(ctx context.Context, message {{ .Name }}_Source_Message) error
 	EmitBulk(ctx context.Context, messages []{{ .Name }}_Source_Message) error
 	Delete(key string) error
+	DeleteContext(ctx context.Context, key string) error
 }
 
 type {{ .Name }}_Source_impl struct {
@@ -58,9 +60,9 @@ func (m *impl_{{ .Name }}_Source_Message) Value() interface{} {
 func New_{{ .Name }}_Source(service *runner.Service) (*{{ .Name }}_Source_impl, func(context.Context) func() error, error) {
 	options := service.Options()
 	brokers := options.Brokers
-	protoWrapper := options.ProtoWrapper
+	{{ .Wrapper.Name }} := options.{{ .Wrapper.Option }}
 
-	codec, err := protoWrapper.Codec("{{ .TopicName }}", &{{ .MessageType }}{})
+	codec, err := {{ .Wrapper.Name }}.Codec("{{ .TopicName }}", &{{ .MessageType }}{})
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "failed to create codec")
 	}
@@ -68,7 +70,9 @@ func New_{{ .Name }}_Source(service *runner.Service) (*{{ .Name }}_Source_impl,
 	emitter, err := goka.NewEmitter(brokers,
 		goka.Stream("{{ .TopicName }}"),
 		codec,
-		goka.WithEmitterHasher(kafkautil.MurmurHasher))
+		options.EmitterOptions(
+			goka.WithEmitterHasher(kafkautil.MurmurHasher),
+		)...)
 
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "failed creating source")
@@ -77,7 +81,10 @@ func New_{{ .Name }}_Source(service *runner.Service) (*{{ .Name }}_Source_impl,
 	emitterCtx, emitterCancel := context.WithCancel(context.Background())
 	e := &{{ .Name }}_Source_impl{
 		emitterCtx,
-		runner.NewEmitter(emitter),
+		runner.NewEmitter(emitter,
+			runner.WithSourceWatch(options.SourceWatch("{{ .ComponentName }}", "{{ .TopicName }}")),
+			runner.WithSourceTracing(options.SourceTracing("{{ .ComponentName }}", "{{ .TopicName }}")),
+		),
 		service.Metrics,
 	}
 
@@ -106,7 +113,11 @@ func New_{{ .Name }}_Source(service *runner.Service) (*{{ .Name }}_Source_impl,
 }
 
 func (e *{{ .Name }}_Source_impl) Emit(message {{ .Name }}_Source_Message) error {
-	err := e.emitter.Emit(message.Key, message.Value)
+	return e.EmitContext(context.Background(), message)
+}
+
+func (e *{{ .Name }}_Source_impl) EmitContext(ctx context.Context, message {{ .Name }}_Source_Message) error {
+	err := e.emitter.EmitContext(ctx, message.Key, message.Value)
 	if err != nil {
 		e.metrics.SourceError("{{ .ServiceName }}", "{{ .ComponentName }}", "{{ .TopicName }}")
 		return err
@@ -132,7 +143,11 @@ func (e *{{ .Name }}_Source_impl) EmitBulk(ctx context.Context, messages []{{ .N
 }
 
 func (e *{{ .Name }}_Source_impl) Delete(key string) error {
-	return e.emitter.Emit(key, nil)
+	return e.DeleteContext(context.Background(), key)
+}
+
+func (e *{{ .Name }}_Source_impl) DeleteContext(ctx context.Context, key string) error {
+	return e.emitter.EmitContext(ctx, key, nil)
 }
 `))
 )
@@ -145,6 +160,7 @@ type sourceOptions struct {
 	MessageType   string
 	ComponentName string
 	ServiceName   string
+	Wrapper       codecWrapper
 }
 
 func generateSource(writer io.Writer, source *sourceOptions) error {
@@ -167,5 +183,11 @@ func buildSourceOptions(pkg string, mod string, modelsPath string, service *mode
 	options.ComponentName = component.Name
 	options.ServiceName = service.Name
 
+	wrapper, err := buildCodecWrapper(service, source.TopicDefinition)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build codec wrapper for '%s'", source.Message)
+	}
+	options.Wrapper = wrapper
+
 	return options, nil
 }
@@ -36,8 +36,10 @@ import (
 
 type TestSerialDetails_Source interface {
 	Emit(message TestSerialDetails_Source_Message) error
+	EmitContext(ctx context.Context, message TestSerialDetails_Source_Message) error
 	EmitBulk(ctx context.Context, messages []TestSerialDetails_Source_Message) error
 	Delete(key string) error
+	DeleteContext(ctx context.Context, key string) error
 }
 
 type TestSerialDetails_Source_impl struct {
@@ -76,7 +78,9 @@ func New_TestSerialDetails_Source(service *runner.Service) (*TestSerialDetails_S
 	emitter, err := goka.NewEmitter(brokers,
 		goka.Stream("testMesh.testSerial.details"),
 		codec,
-		goka.WithEmitterHasher(kafkautil.MurmurHasher))
+		options.EmitterOptions(
+			goka.WithEmitterHasher(kafkautil.MurmurHasher),
+		)...)
 
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "failed creating source")
@@ -85,7 +89,10 @@ func New_TestSerialDetails_Source(service *runner.Service) (*TestSerialDetails_S
 	emitterCtx, emitterCancel := context.WithCancel(context.Background())
 	e := &TestSerialDetails_Source_impl{
 		emitterCtx,
-		runner.NewEmitter(emitter),
+		runner.NewEmitter(emitter,
+			runner.WithSourceWatch(options.SourceWatch("details", "testMesh.testSerial.details")),
+			runner.WithSourceTracing(options.SourceTracing("details", "testMesh.testSerial.details")),
+		),
 		service.Metrics,
 	}
 
@@ -114,7 +121,11 @@ func New_TestSerialDetails_Source(service *runner.Service) (*TestSerialDetails_S
 }
 
 func (e *TestSerialDetails_Source_impl) Emit(message TestSerialDetails_Source_Message) error {
-	err := e.emitter.Emit(message.Key, message.Value)
+	return e.EmitContext(context.Background(), message)
+}
+
+func (e *TestSerialDetails_Source_impl) EmitContext(ctx context.Context, message TestSerialDetails_Source_Message) error {
+	err := e.emitter.EmitContext(ctx, message.Key, message.Value)
 	if err != nil {
 		e.metrics.SourceError("testMesh", "details", "testMesh.testSerial.details")
 		return err
@@ -140,7 +151,11 @@ func (e *TestSerialDetails_Source_impl) EmitBulk(ctx context.Context, messages [
 }
 
 func (e *TestSerialDetails_Source_impl) Delete(key string) error {
-	return e.emitter.Emit(key, nil)
+	return e.DeleteContext(context.Background(), key)
+}
+
+func (e *TestSerialDetails_Source_impl) DeleteContext(ctx context.Context, key string) error {
+	return e.emitter.EmitContext(ctx, key, nil)
 }
 `
 )
@@ -0,0 +1,46 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/syncromatics/kafmesh/internal/models"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	storageBackends = map[string]string{
+		"leveldb": "runner.StorageBackendLevelDB",
+		"memory":  "runner.StorageBackendMemory",
+		"pebble":  "runner.StorageBackendPebble",
+	}
+)
+
+// buildStorageOverride renders the storage options declared in the component definition
+// as an extra argument to runner.StorageConfig.Builder
+func buildStorageOverride(s *models.Storage) (string, error) {
+	if s == nil {
+		return "", nil
+	}
+
+	fields := []string{}
+
+	if s.Backend != "" {
+		backend, ok := storageBackends[s.Backend]
+		if !ok {
+			return "", errors.Errorf("unknown storage backend '%s'", s.Backend)
+		}
+		fields = append(fields, fmt.Sprintf("Backend: %s", backend))
+	}
+
+	if s.BlockCacheCapacity != 0 {
+		fields = append(fields, fmt.Sprintf("BlockCacheCapacity: %d", s.BlockCacheCapacity))
+	}
+
+	if s.WriteBuffer != 0 {
+		fields = append(fields, fmt.Sprintf("WriteBuffer: %d", s.WriteBuffer))
+	}
+
+	return fmt.Sprintf(", runner.StorageOptions{%s}", strings.Join(fields, ", ")), nil
+}
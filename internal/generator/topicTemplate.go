@@ -71,6 +71,8 @@ func generateTopics(writer io.Writer, topic *topicOptions) error {
 
 func buildTopicOption(service *models.Service, components []*models.Component) (*topicOptions, error) {
 	topics := map[string]*topicDefinition{}
+	repartitioned := map[string]string{}
+	copartitioned := map[string]string{}
 
 	for _, c := range components {
 		for _, p := range c.Processors {
@@ -106,6 +108,28 @@ func buildTopicOption(service *models.Service, components []*models.Component) (
 				}
 			}
 
+			for _, join := range p.StreamJoins {
+				name := join.ToTopicName(service)
+				_, ok := topics[name]
+				if !ok {
+					topics[name] = &topicDefinition{}
+				}
+
+				changelog := join.ChangelogName(service, c, &p)
+				topic, ok := topics[changelog]
+				if !ok {
+					topic = &topicDefinition{}
+					topics[changelog] = topic
+				}
+				copartitioned[changelog] = name
+
+				compact := true
+				err := updateTopicCreate(topic, models.TopicCreationDefinition{Compact: &compact})
+				if err != nil {
+					return nil, err
+				}
+			}
+
 			for _, lookup := range p.Lookups {
 				name := lookup.ToTopicName(service)
 				topic, ok := topics[name]
@@ -115,6 +139,47 @@ func buildTopicOption(service *models.Service, components []*models.Component) (
 				}
 			}
 
+			if p.DeadLetter != nil {
+				name := p.DeadLetterTopicName(service, c)
+				topic, ok := topics[name]
+				if !ok {
+					topic = &topicDefinition{}
+					topics[name] = topic
+				}
+
+				err := updateTopicCreate(topic, p.DeadLetter.TopicCreationDefinition)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			groups := []string{}
+			if p.DeadLetter != nil && p.DeadLetter.Retry.MaxAttempts > 1 {
+				groups = append(groups, p.RetryGroupName(service, c))
+			}
+			if p.Timers {
+				groups = append(groups, p.TimerGroupName(service, c))
+			}
+
+			for _, group := range groups {
+				for _, name := range []string{group, group + "-fired", group + "-table"} {
+					topic, ok := topics[name]
+					if !ok {
+						topic = &topicDefinition{}
+						topics[name] = topic
+					}
+					if len(p.Inputs) > 0 {
+						copartitioned[name] = p.Inputs[0].ToTopicName(service)
+					}
+
+					compact := name == group+"-table"
+					err := updateTopicCreate(topic, models.TopicCreationDefinition{Compact: &compact})
+					if err != nil {
+						return nil, err
+					}
+				}
+			}
+
 			if p.Persistence == nil {
 				continue
 			}
@@ -125,6 +190,9 @@ func buildTopicOption(service *models.Service, components []*models.Component) (
 				topic = &topicDefinition{}
 				topics[name] = topic
 			}
+			if p.Persistence.Partitions == nil && len(p.Inputs) > 0 {
+				copartitioned[name] = p.Inputs[0].ToTopicName(service)
+			}
 
 			compact := true
 			p.Persistence.TopicCreationDefinition.Compact = &compact
@@ -135,6 +203,89 @@ func buildTopicOption(service *models.Service, components []*models.Component) (
 			}
 		}
 
+		for _, w := range c.Windows {
+			for _, input := range w.Inputs {
+				name := input.ToTopicName(service)
+				topic, ok := topics[name]
+				if !ok {
+					topic = &topicDefinition{}
+					topics[name] = topic
+				}
+			}
+
+			for _, output := range w.Outputs {
+				name := output.ToTopicName(service)
+				topic, ok := topics[name]
+				if !ok {
+					topic = &topicDefinition{}
+					topics[name] = topic
+				}
+
+				err := updateTopicCreate(topic, output.TopicCreationDefinition)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			group := w.TimerGroupName(service, c)
+			for _, name := range []string{group, group + "-fired", group + "-table"} {
+				topic, ok := topics[name]
+				if !ok {
+					topic = &topicDefinition{}
+					topics[name] = topic
+				}
+				if len(w.Inputs) > 0 {
+					copartitioned[name] = w.Inputs[0].ToTopicName(service)
+				}
+
+				compact := name == group+"-table"
+				err := updateTopicCreate(topic, models.TopicCreationDefinition{Compact: &compact})
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			name := w.TableName(service, c)
+			topic, ok := topics[name]
+			if !ok {
+				topic = &topicDefinition{}
+				topics[name] = topic
+			}
+			if w.Aggregate.Partitions == nil && len(w.Inputs) > 0 {
+				copartitioned[name] = w.Inputs[0].ToTopicName(service)
+			}
+
+			compact := true
+			w.Aggregate.TopicCreationDefinition.Compact = &compact
+
+			err := updateTopicCreate(topic, w.Aggregate.TopicCreationDefinition)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, r := range c.Repartition {
+			name := r.ToTopicName(service)
+			_, ok := topics[name]
+			if !ok {
+				topics[name] = &topicDefinition{}
+			}
+
+			name = r.RepartitionTopicName(service, c)
+			topic, ok := topics[name]
+			if !ok {
+				topic = &topicDefinition{}
+				topics[name] = topic
+			}
+
+			err := updateTopicCreate(topic, r.TopicCreationDefinition)
+			if err != nil {
+				return nil, err
+			}
+
+			repartitioned[name] = r.ToTopicName(service)
+		}
+
 		for _, e := range c.Sources {
 			name := e.ToTopicName(service)
 			topic, ok := topics[name]
@@ -194,6 +345,20 @@ func buildTopicOption(service *models.Service, components []*models.Component) (
 		}
 	}
 
+	// repartition topics default to the partition count of the topic they re-key
+	for name, from := range repartitioned {
+		topic := topics[name]
+		if topic.Partitions == nil {
+			topic.Partitions = topics[from].Partitions
+		}
+	}
+
+	// group tables and the retry and timer topics are consumed with the inputs of their processor, so
+	// they have the partition count of the inputs unless a group table sets its own
+	for name, input := range copartitioned {
+		topics[name].Partitions = topics[input].Partitions
+	}
+
 	t := []*runner.Topic{}
 	for n, tp := range topics {
 		topic := &runner.Topic{
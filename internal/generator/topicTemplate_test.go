@@ -31,6 +31,87 @@ import (
 
 var (
 	topics = []runner.Topic{
+		runner.Topic {
+			Name:       "testMesh.details.detailCounts-table",
+			Partitions: 10,
+			Replicas:   1,
+			Compact:    true,
+			Retention:  86400000 * time.Millisecond,
+			Segment:    43200000 * time.Millisecond,
+			Create:     true,
+		},
+		runner.Topic {
+			Name:       "testMesh.details.detailCounts-timers",
+			Partitions: 10,
+			Replicas:   1,
+			Compact:    false,
+			Retention:  86400000 * time.Millisecond,
+			Segment:    43200000 * time.Millisecond,
+			Create:     true,
+		},
+		runner.Topic {
+			Name:       "testMesh.details.detailCounts-timers-fired",
+			Partitions: 10,
+			Replicas:   1,
+			Compact:    false,
+			Retention:  86400000 * time.Millisecond,
+			Segment:    43200000 * time.Millisecond,
+			Create:     true,
+		},
+		runner.Topic {
+			Name:       "testMesh.details.detailCounts-timers-table",
+			Partitions: 10,
+			Replicas:   1,
+			Compact:    true,
+			Retention:  86400000 * time.Millisecond,
+			Segment:    43200000 * time.Millisecond,
+			Create:     true,
+		},
+		runner.Topic {
+			Name:       "testMesh.details.detailsByCustomer-repartition",
+			Partitions: 10,
+			Replicas:   1,
+			Compact:    false,
+			Retention:  86400000 * time.Millisecond,
+			Segment:    43200000 * time.Millisecond,
+			Create:     true,
+		},
+		runner.Topic {
+			Name:       "testMesh.details.enricher-dlq",
+			Partitions: 10,
+			Replicas:   1,
+			Compact:    false,
+			Retention:  86400000 * time.Millisecond,
+			Segment:    43200000 * time.Millisecond,
+			Create:     true,
+		},
+		runner.Topic {
+			Name:       "testMesh.details.enricher-retries",
+			Partitions: 10,
+			Replicas:   1,
+			Compact:    false,
+			Retention:  86400000 * time.Millisecond,
+			Segment:    43200000 * time.Millisecond,
+			Create:     true,
+		},
+		runner.Topic {
+			Name:       "testMesh.details.enricher-retries-fired",
+			Partitions: 10,
+			Replicas:   1,
+			Compact:    false,
+			Retention:  86400000 * time.Millisecond,
+			Segment:    43200000 * time.Millisecond,
+			Create:     true,
+		},
+		runner.Topic {
+			Name:       "testMesh.details.enricher-retries-table",
+			Partitions: 10,
+			Replicas:   1,
+			Compact:    true,
+			Retention:  86400000 * time.Millisecond,
+			Segment:    43200000 * time.Millisecond,
+			Create:     true,
+		},
 		runner.Topic {
 			Name:       "testMesh.details.enricher-table",
 			Partitions: 10,
@@ -40,6 +121,42 @@ var (
 			Segment:    43200000 * time.Millisecond,
 			Create:     true,
 		},
+		runner.Topic {
+			Name:       "testMesh.details.enricher-testIDTest2-join-table",
+			Partitions: 10,
+			Replicas:   1,
+			Compact:    true,
+			Retention:  86400000 * time.Millisecond,
+			Segment:    43200000 * time.Millisecond,
+			Create:     true,
+		},
+		runner.Topic {
+			Name:       "testMesh.details.enricher-timers",
+			Partitions: 10,
+			Replicas:   1,
+			Compact:    false,
+			Retention:  86400000 * time.Millisecond,
+			Segment:    43200000 * time.Millisecond,
+			Create:     true,
+		},
+		runner.Topic {
+			Name:       "testMesh.details.enricher-timers-fired",
+			Partitions: 10,
+			Replicas:   1,
+			Compact:    false,
+			Retention:  86400000 * time.Millisecond,
+			Segment:    43200000 * time.Millisecond,
+			Create:     true,
+		},
+		runner.Topic {
+			Name:       "testMesh.details.enricher-timers-table",
+			Partitions: 10,
+			Replicas:   1,
+			Compact:    true,
+			Retention:  86400000 * time.Millisecond,
+			Segment:    43200000 * time.Millisecond,
+			Create:     true,
+		},
 		runner.Topic {
 			Name:       "testMesh.testId.test",
 			Partitions: 10,
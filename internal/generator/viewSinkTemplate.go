@@ -19,16 +19,12 @@ package {{ .Package }}
 import (
 	"context"
 	"fmt"
-	"os"
-	"path/filepath"
 	"time"
 
 	"github.com/burdiyan/kafkautil"
 	"github.com/lovoo/goka"
-	"github.com/lovoo/goka/storage"
 	"github.com/pkg/errors"
 	"github.com/syncromatics/kafmesh/pkg/runner"
-	"github.com/syndtr/goleveldb/leveldb/opt"
 	"golang.org/x/sync/errgroup"
 
 	"{{ .Import }}"
@@ -90,37 +86,33 @@ type {{ .Name }}_ViewSink interface {
 
 func Register_{{ .Name }}_ViewSink(options runner.ServiceOptions, synchronizer {{ .Name }}_ViewSink, updateInterval time.Duration, syncTimeout time.Duration) (func(context.Context) func() error, error) {
 	brokers := options.Brokers
-	protoWrapper := options.ProtoWrapper
+	{{ .Wrapper.Name }} := options.{{ .Wrapper.Option }}
 
-	codec, err := protoWrapper.Codec("{{ .TopicName }}", &{{ .MessageType }}{})
+	codec, err := {{ .Wrapper.Name }}.Codec("{{ .TopicName }}", &{{ .MessageType }}{})
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create codec")
 	}
 
-	opts := &opt.Options{
-		BlockCacheCapacity: opt.MiB * 1,
-		WriteBuffer:        opt.MiB * 1,
-	}
-
-	path := filepath.Join("/tmp/storage", "viewSink", "{{ .TopicName }}")
-
-	err = os.MkdirAll(path, os.ModePerm)
+	builder, err := options.Storage.Builder("viewSink", "{{ .TopicName }}"{{ .Storage }})
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to create view sink db directory")
+		return nil, errors.Wrap(err, "failed to create view sink storage")
 	}
-
-	builder := storage.BuilderWithOptions(path, opts)
 	view, err := goka.NewView(brokers,
 		goka.Table("{{ .TopicName }}"),
 		codec,
-		goka.WithViewStorageBuilder(builder),
-		goka.WithViewHasher(kafkautil.MurmurHasher),
-	)
+		options.ViewOptions(
+			goka.WithViewStorageBuilder(builder),
+			goka.WithViewHasher(kafkautil.MurmurHasher),
+		)...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed creating view sink view")
 	}
 
+	watch := options.ViewSinkWatch("{{ .ComponentName }}", "{{ .WatchName }}")
+
 	return func(outerCtx context.Context) func() error {
+		runner.ReportReadiness(outerCtx, view.Recovered)
+
 		return func() error {
 			cancelableCtx, cancel := context.WithCancel(outerCtx)
 			defer cancel()
@@ -144,7 +136,9 @@ func Register_{{ .Name }}_ViewSink(options runner.ServiceOptions, synchronizer {
 							Context: newContext,
 							view:    view,
 						}
+						start := time.Now()
 						err := synchronizer.Sync(c)
+						watch.Synced(start, err)
 						if err != nil {
 							cancel()
 							fmt.Printf("sync error '%v'", err)
@@ -174,11 +168,15 @@ func Register_{{ .Name }}_ViewSink(options runner.ServiceOptions, synchronizer {
 )
 
 type viewSinkOptions struct {
-	Package     string
-	Import      string
-	Name        string
-	TopicName   string
-	MessageType string
+	Package       string
+	Import        string
+	Name          string
+	ComponentName string
+	WatchName     string
+	TopicName     string
+	MessageType   string
+	Wrapper       codecWrapper
+	Storage       string
 }
 
 func generateViewSink(writer io.Writer, viewSink *viewSinkOptions) error {
@@ -189,10 +187,12 @@ func generateViewSink(writer io.Writer, viewSink *viewSinkOptions) error {
 	return nil
 }
 
-func buildViewSinkOptions(pkg string, mod string, modelsPath string, service *models.Service, viewSink models.ViewSink) (*viewSinkOptions, error) {
+func buildViewSinkOptions(pkg string, mod string, modelsPath string, service *models.Service, viewSink models.ViewSink, component *models.Component) (*viewSinkOptions, error) {
 	options := &viewSinkOptions{
-		Package: pkg,
-		Name:    viewSink.ToSafeName(),
+		Package:       pkg,
+		Name:          viewSink.ToSafeName(),
+		ComponentName: component.Name,
+		WatchName:     viewSink.Name,
 	}
 
 	var name strings.Builder
@@ -205,5 +205,17 @@ func buildViewSinkOptions(pkg string, mod string, modelsPath string, service *mo
 	options.Import = viewSink.ToPackage(service)
 	options.MessageType = nameFrags[len(nameFrags)-2] + "." + strcase.ToCamel(nameFrags[len(nameFrags)-1])
 
+	wrapper, err := buildCodecWrapper(service, viewSink.TopicDefinition)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build codec wrapper for '%s'", viewSink.Message)
+	}
+	options.Wrapper = wrapper
+
+	storage, err := buildStorageOverride(viewSink.Storage)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build storage options for viewSink '%s'", viewSink.Name)
+	}
+	options.Storage = storage
+
 	return options, nil
 }
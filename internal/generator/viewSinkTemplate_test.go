@@ -25,16 +25,12 @@ package details
 import (
 	"context"
 	"fmt"
-	"os"
-	"path/filepath"
 	"time"
 
 	"github.com/burdiyan/kafkautil"
 	"github.com/lovoo/goka"
-	"github.com/lovoo/goka/storage"
 	"github.com/pkg/errors"
 	"github.com/syncromatics/kafmesh/pkg/runner"
-	"github.com/syndtr/goleveldb/leveldb/opt"
 	"golang.org/x/sync/errgroup"
 
 	"test/internal/kafmesh/models/testMesh/testId"
@@ -103,30 +99,26 @@ func Register_TestToApi_ViewSink(options runner.ServiceOptions, synchronizer Tes
 		return nil, errors.Wrap(err, "failed to create codec")
 	}
 
-	opts := &opt.Options{
-		BlockCacheCapacity: opt.MiB * 1,
-		WriteBuffer:        opt.MiB * 1,
-	}
-
-	path := filepath.Join("/tmp/storage", "viewSink", "testMesh.testId.test")
-
-	err = os.MkdirAll(path, os.ModePerm)
+	builder, err := options.Storage.Builder("viewSink", "testMesh.testId.test")
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to create view sink db directory")
+		return nil, errors.Wrap(err, "failed to create view sink storage")
 	}
-
-	builder := storage.BuilderWithOptions(path, opts)
 	view, err := goka.NewView(brokers,
 		goka.Table("testMesh.testId.test"),
 		codec,
-		goka.WithViewStorageBuilder(builder),
-		goka.WithViewHasher(kafkautil.MurmurHasher),
-	)
+		options.ViewOptions(
+			goka.WithViewStorageBuilder(builder),
+			goka.WithViewHasher(kafkautil.MurmurHasher),
+		)...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed creating view sink view")
 	}
 
+	watch := options.ViewSinkWatch("details", "test to api")
+
 	return func(outerCtx context.Context) func() error {
+		runner.ReportReadiness(outerCtx, view.Recovered)
+
 		return func() error {
 			cancelableCtx, cancel := context.WithCancel(outerCtx)
 			defer cancel()
@@ -150,7 +142,9 @@ func Register_TestToApi_ViewSink(options runner.ServiceOptions, synchronizer Tes
 							Context: newContext,
 							view:    view,
 						}
+						start := time.Now()
 						err := synchronizer.Sync(c)
+						watch.Synced(start, err)
 						if err != nil {
 							cancel()
 							fmt.Printf("sync error '%v'", err)
@@ -19,16 +19,12 @@ package {{ .Package }}
 import (
 	"context"
 	"fmt"
-	"os"
-	"path/filepath"
 	"time"
 
 	"github.com/burdiyan/kafkautil"
 	"github.com/lovoo/goka"
-	"github.com/lovoo/goka/storage"
 	"github.com/pkg/errors"
 	"github.com/syncromatics/kafmesh/pkg/runner"
-	"github.com/syndtr/goleveldb/leveldb/opt"
 	"golang.org/x/sync/errgroup"
 
 	"{{ .Import }}"
@@ -45,7 +41,7 @@ type {{ .Name }}_ViewSource interface {
 
 type contextWrap_{{ .Name }} struct {
 	context.Context
-	job *runner.ProtoViewSourceJob
+	job *runner.{{ .Wrapper.Kind }}ViewSourceJob
 }
 
 func (c *contextWrap_{{ .Name }}) Update(key string, msg *{{ .MessageType }}) error {
@@ -54,32 +50,24 @@ func (c *contextWrap_{{ .Name }}) Update(key string, msg *{{ .MessageType }}) er
 
 func Register_{{ .Name }}_ViewSource(options runner.ServiceOptions, synchronizer {{ .Name }}_ViewSource, updateInterval time.Duration, syncTimeout time.Duration) (func(context.Context) func() error, error) {
 	brokers := options.Brokers
-	protoWrapper := options.ProtoWrapper
+	{{ .Wrapper.Name }} := options.{{ .Wrapper.Option }}
 
-	codec, err := protoWrapper.Codec("{{ .TopicName }}", &{{ .MessageType }}{})
+	codec, err := {{ .Wrapper.Name }}.Codec("{{ .TopicName }}", &{{ .MessageType }}{})
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create codec")
 	}
 
-	opts := &opt.Options{
-		BlockCacheCapacity: opt.MiB * 1,
-		WriteBuffer:        opt.MiB * 1,
-	}
-
-	path := filepath.Join("/tmp/storage", "viewSource", "{{ .TopicName }}")
-
-	err = os.MkdirAll(path, os.ModePerm)
+	builder, err := options.Storage.Builder("viewSource", "{{ .TopicName }}"{{ .Storage }})
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to create view source db directory")
+		return nil, errors.Wrap(err, "failed to create view source storage")
 	}
-
-	builder := storage.BuilderWithOptions(path, opts)
 	view, err := goka.NewView(brokers,
 		goka.Table("{{ .TopicName }}"),
 		codec,
-		goka.WithViewStorageBuilder(builder),
-		goka.WithViewHasher(kafkautil.MurmurHasher),
-	)
+		options.ViewOptions(
+			goka.WithViewStorageBuilder(builder),
+			goka.WithViewHasher(kafkautil.MurmurHasher),
+		)...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed creating synchronizer view")
 	}
@@ -87,15 +75,21 @@ func Register_{{ .Name }}_ViewSource(options runner.ServiceOptions, synchronizer
 	e, err := goka.NewEmitter(brokers,
 		goka.Stream("{{ .TopicName }}"),
 		codec,
-		goka.WithEmitterHasher(kafkautil.MurmurHasher))
+		options.EmitterOptions(
+			goka.WithEmitterHasher(kafkautil.MurmurHasher),
+		)...)
 
 	if err != nil {
 		return nil, errors.Wrap(err, "failed creating synchronizer emitter")
 	}
 
 	emitter := runner.NewEmitter(e)
+	watch := options.ViewSourceWatch("{{ .ComponentName }}", "{{ .WatchName }}")
+	metrics := options.Metrics.ViewSource("{{ .ServiceName }}", "{{ .ComponentName }}", "{{ .WatchName }}")
 
 	return func(outerCtx context.Context) func() error {
+		runner.ReportReadiness(outerCtx, view.Recovered)
+
 		return func() error {
 			cancelableCtx, cancel := context.WithCancel(outerCtx)
 			defer cancel()
@@ -115,10 +109,11 @@ func Register_{{ .Name }}_ViewSource(options runner.ServiceOptions, synchronizer
 						}
 			
 						newContext, cancel := context.WithTimeout(ctx, syncTimeout)
-						c := runner.NewProtoViewSourceJob(newContext, view, emitter)
+						c := runner.New{{ .Wrapper.Kind }}ViewSourceJob(newContext, view, emitter, watch, metrics)
 						cw := &contextWrap_{{ .Name }}{newContext, c}
 						err := synchronizer.Sync(cw)
 						if err != nil {
+							c.Failed(err)
 							cancel()
 							fmt.Printf("sync error '%v'", err)
 							return err
@@ -152,11 +147,16 @@ func Register_{{ .Name }}_ViewSource(options runner.ServiceOptions, synchronizer
 )
 
 type viewSourceOptions struct {
-	Package     string
-	Import      string
-	Name        string
-	TopicName   string
-	MessageType string
+	Package       string
+	Import        string
+	Name          string
+	ServiceName   string
+	ComponentName string
+	WatchName     string
+	TopicName     string
+	MessageType   string
+	Wrapper       codecWrapper
+	Storage       string
 }
 
 func generateViewSource(writer io.Writer, viewSource *viewSourceOptions) error {
@@ -167,10 +167,13 @@ func generateViewSource(writer io.Writer, viewSource *viewSourceOptions) error {
 	return nil
 }
 
-func buildViewSourceOptions(pkg string, mod string, modelsPath string, service *models.Service, viewSource models.ViewSource) (*viewSourceOptions, error) {
+func buildViewSourceOptions(pkg string, mod string, modelsPath string, service *models.Service, viewSource models.ViewSource, component *models.Component) (*viewSourceOptions, error) {
 	options := &viewSourceOptions{
-		Package: pkg,
-		Name:    viewSource.ToSafeName(),
+		Package:       pkg,
+		Name:          viewSource.ToSafeName(),
+		ServiceName:   service.Name,
+		ComponentName: component.Name,
+		WatchName:     viewSource.Name,
 	}
 
 	var name strings.Builder
@@ -183,5 +186,17 @@ func buildViewSourceOptions(pkg string, mod string, modelsPath string, service *
 	options.Import = viewSource.ToPackage(service)
 	options.MessageType = nameFrags[len(nameFrags)-2] + "." + strcase.ToCamel(nameFrags[len(nameFrags)-1])
 
+	wrapper, err := buildCodecWrapper(service, viewSource.TopicDefinition)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build codec wrapper for '%s'", viewSource.Message)
+	}
+	options.Wrapper = wrapper
+
+	storage, err := buildStorageOverride(viewSource.Storage)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build storage options for viewSource '%s'", viewSource.Name)
+	}
+	options.Storage = storage
+
 	return options, nil
 }
@@ -25,16 +25,12 @@ package details
 import (
 	"context"
 	"fmt"
-	"os"
-	"path/filepath"
 	"time"
 
 	"github.com/burdiyan/kafkautil"
 	"github.com/lovoo/goka"
-	"github.com/lovoo/goka/storage"
 	"github.com/pkg/errors"
 	"github.com/syncromatics/kafmesh/pkg/runner"
-	"github.com/syndtr/goleveldb/leveldb/opt"
 	"golang.org/x/sync/errgroup"
 
 	"test/internal/kafmesh/models/testMesh/testId"
@@ -67,25 +63,17 @@ func Register_TestToDatabase_ViewSource(options runner.ServiceOptions, synchroni
 		return nil, errors.Wrap(err, "failed to create codec")
 	}
 
-	opts := &opt.Options{
-		BlockCacheCapacity: opt.MiB * 1,
-		WriteBuffer:        opt.MiB * 1,
-	}
-
-	path := filepath.Join("/tmp/storage", "viewSource", "testMesh.testId.test")
-
-	err = os.MkdirAll(path, os.ModePerm)
+	builder, err := options.Storage.Builder("viewSource", "testMesh.testId.test")
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to create view source db directory")
+		return nil, errors.Wrap(err, "failed to create view source storage")
 	}
-
-	builder := storage.BuilderWithOptions(path, opts)
 	view, err := goka.NewView(brokers,
 		goka.Table("testMesh.testId.test"),
 		codec,
-		goka.WithViewStorageBuilder(builder),
-		goka.WithViewHasher(kafkautil.MurmurHasher),
-	)
+		options.ViewOptions(
+			goka.WithViewStorageBuilder(builder),
+			goka.WithViewHasher(kafkautil.MurmurHasher),
+		)...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed creating synchronizer view")
 	}
@@ -93,15 +81,21 @@ func Register_TestToDatabase_ViewSource(options runner.ServiceOptions, synchroni
 	e, err := goka.NewEmitter(brokers,
 		goka.Stream("testMesh.testId.test"),
 		codec,
-		goka.WithEmitterHasher(kafkautil.MurmurHasher))
+		options.EmitterOptions(
+			goka.WithEmitterHasher(kafkautil.MurmurHasher),
+		)...)
 
 	if err != nil {
 		return nil, errors.Wrap(err, "failed creating synchronizer emitter")
 	}
 
 	emitter := runner.NewEmitter(e)
+	watch := options.ViewSourceWatch("details", "test to database")
+	metrics := options.Metrics.ViewSource("testMesh", "details", "test to database")
 
 	return func(outerCtx context.Context) func() error {
+		runner.ReportReadiness(outerCtx, view.Recovered)
+
 		return func() error {
 			cancelableCtx, cancel := context.WithCancel(outerCtx)
 			defer cancel()
@@ -121,10 +115,11 @@ func Register_TestToDatabase_ViewSource(options runner.ServiceOptions, synchroni
 						}
 			
 						newContext, cancel := context.WithTimeout(ctx, syncTimeout)
-						c := runner.NewProtoViewSourceJob(newContext, view, emitter)
+						c := runner.NewProtoViewSourceJob(newContext, view, emitter, watch, metrics)
 						cw := &contextWrap_TestToDatabase{newContext, c}
 						err := synchronizer.Sync(cw)
 						if err != nil {
+							c.Failed(err)
 							cancel()
 							fmt.Printf("sync error '%v'", err)
 							return err
@@ -16,15 +16,11 @@ package {{ .Package }}
 
 import (
 	"context"
-	"os"
-	"path/filepath"
 
 	"github.com/burdiyan/kafkautil"
 	"github.com/lovoo/goka"
-	"github.com/lovoo/goka/storage"
 	"github.com/pkg/errors"
 	"github.com/syncromatics/kafmesh/pkg/runner"
-	"github.com/syndtr/goleveldb/leveldb/opt"
 	"golang.org/x/sync/errgroup"
 
 	"{{ .Import }}"
@@ -42,33 +38,28 @@ type {{ .Name }}_View_impl struct {
 
 func New_{{ .Name }}_View(options runner.ServiceOptions) (*{{ .Name }}_View_impl, func(context.Context) func() error, error) {
 	brokers := options.Brokers
-	protoWrapper := options.ProtoWrapper
+	{{ .Wrapper.Name }} := options.{{ .Wrapper.Option }}
 
-	codec, err := protoWrapper.Codec("{{ .TopicName }}", &{{ .MessageType }}{})
+	codec, err := {{ .Wrapper.Name }}.Codec("{{ .TopicName }}", &{{ .MessageType }}{})
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "failed to create codec")
 	}
 
-	opts := &opt.Options{
-		BlockCacheCapacity: opt.MiB * 1,
-		WriteBuffer:        opt.MiB * 1,
-	}
-
-	path := filepath.Join("/tmp/storage", "view", "{{ .TopicName }}")
-
-	err = os.MkdirAll(path, os.ModePerm)
+	builder, err := options.Storage.Builder("view", "{{ .TopicName }}"{{ .Storage }})
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "failed to create view db directory")
+		return nil, nil, errors.Wrap(err, "failed to create view storage")
 	}
 
-	builder := storage.BuilderWithOptions(path, opts)
-
 	view, err := goka.NewView(brokers,
 		goka.Table("{{ .TopicName }}"),
 		codec,
-		goka.WithViewStorageBuilder(builder),
-		goka.WithViewHasher(kafkautil.MurmurHasher),
-	)
+		options.ViewOptions(
+			goka.WithViewStorageBuilder(builder),
+			goka.WithViewHasher(kafkautil.MurmurHasher),
+{{- if .ReadCommitted }}
+			goka.WithViewConsumerSaramaBuilder(goka.SaramaConsumerBuilderWithConfig(runner.ReadCommittedConfig())),
+{{- end }}
+		)...)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "failed creating view")
 	}
@@ -80,6 +71,8 @@ func New_{{ .Name }}_View(options runner.ServiceOptions) (*{{ .Name }}_View_impl
 	}
 
 	return v, func(outerCtx context.Context) func() error {
+		runner.ReportReadiness(outerCtx, view.Recovered)
+
 		return func() error {
 			cancelableCtx, cancel := context.WithCancel(outerCtx)
 			defer cancel()
@@ -95,6 +88,7 @@ func New_{{ .Name }}_View(options runner.ServiceOptions) (*{{ .Name }}_View_impl
 			grp.Go(func() error {
 				return v.view.Run(ctx)
 			})
+			grp.Go(options.Metrics.View("{{ .ServiceName }}", "{{ .ComponentName }}", "{{ .TopicName }}").Report(ctx, v.view))
 			
 			select {
 			case <- ctx.Done():
@@ -152,11 +146,16 @@ func (v *{{ .Name }}_View_impl) Get(key string) (*{{ .MessageType }}, error) {
 )
 
 type viewOptions struct {
-	Package     string
-	Import      string
-	Name        string
-	TopicName   string
-	MessageType string
+	Package       string
+	Import        string
+	Name          string
+	ServiceName   string
+	ComponentName string
+	TopicName     string
+	MessageType   string
+	Wrapper       codecWrapper
+	ReadCommitted bool
+	Storage       string
 }
 
 func generateView(writer io.Writer, view *viewOptions) error {
@@ -167,9 +166,11 @@ func generateView(writer io.Writer, view *viewOptions) error {
 	return nil
 }
 
-func buildViewOptions(pkg string, mod string, modelsPath string, service *models.Service, view models.View) (*viewOptions, error) {
+func buildViewOptions(pkg string, mod string, modelsPath string, service *models.Service, view models.View, component *models.Component) (*viewOptions, error) {
 	options := &viewOptions{
-		Package: pkg,
+		Package:       pkg,
+		ServiceName:   service.Name,
+		ComponentName: component.Name,
 	}
 
 	options.TopicName = view.ToTopicName(service)
@@ -177,5 +178,18 @@ func buildViewOptions(pkg string, mod string, modelsPath string, service *models
 	options.Import = view.ToPackage(service)
 	options.MessageType = view.ToMessageTypeWithPackage()
 
+	wrapper, err := buildCodecWrapper(service, view.TopicDefinition)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build codec wrapper for '%s'", view.Message)
+	}
+	options.Wrapper = wrapper
+	options.ReadCommitted = view.ReadCommitted
+
+	storage, err := buildStorageOverride(view.Storage)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build storage options for view '%s'", view.Message)
+	}
+	options.Storage = storage
+
 	return options, nil
 }
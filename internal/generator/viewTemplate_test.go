@@ -24,15 +24,11 @@ package details
 
 import (
 	"context"
-	"os"
-	"path/filepath"
 
 	"github.com/burdiyan/kafkautil"
 	"github.com/lovoo/goka"
-	"github.com/lovoo/goka/storage"
 	"github.com/pkg/errors"
 	"github.com/syncromatics/kafmesh/pkg/runner"
-	"github.com/syndtr/goleveldb/leveldb/opt"
 	"golang.org/x/sync/errgroup"
 
 	"test/internal/kafmesh/models/testMesh/testSerial"
@@ -57,26 +53,19 @@ func New_TestSerialDetailsEnriched_View(options runner.ServiceOptions) (*TestSer
 		return nil, nil, errors.Wrap(err, "failed to create codec")
 	}
 
-	opts := &opt.Options{
-		BlockCacheCapacity: opt.MiB * 1,
-		WriteBuffer:        opt.MiB * 1,
-	}
-
-	path := filepath.Join("/tmp/storage", "view", "testMesh.testSerial.detailsEnriched")
-
-	err = os.MkdirAll(path, os.ModePerm)
+	builder, err := options.Storage.Builder("view", "testMesh.testSerial.detailsEnriched", runner.StorageOptions{Backend: runner.StorageBackendMemory})
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "failed to create view db directory")
+		return nil, nil, errors.Wrap(err, "failed to create view storage")
 	}
 
-	builder := storage.BuilderWithOptions(path, opts)
-
 	view, err := goka.NewView(brokers,
 		goka.Table("testMesh.testSerial.detailsEnriched"),
 		codec,
-		goka.WithViewStorageBuilder(builder),
-		goka.WithViewHasher(kafkautil.MurmurHasher),
-	)
+		options.ViewOptions(
+			goka.WithViewStorageBuilder(builder),
+			goka.WithViewHasher(kafkautil.MurmurHasher),
+			goka.WithViewConsumerSaramaBuilder(goka.SaramaConsumerBuilderWithConfig(runner.ReadCommittedConfig())),
+		)...)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "failed creating view")
 	}
@@ -88,6 +77,8 @@ func New_TestSerialDetailsEnriched_View(options runner.ServiceOptions) (*TestSer
 	}
 
 	return v, func(outerCtx context.Context) func() error {
+		runner.ReportReadiness(outerCtx, view.Recovered)
+
 		return func() error {
 			cancelableCtx, cancel := context.WithCancel(outerCtx)
 			defer cancel()
@@ -103,6 +94,7 @@ func New_TestSerialDetailsEnriched_View(options runner.ServiceOptions) (*TestSer
 			grp.Go(func() error {
 				return v.view.Run(ctx)
 			})
+			grp.Go(options.Metrics.View("testMesh", "details", "testMesh.testSerial.detailsEnriched").Report(ctx, v.view))
 			
 			select {
 			case <- ctx.Done():
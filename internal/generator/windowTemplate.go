@@ -0,0 +1,389 @@
+package generator
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/syncromatics/kafmesh/internal/models"
+
+	"github.com/iancoleman/strcase"
+	"github.com/pkg/errors"
+)
+
+var (
+	windowTemplate = template.Must(template.New("").Parse(`// Code generated by kafmesh-gen. DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/burdiyan/kafkautil"
+	"github.com/lovoo/goka"
+	"github.com/pkg/errors"
+
+	"github.com/syncromatics/kafmesh/pkg/runner"
+{{ range .Imports }}
+	{{ . }}
+{{- end }}
+)
+
+{{ $name := .Name -}}
+type {{ .Name }}_WindowContext interface {
+	Key() string
+	Timestamp() time.Time
+{{- range .Outputs }}
+	{{ .Func }}(key string, message *{{ .Message }})
+{{- end }}
+}
+
+type {{ .Name }}_WindowedProcessor interface {
+{{- range .Inputs }}
+	{{ .Func }}(ctx {{ $name }}_WindowContext, window runner.Window, aggregate *{{ $.Aggregate.Message }}, message *{{ .Message }}) error
+{{- end }}
+	Emit(ctx {{ .Name }}_WindowContext, window runner.Window, aggregate *{{ .Aggregate.Message }}) error
+}
+
+// {{ .Name }}_TimestampExtractor can be implemented by the windowed processor to window messages by a timestamp
+// other than the kafka message timestamp
+type {{ .Name }}_TimestampExtractor interface {
+{{- range .Inputs }}
+	{{ .TimestampFunc }}(ctx {{ $name }}_WindowContext, message *{{ .Message }}) time.Time
+{{- end }}
+}
+
+type {{ .Name }}_WindowContext_Impl struct {
+	ctx              goka.Context
+	processorContext *runner.ProcessorContext
+}
+
+func new_{{ .Name }}_WindowContext_Impl(ctx goka.Context, pc *runner.ProcessorContext) *{{ .Name }}_WindowContext_Impl {
+	return &{{ .Name }}_WindowContext_Impl{ctx, pc}
+}
+
+func (c *{{ .Name }}_WindowContext_Impl) Key() string {
+	return c.ctx.Key()
+}
+
+func (c *{{ .Name }}_WindowContext_Impl) Timestamp() time.Time {
+	return c.ctx.Timestamp()
+}
+{{ range .Outputs }}
+func (c *{{ $name }}_WindowContext_Impl) {{ .Func }}(key string, message *{{ .Message }}) {
+	value, _ := json.Marshal(message)
+	c.processorContext.Output("{{ .Topic }}", "{{ .MessageType }}", key, string(value))
+	c.ctx.Emit("{{ .Topic }}", key, message, goka.WithCtxEmitHeaders(c.processorContext.Headers()))
+}
+{{ end }}
+func Register_{{ .Name }}_WindowedProcessor(service *runner.Service, impl {{ .Name }}_WindowedProcessor) (func(context.Context) func() error, error) {
+	options := service.Options()
+	brokers := options.Brokers
+	metrics := options.Metrics.Processor("{{ .ServiceName }}", "{{ .Component }}", "{{ .WindowName }}")
+{{- range .Wrappers }}
+	{{ .Name }} := options.{{ .Option }}
+{{- end }}
+
+	config := sarama.NewConfig()
+	config.Version = sarama.MaxVersion
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	config.Consumer.Offsets.AutoCommit.Enable = true
+	config.Consumer.Offsets.CommitInterval = 1 * time.Second
+
+	builder, err := options.Storage.Builder("processor", "{{ .Group }}"{{ .Storage }})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create processor storage")
+	}
+
+	timersBuilder, err := options.Storage.Builder("processor", "{{ .TimerTopic }}"{{ .Storage }})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create timers storage")
+	}
+
+	timers := runner.NewTimers(brokers, "{{ .Group }}", timersBuilder)
+{{ range .Codecs }}
+	c{{ .Index }}, err := {{ .Wrapper.Name }}.Codec("{{ .Topic }}", &{{ .Message }}{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create codec")
+	}
+{{ end }}
+	aggregator := runner.WindowedAggregator{
+		Size:    {{ .Size.Milliseconds }} * time.Millisecond,
+		Advance: {{ .Advance.Milliseconds }} * time.Millisecond,
+		Grace:   {{ .Grace.Milliseconds }} * time.Millisecond,
+		Codec:   c{{ .Aggregate.Codec }},
+		New: func() interface{} {
+			return &{{ .Aggregate.Message }}{}
+		},
+		Timers:     timers,
+		StreamTime: runner.NewPartitionStreamTime(),
+	}
+
+	extractor, hasExtractor := impl.({{ .Name }}_TimestampExtractor)
+
+	edges := []goka.Edge{
+{{- range .Inputs }}
+		goka.Input(goka.Stream("{{ .Topic }}"), c{{ .Codec }}, func(ctx goka.Context, m interface{}) {
+			start := time.Now()
+			msg := m.(*{{ .Message }})
+
+			pc := service.ProcessorContext(ctx.Context(), "{{ $.Component }}", "{{ $.WindowName }}", ctx.Key())
+			pc.Trace("{{ .Topic }}", ctx.Headers())
+			defer pc.Finish()
+
+			v, err := json.Marshal(msg)
+			if err != nil {
+				ctx.Fail(err)
+			}
+			pc.Input("{{ .Topic }}", "{{ .MessageType }}", string(v))
+
+			w := new_{{ $name }}_WindowContext_Impl(ctx, pc)
+
+			timestamp := ctx.Timestamp()
+			if hasExtractor {
+				timestamp = extractor.{{ .TimestampFunc }}(w, msg)
+			}
+
+			err = aggregator.Aggregate(ctx, timestamp, func(window runner.Window, aggregate interface{}) error {
+				return impl.{{ .Func }}(w, window, aggregate.(*{{ $.Aggregate.Message }}), msg)
+			}, func(window runner.Window, aggregate interface{}) error {
+				return impl.Emit(w, window, aggregate.(*{{ $.Aggregate.Message }}))
+			})
+			metrics.Handled("{{ .Topic }}", time.Since(start), err)
+			if err != nil {
+				pc.Fail(err)
+				ctx.Fail(err)
+			}
+		}),
+{{- end }}
+{{- range .Outputs }}
+		goka.Output(goka.Stream("{{ .Topic }}"), c{{ .Codec }}),
+{{- end }}
+		goka.Persist(new(runner.WindowStoreCodec)),
+		timers.Edge(),
+		goka.Input(goka.Stream(timers.FiredTopic()), new(runner.TimerCodec), func(ctx goka.Context, m interface{}) {
+			start := time.Now()
+			timer := m.(*runner.Timer)
+
+			pc := service.ProcessorContext(ctx.Context(), "{{ .Component }}", "{{ .WindowName }}", ctx.Key())
+			defer pc.Finish()
+
+			w := new_{{ .Name }}_WindowContext_Impl(ctx, pc)
+
+			err := aggregator.Close(ctx, timer.At, func(window runner.Window, aggregate interface{}) error {
+				return impl.Emit(w, window, aggregate.(*{{ .Aggregate.Message }}))
+			})
+			metrics.Handled(timers.FiredTopic(), time.Since(start), err)
+			if err != nil {
+				pc.Fail(err)
+				ctx.Fail(err)
+			}
+		}),
+	}
+	group := goka.DefineGroup(goka.Group("{{ .Group }}"), edges...)
+
+	processor, err := goka.NewProcessor(brokers,
+		group,
+		options.ProcessorOptions(
+			goka.WithConsumerGroupBuilder(goka.ConsumerGroupBuilderWithConfig(config)),
+			goka.WithStorageBuilder(builder),
+			goka.WithHasher(kafkautil.MurmurHasher),
+		)...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create goka processor")
+	}
+
+	err = service.RegisterTimers(timers)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register timers")
+	}
+
+	return func(ctx context.Context) func() error {
+		runner.ReportReadiness(ctx, processor.Recovered)
+
+		return func() error {
+			err := processor.Run(ctx)
+			if err != nil {
+				return errors.Wrap(err, "failed to run goka windowed processor")
+			}
+
+			return nil
+		}
+	}, nil
+}
+`))
+)
+
+type windowEdge struct {
+	Func          string
+	TimestampFunc string
+	Topic         string
+	Message       string
+	MessageType   string
+	Codec         int
+}
+
+type windowOptions struct {
+	Package     string
+	ServiceName string
+	Component   string
+	WindowName  string
+	Name        string
+	Group       string
+	TimerTopic  string
+	Imports     []string
+	Inputs      []windowEdge
+	Outputs     []windowEdge
+	Aggregate   windowEdge
+	Codecs      []codec
+	Wrappers    []codecWrapper
+	Size        time.Duration
+	Advance     time.Duration
+	Grace       time.Duration
+	Storage     string
+}
+
+func generateWindow(writer io.Writer, window *windowOptions) error {
+	err := windowTemplate.Execute(writer, window)
+	if err != nil {
+		return errors.Wrap(err, "failed to execute window template")
+	}
+	return nil
+}
+
+func buildWindowOptions(pkg string, service *models.Service, component *models.Component, window models.Window) (*windowOptions, error) {
+	if window.Size <= 0 {
+		return nil, errors.Errorf("window '%s' must have a size greater than zero", window.Name)
+	}
+
+	if window.Advance < 0 || window.Advance > window.Size {
+		return nil, errors.Errorf("window '%s' must advance by a duration between zero and its size", window.Name)
+	}
+
+	if window.Grace < 0 {
+		return nil, errors.Errorf("window '%s' cannot have a negative grace period", window.Name)
+	}
+
+	if len(window.Inputs) == 0 {
+		return nil, errors.Errorf("window '%s' must have at least one input", window.Name)
+	}
+
+	options := &windowOptions{
+		Package:     pkg,
+		ServiceName: service.Name,
+		Component:   component.Name,
+		WindowName:  window.Name,
+		Name:        window.ToSafeName(),
+		Group:       window.GroupName(service, component),
+		TimerTopic:  window.TimerGroupName(service, component),
+		Size:        window.Size,
+		Advance:     window.Advance,
+		Grace:       window.Grace,
+	}
+
+	storage, err := buildStorageOverride(window.Storage)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build storage options for window '%s'", window.Name)
+	}
+	options.Storage = storage
+
+	if options.Advance == 0 {
+		options.Advance = options.Size
+	}
+
+	imports := map[string]int{}
+	codecs := map[string]codec{}
+
+	buildEdge := func(definition models.TopicDefinition, topic string) (windowEdge, error) {
+		modulePackage := definition.ToPackage(service)
+		i, ok := imports[modulePackage]
+		if !ok {
+			i = len(imports)
+			imports[modulePackage] = i
+		}
+
+		nameFrags := strings.Split(definition.Message, ".")
+		message := fmt.Sprintf("m%d.%s", i, strcase.ToCamel(nameFrags[len(nameFrags)-1]))
+
+		wrapper, err := buildCodecWrapper(service, definition)
+		if err != nil {
+			return windowEdge{}, errors.Wrapf(err, "failed to build codec wrapper for '%s'", definition.Message)
+		}
+
+		c, ok := codecs[topic]
+		if !ok {
+			c = codec{
+				Index:   len(codecs),
+				Topic:   topic,
+				Message: message,
+				Wrapper: wrapper,
+			}
+			codecs[topic] = c
+		}
+
+		return windowEdge{
+			Topic:       topic,
+			Message:     message,
+			MessageType: definition.Message,
+			Codec:       c.Index,
+		}, nil
+	}
+
+	for _, input := range window.Inputs {
+		e, err := buildEdge(input.TopicDefinition, input.ToTopicName(service))
+		if err != nil {
+			return nil, err
+		}
+
+		e.Func = fmt.Sprintf("Aggregate%s", input.ToSafeMessageTypeName())
+		e.TimestampFunc = fmt.Sprintf("Timestamp%s", input.ToSafeMessageTypeName())
+		options.Inputs = append(options.Inputs, e)
+	}
+
+	for _, output := range window.Outputs {
+		e, err := buildEdge(output.TopicDefinition, output.ToTopicName(service))
+		if err != nil {
+			return nil, err
+		}
+
+		e.Func = fmt.Sprintf("Output_%s", output.ToSafeMessageTypeName())
+		options.Outputs = append(options.Outputs, e)
+	}
+
+	aggregate, err := buildEdge(window.Aggregate.TopicDefinition, window.TableName(service, component))
+	if err != nil {
+		return nil, err
+	}
+	options.Aggregate = aggregate
+
+	for _, c := range codecs {
+		options.Codecs = append(options.Codecs, c)
+	}
+
+	sort.SliceStable(options.Codecs, func(i, j int) bool {
+		return options.Codecs[i].Index < options.Codecs[j].Index
+	})
+
+	for _, w := range []codecWrapper{protoCodecWrapper, avroCodecWrapper} {
+		for _, c := range options.Codecs {
+			if c.Wrapper == w {
+				options.Wrappers = append(options.Wrappers, w)
+				break
+			}
+		}
+	}
+
+	for k, v := range imports {
+		options.Imports = append(options.Imports, fmt.Sprintf("m%d \"%s\"", v, k))
+	}
+
+	sort.Strings(options.Imports)
+
+	return options, nil
+}
@@ -0,0 +1,220 @@
+package generator_test
+
+import (
+	"io/ioutil"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func validateWindow(tmpDir string, t *testing.T) {
+	s, err := ioutil.ReadFile(path.Join(tmpDir, "internal", "kafmesh", "details", "detail_counts_window.km.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, expectedWindow, string(s))
+}
+
+var (
+	expectedWindow = `// Code generated by kafmesh-gen. DO NOT EDIT.
+
+package details
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/burdiyan/kafkautil"
+	"github.com/lovoo/goka"
+	"github.com/pkg/errors"
+
+	"github.com/syncromatics/kafmesh/pkg/runner"
+
+	m0 "test/internal/kafmesh/models/testMesh/testSerial"
+)
+
+type DetailCounts_WindowContext interface {
+	Key() string
+	Timestamp() time.Time
+	Output_TestSerialDetailsEnriched(key string, message *m0.DetailsEnriched)
+}
+
+type DetailCounts_WindowedProcessor interface {
+	AggregateTestSerialDetails(ctx DetailCounts_WindowContext, window runner.Window, aggregate *m0.DetailsState, message *m0.Details) error
+	Emit(ctx DetailCounts_WindowContext, window runner.Window, aggregate *m0.DetailsState) error
+}
+
+// DetailCounts_TimestampExtractor can be implemented by the windowed processor to window messages by a timestamp
+// other than the kafka message timestamp
+type DetailCounts_TimestampExtractor interface {
+	TimestampTestSerialDetails(ctx DetailCounts_WindowContext, message *m0.Details) time.Time
+}
+
+type DetailCounts_WindowContext_Impl struct {
+	ctx              goka.Context
+	processorContext *runner.ProcessorContext
+}
+
+func new_DetailCounts_WindowContext_Impl(ctx goka.Context, pc *runner.ProcessorContext) *DetailCounts_WindowContext_Impl {
+	return &DetailCounts_WindowContext_Impl{ctx, pc}
+}
+
+func (c *DetailCounts_WindowContext_Impl) Key() string {
+	return c.ctx.Key()
+}
+
+func (c *DetailCounts_WindowContext_Impl) Timestamp() time.Time {
+	return c.ctx.Timestamp()
+}
+
+func (c *DetailCounts_WindowContext_Impl) Output_TestSerialDetailsEnriched(key string, message *m0.DetailsEnriched) {
+	value, _ := json.Marshal(message)
+	c.processorContext.Output("testMesh.testSerial.detailsEnriched", "testSerial.detailsEnriched", key, string(value))
+	c.ctx.Emit("testMesh.testSerial.detailsEnriched", key, message, goka.WithCtxEmitHeaders(c.processorContext.Headers()))
+}
+
+func Register_DetailCounts_WindowedProcessor(service *runner.Service, impl DetailCounts_WindowedProcessor) (func(context.Context) func() error, error) {
+	options := service.Options()
+	brokers := options.Brokers
+	metrics := options.Metrics.Processor("testMesh", "details", "detail counts")
+	protoWrapper := options.ProtoWrapper
+
+	config := sarama.NewConfig()
+	config.Version = sarama.MaxVersion
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	config.Consumer.Offsets.AutoCommit.Enable = true
+	config.Consumer.Offsets.CommitInterval = 1 * time.Second
+
+	builder, err := options.Storage.Builder("processor", "testMesh.details.detailCounts")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create processor storage")
+	}
+
+	timersBuilder, err := options.Storage.Builder("processor", "testMesh.details.detailCounts-timers")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create timers storage")
+	}
+
+	timers := runner.NewTimers(brokers, "testMesh.details.detailCounts", timersBuilder)
+
+	c0, err := protoWrapper.Codec("testMesh.testSerial.details", &m0.Details{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create codec")
+	}
+
+	c1, err := protoWrapper.Codec("testMesh.testSerial.detailsEnriched", &m0.DetailsEnriched{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create codec")
+	}
+
+	c2, err := protoWrapper.Codec("testMesh.details.detailCounts-table", &m0.DetailsState{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create codec")
+	}
+
+	aggregator := runner.WindowedAggregator{
+		Size:    300000 * time.Millisecond,
+		Advance: 60000 * time.Millisecond,
+		Grace:   30000 * time.Millisecond,
+		Codec:   c2,
+		New: func() interface{} {
+			return &m0.DetailsState{}
+		},
+		Timers:     timers,
+		StreamTime: runner.NewPartitionStreamTime(),
+	}
+
+	extractor, hasExtractor := impl.(DetailCounts_TimestampExtractor)
+
+	edges := []goka.Edge{
+		goka.Input(goka.Stream("testMesh.testSerial.details"), c0, func(ctx goka.Context, m interface{}) {
+			start := time.Now()
+			msg := m.(*m0.Details)
+
+			pc := service.ProcessorContext(ctx.Context(), "details", "detail counts", ctx.Key())
+			pc.Trace("testMesh.testSerial.details", ctx.Headers())
+			defer pc.Finish()
+
+			v, err := json.Marshal(msg)
+			if err != nil {
+				ctx.Fail(err)
+			}
+			pc.Input("testMesh.testSerial.details", "testSerial.details", string(v))
+
+			w := new_DetailCounts_WindowContext_Impl(ctx, pc)
+
+			timestamp := ctx.Timestamp()
+			if hasExtractor {
+				timestamp = extractor.TimestampTestSerialDetails(w, msg)
+			}
+
+			err = aggregator.Aggregate(ctx, timestamp, func(window runner.Window, aggregate interface{}) error {
+				return impl.AggregateTestSerialDetails(w, window, aggregate.(*m0.DetailsState), msg)
+			}, func(window runner.Window, aggregate interface{}) error {
+				return impl.Emit(w, window, aggregate.(*m0.DetailsState))
+			})
+			metrics.Handled("testMesh.testSerial.details", time.Since(start), err)
+			if err != nil {
+				pc.Fail(err)
+				ctx.Fail(err)
+			}
+		}),
+		goka.Output(goka.Stream("testMesh.testSerial.detailsEnriched"), c1),
+		goka.Persist(new(runner.WindowStoreCodec)),
+		timers.Edge(),
+		goka.Input(goka.Stream(timers.FiredTopic()), new(runner.TimerCodec), func(ctx goka.Context, m interface{}) {
+			start := time.Now()
+			timer := m.(*runner.Timer)
+
+			pc := service.ProcessorContext(ctx.Context(), "details", "detail counts", ctx.Key())
+			defer pc.Finish()
+
+			w := new_DetailCounts_WindowContext_Impl(ctx, pc)
+
+			err := aggregator.Close(ctx, timer.At, func(window runner.Window, aggregate interface{}) error {
+				return impl.Emit(w, window, aggregate.(*m0.DetailsState))
+			})
+			metrics.Handled(timers.FiredTopic(), time.Since(start), err)
+			if err != nil {
+				pc.Fail(err)
+				ctx.Fail(err)
+			}
+		}),
+	}
+	group := goka.DefineGroup(goka.Group("testMesh.details.detailCounts"), edges...)
+
+	processor, err := goka.NewProcessor(brokers,
+		group,
+		options.ProcessorOptions(
+			goka.WithConsumerGroupBuilder(goka.ConsumerGroupBuilderWithConfig(config)),
+			goka.WithStorageBuilder(builder),
+			goka.WithHasher(kafkautil.MurmurHasher),
+		)...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create goka processor")
+	}
+
+	err = service.RegisterTimers(timers)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register timers")
+	}
+
+	return func(ctx context.Context) func() error {
+		runner.ReportReadiness(ctx, processor.Recovered)
+
+		return func() error {
+			err := processor.Run(ctx)
+			if err != nil {
+				return errors.Wrap(err, "failed to run goka windowed processor")
+			}
+
+			return nil
+		}
+	}, nil
+}
+`
+)
@@ -38,6 +38,7 @@ type Config struct {
 
 type ResolverRoot interface {
 	Component() ComponentResolver
+	ConsumerLag() ConsumerLagResolver
 	Pod() PodResolver
 	Processor() ProcessorResolver
 	ProcessorInput() ProcessorInputResolver
@@ -73,6 +74,16 @@ type ComplexityRoot struct {
 		Views       func(childComplexity int) int
 	}
 
+	ConsumerLag struct {
+		CommittedOffset func(childComplexity int) int
+		GroupName       func(childComplexity int) int
+		HighWatermark   func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Lag             func(childComplexity int) int
+		Partition       func(childComplexity int) int
+		Topic           func(childComplexity int) int
+	}
+
 	GetState struct {
 		Message func(childComplexity int) int
 		Topic   func(childComplexity int) int
@@ -91,6 +102,11 @@ type ComplexityRoot struct {
 		Value   func(childComplexity int) int
 	}
 
+	Lagging struct {
+		Processors func(childComplexity int) int
+		Sinks      func(childComplexity int) int
+	}
+
 	Lookup struct {
 		Key     func(childComplexity int) int
 		Message func(childComplexity int) int
@@ -112,6 +128,11 @@ type ComplexityRoot struct {
 		Value   func(childComplexity int) int
 	}
 
+	PartitionOffset struct {
+		Offset    func(childComplexity int) int
+		Partition func(childComplexity int) int
+	}
+
 	Pod struct {
 		ID          func(childComplexity int) int
 		Name        func(childComplexity int) int
@@ -130,6 +151,7 @@ type ComplexityRoot struct {
 		ID          func(childComplexity int) int
 		Inputs      func(childComplexity int) int
 		Joins       func(childComplexity int) int
+		Lag         func(childComplexity int) int
 		Lookups     func(childComplexity int) int
 		Name        func(childComplexity int) int
 		Outputs     func(childComplexity int) int
@@ -163,6 +185,7 @@ type ComplexityRoot struct {
 
 	Query struct {
 		ComponentByID func(childComplexity int, id int) int
+		Lagging       func(childComplexity int, threshold int) int
 		Pods          func(childComplexity int) int
 		ServiceByID   func(childComplexity int, id int) int
 		Services      func(childComplexity int) int
@@ -186,12 +209,34 @@ type ComplexityRoot struct {
 	Sink struct {
 		Component   func(childComplexity int) int
 		Description func(childComplexity int) int
+		GroupName   func(childComplexity int) int
 		ID          func(childComplexity int) int
+		Lag         func(childComplexity int) int
 		Name        func(childComplexity int) int
 		Pods        func(childComplexity int) int
 		Topic       func(childComplexity int) int
 	}
 
+	SinkCollect struct {
+		Key       func(childComplexity int) int
+		Offset    func(childComplexity int) int
+		Partition func(childComplexity int) int
+		Time      func(childComplexity int) int
+		Value     func(childComplexity int) int
+	}
+
+	SinkCommit struct {
+		Offsets func(childComplexity int) int
+		Time    func(childComplexity int) int
+	}
+
+	SinkFlush struct {
+		Attempts func(childComplexity int) int
+		Count    func(childComplexity int) int
+		Error    func(childComplexity int) int
+		Time     func(childComplexity int) int
+	}
+
 	Source struct {
 		Component func(childComplexity int) int
 		ID        func(childComplexity int) int
@@ -199,12 +244,25 @@ type ComplexityRoot struct {
 		Topic     func(childComplexity int) int
 	}
 
+	SourceEmit struct {
+		Delete func(childComplexity int) int
+		Error  func(childComplexity int) int
+		Key    func(childComplexity int) int
+		Time   func(childComplexity int) int
+		Value  func(childComplexity int) int
+	}
+
 	Subscription struct {
-		WatchProcessor func(childComplexity int, options *model.WatchProcessorInput) int
+		WatchProcessor  func(childComplexity int, options *model.WatchProcessorInput) int
+		WatchSink       func(childComplexity int, options *model.WatchSinkInput) int
+		WatchSource     func(childComplexity int, options *model.WatchSourceInput) int
+		WatchViewSink   func(childComplexity int, options *model.WatchViewSinkInput) int
+		WatchViewSource func(childComplexity int, options *model.WatchViewSourceInput) int
 	}
 
 	Topic struct {
 		ID                    func(childComplexity int) int
+		Lag                   func(childComplexity int) int
 		Message               func(childComplexity int) int
 		Name                  func(childComplexity int) int
 		ProcessorInputs       func(childComplexity int) int
@@ -235,6 +293,12 @@ type ComplexityRoot struct {
 		Topic       func(childComplexity int) int
 	}
 
+	ViewSinkSync struct {
+		EndTime   func(childComplexity int) int
+		Error     func(childComplexity int) int
+		StartTime func(childComplexity int) int
+	}
+
 	ViewSource struct {
 		Component   func(childComplexity int) int
 		Description func(childComplexity int) int
@@ -243,6 +307,19 @@ type ComplexityRoot struct {
 		Pods        func(childComplexity int) int
 		Topic       func(childComplexity int) int
 	}
+
+	ViewSourceSync struct {
+		Deletes   func(childComplexity int) int
+		EndTime   func(childComplexity int) int
+		Error     func(childComplexity int) int
+		StartTime func(childComplexity int) int
+		Updates   func(childComplexity int) int
+	}
+
+	ViewSourceUpdate struct {
+		Key   func(childComplexity int) int
+		Value func(childComplexity int) int
+	}
 }
 
 type ComponentResolver interface {
@@ -256,6 +333,9 @@ type ComponentResolver interface {
 	Views(ctx context.Context, obj *model.Component) ([]*model.View, error)
 	DependsOn(ctx context.Context, obj *model.Component) ([]*model.Component, error)
 }
+type ConsumerLagResolver interface {
+	Topic(ctx context.Context, obj *model.ConsumerLag) (*model.Topic, error)
+}
 type PodResolver interface {
 	Processors(ctx context.Context, obj *model.Pod) ([]*model.Processor, error)
 	Sinks(ctx context.Context, obj *model.Pod) ([]*model.Sink, error)
@@ -273,6 +353,7 @@ type ProcessorResolver interface {
 	Joins(ctx context.Context, obj *model.Processor) ([]*model.ProcessorJoin, error)
 	Lookups(ctx context.Context, obj *model.Processor) ([]*model.ProcessorLookup, error)
 	Outputs(ctx context.Context, obj *model.Processor) ([]*model.ProcessorOutput, error)
+	Lag(ctx context.Context, obj *model.Processor) ([]*model.ConsumerLag, error)
 }
 type ProcessorInputResolver interface {
 	Processor(ctx context.Context, obj *model.ProcessorInput) (*model.Processor, error)
@@ -296,6 +377,7 @@ type QueryResolver interface {
 	Topics(ctx context.Context) ([]*model.Topic, error)
 	ServiceByID(ctx context.Context, id int) (*model.Service, error)
 	ComponentByID(ctx context.Context, id int) (*model.Component, error)
+	Lagging(ctx context.Context, threshold int) (*model.Lagging, error)
 }
 type ServiceResolver interface {
 	Components(ctx context.Context, obj *model.Service) ([]*model.Component, error)
@@ -306,6 +388,7 @@ type SinkResolver interface {
 
 	Topic(ctx context.Context, obj *model.Sink) (*model.Topic, error)
 	Pods(ctx context.Context, obj *model.Sink) ([]*model.Pod, error)
+	Lag(ctx context.Context, obj *model.Sink) ([]*model.ConsumerLag, error)
 }
 type SourceResolver interface {
 	Component(ctx context.Context, obj *model.Source) (*model.Component, error)
@@ -314,6 +397,10 @@ type SourceResolver interface {
 }
 type SubscriptionResolver interface {
 	WatchProcessor(ctx context.Context, options *model.WatchProcessorInput) (<-chan *model.Operation, error)
+	WatchSink(ctx context.Context, options *model.WatchSinkInput) (<-chan model.SinkEvent, error)
+	WatchSource(ctx context.Context, options *model.WatchSourceInput) (<-chan *model.SourceEmit, error)
+	WatchViewSource(ctx context.Context, options *model.WatchViewSourceInput) (<-chan *model.ViewSourceSync, error)
+	WatchViewSink(ctx context.Context, options *model.WatchViewSinkInput) (<-chan *model.ViewSinkSync, error)
 }
 type TopicResolver interface {
 	ProcessorInputs(ctx context.Context, obj *model.Topic) ([]*model.ProcessorInput, error)
@@ -326,6 +413,7 @@ type TopicResolver interface {
 	ViewSinks(ctx context.Context, obj *model.Topic) ([]*model.ViewSink, error)
 	ViewSources(ctx context.Context, obj *model.Topic) ([]*model.ViewSource, error)
 	Views(ctx context.Context, obj *model.Topic) ([]*model.View, error)
+	Lag(ctx context.Context, obj *model.Topic) ([]*model.ConsumerLag, error)
 }
 type ViewResolver interface {
 	Component(ctx context.Context, obj *model.View) (*model.Component, error)
@@ -437,6 +525,55 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Component.Views(childComplexity), true
 
+	case "ConsumerLag.committedOffset":
+		if e.complexity.ConsumerLag.CommittedOffset == nil {
+			break
+		}
+
+		return e.complexity.ConsumerLag.CommittedOffset(childComplexity), true
+
+	case "ConsumerLag.groupName":
+		if e.complexity.ConsumerLag.GroupName == nil {
+			break
+		}
+
+		return e.complexity.ConsumerLag.GroupName(childComplexity), true
+
+	case "ConsumerLag.highWatermark":
+		if e.complexity.ConsumerLag.HighWatermark == nil {
+			break
+		}
+
+		return e.complexity.ConsumerLag.HighWatermark(childComplexity), true
+
+	case "ConsumerLag.id":
+		if e.complexity.ConsumerLag.ID == nil {
+			break
+		}
+
+		return e.complexity.ConsumerLag.ID(childComplexity), true
+
+	case "ConsumerLag.lag":
+		if e.complexity.ConsumerLag.Lag == nil {
+			break
+		}
+
+		return e.complexity.ConsumerLag.Lag(childComplexity), true
+
+	case "ConsumerLag.partition":
+		if e.complexity.ConsumerLag.Partition == nil {
+			break
+		}
+
+		return e.complexity.ConsumerLag.Partition(childComplexity), true
+
+	case "ConsumerLag.topic":
+		if e.complexity.ConsumerLag.Topic == nil {
+			break
+		}
+
+		return e.complexity.ConsumerLag.Topic(childComplexity), true
+
 	case "GetState.message":
 		if e.complexity.GetState.Message == nil {
 			break
@@ -500,6 +637,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Join.Value(childComplexity), true
 
+	case "Lagging.processors":
+		if e.complexity.Lagging.Processors == nil {
+			break
+		}
+
+		return e.complexity.Lagging.Processors(childComplexity), true
+
+	case "Lagging.sinks":
+		if e.complexity.Lagging.Sinks == nil {
+			break
+		}
+
+		return e.complexity.Lagging.Sinks(childComplexity), true
+
 	case "Lookup.key":
 		if e.complexity.Lookup.Key == nil {
 			break
@@ -584,6 +735,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Output.Value(childComplexity), true
 
+	case "PartitionOffset.offset":
+		if e.complexity.PartitionOffset.Offset == nil {
+			break
+		}
+
+		return e.complexity.PartitionOffset.Offset(childComplexity), true
+
+	case "PartitionOffset.partition":
+		if e.complexity.PartitionOffset.Partition == nil {
+			break
+		}
+
+		return e.complexity.PartitionOffset.Partition(childComplexity), true
+
 	case "Pod.id":
 		if e.complexity.Pod.ID == nil {
 			break
@@ -682,6 +847,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Processor.Joins(childComplexity), true
 
+	case "Processor.lag":
+		if e.complexity.Processor.Lag == nil {
+			break
+		}
+
+		return e.complexity.Processor.Lag(childComplexity), true
+
 	case "Processor.lookups":
 		if e.complexity.Processor.Lookups == nil {
 			break
@@ -813,6 +985,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.ComponentByID(childComplexity, args["id"].(int)), true
 
+	case "Query.lagging":
+		if e.complexity.Query.Lagging == nil {
+			break
+		}
+
+		args, err := ec.field_Query_lagging_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Lagging(childComplexity, args["threshold"].(int)), true
+
 	case "Query.pods":
 		if e.complexity.Query.Pods == nil {
 			break
@@ -916,6 +1100,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Sink.Description(childComplexity), true
 
+	case "Sink.groupName":
+		if e.complexity.Sink.GroupName == nil {
+			break
+		}
+
+		return e.complexity.Sink.GroupName(childComplexity), true
+
 	case "Sink.id":
 		if e.complexity.Sink.ID == nil {
 			break
@@ -923,6 +1114,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Sink.ID(childComplexity), true
 
+	case "Sink.lag":
+		if e.complexity.Sink.Lag == nil {
+			break
+		}
+
+		return e.complexity.Sink.Lag(childComplexity), true
+
 	case "Sink.name":
 		if e.complexity.Sink.Name == nil {
 			break
@@ -944,6 +1142,83 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Sink.Topic(childComplexity), true
 
+	case "SinkCollect.key":
+		if e.complexity.SinkCollect.Key == nil {
+			break
+		}
+
+		return e.complexity.SinkCollect.Key(childComplexity), true
+
+	case "SinkCollect.offset":
+		if e.complexity.SinkCollect.Offset == nil {
+			break
+		}
+
+		return e.complexity.SinkCollect.Offset(childComplexity), true
+
+	case "SinkCollect.partition":
+		if e.complexity.SinkCollect.Partition == nil {
+			break
+		}
+
+		return e.complexity.SinkCollect.Partition(childComplexity), true
+
+	case "SinkCollect.time":
+		if e.complexity.SinkCollect.Time == nil {
+			break
+		}
+
+		return e.complexity.SinkCollect.Time(childComplexity), true
+
+	case "SinkCollect.value":
+		if e.complexity.SinkCollect.Value == nil {
+			break
+		}
+
+		return e.complexity.SinkCollect.Value(childComplexity), true
+
+	case "SinkCommit.offsets":
+		if e.complexity.SinkCommit.Offsets == nil {
+			break
+		}
+
+		return e.complexity.SinkCommit.Offsets(childComplexity), true
+
+	case "SinkCommit.time":
+		if e.complexity.SinkCommit.Time == nil {
+			break
+		}
+
+		return e.complexity.SinkCommit.Time(childComplexity), true
+
+	case "SinkFlush.attempts":
+		if e.complexity.SinkFlush.Attempts == nil {
+			break
+		}
+
+		return e.complexity.SinkFlush.Attempts(childComplexity), true
+
+	case "SinkFlush.count":
+		if e.complexity.SinkFlush.Count == nil {
+			break
+		}
+
+		return e.complexity.SinkFlush.Count(childComplexity), true
+
+	case "SinkFlush.error":
+		if e.complexity.SinkFlush.Error == nil {
+			break
+		}
+
+		return e.complexity.SinkFlush.Error(childComplexity), true
+
+	case "SinkFlush.time":
+		if e.complexity.SinkFlush.Time == nil {
+			break
+		}
+
+		return e.complexity.SinkFlush.Time(childComplexity), true
+
 	case "Source.component":
 		if e.complexity.Source.Component == nil {
 			break
@@ -972,6 +1247,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Source.Topic(childComplexity), true
 
+	case "SourceEmit.delete":
+		if e.complexity.SourceEmit.Delete == nil {
+			break
+		}
+
+		return e.complexity.SourceEmit.Delete(childComplexity), true
+
+	case "SourceEmit.error":
+		if e.complexity.SourceEmit.Error == nil {
+			break
+		}
+
+		return e.complexity.SourceEmit.Error(childComplexity), true
+
+	case "SourceEmit.key":
+		if e.complexity.SourceEmit.Key == nil {
+			break
+		}
+
+		return e.complexity.SourceEmit.Key(childComplexity), true
+
+	case "SourceEmit.time":
+		if e.complexity.SourceEmit.Time == nil {
+			break
+		}
+
+		return e.complexity.SourceEmit.Time(childComplexity), true
+
+	case "SourceEmit.value":
+		if e.complexity.SourceEmit.Value == nil {
+			break
+		}
+
+		return e.complexity.SourceEmit.Value(childComplexity), true
+
 	case "Subscription.watchProcessor":
 		if e.complexity.Subscription.WatchProcessor == nil {
 			break
@@ -984,6 +1294,54 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Subscription.WatchProcessor(childComplexity, args["options"].(*model.WatchProcessorInput)), true
 
+	case "Subscription.watchSink":
+		if e.complexity.Subscription.WatchSink == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_watchSink_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.WatchSink(childComplexity, args["options"].(*model.WatchSinkInput)), true
+
+	case "Subscription.watchSource":
+		if e.complexity.Subscription.WatchSource == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_watchSource_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.WatchSource(childComplexity, args["options"].(*model.WatchSourceInput)), true
+
+	case "Subscription.watchViewSink":
+		if e.complexity.Subscription.WatchViewSink == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_watchViewSink_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.WatchViewSink(childComplexity, args["options"].(*model.WatchViewSinkInput)), true
+
+	case "Subscription.watchViewSource":
+		if e.complexity.Subscription.WatchViewSource == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_watchViewSource_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.WatchViewSource(childComplexity, args["options"].(*model.WatchViewSourceInput)), true
+
 	case "Topic.id":
 		if e.complexity.Topic.ID == nil {
 			break
@@ -991,6 +1349,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Topic.ID(childComplexity), true
 
+	case "Topic.lag":
+		if e.complexity.Topic.Lag == nil {
+			break
+		}
+
+		return e.complexity.Topic.Lag(childComplexity), true
+
 	case "Topic.message":
 		if e.complexity.Topic.Message == nil {
 			break
@@ -1145,6 +1510,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ViewSink.Topic(childComplexity), true
 
+	case "ViewSinkSync.endTime":
+		if e.complexity.ViewSinkSync.EndTime == nil {
+			break
+		}
+
+		return e.complexity.ViewSinkSync.EndTime(childComplexity), true
+
+	case "ViewSinkSync.error":
+		if e.complexity.ViewSinkSync.Error == nil {
+			break
+		}
+
+		return e.complexity.ViewSinkSync.Error(childComplexity), true
+
+	case "ViewSinkSync.startTime":
+		if e.complexity.ViewSinkSync.StartTime == nil {
+			break
+		}
+
+		return e.complexity.ViewSinkSync.StartTime(childComplexity), true
+
 	case "ViewSource.component":
 		if e.complexity.ViewSource.Component == nil {
 			break
@@ -1187,6 +1573,55 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ViewSource.Topic(childComplexity), true
 
+	case "ViewSourceSync.deletes":
+		if e.complexity.ViewSourceSync.Deletes == nil {
+			break
+		}
+
+		return e.complexity.ViewSourceSync.Deletes(childComplexity), true
+
+	case "ViewSourceSync.endTime":
+		if e.complexity.ViewSourceSync.EndTime == nil {
+			break
+		}
+
+		return e.complexity.ViewSourceSync.EndTime(childComplexity), true
+
+	case "ViewSourceSync.error":
+		if e.complexity.ViewSourceSync.Error == nil {
+			break
+		}
+
+		return e.complexity.ViewSourceSync.Error(childComplexity), true
+
+	case "ViewSourceSync.startTime":
+		if e.complexity.ViewSourceSync.StartTime == nil {
+			break
+		}
+
+		return e.complexity.ViewSourceSync.StartTime(childComplexity), true
+
+	case "ViewSourceSync.updates":
+		if e.complexity.ViewSourceSync.Updates == nil {
+			break
+		}
+
+		return e.complexity.ViewSourceSync.Updates(childComplexity), true
+
+	case "ViewSourceUpdate.key":
+		if e.complexity.ViewSourceUpdate.Key == nil {
+			break
+		}
+
+		return e.complexity.ViewSourceUpdate.Key(childComplexity), true
+
+	case "ViewSourceUpdate.value":
+		if e.complexity.ViewSourceUpdate.Value == nil {
+			break
+		}
+
+		return e.complexity.ViewSourceUpdate.Value(childComplexity), true
+
 	}
 	return 0, false
 }
@@ -1330,6 +1765,7 @@ type Processor {
 	joins: [ProcessorJoin!]! @goField(forceResolver: true)
 	lookups: [ProcessorLookup!]! @goField(forceResolver: true)
 	outputs: [ProcessorOutput!]! @goField(forceResolver: true)
+	lag: [ConsumerLag!]! @goField(forceResolver: true)
 }
 
 type Sink {
@@ -1337,8 +1773,10 @@ type Sink {
 	component: Component! @goField(forceResolver: true)
 	name: String!
 	description: String!
+	groupName: String!
 	topic: Topic! @goField(forceResolver: true)
 	pods: [Pod!]! @goField(forceResolver: true)
+	lag: [ConsumerLag!]! @goField(forceResolver: true)
 }
 
 type Source {
@@ -1362,6 +1800,17 @@ type Topic {
 	viewSinks: [ViewSink!]! @goField(forceResolver: true)
 	viewSources: [ViewSource!]! @goField(forceResolver: true)
 	views: [View!]! @goField(forceResolver: true)
+	lag: [ConsumerLag!]! @goField(forceResolver: true)
+}
+
+type ConsumerLag {
+	id: ID!
+	groupName: String!
+	topic: Topic! @goField(forceResolver: true)
+	partition: Int!
+	committedOffset: Int!
+	highWatermark: Int!
+	lag: Int!
 }
 
 type ViewSink {
@@ -1439,33 +1888,118 @@ type Output implements Action {
 	value: String!
 	key: String!
 }
-`, BuiltIn: false},
-	{Name: "docs/graphql/schema.graphql", Input: `type Query {
-	services: [Service!]!
-	pods: [Pod!]!
-	topics: [Topic!]!
-	serviceById(id: ID!): Service
-	componentById(id: ID!): Component
+
+interface SinkEvent {
+	time: Int!
 }
 
-input WatchProcessorInput {
-	processorId: ID!
+type SinkCollect implements SinkEvent {
+	time: Int!
+	partition: Int!
+	offset: Int!
 	key: String!
+	value: String!
 }
 
-type Subscription {
-	watchProcessor(options: WatchProcessorInput): Operation!
+type SinkFlush implements SinkEvent {
+	time: Int!
+	count: Int!
+	attempts: Int!
+	error: String
 }
 
-schema {
-	query: Query
-	subscription: Subscription
+type SinkCommit implements SinkEvent {
+	time: Int!
+	offsets: [PartitionOffset!]!
 }
-`, BuiltIn: false},
+
+type PartitionOffset {
+	partition: Int!
+	offset: Int!
 }
-var parsedSchema = gqlparser.MustLoadSchema(sources...)
 
-// endregion ************************** generated!.gotpl **************************
+type SourceEmit {
+	time: Int!
+	key: String!
+	value: String
+	delete: Boolean!
+	error: String
+}
+
+type ViewSourceSync {
+	startTime: Int!
+	endTime: Int!
+	updates: [ViewSourceUpdate!]!
+	deletes: [String!]!
+	error: String
+}
+
+type ViewSourceUpdate {
+	key: String!
+	value: String!
+}
+
+type ViewSinkSync {
+	startTime: Int!
+	endTime: Int!
+	error: String
+}
+`, BuiltIn: false},
+	{Name: "docs/graphql/schema.graphql", Input: `type Query {
+	services: [Service!]!
+	pods: [Pod!]!
+	topics: [Topic!]!
+	serviceById(id: ID!): Service
+	componentById(id: ID!): Component
+	lagging(threshold: Int!): Lagging!
+}
+
+type Lagging {
+	processors: [Processor!]!
+	sinks: [Sink!]!
+}
+
+input WatchProcessorInput {
+	processorId: ID!
+	key: String
+	keyPrefix: String
+	keyRegex: String
+	sampleRate: Float
+}
+
+input WatchSinkInput {
+	sinkId: ID!
+}
+
+input WatchSourceInput {
+	sourceId: ID!
+}
+
+input WatchViewSourceInput {
+	viewSourceId: ID!
+}
+
+input WatchViewSinkInput {
+	viewSinkId: ID!
+}
+
+type Subscription {
+	watchProcessor(options: WatchProcessorInput): Operation!
+	watchSink(options: WatchSinkInput): SinkEvent!
+	watchSource(options: WatchSourceInput): SourceEmit!
+	watchViewSource(options: WatchViewSourceInput): ViewSourceSync!
+	watchViewSink(options: WatchViewSinkInput): ViewSinkSync!
+}
+
+schema {
+	query: Query
+	subscription: Subscription
+}
+`, BuiltIn: false},
+}
+var parsedSchema = gqlparser.MustLoadSchema(sources...)
+
+// endregion ************************** generated!.gotpl **************************
 
 // region    ***************************** args.gotpl *****************************
 
@@ -1499,6 +2033,21 @@ func (ec *executionContext) field_Query_componentById_args(ctx context.Context,
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_lagging_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 int
+	if tmp, ok := rawArgs["threshold"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("threshold"))
+		arg0, err = ec.unmarshalNInt2int(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["threshold"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_serviceById_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1529,6 +2078,66 @@ func (ec *executionContext) field_Subscription_watchProcessor_args(ctx context.C
 	return args, nil
 }
 
+func (ec *executionContext) field_Subscription_watchSink_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *model.WatchSinkInput
+	if tmp, ok := rawArgs["options"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("options"))
+		arg0, err = ec.unmarshalOWatchSinkInput2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐWatchSinkInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["options"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_watchSource_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *model.WatchSourceInput
+	if tmp, ok := rawArgs["options"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("options"))
+		arg0, err = ec.unmarshalOWatchSourceInput2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐWatchSourceInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["options"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_watchViewSink_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *model.WatchViewSinkInput
+	if tmp, ok := rawArgs["options"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("options"))
+		arg0, err = ec.unmarshalOWatchViewSinkInput2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐWatchViewSinkInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["options"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_watchViewSource_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *model.WatchViewSourceInput
+	if tmp, ok := rawArgs["options"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("options"))
+		arg0, err = ec.unmarshalOWatchViewSourceInput2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐWatchViewSourceInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["options"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1952,7 +2561,7 @@ func (ec *executionContext) _Component_dependsOn(ctx context.Context, field grap
 	return ec.marshalNComponent2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐComponentᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _GetState_topic(ctx context.Context, field graphql.CollectedField, obj *model.GetState) (ret graphql.Marshaler) {
+func (ec *executionContext) _ConsumerLag_id(ctx context.Context, field graphql.CollectedField, obj *model.ConsumerLag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1960,7 +2569,7 @@ func (ec *executionContext) _GetState_topic(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "GetState",
+		Object:     "ConsumerLag",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -1970,7 +2579,7 @@ func (ec *executionContext) _GetState_topic(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Topic, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1982,12 +2591,12 @@ func (ec *executionContext) _GetState_topic(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNID2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _GetState_message(ctx context.Context, field graphql.CollectedField, obj *model.GetState) (ret graphql.Marshaler) {
+func (ec *executionContext) _ConsumerLag_groupName(ctx context.Context, field graphql.CollectedField, obj *model.ConsumerLag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1995,7 +2604,7 @@ func (ec *executionContext) _GetState_message(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "GetState",
+		Object:     "ConsumerLag",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2005,7 +2614,7 @@ func (ec *executionContext) _GetState_message(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return obj.GroupName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2022,7 +2631,7 @@ func (ec *executionContext) _GetState_message(ctx context.Context, field graphql
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _GetState_value(ctx context.Context, field graphql.CollectedField, obj *model.GetState) (ret graphql.Marshaler) {
+func (ec *executionContext) _ConsumerLag_topic(ctx context.Context, field graphql.CollectedField, obj *model.ConsumerLag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2030,17 +2639,17 @@ func (ec *executionContext) _GetState_value(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "GetState",
+		Object:     "ConsumerLag",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return ec.resolvers.ConsumerLag().Topic(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2052,12 +2661,12 @@ func (ec *executionContext) _GetState_value(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Topic)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTopic2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐTopic(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Input_topic(ctx context.Context, field graphql.CollectedField, obj *model.Input) (ret graphql.Marshaler) {
+func (ec *executionContext) _ConsumerLag_partition(ctx context.Context, field graphql.CollectedField, obj *model.ConsumerLag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2065,7 +2674,7 @@ func (ec *executionContext) _Input_topic(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Input",
+		Object:     "ConsumerLag",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2075,7 +2684,7 @@ func (ec *executionContext) _Input_topic(ctx context.Context, field graphql.Coll
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Topic, nil
+		return obj.Partition, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2087,12 +2696,12 @@ func (ec *executionContext) _Input_topic(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Input_message(ctx context.Context, field graphql.CollectedField, obj *model.Input) (ret graphql.Marshaler) {
+func (ec *executionContext) _ConsumerLag_committedOffset(ctx context.Context, field graphql.CollectedField, obj *model.ConsumerLag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2100,7 +2709,7 @@ func (ec *executionContext) _Input_message(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Input",
+		Object:     "ConsumerLag",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2110,7 +2719,7 @@ func (ec *executionContext) _Input_message(ctx context.Context, field graphql.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return obj.CommittedOffset, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2122,12 +2731,12 @@ func (ec *executionContext) _Input_message(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Input_value(ctx context.Context, field graphql.CollectedField, obj *model.Input) (ret graphql.Marshaler) {
+func (ec *executionContext) _ConsumerLag_highWatermark(ctx context.Context, field graphql.CollectedField, obj *model.ConsumerLag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2135,7 +2744,7 @@ func (ec *executionContext) _Input_value(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Input",
+		Object:     "ConsumerLag",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2145,7 +2754,7 @@ func (ec *executionContext) _Input_value(ctx context.Context, field graphql.Coll
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return obj.HighWatermark, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2157,12 +2766,12 @@ func (ec *executionContext) _Input_value(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Join_topic(ctx context.Context, field graphql.CollectedField, obj *model.Join) (ret graphql.Marshaler) {
+func (ec *executionContext) _ConsumerLag_lag(ctx context.Context, field graphql.CollectedField, obj *model.ConsumerLag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2170,7 +2779,7 @@ func (ec *executionContext) _Join_topic(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Join",
+		Object:     "ConsumerLag",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2180,7 +2789,7 @@ func (ec *executionContext) _Join_topic(ctx context.Context, field graphql.Colle
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Topic, nil
+		return obj.Lag, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2192,12 +2801,12 @@ func (ec *executionContext) _Join_topic(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Join_message(ctx context.Context, field graphql.CollectedField, obj *model.Join) (ret graphql.Marshaler) {
+func (ec *executionContext) _GetState_topic(ctx context.Context, field graphql.CollectedField, obj *model.GetState) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2205,7 +2814,7 @@ func (ec *executionContext) _Join_message(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Join",
+		Object:     "GetState",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2215,7 +2824,7 @@ func (ec *executionContext) _Join_message(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return obj.Topic, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2232,7 +2841,7 @@ func (ec *executionContext) _Join_message(ctx context.Context, field graphql.Col
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Join_value(ctx context.Context, field graphql.CollectedField, obj *model.Join) (ret graphql.Marshaler) {
+func (ec *executionContext) _GetState_message(ctx context.Context, field graphql.CollectedField, obj *model.GetState) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2240,7 +2849,7 @@ func (ec *executionContext) _Join_value(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Join",
+		Object:     "GetState",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2250,7 +2859,7 @@ func (ec *executionContext) _Join_value(ctx context.Context, field graphql.Colle
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2267,7 +2876,7 @@ func (ec *executionContext) _Join_value(ctx context.Context, field graphql.Colle
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Lookup_topic(ctx context.Context, field graphql.CollectedField, obj *model.Lookup) (ret graphql.Marshaler) {
+func (ec *executionContext) _GetState_value(ctx context.Context, field graphql.CollectedField, obj *model.GetState) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2275,7 +2884,7 @@ func (ec *executionContext) _Lookup_topic(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Lookup",
+		Object:     "GetState",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2285,7 +2894,7 @@ func (ec *executionContext) _Lookup_topic(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Topic, nil
+		return obj.Value, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2302,7 +2911,7 @@ func (ec *executionContext) _Lookup_topic(ctx context.Context, field graphql.Col
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Lookup_message(ctx context.Context, field graphql.CollectedField, obj *model.Lookup) (ret graphql.Marshaler) {
+func (ec *executionContext) _Input_topic(ctx context.Context, field graphql.CollectedField, obj *model.Input) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2310,7 +2919,7 @@ func (ec *executionContext) _Lookup_message(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Lookup",
+		Object:     "Input",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2320,7 +2929,7 @@ func (ec *executionContext) _Lookup_message(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return obj.Topic, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2337,7 +2946,7 @@ func (ec *executionContext) _Lookup_message(ctx context.Context, field graphql.C
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Lookup_value(ctx context.Context, field graphql.CollectedField, obj *model.Lookup) (ret graphql.Marshaler) {
+func (ec *executionContext) _Input_message(ctx context.Context, field graphql.CollectedField, obj *model.Input) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2345,7 +2954,7 @@ func (ec *executionContext) _Lookup_value(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Lookup",
+		Object:     "Input",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2355,7 +2964,7 @@ func (ec *executionContext) _Lookup_value(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2372,7 +2981,7 @@ func (ec *executionContext) _Lookup_value(ctx context.Context, field graphql.Col
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Lookup_key(ctx context.Context, field graphql.CollectedField, obj *model.Lookup) (ret graphql.Marshaler) {
+func (ec *executionContext) _Input_value(ctx context.Context, field graphql.CollectedField, obj *model.Input) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2380,7 +2989,7 @@ func (ec *executionContext) _Lookup_key(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Lookup",
+		Object:     "Input",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2390,7 +2999,7 @@ func (ec *executionContext) _Lookup_key(ctx context.Context, field graphql.Colle
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Key, nil
+		return obj.Value, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2407,7 +3016,7 @@ func (ec *executionContext) _Lookup_key(ctx context.Context, field graphql.Colle
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Operation_input(ctx context.Context, field graphql.CollectedField, obj *model.Operation) (ret graphql.Marshaler) {
+func (ec *executionContext) _Join_topic(ctx context.Context, field graphql.CollectedField, obj *model.Join) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2415,7 +3024,7 @@ func (ec *executionContext) _Operation_input(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Operation",
+		Object:     "Join",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2425,7 +3034,7 @@ func (ec *executionContext) _Operation_input(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Input, nil
+		return obj.Topic, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2437,12 +3046,12 @@ func (ec *executionContext) _Operation_input(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Input)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInput2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐInput(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Operation_startTime(ctx context.Context, field graphql.CollectedField, obj *model.Operation) (ret graphql.Marshaler) {
+func (ec *executionContext) _Join_message(ctx context.Context, field graphql.CollectedField, obj *model.Join) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2450,7 +3059,7 @@ func (ec *executionContext) _Operation_startTime(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Operation",
+		Object:     "Join",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2460,7 +3069,7 @@ func (ec *executionContext) _Operation_startTime(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.StartTime, nil
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2472,12 +3081,12 @@ func (ec *executionContext) _Operation_startTime(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Operation_endTime(ctx context.Context, field graphql.CollectedField, obj *model.Operation) (ret graphql.Marshaler) {
+func (ec *executionContext) _Join_value(ctx context.Context, field graphql.CollectedField, obj *model.Join) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2485,7 +3094,7 @@ func (ec *executionContext) _Operation_endTime(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Operation",
+		Object:     "Join",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2495,7 +3104,7 @@ func (ec *executionContext) _Operation_endTime(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EndTime, nil
+		return obj.Value, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2507,12 +3116,12 @@ func (ec *executionContext) _Operation_endTime(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Operation_actions(ctx context.Context, field graphql.CollectedField, obj *model.Operation) (ret graphql.Marshaler) {
+func (ec *executionContext) _Lagging_processors(ctx context.Context, field graphql.CollectedField, obj *model.Lagging) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2520,7 +3129,7 @@ func (ec *executionContext) _Operation_actions(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Operation",
+		Object:     "Lagging",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2530,7 +3139,7 @@ func (ec *executionContext) _Operation_actions(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Actions, nil
+		return obj.Processors, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2542,12 +3151,12 @@ func (ec *executionContext) _Operation_actions(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.Action)
+	res := resTmp.([]*model.Processor)
 	fc.Result = res
-	return ec.marshalNAction2ᚕgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐActionᚄ(ctx, field.Selections, res)
+	return ec.marshalNProcessor2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐProcessorᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Output_topic(ctx context.Context, field graphql.CollectedField, obj *model.Output) (ret graphql.Marshaler) {
+func (ec *executionContext) _Lagging_sinks(ctx context.Context, field graphql.CollectedField, obj *model.Lagging) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2555,7 +3164,7 @@ func (ec *executionContext) _Output_topic(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Output",
+		Object:     "Lagging",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2565,7 +3174,7 @@ func (ec *executionContext) _Output_topic(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Topic, nil
+		return obj.Sinks, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2577,12 +3186,12 @@ func (ec *executionContext) _Output_topic(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.Sink)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNSink2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐSinkᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Output_message(ctx context.Context, field graphql.CollectedField, obj *model.Output) (ret graphql.Marshaler) {
+func (ec *executionContext) _Lookup_topic(ctx context.Context, field graphql.CollectedField, obj *model.Lookup) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2590,7 +3199,7 @@ func (ec *executionContext) _Output_message(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Output",
+		Object:     "Lookup",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2600,7 +3209,7 @@ func (ec *executionContext) _Output_message(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return obj.Topic, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2617,7 +3226,7 @@ func (ec *executionContext) _Output_message(ctx context.Context, field graphql.C
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Output_value(ctx context.Context, field graphql.CollectedField, obj *model.Output) (ret graphql.Marshaler) {
+func (ec *executionContext) _Lookup_message(ctx context.Context, field graphql.CollectedField, obj *model.Lookup) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2625,7 +3234,7 @@ func (ec *executionContext) _Output_value(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Output",
+		Object:     "Lookup",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2635,7 +3244,7 @@ func (ec *executionContext) _Output_value(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2652,7 +3261,7 @@ func (ec *executionContext) _Output_value(ctx context.Context, field graphql.Col
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Output_key(ctx context.Context, field graphql.CollectedField, obj *model.Output) (ret graphql.Marshaler) {
+func (ec *executionContext) _Lookup_value(ctx context.Context, field graphql.CollectedField, obj *model.Lookup) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2660,7 +3269,7 @@ func (ec *executionContext) _Output_key(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Output",
+		Object:     "Lookup",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2670,7 +3279,7 @@ func (ec *executionContext) _Output_key(ctx context.Context, field graphql.Colle
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Key, nil
+		return obj.Value, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2687,7 +3296,7 @@ func (ec *executionContext) _Output_key(ctx context.Context, field graphql.Colle
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Pod_id(ctx context.Context, field graphql.CollectedField, obj *model.Pod) (ret graphql.Marshaler) {
+func (ec *executionContext) _Lookup_key(ctx context.Context, field graphql.CollectedField, obj *model.Lookup) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2695,7 +3304,7 @@ func (ec *executionContext) _Pod_id(ctx context.Context, field graphql.Collected
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Pod",
+		Object:     "Lookup",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2705,7 +3314,7 @@ func (ec *executionContext) _Pod_id(ctx context.Context, field graphql.Collected
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Key, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2717,12 +3326,12 @@ func (ec *executionContext) _Pod_id(ctx context.Context, field graphql.Collected
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNID2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Pod_name(ctx context.Context, field graphql.CollectedField, obj *model.Pod) (ret graphql.Marshaler) {
+func (ec *executionContext) _Operation_input(ctx context.Context, field graphql.CollectedField, obj *model.Operation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2730,7 +3339,7 @@ func (ec *executionContext) _Pod_name(ctx context.Context, field graphql.Collect
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Pod",
+		Object:     "Operation",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2740,7 +3349,7 @@ func (ec *executionContext) _Pod_name(ctx context.Context, field graphql.Collect
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Input, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2752,12 +3361,12 @@ func (ec *executionContext) _Pod_name(ctx context.Context, field graphql.Collect
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Input)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInput2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐInput(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Pod_processors(ctx context.Context, field graphql.CollectedField, obj *model.Pod) (ret graphql.Marshaler) {
+func (ec *executionContext) _Operation_startTime(ctx context.Context, field graphql.CollectedField, obj *model.Operation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2765,17 +3374,17 @@ func (ec *executionContext) _Pod_processors(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Pod",
+		Object:     "Operation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Pod().Processors(rctx, obj)
+		return obj.StartTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2787,12 +3396,12 @@ func (ec *executionContext) _Pod_processors(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Processor)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNProcessor2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐProcessorᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Pod_sinks(ctx context.Context, field graphql.CollectedField, obj *model.Pod) (ret graphql.Marshaler) {
+func (ec *executionContext) _Operation_endTime(ctx context.Context, field graphql.CollectedField, obj *model.Operation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2800,17 +3409,17 @@ func (ec *executionContext) _Pod_sinks(ctx context.Context, field graphql.Collec
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Pod",
+		Object:     "Operation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Pod().Sinks(rctx, obj)
+		return obj.EndTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2822,12 +3431,12 @@ func (ec *executionContext) _Pod_sinks(ctx context.Context, field graphql.Collec
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Sink)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNSink2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐSinkᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Pod_sources(ctx context.Context, field graphql.CollectedField, obj *model.Pod) (ret graphql.Marshaler) {
+func (ec *executionContext) _Operation_actions(ctx context.Context, field graphql.CollectedField, obj *model.Operation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2835,17 +3444,17 @@ func (ec *executionContext) _Pod_sources(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Pod",
+		Object:     "Operation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Pod().Sources(rctx, obj)
+		return obj.Actions, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2857,12 +3466,12 @@ func (ec *executionContext) _Pod_sources(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Source)
+	res := resTmp.([]model.Action)
 	fc.Result = res
-	return ec.marshalNSource2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐSourceᚄ(ctx, field.Selections, res)
+	return ec.marshalNAction2ᚕgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐActionᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Pod_viewSinks(ctx context.Context, field graphql.CollectedField, obj *model.Pod) (ret graphql.Marshaler) {
+func (ec *executionContext) _Output_topic(ctx context.Context, field graphql.CollectedField, obj *model.Output) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2870,17 +3479,17 @@ func (ec *executionContext) _Pod_viewSinks(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Pod",
+		Object:     "Output",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Pod().ViewSinks(rctx, obj)
+		return obj.Topic, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2892,12 +3501,12 @@ func (ec *executionContext) _Pod_viewSinks(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.ViewSink)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNViewSink2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐViewSinkᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Pod_viewSources(ctx context.Context, field graphql.CollectedField, obj *model.Pod) (ret graphql.Marshaler) {
+func (ec *executionContext) _Output_message(ctx context.Context, field graphql.CollectedField, obj *model.Output) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2905,17 +3514,17 @@ func (ec *executionContext) _Pod_viewSources(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Pod",
+		Object:     "Output",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Pod().ViewSources(rctx, obj)
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2927,12 +3536,12 @@ func (ec *executionContext) _Pod_viewSources(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.ViewSource)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNViewSource2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐViewSourceᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Pod_views(ctx context.Context, field graphql.CollectedField, obj *model.Pod) (ret graphql.Marshaler) {
+func (ec *executionContext) _Output_value(ctx context.Context, field graphql.CollectedField, obj *model.Output) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2940,17 +3549,17 @@ func (ec *executionContext) _Pod_views(ctx context.Context, field graphql.Collec
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Pod",
+		Object:     "Output",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Pod().Views(rctx, obj)
+		return obj.Value, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2962,12 +3571,12 @@ func (ec *executionContext) _Pod_views(ctx context.Context, field graphql.Collec
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.View)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNView2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐViewᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Processor_id(ctx context.Context, field graphql.CollectedField, obj *model.Processor) (ret graphql.Marshaler) {
+func (ec *executionContext) _Output_key(ctx context.Context, field graphql.CollectedField, obj *model.Output) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2975,7 +3584,7 @@ func (ec *executionContext) _Processor_id(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Processor",
+		Object:     "Output",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2985,7 +3594,7 @@ func (ec *executionContext) _Processor_id(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Key, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2997,12 +3606,12 @@ func (ec *executionContext) _Processor_id(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNID2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Processor_component(ctx context.Context, field graphql.CollectedField, obj *model.Processor) (ret graphql.Marshaler) {
+func (ec *executionContext) _PartitionOffset_partition(ctx context.Context, field graphql.CollectedField, obj *model.PartitionOffset) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3010,17 +3619,17 @@ func (ec *executionContext) _Processor_component(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Processor",
+		Object:     "PartitionOffset",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Processor().Component(rctx, obj)
+		return obj.Partition, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3032,12 +3641,12 @@ func (ec *executionContext) _Processor_component(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Component)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNComponent2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐComponent(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Processor_name(ctx context.Context, field graphql.CollectedField, obj *model.Processor) (ret graphql.Marshaler) {
+func (ec *executionContext) _PartitionOffset_offset(ctx context.Context, field graphql.CollectedField, obj *model.PartitionOffset) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3045,7 +3654,7 @@ func (ec *executionContext) _Processor_name(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Processor",
+		Object:     "PartitionOffset",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3055,7 +3664,7 @@ func (ec *executionContext) _Processor_name(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Offset, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3067,12 +3676,12 @@ func (ec *executionContext) _Processor_name(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Processor_description(ctx context.Context, field graphql.CollectedField, obj *model.Processor) (ret graphql.Marshaler) {
+func (ec *executionContext) _Pod_id(ctx context.Context, field graphql.CollectedField, obj *model.Pod) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3080,7 +3689,7 @@ func (ec *executionContext) _Processor_description(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Processor",
+		Object:     "Pod",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3090,7 +3699,7 @@ func (ec *executionContext) _Processor_description(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3102,12 +3711,12 @@ func (ec *executionContext) _Processor_description(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNID2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Processor_groupName(ctx context.Context, field graphql.CollectedField, obj *model.Processor) (ret graphql.Marshaler) {
+func (ec *executionContext) _Pod_name(ctx context.Context, field graphql.CollectedField, obj *model.Pod) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3115,7 +3724,7 @@ func (ec *executionContext) _Processor_groupName(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Processor",
+		Object:     "Pod",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3125,7 +3734,7 @@ func (ec *executionContext) _Processor_groupName(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.GroupName, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3142,7 +3751,7 @@ func (ec *executionContext) _Processor_groupName(ctx context.Context, field grap
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Processor_persistence(ctx context.Context, field graphql.CollectedField, obj *model.Processor) (ret graphql.Marshaler) {
+func (ec *executionContext) _Pod_processors(ctx context.Context, field graphql.CollectedField, obj *model.Pod) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3150,7 +3759,7 @@ func (ec *executionContext) _Processor_persistence(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Processor",
+		Object:     "Pod",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -3160,21 +3769,24 @@ func (ec *executionContext) _Processor_persistence(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Processor().Persistence(rctx, obj)
+		return ec.resolvers.Pod().Processors(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Topic)
+	res := resTmp.([]*model.Processor)
 	fc.Result = res
-	return ec.marshalOTopic2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐTopic(ctx, field.Selections, res)
+	return ec.marshalNProcessor2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐProcessorᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Processor_pods(ctx context.Context, field graphql.CollectedField, obj *model.Processor) (ret graphql.Marshaler) {
+func (ec *executionContext) _Pod_sinks(ctx context.Context, field graphql.CollectedField, obj *model.Pod) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3182,7 +3794,7 @@ func (ec *executionContext) _Processor_pods(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Processor",
+		Object:     "Pod",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -3192,7 +3804,7 @@ func (ec *executionContext) _Processor_pods(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Processor().Pods(rctx, obj)
+		return ec.resolvers.Pod().Sinks(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3204,12 +3816,12 @@ func (ec *executionContext) _Processor_pods(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Pod)
+	res := resTmp.([]*model.Sink)
 	fc.Result = res
-	return ec.marshalNPod2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐPodᚄ(ctx, field.Selections, res)
+	return ec.marshalNSink2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐSinkᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Processor_inputs(ctx context.Context, field graphql.CollectedField, obj *model.Processor) (ret graphql.Marshaler) {
+func (ec *executionContext) _Pod_sources(ctx context.Context, field graphql.CollectedField, obj *model.Pod) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3217,7 +3829,7 @@ func (ec *executionContext) _Processor_inputs(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Processor",
+		Object:     "Pod",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -3227,7 +3839,7 @@ func (ec *executionContext) _Processor_inputs(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Processor().Inputs(rctx, obj)
+		return ec.resolvers.Pod().Sources(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3239,12 +3851,12 @@ func (ec *executionContext) _Processor_inputs(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.ProcessorInput)
+	res := resTmp.([]*model.Source)
 	fc.Result = res
-	return ec.marshalNProcessorInput2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐProcessorInputᚄ(ctx, field.Selections, res)
+	return ec.marshalNSource2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐSourceᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Processor_joins(ctx context.Context, field graphql.CollectedField, obj *model.Processor) (ret graphql.Marshaler) {
+func (ec *executionContext) _Pod_viewSinks(ctx context.Context, field graphql.CollectedField, obj *model.Pod) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3252,7 +3864,7 @@ func (ec *executionContext) _Processor_joins(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Processor",
+		Object:     "Pod",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -3262,7 +3874,7 @@ func (ec *executionContext) _Processor_joins(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Processor().Joins(rctx, obj)
+		return ec.resolvers.Pod().ViewSinks(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3274,12 +3886,12 @@ func (ec *executionContext) _Processor_joins(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.ProcessorJoin)
+	res := resTmp.([]*model.ViewSink)
 	fc.Result = res
-	return ec.marshalNProcessorJoin2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐProcessorJoinᚄ(ctx, field.Selections, res)
+	return ec.marshalNViewSink2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐViewSinkᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Processor_lookups(ctx context.Context, field graphql.CollectedField, obj *model.Processor) (ret graphql.Marshaler) {
+func (ec *executionContext) _Pod_viewSources(ctx context.Context, field graphql.CollectedField, obj *model.Pod) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3287,7 +3899,7 @@ func (ec *executionContext) _Processor_lookups(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Processor",
+		Object:     "Pod",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -3297,7 +3909,7 @@ func (ec *executionContext) _Processor_lookups(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Processor().Lookups(rctx, obj)
+		return ec.resolvers.Pod().ViewSources(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3309,12 +3921,12 @@ func (ec *executionContext) _Processor_lookups(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.ProcessorLookup)
+	res := resTmp.([]*model.ViewSource)
 	fc.Result = res
-	return ec.marshalNProcessorLookup2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐProcessorLookupᚄ(ctx, field.Selections, res)
+	return ec.marshalNViewSource2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐViewSourceᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Processor_outputs(ctx context.Context, field graphql.CollectedField, obj *model.Processor) (ret graphql.Marshaler) {
+func (ec *executionContext) _Pod_views(ctx context.Context, field graphql.CollectedField, obj *model.Pod) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3322,7 +3934,7 @@ func (ec *executionContext) _Processor_outputs(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Processor",
+		Object:     "Pod",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -3332,7 +3944,7 @@ func (ec *executionContext) _Processor_outputs(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Processor().Outputs(rctx, obj)
+		return ec.resolvers.Pod().Views(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3344,12 +3956,12 @@ func (ec *executionContext) _Processor_outputs(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.ProcessorOutput)
+	res := resTmp.([]*model.View)
 	fc.Result = res
-	return ec.marshalNProcessorOutput2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐProcessorOutputᚄ(ctx, field.Selections, res)
+	return ec.marshalNView2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐViewᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ProcessorInput_id(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorInput) (ret graphql.Marshaler) {
+func (ec *executionContext) _Processor_id(ctx context.Context, field graphql.CollectedField, obj *model.Processor) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3357,7 +3969,7 @@ func (ec *executionContext) _ProcessorInput_id(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "ProcessorInput",
+		Object:     "Processor",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3384,7 +3996,7 @@ func (ec *executionContext) _ProcessorInput_id(ctx context.Context, field graphq
 	return ec.marshalNID2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ProcessorInput_processor(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorInput) (ret graphql.Marshaler) {
+func (ec *executionContext) _Processor_component(ctx context.Context, field graphql.CollectedField, obj *model.Processor) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3392,7 +4004,7 @@ func (ec *executionContext) _ProcessorInput_processor(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "ProcessorInput",
+		Object:     "Processor",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -3402,7 +4014,7 @@ func (ec *executionContext) _ProcessorInput_processor(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ProcessorInput().Processor(rctx, obj)
+		return ec.resolvers.Processor().Component(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3414,12 +4026,12 @@ func (ec *executionContext) _ProcessorInput_processor(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Processor)
+	res := resTmp.(*model.Component)
 	fc.Result = res
-	return ec.marshalNProcessor2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐProcessor(ctx, field.Selections, res)
+	return ec.marshalNComponent2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐComponent(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ProcessorInput_topic(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorInput) (ret graphql.Marshaler) {
+func (ec *executionContext) _Processor_name(ctx context.Context, field graphql.CollectedField, obj *model.Processor) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3427,17 +4039,17 @@ func (ec *executionContext) _ProcessorInput_topic(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "ProcessorInput",
+		Object:     "Processor",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ProcessorInput().Topic(rctx, obj)
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3449,12 +4061,12 @@ func (ec *executionContext) _ProcessorInput_topic(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Topic)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTopic2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐTopic(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ProcessorJoin_id(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorJoin) (ret graphql.Marshaler) {
+func (ec *executionContext) _Processor_description(ctx context.Context, field graphql.CollectedField, obj *model.Processor) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3462,7 +4074,7 @@ func (ec *executionContext) _ProcessorJoin_id(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "ProcessorJoin",
+		Object:     "Processor",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3472,7 +4084,7 @@ func (ec *executionContext) _ProcessorJoin_id(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3484,12 +4096,12 @@ func (ec *executionContext) _ProcessorJoin_id(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNID2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ProcessorJoin_processor(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorJoin) (ret graphql.Marshaler) {
+func (ec *executionContext) _Processor_groupName(ctx context.Context, field graphql.CollectedField, obj *model.Processor) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3497,17 +4109,17 @@ func (ec *executionContext) _ProcessorJoin_processor(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "ProcessorJoin",
+		Object:     "Processor",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ProcessorJoin().Processor(rctx, obj)
+		return obj.GroupName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3519,12 +4131,12 @@ func (ec *executionContext) _ProcessorJoin_processor(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Processor)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNProcessor2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐProcessor(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ProcessorJoin_topic(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorJoin) (ret graphql.Marshaler) {
+func (ec *executionContext) _Processor_persistence(ctx context.Context, field graphql.CollectedField, obj *model.Processor) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3532,7 +4144,7 @@ func (ec *executionContext) _ProcessorJoin_topic(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "ProcessorJoin",
+		Object:     "Processor",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -3542,24 +4154,21 @@ func (ec *executionContext) _ProcessorJoin_topic(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ProcessorJoin().Topic(rctx, obj)
+		return ec.resolvers.Processor().Persistence(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
 	res := resTmp.(*model.Topic)
 	fc.Result = res
-	return ec.marshalNTopic2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐTopic(ctx, field.Selections, res)
+	return ec.marshalOTopic2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐTopic(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ProcessorLookup_id(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorLookup) (ret graphql.Marshaler) {
+func (ec *executionContext) _Processor_pods(ctx context.Context, field graphql.CollectedField, obj *model.Processor) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3567,17 +4176,17 @@ func (ec *executionContext) _ProcessorLookup_id(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "ProcessorLookup",
+		Object:     "Processor",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return ec.resolvers.Processor().Pods(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3589,12 +4198,12 @@ func (ec *executionContext) _ProcessorLookup_id(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]*model.Pod)
 	fc.Result = res
-	return ec.marshalNID2int(ctx, field.Selections, res)
+	return ec.marshalNPod2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐPodᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ProcessorLookup_processor(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorLookup) (ret graphql.Marshaler) {
+func (ec *executionContext) _Processor_inputs(ctx context.Context, field graphql.CollectedField, obj *model.Processor) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3602,7 +4211,7 @@ func (ec *executionContext) _ProcessorLookup_processor(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "ProcessorLookup",
+		Object:     "Processor",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -3612,7 +4221,7 @@ func (ec *executionContext) _ProcessorLookup_processor(ctx context.Context, fiel
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ProcessorLookup().Processor(rctx, obj)
+		return ec.resolvers.Processor().Inputs(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3624,12 +4233,12 @@ func (ec *executionContext) _ProcessorLookup_processor(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Processor)
+	res := resTmp.([]*model.ProcessorInput)
 	fc.Result = res
-	return ec.marshalNProcessor2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐProcessor(ctx, field.Selections, res)
+	return ec.marshalNProcessorInput2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐProcessorInputᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ProcessorLookup_topic(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorLookup) (ret graphql.Marshaler) {
+func (ec *executionContext) _Processor_joins(ctx context.Context, field graphql.CollectedField, obj *model.Processor) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3637,7 +4246,7 @@ func (ec *executionContext) _ProcessorLookup_topic(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "ProcessorLookup",
+		Object:     "Processor",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -3647,7 +4256,7 @@ func (ec *executionContext) _ProcessorLookup_topic(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ProcessorLookup().Topic(rctx, obj)
+		return ec.resolvers.Processor().Joins(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3659,12 +4268,12 @@ func (ec *executionContext) _ProcessorLookup_topic(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Topic)
+	res := resTmp.([]*model.ProcessorJoin)
 	fc.Result = res
-	return ec.marshalNTopic2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐTopic(ctx, field.Selections, res)
+	return ec.marshalNProcessorJoin2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐProcessorJoinᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ProcessorOutput_id(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorOutput) (ret graphql.Marshaler) {
+func (ec *executionContext) _Processor_lookups(ctx context.Context, field graphql.CollectedField, obj *model.Processor) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3672,17 +4281,17 @@ func (ec *executionContext) _ProcessorOutput_id(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "ProcessorOutput",
+		Object:     "Processor",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return ec.resolvers.Processor().Lookups(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3694,12 +4303,12 @@ func (ec *executionContext) _ProcessorOutput_id(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]*model.ProcessorLookup)
 	fc.Result = res
-	return ec.marshalNID2int(ctx, field.Selections, res)
+	return ec.marshalNProcessorLookup2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐProcessorLookupᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ProcessorOutput_processor(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorOutput) (ret graphql.Marshaler) {
+func (ec *executionContext) _Processor_outputs(ctx context.Context, field graphql.CollectedField, obj *model.Processor) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3707,7 +4316,7 @@ func (ec *executionContext) _ProcessorOutput_processor(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "ProcessorOutput",
+		Object:     "Processor",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -3717,7 +4326,7 @@ func (ec *executionContext) _ProcessorOutput_processor(ctx context.Context, fiel
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ProcessorOutput().Processor(rctx, obj)
+		return ec.resolvers.Processor().Outputs(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3729,12 +4338,12 @@ func (ec *executionContext) _ProcessorOutput_processor(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Processor)
+	res := resTmp.([]*model.ProcessorOutput)
 	fc.Result = res
-	return ec.marshalNProcessor2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐProcessor(ctx, field.Selections, res)
+	return ec.marshalNProcessorOutput2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐProcessorOutputᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ProcessorOutput_topic(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorOutput) (ret graphql.Marshaler) {
+func (ec *executionContext) _Processor_lag(ctx context.Context, field graphql.CollectedField, obj *model.Processor) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3742,7 +4351,7 @@ func (ec *executionContext) _ProcessorOutput_topic(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "ProcessorOutput",
+		Object:     "Processor",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -3752,7 +4361,7 @@ func (ec *executionContext) _ProcessorOutput_topic(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ProcessorOutput().Topic(rctx, obj)
+		return ec.resolvers.Processor().Lag(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3764,12 +4373,12 @@ func (ec *executionContext) _ProcessorOutput_topic(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Topic)
+	res := resTmp.([]*model.ConsumerLag)
 	fc.Result = res
-	return ec.marshalNTopic2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐTopic(ctx, field.Selections, res)
+	return ec.marshalNConsumerLag2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐConsumerLagᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_services(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ProcessorInput_id(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorInput) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3777,17 +4386,17 @@ func (ec *executionContext) _Query_services(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ProcessorInput",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Services(rctx)
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3799,12 +4408,12 @@ func (ec *executionContext) _Query_services(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Service)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNService2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐServiceᚄ(ctx, field.Selections, res)
+	return ec.marshalNID2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_pods(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ProcessorInput_processor(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorInput) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3812,7 +4421,7 @@ func (ec *executionContext) _Query_pods(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ProcessorInput",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -3822,7 +4431,7 @@ func (ec *executionContext) _Query_pods(ctx context.Context, field graphql.Colle
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Pods(rctx)
+		return ec.resolvers.ProcessorInput().Processor(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3834,12 +4443,12 @@ func (ec *executionContext) _Query_pods(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Pod)
+	res := resTmp.(*model.Processor)
 	fc.Result = res
-	return ec.marshalNPod2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐPodᚄ(ctx, field.Selections, res)
+	return ec.marshalNProcessor2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐProcessor(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_topics(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ProcessorInput_topic(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorInput) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3847,7 +4456,7 @@ func (ec *executionContext) _Query_topics(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ProcessorInput",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -3857,7 +4466,7 @@ func (ec *executionContext) _Query_topics(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Topics(rctx)
+		return ec.resolvers.ProcessorInput().Topic(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3869,12 +4478,12 @@ func (ec *executionContext) _Query_topics(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Topic)
+	res := resTmp.(*model.Topic)
 	fc.Result = res
-	return ec.marshalNTopic2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐTopicᚄ(ctx, field.Selections, res)
+	return ec.marshalNTopic2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐTopic(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_serviceById(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ProcessorJoin_id(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorJoin) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3882,38 +4491,34 @@ func (ec *executionContext) _Query_serviceById(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ProcessorJoin",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_serviceById_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().ServiceByID(rctx, args["id"].(int))
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Service)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOService2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐService(ctx, field.Selections, res)
+	return ec.marshalNID2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_componentById(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ProcessorJoin_processor(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorJoin) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3921,7 +4526,7 @@ func (ec *executionContext) _Query_componentById(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ProcessorJoin",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -3929,30 +4534,26 @@ func (ec *executionContext) _Query_componentById(ctx context.Context, field grap
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_componentById_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().ComponentByID(rctx, args["id"].(int))
+		return ec.resolvers.ProcessorJoin().Processor(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Component)
+	res := resTmp.(*model.Processor)
 	fc.Result = res
-	return ec.marshalOComponent2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐComponent(ctx, field.Selections, res)
+	return ec.marshalNProcessor2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐProcessor(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ProcessorJoin_topic(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorJoin) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3960,38 +4561,34 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ProcessorJoin",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query___type_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(args["name"].(string))
+		return ec.resolvers.ProcessorJoin().Topic(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*model.Topic)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNTopic2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐTopic(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ProcessorLookup_id(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorLookup) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3999,31 +4596,34 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ProcessorLookup",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Schema)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+	return ec.marshalNID2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Service_id(ctx context.Context, field graphql.CollectedField, obj *model.Service) (ret graphql.Marshaler) {
+func (ec *executionContext) _ProcessorLookup_processor(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorLookup) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4031,17 +4631,17 @@ func (ec *executionContext) _Service_id(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Service",
+		Object:     "ProcessorLookup",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return ec.resolvers.ProcessorLookup().Processor(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4053,12 +4653,12 @@ func (ec *executionContext) _Service_id(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*model.Processor)
 	fc.Result = res
-	return ec.marshalNID2int(ctx, field.Selections, res)
+	return ec.marshalNProcessor2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐProcessor(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Service_name(ctx context.Context, field graphql.CollectedField, obj *model.Service) (ret graphql.Marshaler) {
+func (ec *executionContext) _ProcessorLookup_topic(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorLookup) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4066,17 +4666,17 @@ func (ec *executionContext) _Service_name(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Service",
+		Object:     "ProcessorLookup",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return ec.resolvers.ProcessorLookup().Topic(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4088,12 +4688,12 @@ func (ec *executionContext) _Service_name(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Topic)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTopic2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐTopic(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Service_description(ctx context.Context, field graphql.CollectedField, obj *model.Service) (ret graphql.Marshaler) {
+func (ec *executionContext) _ProcessorOutput_id(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorOutput) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4101,7 +4701,7 @@ func (ec *executionContext) _Service_description(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Service",
+		Object:     "ProcessorOutput",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4111,7 +4711,7 @@ func (ec *executionContext) _Service_description(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4123,12 +4723,12 @@ func (ec *executionContext) _Service_description(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNID2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Service_components(ctx context.Context, field graphql.CollectedField, obj *model.Service) (ret graphql.Marshaler) {
+func (ec *executionContext) _ProcessorOutput_processor(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorOutput) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4136,7 +4736,7 @@ func (ec *executionContext) _Service_components(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Service",
+		Object:     "ProcessorOutput",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -4146,7 +4746,7 @@ func (ec *executionContext) _Service_components(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Service().Components(rctx, obj)
+		return ec.resolvers.ProcessorOutput().Processor(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4158,12 +4758,12 @@ func (ec *executionContext) _Service_components(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Component)
+	res := resTmp.(*model.Processor)
 	fc.Result = res
-	return ec.marshalNComponent2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐComponentᚄ(ctx, field.Selections, res)
+	return ec.marshalNProcessor2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐProcessor(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Service_dependsOn(ctx context.Context, field graphql.CollectedField, obj *model.Service) (ret graphql.Marshaler) {
+func (ec *executionContext) _ProcessorOutput_topic(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorOutput) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4171,7 +4771,7 @@ func (ec *executionContext) _Service_dependsOn(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Service",
+		Object:     "ProcessorOutput",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -4181,7 +4781,7 @@ func (ec *executionContext) _Service_dependsOn(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Service().DependsOn(rctx, obj)
+		return ec.resolvers.ProcessorOutput().Topic(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4193,12 +4793,12 @@ func (ec *executionContext) _Service_dependsOn(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Service)
+	res := resTmp.(*model.Topic)
 	fc.Result = res
-	return ec.marshalNService2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐServiceᚄ(ctx, field.Selections, res)
+	return ec.marshalNTopic2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐTopic(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _SetState_topic(ctx context.Context, field graphql.CollectedField, obj *model.SetState) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_services(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4206,17 +4806,17 @@ func (ec *executionContext) _SetState_topic(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "SetState",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Topic, nil
+		return ec.resolvers.Query().Services(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4228,12 +4828,12 @@ func (ec *executionContext) _SetState_topic(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.Service)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNService2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐServiceᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _SetState_message(ctx context.Context, field graphql.CollectedField, obj *model.SetState) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_pods(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4241,17 +4841,17 @@ func (ec *executionContext) _SetState_message(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "SetState",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return ec.resolvers.Query().Pods(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4263,12 +4863,12 @@ func (ec *executionContext) _SetState_message(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.Pod)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNPod2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐPodᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _SetState_value(ctx context.Context, field graphql.CollectedField, obj *model.SetState) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_topics(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4276,17 +4876,17 @@ func (ec *executionContext) _SetState_value(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "SetState",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return ec.resolvers.Query().Topics(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4298,12 +4898,12 @@ func (ec *executionContext) _SetState_value(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.Topic)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTopic2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐTopicᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Sink_id(ctx context.Context, field graphql.CollectedField, obj *model.Sink) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_serviceById(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4311,34 +4911,38 @@ func (ec *executionContext) _Sink_id(ctx context.Context, field graphql.Collecte
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Sink",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_serviceById_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return ec.resolvers.Query().ServiceByID(rctx, args["id"].(int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*model.Service)
 	fc.Result = res
-	return ec.marshalNID2int(ctx, field.Selections, res)
+	return ec.marshalOService2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐService(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Sink_component(ctx context.Context, field graphql.CollectedField, obj *model.Sink) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_componentById(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4346,7 +4950,7 @@ func (ec *executionContext) _Sink_component(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Sink",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -4354,26 +4958,30 @@ func (ec *executionContext) _Sink_component(ctx context.Context, field graphql.C
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_componentById_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Sink().Component(rctx, obj)
+		return ec.resolvers.Query().ComponentByID(rctx, args["id"].(int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
 	res := resTmp.(*model.Component)
 	fc.Result = res
-	return ec.marshalNComponent2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐComponent(ctx, field.Selections, res)
+	return ec.marshalOComponent2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐComponent(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Sink_name(ctx context.Context, field graphql.CollectedField, obj *model.Sink) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_lagging(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4381,17 +4989,24 @@ func (ec *executionContext) _Sink_name(ctx context.Context, field graphql.Collec
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Sink",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_lagging_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return ec.resolvers.Query().Lagging(rctx, args["threshold"].(int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4403,12 +5018,12 @@ func (ec *executionContext) _Sink_name(ctx context.Context, field graphql.Collec
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Lagging)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNLagging2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐLagging(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Sink_description(ctx context.Context, field graphql.CollectedField, obj *model.Sink) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4416,34 +5031,38 @@ func (ec *executionContext) _Sink_description(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Sink",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query___type_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return ec.introspectType(args["name"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*introspection.Type)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Sink_topic(ctx context.Context, field graphql.CollectedField, obj *model.Sink) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4451,34 +5070,31 @@ func (ec *executionContext) _Sink_topic(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Sink",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
-		IsResolver: true,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Sink().Topic(rctx, obj)
+		return ec.introspectSchema()
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Topic)
+	res := resTmp.(*introspection.Schema)
 	fc.Result = res
-	return ec.marshalNTopic2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐTopic(ctx, field.Selections, res)
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Sink_pods(ctx context.Context, field graphql.CollectedField, obj *model.Sink) (ret graphql.Marshaler) {
+func (ec *executionContext) _Service_id(ctx context.Context, field graphql.CollectedField, obj *model.Service) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4486,17 +5102,17 @@ func (ec *executionContext) _Sink_pods(ctx context.Context, field graphql.Collec
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Sink",
+		Object:     "Service",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Sink().Pods(rctx, obj)
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4508,12 +5124,12 @@ func (ec *executionContext) _Sink_pods(ctx context.Context, field graphql.Collec
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Pod)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNPod2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐPodᚄ(ctx, field.Selections, res)
+	return ec.marshalNID2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Source_id(ctx context.Context, field graphql.CollectedField, obj *model.Source) (ret graphql.Marshaler) {
+func (ec *executionContext) _Service_name(ctx context.Context, field graphql.CollectedField, obj *model.Service) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4521,7 +5137,7 @@ func (ec *executionContext) _Source_id(ctx context.Context, field graphql.Collec
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Source",
+		Object:     "Service",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4531,7 +5147,7 @@ func (ec *executionContext) _Source_id(ctx context.Context, field graphql.Collec
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4543,12 +5159,12 @@ func (ec *executionContext) _Source_id(ctx context.Context, field graphql.Collec
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNID2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Source_component(ctx context.Context, field graphql.CollectedField, obj *model.Source) (ret graphql.Marshaler) {
+func (ec *executionContext) _Service_description(ctx context.Context, field graphql.CollectedField, obj *model.Service) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4556,17 +5172,17 @@ func (ec *executionContext) _Source_component(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Source",
+		Object:     "Service",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Source().Component(rctx, obj)
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4578,12 +5194,12 @@ func (ec *executionContext) _Source_component(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Component)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNComponent2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐComponent(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Source_topic(ctx context.Context, field graphql.CollectedField, obj *model.Source) (ret graphql.Marshaler) {
+func (ec *executionContext) _Service_components(ctx context.Context, field graphql.CollectedField, obj *model.Service) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4591,7 +5207,7 @@ func (ec *executionContext) _Source_topic(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Source",
+		Object:     "Service",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -4601,7 +5217,7 @@ func (ec *executionContext) _Source_topic(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Source().Topic(rctx, obj)
+		return ec.resolvers.Service().Components(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4613,12 +5229,12 @@ func (ec *executionContext) _Source_topic(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Topic)
+	res := resTmp.([]*model.Component)
 	fc.Result = res
-	return ec.marshalNTopic2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐTopic(ctx, field.Selections, res)
+	return ec.marshalNComponent2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐComponentᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Source_pods(ctx context.Context, field graphql.CollectedField, obj *model.Source) (ret graphql.Marshaler) {
+func (ec *executionContext) _Service_dependsOn(ctx context.Context, field graphql.CollectedField, obj *model.Service) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4626,7 +5242,7 @@ func (ec *executionContext) _Source_pods(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Source",
+		Object:     "Service",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -4636,7 +5252,7 @@ func (ec *executionContext) _Source_pods(ctx context.Context, field graphql.Coll
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Source().Pods(rctx, obj)
+		return ec.resolvers.Service().DependsOn(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4648,64 +5264,47 @@ func (ec *executionContext) _Source_pods(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Pod)
+	res := resTmp.([]*model.Service)
 	fc.Result = res
-	return ec.marshalNPod2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐPodᚄ(ctx, field.Selections, res)
+	return ec.marshalNService2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐServiceᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Subscription_watchProcessor(ctx context.Context, field graphql.CollectedField) (ret func() graphql.Marshaler) {
+func (ec *executionContext) _SetState_topic(ctx context.Context, field graphql.CollectedField, obj *model.SetState) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "SetState",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Subscription_watchProcessor_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return nil
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().WatchProcessor(rctx, args["options"].(*model.WatchProcessorInput))
+		return obj.Topic, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
 		if !graphql.HasFieldError(ctx, fc) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return nil
-	}
-	return func() graphql.Marshaler {
-		res, ok := <-resTmp.(<-chan *model.Operation)
-		if !ok {
-			return nil
-		}
-		return graphql.WriterFunc(func(w io.Writer) {
-			w.Write([]byte{'{'})
-			graphql.MarshalString(field.Alias).MarshalGQL(w)
-			w.Write([]byte{':'})
-			ec.marshalNOperation2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐOperation(ctx, field.Selections, res).MarshalGQL(w)
-			w.Write([]byte{'}'})
-		})
+		return graphql.Null
 	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Topic_id(ctx context.Context, field graphql.CollectedField, obj *model.Topic) (ret graphql.Marshaler) {
+func (ec *executionContext) _SetState_message(ctx context.Context, field graphql.CollectedField, obj *model.SetState) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4713,7 +5312,7 @@ func (ec *executionContext) _Topic_id(ctx context.Context, field graphql.Collect
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Topic",
+		Object:     "SetState",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4723,7 +5322,7 @@ func (ec *executionContext) _Topic_id(ctx context.Context, field graphql.Collect
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4735,12 +5334,12 @@ func (ec *executionContext) _Topic_id(ctx context.Context, field graphql.Collect
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNID2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Topic_name(ctx context.Context, field graphql.CollectedField, obj *model.Topic) (ret graphql.Marshaler) {
+func (ec *executionContext) _SetState_value(ctx context.Context, field graphql.CollectedField, obj *model.SetState) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4748,7 +5347,7 @@ func (ec *executionContext) _Topic_name(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Topic",
+		Object:     "SetState",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4758,7 +5357,1658 @@ func (ec *executionContext) _Topic_name(ctx context.Context, field graphql.Colle
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Value, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Sink_id(ctx context.Context, field graphql.CollectedField, obj *model.Sink) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Sink",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNID2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Sink_component(ctx context.Context, field graphql.CollectedField, obj *model.Sink) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Sink",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Sink().Component(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.Component)
+	fc.Result = res
+	return ec.marshalNComponent2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐComponent(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Sink_name(ctx context.Context, field graphql.CollectedField, obj *model.Sink) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Sink",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Sink_description(ctx context.Context, field graphql.CollectedField, obj *model.Sink) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Sink",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Sink_groupName(ctx context.Context, field graphql.CollectedField, obj *model.Sink) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Sink",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.GroupName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Sink_topic(ctx context.Context, field graphql.CollectedField, obj *model.Sink) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Sink",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Sink().Topic(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.Topic)
+	fc.Result = res
+	return ec.marshalNTopic2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐTopic(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Sink_pods(ctx context.Context, field graphql.CollectedField, obj *model.Sink) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Sink",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Sink().Pods(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Pod)
+	fc.Result = res
+	return ec.marshalNPod2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐPodᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Sink_lag(ctx context.Context, field graphql.CollectedField, obj *model.Sink) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Sink",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Sink().Lag(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ConsumerLag)
+	fc.Result = res
+	return ec.marshalNConsumerLag2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐConsumerLagᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SinkCollect_time(ctx context.Context, field graphql.CollectedField, obj *model.SinkCollect) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SinkCollect",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Time, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SinkCollect_partition(ctx context.Context, field graphql.CollectedField, obj *model.SinkCollect) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SinkCollect",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Partition, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SinkCollect_offset(ctx context.Context, field graphql.CollectedField, obj *model.SinkCollect) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SinkCollect",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Offset, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SinkCollect_key(ctx context.Context, field graphql.CollectedField, obj *model.SinkCollect) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SinkCollect",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Key, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SinkCollect_value(ctx context.Context, field graphql.CollectedField, obj *model.SinkCollect) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SinkCollect",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Value, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SinkCommit_time(ctx context.Context, field graphql.CollectedField, obj *model.SinkCommit) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SinkCommit",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Time, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SinkCommit_offsets(ctx context.Context, field graphql.CollectedField, obj *model.SinkCommit) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SinkCommit",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Offsets, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.PartitionOffset)
+	fc.Result = res
+	return ec.marshalNPartitionOffset2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐPartitionOffsetᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SinkFlush_time(ctx context.Context, field graphql.CollectedField, obj *model.SinkFlush) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SinkFlush",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Time, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SinkFlush_count(ctx context.Context, field graphql.CollectedField, obj *model.SinkFlush) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SinkFlush",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Count, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SinkFlush_attempts(ctx context.Context, field graphql.CollectedField, obj *model.SinkFlush) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SinkFlush",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Attempts, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SinkFlush_error(ctx context.Context, field graphql.CollectedField, obj *model.SinkFlush) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SinkFlush",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Error, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Source_id(ctx context.Context, field graphql.CollectedField, obj *model.Source) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Source",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNID2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Source_component(ctx context.Context, field graphql.CollectedField, obj *model.Source) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Source",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Source().Component(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.Component)
+	fc.Result = res
+	return ec.marshalNComponent2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐComponent(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Source_topic(ctx context.Context, field graphql.CollectedField, obj *model.Source) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Source",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Source().Topic(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.Topic)
+	fc.Result = res
+	return ec.marshalNTopic2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐTopic(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Source_pods(ctx context.Context, field graphql.CollectedField, obj *model.Source) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Source",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Source().Pods(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Pod)
+	fc.Result = res
+	return ec.marshalNPod2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐPodᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SourceEmit_time(ctx context.Context, field graphql.CollectedField, obj *model.SourceEmit) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SourceEmit",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Time, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SourceEmit_key(ctx context.Context, field graphql.CollectedField, obj *model.SourceEmit) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SourceEmit",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Key, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SourceEmit_value(ctx context.Context, field graphql.CollectedField, obj *model.SourceEmit) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SourceEmit",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Value, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SourceEmit_delete(ctx context.Context, field graphql.CollectedField, obj *model.SourceEmit) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SourceEmit",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Delete, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SourceEmit_error(ctx context.Context, field graphql.CollectedField, obj *model.SourceEmit) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SourceEmit",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Error, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Subscription_watchProcessor(ctx context.Context, field graphql.CollectedField) (ret func() graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Subscription_watchProcessor_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().WatchProcessor(rctx, args["options"].(*model.WatchProcessorInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func() graphql.Marshaler {
+		res, ok := <-resTmp.(<-chan *model.Operation)
+		if !ok {
+			return nil
+		}
+		return graphql.WriterFunc(func(w io.Writer) {
+			w.Write([]byte{'{'})
+			graphql.MarshalString(field.Alias).MarshalGQL(w)
+			w.Write([]byte{':'})
+			ec.marshalNOperation2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐOperation(ctx, field.Selections, res).MarshalGQL(w)
+			w.Write([]byte{'}'})
+		})
+	}
+}
+
+func (ec *executionContext) _Subscription_watchSink(ctx context.Context, field graphql.CollectedField) (ret func() graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Subscription_watchSink_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().WatchSink(rctx, args["options"].(*model.WatchSinkInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func() graphql.Marshaler {
+		res, ok := <-resTmp.(<-chan model.SinkEvent)
+		if !ok {
+			return nil
+		}
+		return graphql.WriterFunc(func(w io.Writer) {
+			w.Write([]byte{'{'})
+			graphql.MarshalString(field.Alias).MarshalGQL(w)
+			w.Write([]byte{':'})
+			ec.marshalNSinkEvent2githubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐSinkEvent(ctx, field.Selections, res).MarshalGQL(w)
+			w.Write([]byte{'}'})
+		})
+	}
+}
+
+func (ec *executionContext) _Subscription_watchSource(ctx context.Context, field graphql.CollectedField) (ret func() graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Subscription_watchSource_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().WatchSource(rctx, args["options"].(*model.WatchSourceInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func() graphql.Marshaler {
+		res, ok := <-resTmp.(<-chan *model.SourceEmit)
+		if !ok {
+			return nil
+		}
+		return graphql.WriterFunc(func(w io.Writer) {
+			w.Write([]byte{'{'})
+			graphql.MarshalString(field.Alias).MarshalGQL(w)
+			w.Write([]byte{':'})
+			ec.marshalNSourceEmit2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐSourceEmit(ctx, field.Selections, res).MarshalGQL(w)
+			w.Write([]byte{'}'})
+		})
+	}
+}
+
+func (ec *executionContext) _Subscription_watchViewSource(ctx context.Context, field graphql.CollectedField) (ret func() graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Subscription_watchViewSource_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().WatchViewSource(rctx, args["options"].(*model.WatchViewSourceInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func() graphql.Marshaler {
+		res, ok := <-resTmp.(<-chan *model.ViewSourceSync)
+		if !ok {
+			return nil
+		}
+		return graphql.WriterFunc(func(w io.Writer) {
+			w.Write([]byte{'{'})
+			graphql.MarshalString(field.Alias).MarshalGQL(w)
+			w.Write([]byte{':'})
+			ec.marshalNViewSourceSync2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐViewSourceSync(ctx, field.Selections, res).MarshalGQL(w)
+			w.Write([]byte{'}'})
+		})
+	}
+}
+
+func (ec *executionContext) _Subscription_watchViewSink(ctx context.Context, field graphql.CollectedField) (ret func() graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Subscription_watchViewSink_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().WatchViewSink(rctx, args["options"].(*model.WatchViewSinkInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func() graphql.Marshaler {
+		res, ok := <-resTmp.(<-chan *model.ViewSinkSync)
+		if !ok {
+			return nil
+		}
+		return graphql.WriterFunc(func(w io.Writer) {
+			w.Write([]byte{'{'})
+			graphql.MarshalString(field.Alias).MarshalGQL(w)
+			w.Write([]byte{':'})
+			ec.marshalNViewSinkSync2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐViewSinkSync(ctx, field.Selections, res).MarshalGQL(w)
+			w.Write([]byte{'}'})
+		})
+	}
+}
+
+func (ec *executionContext) _Topic_id(ctx context.Context, field graphql.CollectedField, obj *model.Topic) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Topic",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNID2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Topic_name(ctx context.Context, field graphql.CollectedField, obj *model.Topic) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Topic",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Topic_message(ctx context.Context, field graphql.CollectedField, obj *model.Topic) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Topic",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Topic_processorInputs(ctx context.Context, field graphql.CollectedField, obj *model.Topic) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Topic",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Topic().ProcessorInputs(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ProcessorInput)
+	fc.Result = res
+	return ec.marshalNProcessorInput2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐProcessorInputᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Topic_processorJoins(ctx context.Context, field graphql.CollectedField, obj *model.Topic) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Topic",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Topic().ProcessorJoins(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ProcessorJoin)
+	fc.Result = res
+	return ec.marshalNProcessorJoin2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐProcessorJoinᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Topic_processorLookups(ctx context.Context, field graphql.CollectedField, obj *model.Topic) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Topic",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Topic().ProcessorLookups(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ProcessorLookup)
+	fc.Result = res
+	return ec.marshalNProcessorLookup2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐProcessorLookupᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Topic_processorOutputs(ctx context.Context, field graphql.CollectedField, obj *model.Topic) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Topic",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Topic().ProcessorOutputs(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ProcessorOutput)
+	fc.Result = res
+	return ec.marshalNProcessorOutput2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐProcessorOutputᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Topic_processorPersistences(ctx context.Context, field graphql.CollectedField, obj *model.Topic) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Topic",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Topic().ProcessorPersistences(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Processor)
+	fc.Result = res
+	return ec.marshalNProcessor2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐProcessorᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Topic_sinks(ctx context.Context, field graphql.CollectedField, obj *model.Topic) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Topic",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Topic().Sinks(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Sink)
+	fc.Result = res
+	return ec.marshalNSink2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐSinkᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Topic_sources(ctx context.Context, field graphql.CollectedField, obj *model.Topic) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Topic",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Topic().Sources(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Source)
+	fc.Result = res
+	return ec.marshalNSource2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐSourceᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Topic_viewSinks(ctx context.Context, field graphql.CollectedField, obj *model.Topic) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Topic",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Topic().ViewSinks(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ViewSink)
+	fc.Result = res
+	return ec.marshalNViewSink2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐViewSinkᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Topic_viewSources(ctx context.Context, field graphql.CollectedField, obj *model.Topic) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Topic",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Topic().ViewSources(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4770,12 +7020,12 @@ func (ec *executionContext) _Topic_name(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.ViewSource)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNViewSource2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐViewSourceᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Topic_message(ctx context.Context, field graphql.CollectedField, obj *model.Topic) (ret graphql.Marshaler) {
+func (ec *executionContext) _Topic_views(ctx context.Context, field graphql.CollectedField, obj *model.Topic) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4786,14 +7036,14 @@ func (ec *executionContext) _Topic_message(ctx context.Context, field graphql.Co
 		Object:     "Topic",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return ec.resolvers.Topic().Views(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4805,12 +7055,12 @@ func (ec *executionContext) _Topic_message(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.View)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNView2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐViewᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Topic_processorInputs(ctx context.Context, field graphql.CollectedField, obj *model.Topic) (ret graphql.Marshaler) {
+func (ec *executionContext) _Topic_lag(ctx context.Context, field graphql.CollectedField, obj *model.Topic) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4828,7 +7078,7 @@ func (ec *executionContext) _Topic_processorInputs(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Topic().ProcessorInputs(rctx, obj)
+		return ec.resolvers.Topic().Lag(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4840,12 +7090,12 @@ func (ec *executionContext) _Topic_processorInputs(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.ProcessorInput)
+	res := resTmp.([]*model.ConsumerLag)
 	fc.Result = res
-	return ec.marshalNProcessorInput2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐProcessorInputᚄ(ctx, field.Selections, res)
+	return ec.marshalNConsumerLag2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐConsumerLagᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Topic_processorJoins(ctx context.Context, field graphql.CollectedField, obj *model.Topic) (ret graphql.Marshaler) {
+func (ec *executionContext) _View_id(ctx context.Context, field graphql.CollectedField, obj *model.View) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4853,17 +7103,17 @@ func (ec *executionContext) _Topic_processorJoins(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Topic",
+		Object:     "View",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Topic().ProcessorJoins(rctx, obj)
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4875,12 +7125,12 @@ func (ec *executionContext) _Topic_processorJoins(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.ProcessorJoin)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNProcessorJoin2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐProcessorJoinᚄ(ctx, field.Selections, res)
+	return ec.marshalNID2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Topic_processorLookups(ctx context.Context, field graphql.CollectedField, obj *model.Topic) (ret graphql.Marshaler) {
+func (ec *executionContext) _View_component(ctx context.Context, field graphql.CollectedField, obj *model.View) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4888,7 +7138,7 @@ func (ec *executionContext) _Topic_processorLookups(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Topic",
+		Object:     "View",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -4898,7 +7148,7 @@ func (ec *executionContext) _Topic_processorLookups(ctx context.Context, field g
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Topic().ProcessorLookups(rctx, obj)
+		return ec.resolvers.View().Component(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4910,12 +7160,12 @@ func (ec *executionContext) _Topic_processorLookups(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.ProcessorLookup)
+	res := resTmp.(*model.Component)
 	fc.Result = res
-	return ec.marshalNProcessorLookup2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐProcessorLookupᚄ(ctx, field.Selections, res)
+	return ec.marshalNComponent2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐComponent(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Topic_processorOutputs(ctx context.Context, field graphql.CollectedField, obj *model.Topic) (ret graphql.Marshaler) {
+func (ec *executionContext) _View_topic(ctx context.Context, field graphql.CollectedField, obj *model.View) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4923,7 +7173,7 @@ func (ec *executionContext) _Topic_processorOutputs(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Topic",
+		Object:     "View",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -4933,7 +7183,7 @@ func (ec *executionContext) _Topic_processorOutputs(ctx context.Context, field g
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Topic().ProcessorOutputs(rctx, obj)
+		return ec.resolvers.View().Topic(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4945,12 +7195,12 @@ func (ec *executionContext) _Topic_processorOutputs(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.ProcessorOutput)
+	res := resTmp.(*model.Topic)
 	fc.Result = res
-	return ec.marshalNProcessorOutput2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐProcessorOutputᚄ(ctx, field.Selections, res)
+	return ec.marshalNTopic2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐTopic(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Topic_processorPersistences(ctx context.Context, field graphql.CollectedField, obj *model.Topic) (ret graphql.Marshaler) {
+func (ec *executionContext) _View_pods(ctx context.Context, field graphql.CollectedField, obj *model.View) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4958,7 +7208,7 @@ func (ec *executionContext) _Topic_processorPersistences(ctx context.Context, fi
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Topic",
+		Object:     "View",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -4968,7 +7218,7 @@ func (ec *executionContext) _Topic_processorPersistences(ctx context.Context, fi
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Topic().ProcessorPersistences(rctx, obj)
+		return ec.resolvers.View().Pods(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4980,12 +7230,12 @@ func (ec *executionContext) _Topic_processorPersistences(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Processor)
+	res := resTmp.([]*model.Pod)
 	fc.Result = res
-	return ec.marshalNProcessor2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐProcessorᚄ(ctx, field.Selections, res)
+	return ec.marshalNPod2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐPodᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Topic_sinks(ctx context.Context, field graphql.CollectedField, obj *model.Topic) (ret graphql.Marshaler) {
+func (ec *executionContext) _ViewSink_id(ctx context.Context, field graphql.CollectedField, obj *model.ViewSink) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4993,17 +7243,17 @@ func (ec *executionContext) _Topic_sinks(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Topic",
+		Object:     "ViewSink",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Topic().Sinks(rctx, obj)
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5015,12 +7265,12 @@ func (ec *executionContext) _Topic_sinks(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Sink)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNSink2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐSinkᚄ(ctx, field.Selections, res)
+	return ec.marshalNID2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Topic_sources(ctx context.Context, field graphql.CollectedField, obj *model.Topic) (ret graphql.Marshaler) {
+func (ec *executionContext) _ViewSink_component(ctx context.Context, field graphql.CollectedField, obj *model.ViewSink) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5028,7 +7278,7 @@ func (ec *executionContext) _Topic_sources(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Topic",
+		Object:     "ViewSink",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -5038,7 +7288,7 @@ func (ec *executionContext) _Topic_sources(ctx context.Context, field graphql.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Topic().Sources(rctx, obj)
+		return ec.resolvers.ViewSink().Component(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5050,12 +7300,12 @@ func (ec *executionContext) _Topic_sources(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Source)
+	res := resTmp.(*model.Component)
 	fc.Result = res
-	return ec.marshalNSource2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐSourceᚄ(ctx, field.Selections, res)
+	return ec.marshalNComponent2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐComponent(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Topic_viewSinks(ctx context.Context, field graphql.CollectedField, obj *model.Topic) (ret graphql.Marshaler) {
+func (ec *executionContext) _ViewSink_name(ctx context.Context, field graphql.CollectedField, obj *model.ViewSink) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5063,17 +7313,17 @@ func (ec *executionContext) _Topic_viewSinks(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Topic",
+		Object:     "ViewSink",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Topic().ViewSinks(rctx, obj)
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5085,12 +7335,12 @@ func (ec *executionContext) _Topic_viewSinks(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.ViewSink)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNViewSink2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐViewSinkᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Topic_viewSources(ctx context.Context, field graphql.CollectedField, obj *model.Topic) (ret graphql.Marshaler) {
+func (ec *executionContext) _ViewSink_description(ctx context.Context, field graphql.CollectedField, obj *model.ViewSink) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5098,17 +7348,17 @@ func (ec *executionContext) _Topic_viewSources(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Topic",
+		Object:     "ViewSink",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Topic().ViewSources(rctx, obj)
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5120,12 +7370,12 @@ func (ec *executionContext) _Topic_viewSources(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.ViewSource)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNViewSource2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐViewSourceᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Topic_views(ctx context.Context, field graphql.CollectedField, obj *model.Topic) (ret graphql.Marshaler) {
+func (ec *executionContext) _ViewSink_topic(ctx context.Context, field graphql.CollectedField, obj *model.ViewSink) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5133,7 +7383,7 @@ func (ec *executionContext) _Topic_views(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Topic",
+		Object:     "ViewSink",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -5143,7 +7393,7 @@ func (ec *executionContext) _Topic_views(ctx context.Context, field graphql.Coll
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Topic().Views(rctx, obj)
+		return ec.resolvers.ViewSink().Topic(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5155,12 +7405,12 @@ func (ec *executionContext) _Topic_views(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.View)
+	res := resTmp.(*model.Topic)
 	fc.Result = res
-	return ec.marshalNView2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐViewᚄ(ctx, field.Selections, res)
+	return ec.marshalNTopic2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐTopic(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _View_id(ctx context.Context, field graphql.CollectedField, obj *model.View) (ret graphql.Marshaler) {
+func (ec *executionContext) _ViewSink_pods(ctx context.Context, field graphql.CollectedField, obj *model.ViewSink) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5168,17 +7418,17 @@ func (ec *executionContext) _View_id(ctx context.Context, field graphql.Collecte
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "View",
+		Object:     "ViewSink",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return ec.resolvers.ViewSink().Pods(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5190,12 +7440,12 @@ func (ec *executionContext) _View_id(ctx context.Context, field graphql.Collecte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]*model.Pod)
 	fc.Result = res
-	return ec.marshalNID2int(ctx, field.Selections, res)
+	return ec.marshalNPod2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐPodᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _View_component(ctx context.Context, field graphql.CollectedField, obj *model.View) (ret graphql.Marshaler) {
+func (ec *executionContext) _ViewSinkSync_startTime(ctx context.Context, field graphql.CollectedField, obj *model.ViewSinkSync) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5203,17 +7453,17 @@ func (ec *executionContext) _View_component(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "View",
+		Object:     "ViewSinkSync",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.View().Component(rctx, obj)
+		return obj.StartTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5225,12 +7475,12 @@ func (ec *executionContext) _View_component(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Component)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNComponent2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐComponent(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _View_topic(ctx context.Context, field graphql.CollectedField, obj *model.View) (ret graphql.Marshaler) {
+func (ec *executionContext) _ViewSinkSync_endTime(ctx context.Context, field graphql.CollectedField, obj *model.ViewSinkSync) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5238,17 +7488,17 @@ func (ec *executionContext) _View_topic(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "View",
+		Object:     "ViewSinkSync",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.View().Topic(rctx, obj)
+		return obj.EndTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5260,12 +7510,12 @@ func (ec *executionContext) _View_topic(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Topic)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNTopic2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐTopic(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _View_pods(ctx context.Context, field graphql.CollectedField, obj *model.View) (ret graphql.Marshaler) {
+func (ec *executionContext) _ViewSinkSync_error(ctx context.Context, field graphql.CollectedField, obj *model.ViewSinkSync) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5273,34 +7523,31 @@ func (ec *executionContext) _View_pods(ctx context.Context, field graphql.Collec
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "View",
+		Object:     "ViewSinkSync",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.View().Pods(rctx, obj)
+		return obj.Error, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Pod)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNPod2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐPodᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ViewSink_id(ctx context.Context, field graphql.CollectedField, obj *model.ViewSink) (ret graphql.Marshaler) {
+func (ec *executionContext) _ViewSource_id(ctx context.Context, field graphql.CollectedField, obj *model.ViewSource) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5308,7 +7555,7 @@ func (ec *executionContext) _ViewSink_id(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "ViewSink",
+		Object:     "ViewSource",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5335,7 +7582,7 @@ func (ec *executionContext) _ViewSink_id(ctx context.Context, field graphql.Coll
 	return ec.marshalNID2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ViewSink_component(ctx context.Context, field graphql.CollectedField, obj *model.ViewSink) (ret graphql.Marshaler) {
+func (ec *executionContext) _ViewSource_component(ctx context.Context, field graphql.CollectedField, obj *model.ViewSource) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5343,7 +7590,7 @@ func (ec *executionContext) _ViewSink_component(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "ViewSink",
+		Object:     "ViewSource",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -5353,7 +7600,7 @@ func (ec *executionContext) _ViewSink_component(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ViewSink().Component(rctx, obj)
+		return ec.resolvers.ViewSource().Component(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5370,7 +7617,7 @@ func (ec *executionContext) _ViewSink_component(ctx context.Context, field graph
 	return ec.marshalNComponent2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐComponent(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ViewSink_name(ctx context.Context, field graphql.CollectedField, obj *model.ViewSink) (ret graphql.Marshaler) {
+func (ec *executionContext) _ViewSource_description(ctx context.Context, field graphql.CollectedField, obj *model.ViewSource) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5378,7 +7625,7 @@ func (ec *executionContext) _ViewSink_name(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "ViewSink",
+		Object:     "ViewSource",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5388,7 +7635,7 @@ func (ec *executionContext) _ViewSink_name(ctx context.Context, field graphql.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5405,7 +7652,7 @@ func (ec *executionContext) _ViewSink_name(ctx context.Context, field graphql.Co
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ViewSink_description(ctx context.Context, field graphql.CollectedField, obj *model.ViewSink) (ret graphql.Marshaler) {
+func (ec *executionContext) _ViewSource_name(ctx context.Context, field graphql.CollectedField, obj *model.ViewSource) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5413,7 +7660,7 @@ func (ec *executionContext) _ViewSink_description(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "ViewSink",
+		Object:     "ViewSource",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5423,7 +7670,7 @@ func (ec *executionContext) _ViewSink_description(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5440,7 +7687,7 @@ func (ec *executionContext) _ViewSink_description(ctx context.Context, field gra
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ViewSink_topic(ctx context.Context, field graphql.CollectedField, obj *model.ViewSink) (ret graphql.Marshaler) {
+func (ec *executionContext) _ViewSource_topic(ctx context.Context, field graphql.CollectedField, obj *model.ViewSource) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5448,7 +7695,7 @@ func (ec *executionContext) _ViewSink_topic(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "ViewSink",
+		Object:     "ViewSource",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -5458,7 +7705,7 @@ func (ec *executionContext) _ViewSink_topic(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ViewSink().Topic(rctx, obj)
+		return ec.resolvers.ViewSource().Topic(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5475,7 +7722,7 @@ func (ec *executionContext) _ViewSink_topic(ctx context.Context, field graphql.C
 	return ec.marshalNTopic2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐTopic(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ViewSink_pods(ctx context.Context, field graphql.CollectedField, obj *model.ViewSink) (ret graphql.Marshaler) {
+func (ec *executionContext) _ViewSource_pods(ctx context.Context, field graphql.CollectedField, obj *model.ViewSource) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5483,7 +7730,7 @@ func (ec *executionContext) _ViewSink_pods(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "ViewSink",
+		Object:     "ViewSource",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -5493,7 +7740,7 @@ func (ec *executionContext) _ViewSink_pods(ctx context.Context, field graphql.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ViewSink().Pods(rctx, obj)
+		return ec.resolvers.ViewSource().Pods(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5510,7 +7757,7 @@ func (ec *executionContext) _ViewSink_pods(ctx context.Context, field graphql.Co
 	return ec.marshalNPod2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐPodᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ViewSource_id(ctx context.Context, field graphql.CollectedField, obj *model.ViewSource) (ret graphql.Marshaler) {
+func (ec *executionContext) _ViewSourceSync_startTime(ctx context.Context, field graphql.CollectedField, obj *model.ViewSourceSync) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5518,7 +7765,7 @@ func (ec *executionContext) _ViewSource_id(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "ViewSource",
+		Object:     "ViewSourceSync",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5528,7 +7775,7 @@ func (ec *executionContext) _ViewSource_id(ctx context.Context, field graphql.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.StartTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5542,10 +7789,45 @@ func (ec *executionContext) _ViewSource_id(ctx context.Context, field graphql.Co
 	}
 	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNID2int(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ViewSource_component(ctx context.Context, field graphql.CollectedField, obj *model.ViewSource) (ret graphql.Marshaler) {
+func (ec *executionContext) _ViewSourceSync_endTime(ctx context.Context, field graphql.CollectedField, obj *model.ViewSourceSync) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ViewSourceSync",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EndTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ViewSourceSync_updates(ctx context.Context, field graphql.CollectedField, obj *model.ViewSourceSync) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5553,17 +7835,17 @@ func (ec *executionContext) _ViewSource_component(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "ViewSource",
+		Object:     "ViewSourceSync",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ViewSource().Component(rctx, obj)
+		return obj.Updates, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5575,12 +7857,12 @@ func (ec *executionContext) _ViewSource_component(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Component)
+	res := resTmp.([]*model.ViewSourceUpdate)
 	fc.Result = res
-	return ec.marshalNComponent2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐComponent(ctx, field.Selections, res)
+	return ec.marshalNViewSourceUpdate2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐViewSourceUpdateᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ViewSource_description(ctx context.Context, field graphql.CollectedField, obj *model.ViewSource) (ret graphql.Marshaler) {
+func (ec *executionContext) _ViewSourceSync_deletes(ctx context.Context, field graphql.CollectedField, obj *model.ViewSourceSync) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5588,7 +7870,7 @@ func (ec *executionContext) _ViewSource_description(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "ViewSource",
+		Object:     "ViewSourceSync",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5598,7 +7880,7 @@ func (ec *executionContext) _ViewSource_description(ctx context.Context, field g
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.Deletes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5610,12 +7892,12 @@ func (ec *executionContext) _ViewSource_description(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ViewSource_name(ctx context.Context, field graphql.CollectedField, obj *model.ViewSource) (ret graphql.Marshaler) {
+func (ec *executionContext) _ViewSourceSync_error(ctx context.Context, field graphql.CollectedField, obj *model.ViewSourceSync) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5623,7 +7905,7 @@ func (ec *executionContext) _ViewSource_name(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "ViewSource",
+		Object:     "ViewSourceSync",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5633,24 +7915,21 @@ func (ec *executionContext) _ViewSource_name(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Error, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ViewSource_topic(ctx context.Context, field graphql.CollectedField, obj *model.ViewSource) (ret graphql.Marshaler) {
+func (ec *executionContext) _ViewSourceUpdate_key(ctx context.Context, field graphql.CollectedField, obj *model.ViewSourceUpdate) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5658,17 +7937,17 @@ func (ec *executionContext) _ViewSource_topic(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "ViewSource",
+		Object:     "ViewSourceUpdate",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ViewSource().Topic(rctx, obj)
+		return obj.Key, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5680,12 +7959,12 @@ func (ec *executionContext) _ViewSource_topic(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Topic)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTopic2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐTopic(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ViewSource_pods(ctx context.Context, field graphql.CollectedField, obj *model.ViewSource) (ret graphql.Marshaler) {
+func (ec *executionContext) _ViewSourceUpdate_value(ctx context.Context, field graphql.CollectedField, obj *model.ViewSourceUpdate) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5693,17 +7972,17 @@ func (ec *executionContext) _ViewSource_pods(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "ViewSource",
+		Object:     "ViewSourceUpdate",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ViewSource().Pods(rctx, obj)
+		return obj.Value, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5715,9 +7994,9 @@ func (ec *executionContext) _ViewSource_pods(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Pod)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNPod2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐPodᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
@@ -6825,7 +9104,111 @@ func (ec *executionContext) unmarshalInputWatchProcessorInput(ctx context.Contex
 			var err error
 
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("key"))
-			it.Key, err = ec.unmarshalNString2string(ctx, v)
+			it.Key, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "keyPrefix":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("keyPrefix"))
+			it.KeyPrefix, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "keyRegex":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("keyRegex"))
+			it.KeyRegex, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "sampleRate":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sampleRate"))
+			it.SampleRate, err = ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputWatchSinkInput(ctx context.Context, obj interface{}) (model.WatchSinkInput, error) {
+	var it model.WatchSinkInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "sinkId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sinkId"))
+			it.SinkID, err = ec.unmarshalNID2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputWatchSourceInput(ctx context.Context, obj interface{}) (model.WatchSourceInput, error) {
+	var it model.WatchSourceInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "sourceId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sourceId"))
+			it.SourceID, err = ec.unmarshalNID2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputWatchViewSinkInput(ctx context.Context, obj interface{}) (model.WatchViewSinkInput, error) {
+	var it model.WatchViewSinkInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "viewSinkId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("viewSinkId"))
+			it.ViewSinkID, err = ec.unmarshalNID2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputWatchViewSourceInput(ctx context.Context, obj interface{}) (model.WatchViewSourceInput, error) {
+	var it model.WatchViewSourceInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "viewSourceId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("viewSourceId"))
+			it.ViewSourceID, err = ec.unmarshalNID2int(ctx, v)
 			if err != nil {
 				return it, err
 			}
@@ -6883,6 +9266,36 @@ func (ec *executionContext) _Action(ctx context.Context, sel ast.SelectionSet, o
 	}
 }
 
+func (ec *executionContext) _SinkEvent(ctx context.Context, sel ast.SelectionSet, obj model.SinkEvent) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.SinkCollect:
+		return ec._SinkCollect(ctx, sel, &obj)
+	case *model.SinkCollect:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._SinkCollect(ctx, sel, obj)
+	case model.SinkFlush:
+		return ec._SinkFlush(ctx, sel, &obj)
+	case *model.SinkFlush:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._SinkFlush(ctx, sel, obj)
+	case model.SinkCommit:
+		return ec._SinkCommit(ctx, sel, &obj)
+	case *model.SinkCommit:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._SinkCommit(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
 // endregion ************************** interface.gotpl ***************************
 
 // region    **************************** object.gotpl ****************************
@@ -7036,6 +9449,72 @@ func (ec *executionContext) _Component(ctx context.Context, sel ast.SelectionSet
 	return out
 }
 
+var consumerLagImplementors = []string{"ConsumerLag"}
+
+func (ec *executionContext) _ConsumerLag(ctx context.Context, sel ast.SelectionSet, obj *model.ConsumerLag) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, consumerLagImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ConsumerLag")
+		case "id":
+			out.Values[i] = ec._ConsumerLag_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "groupName":
+			out.Values[i] = ec._ConsumerLag_groupName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "topic":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ConsumerLag_topic(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "partition":
+			out.Values[i] = ec._ConsumerLag_partition(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "committedOffset":
+			out.Values[i] = ec._ConsumerLag_committedOffset(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "highWatermark":
+			out.Values[i] = ec._ConsumerLag_highWatermark(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "lag":
+			out.Values[i] = ec._ConsumerLag_lag(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var getStateImplementors = []string{"GetState", "Action"}
 
 func (ec *executionContext) _GetState(ctx context.Context, sel ast.SelectionSet, obj *model.GetState) graphql.Marshaler {
@@ -7147,6 +9626,38 @@ func (ec *executionContext) _Join(ctx context.Context, sel ast.SelectionSet, obj
 	return out
 }
 
+var laggingImplementors = []string{"Lagging"}
+
+func (ec *executionContext) _Lagging(ctx context.Context, sel ast.SelectionSet, obj *model.Lagging) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, laggingImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Lagging")
+		case "processors":
+			out.Values[i] = ec._Lagging_processors(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "sinks":
+			out.Values[i] = ec._Lagging_sinks(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var lookupImplementors = []string{"Lookup", "Action"}
 
 func (ec *executionContext) _Lookup(ctx context.Context, sel ast.SelectionSet, obj *model.Lookup) graphql.Marshaler {
@@ -7231,34 +9742,66 @@ func (ec *executionContext) _Operation(ctx context.Context, sel ast.SelectionSet
 	return out
 }
 
-var outputImplementors = []string{"Output", "Action"}
+var outputImplementors = []string{"Output", "Action"}
+
+func (ec *executionContext) _Output(ctx context.Context, sel ast.SelectionSet, obj *model.Output) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, outputImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Output")
+		case "topic":
+			out.Values[i] = ec._Output_topic(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "message":
+			out.Values[i] = ec._Output_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "value":
+			out.Values[i] = ec._Output_value(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "key":
+			out.Values[i] = ec._Output_key(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var partitionOffsetImplementors = []string{"PartitionOffset"}
 
-func (ec *executionContext) _Output(ctx context.Context, sel ast.SelectionSet, obj *model.Output) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, outputImplementors)
+func (ec *executionContext) _PartitionOffset(ctx context.Context, sel ast.SelectionSet, obj *model.PartitionOffset) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, partitionOffsetImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Output")
-		case "topic":
-			out.Values[i] = ec._Output_topic(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "message":
-			out.Values[i] = ec._Output_message(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "value":
-			out.Values[i] = ec._Output_value(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("PartitionOffset")
+		case "partition":
+			out.Values[i] = ec._PartitionOffset_partition(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "key":
-			out.Values[i] = ec._Output_key(ctx, field, obj)
+		case "offset":
+			out.Values[i] = ec._PartitionOffset_offset(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -7515,6 +10058,20 @@ func (ec *executionContext) _Processor(ctx context.Context, sel ast.SelectionSet
 				}
 				return res
 			})
+		case "lag":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Processor_lag(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7825,6 +10382,20 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 				res = ec._Query_componentById(ctx, field)
 				return res
 			})
+		case "lagging":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_lagging(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
 		case "__type":
 			out.Values[i] = ec._Query___type(ctx, field)
 		case "__schema":
@@ -7982,6 +10553,11 @@ func (ec *executionContext) _Sink(ctx context.Context, sel ast.SelectionSet, obj
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
+		case "groupName":
+			out.Values[i] = ec._Sink_groupName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
 		case "topic":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
@@ -8010,6 +10586,138 @@ func (ec *executionContext) _Sink(ctx context.Context, sel ast.SelectionSet, obj
 				}
 				return res
 			})
+		case "lag":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Sink_lag(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var sinkCollectImplementors = []string{"SinkCollect", "SinkEvent"}
+
+func (ec *executionContext) _SinkCollect(ctx context.Context, sel ast.SelectionSet, obj *model.SinkCollect) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sinkCollectImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SinkCollect")
+		case "time":
+			out.Values[i] = ec._SinkCollect_time(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "partition":
+			out.Values[i] = ec._SinkCollect_partition(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "offset":
+			out.Values[i] = ec._SinkCollect_offset(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "key":
+			out.Values[i] = ec._SinkCollect_key(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "value":
+			out.Values[i] = ec._SinkCollect_value(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var sinkCommitImplementors = []string{"SinkCommit", "SinkEvent"}
+
+func (ec *executionContext) _SinkCommit(ctx context.Context, sel ast.SelectionSet, obj *model.SinkCommit) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sinkCommitImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SinkCommit")
+		case "time":
+			out.Values[i] = ec._SinkCommit_time(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "offsets":
+			out.Values[i] = ec._SinkCommit_offsets(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var sinkFlushImplementors = []string{"SinkFlush", "SinkEvent"}
+
+func (ec *executionContext) _SinkFlush(ctx context.Context, sel ast.SelectionSet, obj *model.SinkFlush) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sinkFlushImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SinkFlush")
+		case "time":
+			out.Values[i] = ec._SinkFlush_time(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "count":
+			out.Values[i] = ec._SinkFlush_count(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "attempts":
+			out.Values[i] = ec._SinkFlush_attempts(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "error":
+			out.Values[i] = ec._SinkFlush_error(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8090,6 +10798,47 @@ func (ec *executionContext) _Source(ctx context.Context, sel ast.SelectionSet, o
 	return out
 }
 
+var sourceEmitImplementors = []string{"SourceEmit"}
+
+func (ec *executionContext) _SourceEmit(ctx context.Context, sel ast.SelectionSet, obj *model.SourceEmit) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sourceEmitImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SourceEmit")
+		case "time":
+			out.Values[i] = ec._SourceEmit_time(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "key":
+			out.Values[i] = ec._SourceEmit_key(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "value":
+			out.Values[i] = ec._SourceEmit_value(ctx, field, obj)
+		case "delete":
+			out.Values[i] = ec._SourceEmit_delete(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "error":
+			out.Values[i] = ec._SourceEmit_error(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var subscriptionImplementors = []string{"Subscription"}
 
 func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func() graphql.Marshaler {
@@ -8105,6 +10854,14 @@ func (ec *executionContext) _Subscription(ctx context.Context, sel ast.Selection
 	switch fields[0].Name {
 	case "watchProcessor":
 		return ec._Subscription_watchProcessor(ctx, fields[0])
+	case "watchSink":
+		return ec._Subscription_watchSink(ctx, fields[0])
+	case "watchSource":
+		return ec._Subscription_watchSource(ctx, fields[0])
+	case "watchViewSource":
+		return ec._Subscription_watchViewSource(ctx, fields[0])
+	case "watchViewSink":
+		return ec._Subscription_watchViewSink(ctx, fields[0])
 	default:
 		panic("unknown field " + strconv.Quote(fields[0].Name))
 	}
@@ -8276,6 +11033,20 @@ func (ec *executionContext) _Topic(ctx context.Context, sel ast.SelectionSet, ob
 				}
 				return res
 			})
+		case "lag":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Topic_lag(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8435,6 +11206,40 @@ func (ec *executionContext) _ViewSink(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
+var viewSinkSyncImplementors = []string{"ViewSinkSync"}
+
+func (ec *executionContext) _ViewSinkSync(ctx context.Context, sel ast.SelectionSet, obj *model.ViewSinkSync) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, viewSinkSyncImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ViewSinkSync")
+		case "startTime":
+			out.Values[i] = ec._ViewSinkSync_startTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "endTime":
+			out.Values[i] = ec._ViewSinkSync_endTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "error":
+			out.Values[i] = ec._ViewSinkSync_error(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var viewSourceImplementors = []string{"ViewSource"}
 
 func (ec *executionContext) _ViewSource(ctx context.Context, sel ast.SelectionSet, obj *model.ViewSource) graphql.Marshaler {
@@ -8514,6 +11319,82 @@ func (ec *executionContext) _ViewSource(ctx context.Context, sel ast.SelectionSe
 	return out
 }
 
+var viewSourceSyncImplementors = []string{"ViewSourceSync"}
+
+func (ec *executionContext) _ViewSourceSync(ctx context.Context, sel ast.SelectionSet, obj *model.ViewSourceSync) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, viewSourceSyncImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ViewSourceSync")
+		case "startTime":
+			out.Values[i] = ec._ViewSourceSync_startTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "endTime":
+			out.Values[i] = ec._ViewSourceSync_endTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "updates":
+			out.Values[i] = ec._ViewSourceSync_updates(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "deletes":
+			out.Values[i] = ec._ViewSourceSync_deletes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "error":
+			out.Values[i] = ec._ViewSourceSync_error(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var viewSourceUpdateImplementors = []string{"ViewSourceUpdate"}
+
+func (ec *executionContext) _ViewSourceUpdate(ctx context.Context, sel ast.SelectionSet, obj *model.ViewSourceUpdate) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, viewSourceUpdateImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ViewSourceUpdate")
+		case "key":
+			out.Values[i] = ec._ViewSourceUpdate_key(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "value":
+			out.Values[i] = ec._ViewSourceUpdate_value(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var __DirectiveImplementors = []string{"__Directive"}
 
 func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
@@ -8872,6 +11753,53 @@ func (ec *executionContext) marshalNComponent2ᚖgithubᚗcomᚋsyncromaticsᚋk
 	return ec._Component(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNConsumerLag2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐConsumerLagᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ConsumerLag) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNConsumerLag2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐConsumerLag(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNConsumerLag2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐConsumerLag(ctx context.Context, sel ast.SelectionSet, v *model.ConsumerLag) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._ConsumerLag(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNID2int(ctx context.Context, v interface{}) (int, error) {
 	res, err := graphql.UnmarshalIntID(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -8912,6 +11840,20 @@ func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.Selecti
 	return res
 }
 
+func (ec *executionContext) marshalNLagging2githubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐLagging(ctx context.Context, sel ast.SelectionSet, v model.Lagging) graphql.Marshaler {
+	return ec._Lagging(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNLagging2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐLagging(ctx context.Context, sel ast.SelectionSet, v *model.Lagging) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._Lagging(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNOperation2githubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐOperation(ctx context.Context, sel ast.SelectionSet, v model.Operation) graphql.Marshaler {
 	return ec._Operation(ctx, sel, &v)
 }
@@ -8926,6 +11868,53 @@ func (ec *executionContext) marshalNOperation2ᚖgithubᚗcomᚋsyncromaticsᚋk
 	return ec._Operation(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNPartitionOffset2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐPartitionOffsetᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PartitionOffset) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPartitionOffset2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐPartitionOffset(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNPartitionOffset2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐPartitionOffset(ctx context.Context, sel ast.SelectionSet, v *model.PartitionOffset) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._PartitionOffset(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNPod2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐPodᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Pod) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
@@ -9310,6 +12299,16 @@ func (ec *executionContext) marshalNSink2ᚖgithubᚗcomᚋsyncromaticsᚋkafmes
 	return ec._Sink(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNSinkEvent2githubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐSinkEvent(ctx context.Context, sel ast.SelectionSet, v model.SinkEvent) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._SinkEvent(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNSource2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐSourceᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Source) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
@@ -9357,6 +12356,20 @@ func (ec *executionContext) marshalNSource2ᚖgithubᚗcomᚋsyncromaticsᚋkafm
 	return ec._Source(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNSourceEmit2githubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐSourceEmit(ctx context.Context, sel ast.SelectionSet, v model.SourceEmit) graphql.Marshaler {
+	return ec._SourceEmit(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNSourceEmit2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐSourceEmit(ctx context.Context, sel ast.SelectionSet, v *model.SourceEmit) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._SourceEmit(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNString2string(ctx context.Context, v interface{}) (string, error) {
 	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -9372,6 +12385,36 @@ func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.S
 	return res
 }
 
+func (ec *executionContext) unmarshalNString2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
+
+	return ret
+}
+
 func (ec *executionContext) marshalNTopic2githubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐTopic(ctx context.Context, sel ast.SelectionSet, v model.Topic) graphql.Marshaler {
 	return ec._Topic(ctx, sel, &v)
 }
@@ -9517,6 +12560,20 @@ func (ec *executionContext) marshalNViewSink2ᚖgithubᚗcomᚋsyncromaticsᚋka
 	return ec._ViewSink(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNViewSinkSync2githubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐViewSinkSync(ctx context.Context, sel ast.SelectionSet, v model.ViewSinkSync) graphql.Marshaler {
+	return ec._ViewSinkSync(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNViewSinkSync2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐViewSinkSync(ctx context.Context, sel ast.SelectionSet, v *model.ViewSinkSync) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._ViewSinkSync(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNViewSource2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐViewSourceᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ViewSource) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
@@ -9564,6 +12621,67 @@ func (ec *executionContext) marshalNViewSource2ᚖgithubᚗcomᚋsyncromaticsᚋ
 	return ec._ViewSource(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNViewSourceSync2githubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐViewSourceSync(ctx context.Context, sel ast.SelectionSet, v model.ViewSourceSync) graphql.Marshaler {
+	return ec._ViewSourceSync(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNViewSourceSync2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐViewSourceSync(ctx context.Context, sel ast.SelectionSet, v *model.ViewSourceSync) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._ViewSourceSync(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNViewSourceUpdate2ᚕᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐViewSourceUpdateᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ViewSourceUpdate) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNViewSourceUpdate2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐViewSourceUpdate(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNViewSourceUpdate2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐViewSourceUpdate(ctx context.Context, sel ast.SelectionSet, v *model.ViewSourceUpdate) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._ViewSourceUpdate(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
 	return ec.___Directive(ctx, sel, &v)
 }
@@ -9824,6 +12942,21 @@ func (ec *executionContext) marshalOComponent2ᚖgithubᚗcomᚋsyncromaticsᚋk
 	return ec._Component(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalOFloat2ᚖfloat64(ctx context.Context, v interface{}) (*float64, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalFloat(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOFloat2ᚖfloat64(ctx context.Context, sel ast.SelectionSet, v *float64) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalFloat(*v)
+}
+
 func (ec *executionContext) marshalOService2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐService(ctx context.Context, sel ast.SelectionSet, v *model.Service) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -9906,6 +13039,38 @@ func (ec *executionContext) unmarshalOWatchProcessorInput2ᚖgithubᚗcomᚋsync
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalOWatchSinkInput2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐWatchSinkInput(ctx context.Context, v interface{}) (*model.WatchSinkInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputWatchSinkInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalOWatchSourceInput2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐWatchSourceInput(ctx context.Context, v interface{}) (*model.WatchSourceInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputWatchSourceInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalOWatchViewSinkInput2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐWatchViewSinkInput(ctx context.Context, v interface{}) (*model.WatchViewSinkInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputWatchViewSinkInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalOWatchViewSourceInput2ᚖgithubᚗcomᚋsyncromaticsᚋkafmeshᚋinternalᚋgraphᚋmodelᚐWatchViewSourceInput(ctx context.Context, v interface{}) (*model.WatchViewSourceInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputWatchViewSourceInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -0,0 +1,50 @@
+package loaders
+
+import (
+	"context"
+	"time"
+
+	"github.com/syncromatics/kafmesh/internal/graph/loaders/generated"
+	"github.com/syncromatics/kafmesh/internal/graph/model"
+	"github.com/syncromatics/kafmesh/internal/graph/resolvers"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockgen -source=./consumerLags.go -destination=./consumerLags_mock_test.go -package=loaders_test
+
+// ConsumerLagRepository is the datastore repository for consumer lags
+type ConsumerLagRepository interface {
+	TopicByConsumerLags(context.Context, []int) ([]*model.Topic, error)
+}
+
+var _ resolvers.ConsumerLagLoader = &ConsumerLagLoader{}
+
+// ConsumerLagLoader contains data loaders for consumer lag relationships
+type ConsumerLagLoader struct {
+	topicByConsumerLag *generated.TopicLoader
+}
+
+// NewConsumerLagLoader creates a new consumer lags loader
+func NewConsumerLagLoader(ctx context.Context, repository ConsumerLagRepository, waitTime time.Duration) *ConsumerLagLoader {
+	loader := &ConsumerLagLoader{}
+
+	loader.topicByConsumerLag = generated.NewTopicLoader(generated.TopicLoaderConfig{
+		Wait:     waitTime,
+		MaxBatch: 100,
+		Fetch: func(keys []int) ([]*model.Topic, []error) {
+			r, err := repository.TopicByConsumerLags(ctx, keys)
+			if err != nil {
+				return nil, []error{errors.Wrap(err, "failed to get topic from repository")}
+			}
+			return r, nil
+		},
+	})
+
+	return loader
+}
+
+// TopicByConsumerLag returns the topic for the consumer lag
+func (l *ConsumerLagLoader) TopicByConsumerLag(consumerLagID int) (*model.Topic, error) {
+	return l.topicByConsumerLag.Load(consumerLagID)
+}
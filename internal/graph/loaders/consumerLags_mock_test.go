@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./consumerLags.go
+
+// Package loaders_test is a generated GoMock package.
+package loaders_test
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/syncromatics/kafmesh/internal/graph/model"
+	reflect "reflect"
+)
+
+// MockConsumerLagRepository is a mock of ConsumerLagRepository interface
+type MockConsumerLagRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockConsumerLagRepositoryMockRecorder
+}
+
+// MockConsumerLagRepositoryMockRecorder is the mock recorder for MockConsumerLagRepository
+type MockConsumerLagRepositoryMockRecorder struct {
+	mock *MockConsumerLagRepository
+}
+
+// NewMockConsumerLagRepository creates a new mock instance
+func NewMockConsumerLagRepository(ctrl *gomock.Controller) *MockConsumerLagRepository {
+	mock := &MockConsumerLagRepository{ctrl: ctrl}
+	mock.recorder = &MockConsumerLagRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockConsumerLagRepository) EXPECT() *MockConsumerLagRepositoryMockRecorder {
+	return m.recorder
+}
+
+// TopicByConsumerLags mocks base method
+func (m *MockConsumerLagRepository) TopicByConsumerLags(arg0 context.Context, arg1 []int) ([]*model.Topic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TopicByConsumerLags", arg0, arg1)
+	ret0, _ := ret[0].([]*model.Topic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TopicByConsumerLags indicates an expected call of TopicByConsumerLags
+func (mr *MockConsumerLagRepositoryMockRecorder) TopicByConsumerLags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopicByConsumerLags", reflect.TypeOf((*MockConsumerLagRepository)(nil).TopicByConsumerLags), arg0, arg1)
+}
@@ -0,0 +1,41 @@
+package loaders_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/syncromatics/kafmesh/internal/graph/loaders"
+	"github.com/syncromatics/kafmesh/internal/graph/model"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"gotest.tools/assert"
+)
+
+func Test_ConsumerLag_Topic(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := NewMockConsumerLagRepository(ctrl)
+	repository.EXPECT().
+		TopicByConsumerLags(gomock.Any(), []int{12}).
+		Return([]*model.Topic{
+			&model.Topic{},
+		}, nil).
+		Times(1)
+
+	repository.EXPECT().
+		TopicByConsumerLags(gomock.Any(), []int{13}).
+		Return(nil, errors.Errorf("boom")).
+		Times(1)
+
+	loader := loaders.NewConsumerLagLoader(context.Background(), repository, 10*time.Millisecond)
+
+	r, err := loader.TopicByConsumerLag(12)
+	assert.NilError(t, err)
+	assert.Assert(t, r != nil)
+
+	_, err = loader.TopicByConsumerLag(13)
+	assert.ErrorContains(t, err, "failed to get topic from repository: boom")
+}
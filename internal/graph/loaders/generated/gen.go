@@ -31,3 +31,5 @@ package generated
 //go:generate dataloaden TopicLoader int *github.com/syncromatics/kafmesh/internal/graph/model.Topic
 
 //go:generate dataloaden ComponentSliceLoader int []*github.com/syncromatics/kafmesh/internal/graph/model.Component
+
+//go:generate dataloaden ConsumerLagSliceLoader int []*github.com/syncromatics/kafmesh/internal/graph/model.ConsumerLag
@@ -13,6 +13,7 @@ import (
 // Repositories is a collection of all data repositories
 type Repositories interface {
 	Component() ComponentRepository
+	ConsumerLag() ConsumerLagRepository
 	Service() ServiceRepository
 	Processor() ProcessorRepository
 	ProcessorInput() ProcessorInputRepository
@@ -36,6 +37,7 @@ var ctxKey = ctxKeyType{"loadersCtx"}
 // Loaders is a collection of model loaders
 type Loaders struct {
 	ComponentLoader       *ComponentLoader
+	ConsumerLagLoader     *ConsumerLagLoader
 	ServiceLoader         *ServiceLoader
 	ProcessorLoader       *ProcessorLoader
 	ProcessorInputLoader  *ProcessorInputLoader
@@ -56,6 +58,7 @@ type Loaders struct {
 func NewLoaders(ctx context.Context, repositories Repositories, waitTime time.Duration) *Loaders {
 	return &Loaders{
 		ComponentLoader:       NewComponentLoader(ctx, repositories.Component(), waitTime),
+		ConsumerLagLoader:     NewConsumerLagLoader(ctx, repositories.ConsumerLag(), waitTime),
 		ServiceLoader:         NewServiceLoader(ctx, repositories.Service(), waitTime),
 		ProcessorLoader:       NewProcessorLoader(ctx, repositories.Processor(), waitTime),
 		ProcessorInputLoader:  NewProcessorInputLoader(ctx, repositories.ProcessorInput(), waitTime),
@@ -98,6 +101,11 @@ func (f *LoaderFactory) ComponentLoader(ctx context.Context) resolvers.Component
 	return f.ctxLoaders(ctx).ComponentLoader
 }
 
+// ConsumerLagLoader returns the consumer lag data loader
+func (f *LoaderFactory) ConsumerLagLoader(ctx context.Context) resolvers.ConsumerLagLoader {
+	return f.ctxLoaders(ctx).ConsumerLagLoader
+}
+
 // PodLoader returns the pod data loader
 func (f *LoaderFactory) PodLoader(ctx context.Context) resolvers.PodLoader {
 	return f.ctxLoaders(ctx).PodLoader
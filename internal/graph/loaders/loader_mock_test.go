@@ -47,6 +47,20 @@ func (mr *MockRepositoriesMockRecorder) Component() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Component", reflect.TypeOf((*MockRepositories)(nil).Component))
 }
 
+// ConsumerLag mocks base method
+func (m *MockRepositories) ConsumerLag() loaders.ConsumerLagRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumerLag")
+	ret0, _ := ret[0].(loaders.ConsumerLagRepository)
+	return ret0
+}
+
+// ConsumerLag indicates an expected call of ConsumerLag
+func (mr *MockRepositoriesMockRecorder) ConsumerLag() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumerLag", reflect.TypeOf((*MockRepositories)(nil).ConsumerLag))
+}
+
 // Service mocks base method
 func (m *MockRepositories) Service() loaders.ServiceRepository {
 	m.ctrl.T.Helper()
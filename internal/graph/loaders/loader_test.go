@@ -21,6 +21,9 @@ func Test_Loaders(t *testing.T) {
 	repositories.EXPECT().
 		Component().
 		Times(1)
+	repositories.EXPECT().
+		ConsumerLag().
+		Times(1)
 	repositories.EXPECT().
 		Service().
 		Times(1)
@@ -71,6 +74,7 @@ func Test_Loaders(t *testing.T) {
 		factory := loaders.LoaderFactory{}
 
 		assert.Assert(t, factory.ComponentLoader(r.Context()) != nil)
+		assert.Assert(t, factory.ConsumerLagLoader(r.Context()) != nil)
 		assert.Assert(t, factory.PodLoader(r.Context()) != nil)
 		assert.Assert(t, factory.ProcessorInputLoader(r.Context()) != nil)
 		assert.Assert(t, factory.ProcessorJoinLoader(r.Context()) != nil)
@@ -22,6 +22,7 @@ type ProcessorRepository interface {
 	OutputsByProcessors(ctx context.Context, processors []int) ([][]*model.ProcessorOutput, error)
 	PodsByProcessors(ctx context.Context, processors []int) ([][]*model.Pod, error)
 	PersistenceByProcessors(ctx context.Context, processors []int) ([]*model.Topic, error)
+	LagByProcessors(ctx context.Context, processors []int) ([][]*model.ConsumerLag, error)
 	ByID(context.Context, int) (*model.Processor, error)
 }
 
@@ -36,6 +37,7 @@ type ProcessorLoader struct {
 	outputsByProcessor     *generated.OutputSliceLoader
 	podsByProcessor        *generated.PodSliceLoader
 	persistenceByProcessor *generated.TopicLoader
+	lagByProcessor         *generated.ConsumerLagSliceLoader
 }
 
 // NewProcessorLoader creates a new ProcessorLoader
@@ -133,6 +135,19 @@ func NewProcessorLoader(ctx context.Context, repository ProcessorRepository, wai
 		},
 	})
 
+	loader.lagByProcessor = generated.NewConsumerLagSliceLoader(generated.ConsumerLagSliceLoaderConfig{
+		Wait:     waitTime,
+		MaxBatch: 100,
+		Fetch: func(keys []int) ([][]*model.ConsumerLag, []error) {
+			r, err := repository.LagByProcessors(ctx, keys)
+			if err != nil {
+				return nil, []error{errors.Wrap(err, "failed to get lag from repository")}
+			}
+
+			return r, nil
+		},
+	})
+
 	return loader
 }
 
@@ -170,3 +185,8 @@ func (l *ProcessorLoader) PodsByProcessor(processorID int) ([]*model.Pod, error)
 func (l *ProcessorLoader) PersistenceByProcessor(processorID int) (*model.Topic, error) {
 	return l.persistenceByProcessor.Load(processorID)
 }
+
+// LagByProcessor returns the consumer lag for the processor
+func (l *ProcessorLoader) LagByProcessor(processorID int) ([]*model.ConsumerLag, error) {
+	return l.lagByProcessor.Load(processorID)
+}
@@ -139,6 +139,21 @@ func (mr *MockProcessorRepositoryMockRecorder) PersistenceByProcessors(ctx, proc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PersistenceByProcessors", reflect.TypeOf((*MockProcessorRepository)(nil).PersistenceByProcessors), ctx, processors)
 }
 
+// LagByProcessors mocks base method
+func (m *MockProcessorRepository) LagByProcessors(ctx context.Context, processors []int) ([][]*model.ConsumerLag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LagByProcessors", ctx, processors)
+	ret0, _ := ret[0].([][]*model.ConsumerLag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LagByProcessors indicates an expected call of LagByProcessors
+func (mr *MockProcessorRepositoryMockRecorder) LagByProcessors(ctx, processors interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LagByProcessors", reflect.TypeOf((*MockProcessorRepository)(nil).LagByProcessors), ctx, processors)
+}
+
 // ByID mocks base method
 func (m *MockProcessorRepository) ByID(arg0 context.Context, arg1 int) (*model.Processor, error) {
 	m.ctrl.T.Helper()
@@ -201,3 +201,30 @@ func Test_Processors_Persistence(t *testing.T) {
 	_, err = loader.PersistenceByProcessor(13)
 	assert.ErrorContains(t, err, "failed to get persistence from repository: boom")
 }
+
+func Test_Processors_Lag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := NewMockProcessorRepository(ctrl)
+	repository.EXPECT().
+		LagByProcessors(gomock.Any(), []int{12}).
+		Return([][]*model.ConsumerLag{
+			[]*model.ConsumerLag{&model.ConsumerLag{}},
+		}, nil).
+		Times(1)
+
+	repository.EXPECT().
+		LagByProcessors(gomock.Any(), []int{13}).
+		Return(nil, errors.Errorf("boom")).
+		Times(1)
+
+	loader := loaders.NewProcessorLoader(context.Background(), repository, 10*time.Millisecond)
+
+	r, err := loader.LagByProcessor(12)
+	assert.NilError(t, err)
+	assert.Assert(t, r != nil)
+
+	_, err = loader.LagByProcessor(13)
+	assert.ErrorContains(t, err, "failed to get lag from repository: boom")
+}
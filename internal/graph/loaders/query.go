@@ -18,6 +18,8 @@ type QueryRepository interface {
 	GetAllTopics(context.Context) ([]*model.Topic, error)
 	ServiceByID(context.Context, int) (*model.Service, error)
 	ComponentByID(context.Context, int) (*model.Component, error)
+	LaggingProcessors(context.Context, int) ([]*model.Processor, error)
+	LaggingSinks(context.Context, int) ([]*model.Sink, error)
 }
 
 var _ resolvers.QueryLoader = &QueryLoader{}
@@ -77,3 +79,21 @@ func (l *QueryLoader) ComponentByID(id int) (*model.Component, error) {
 	}
 	return results, nil
 }
+
+// LaggingProcessors returns the processors lagging by more messages than the threshold
+func (l *QueryLoader) LaggingProcessors(threshold int) ([]*model.Processor, error) {
+	results, err := l.repository.LaggingProcessors(l.ctx, threshold)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get lagging processors from repository")
+	}
+	return results, nil
+}
+
+// LaggingSinks returns the sinks lagging by more messages than the threshold
+func (l *QueryLoader) LaggingSinks(threshold int) ([]*model.Sink, error) {
+	results, err := l.repository.LaggingSinks(l.ctx, threshold)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get lagging sinks from repository")
+	}
+	return results, nil
+}
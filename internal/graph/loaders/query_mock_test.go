@@ -108,3 +108,33 @@ func (mr *MockQueryRepositoryMockRecorder) ComponentByID(arg0, arg1 interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComponentByID", reflect.TypeOf((*MockQueryRepository)(nil).ComponentByID), arg0, arg1)
 }
+
+// LaggingProcessors mocks base method
+func (m *MockQueryRepository) LaggingProcessors(arg0 context.Context, arg1 int) ([]*model.Processor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LaggingProcessors", arg0, arg1)
+	ret0, _ := ret[0].([]*model.Processor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LaggingProcessors indicates an expected call of LaggingProcessors
+func (mr *MockQueryRepositoryMockRecorder) LaggingProcessors(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LaggingProcessors", reflect.TypeOf((*MockQueryRepository)(nil).LaggingProcessors), arg0, arg1)
+}
+
+// LaggingSinks mocks base method
+func (m *MockQueryRepository) LaggingSinks(arg0 context.Context, arg1 int) ([]*model.Sink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LaggingSinks", arg0, arg1)
+	ret0, _ := ret[0].([]*model.Sink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LaggingSinks indicates an expected call of LaggingSinks
+func (mr *MockQueryRepositoryMockRecorder) LaggingSinks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LaggingSinks", reflect.TypeOf((*MockQueryRepository)(nil).LaggingSinks), arg0, arg1)
+}
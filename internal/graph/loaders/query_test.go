@@ -182,3 +182,69 @@ func Test_Query_ComponentByIDShouldReturnError(t *testing.T) {
 	_, err := loader.ComponentByID(12)
 	assert.ErrorContains(t, err, "failed to get component by id from repository: boom")
 }
+
+func Test_Query_LaggingProcessors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := NewMockQueryRepository(ctrl)
+	repository.EXPECT().
+		LaggingProcessors(gomock.Any(), 100).
+		Return([]*model.Processor{}, nil).
+		Times(1)
+
+	loader := loaders.NewQueryLoader(context.Background(), repository)
+
+	r, err := loader.LaggingProcessors(100)
+	assert.NilError(t, err)
+	assert.Assert(t, r != nil)
+}
+
+func Test_Query_LaggingProcessorsShouldReturnError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := NewMockQueryRepository(ctrl)
+	repository.EXPECT().
+		LaggingProcessors(gomock.Any(), 100).
+		Return(nil, errors.Errorf("boom")).
+		Times(1)
+
+	loader := loaders.NewQueryLoader(context.Background(), repository)
+
+	_, err := loader.LaggingProcessors(100)
+	assert.ErrorContains(t, err, "failed to get lagging processors from repository: boom")
+}
+
+func Test_Query_LaggingSinks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := NewMockQueryRepository(ctrl)
+	repository.EXPECT().
+		LaggingSinks(gomock.Any(), 100).
+		Return([]*model.Sink{}, nil).
+		Times(1)
+
+	loader := loaders.NewQueryLoader(context.Background(), repository)
+
+	r, err := loader.LaggingSinks(100)
+	assert.NilError(t, err)
+	assert.Assert(t, r != nil)
+}
+
+func Test_Query_LaggingSinksShouldReturnError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := NewMockQueryRepository(ctrl)
+	repository.EXPECT().
+		LaggingSinks(gomock.Any(), 100).
+		Return(nil, errors.Errorf("boom")).
+		Times(1)
+
+	loader := loaders.NewQueryLoader(context.Background(), repository)
+
+	_, err := loader.LaggingSinks(100)
+	assert.ErrorContains(t, err, "failed to get lagging sinks from repository: boom")
+}
@@ -18,6 +18,8 @@ type SinkRepository interface {
 	ComponentBySinks(ctx context.Context, sinks []int) ([]*model.Component, error)
 	PodsBySinks(ctx context.Context, sinks []int) ([][]*model.Pod, error)
 	TopicBySinks(ctx context.Context, sinks []int) ([]*model.Topic, error)
+	LagBySinks(ctx context.Context, sinks []int) ([][]*model.ConsumerLag, error)
+	ByID(context.Context, int) (*model.Sink, error)
 }
 
 var _ resolvers.SinkLoader = &SinkLoader{}
@@ -27,6 +29,7 @@ type SinkLoader struct {
 	componentBySink *generated.ComponentLoader
 	podsBySink      *generated.PodSliceLoader
 	topicBySink     *generated.TopicLoader
+	lagBySink       *generated.ConsumerLagSliceLoader
 }
 
 // NewSinkLoader creates a new SinkLoader
@@ -72,6 +75,19 @@ func NewSinkLoader(ctx context.Context, repository SinkRepository, waitTime time
 		},
 	})
 
+	loader.lagBySink = generated.NewConsumerLagSliceLoader(generated.ConsumerLagSliceLoaderConfig{
+		Wait:     waitTime,
+		MaxBatch: 100,
+		Fetch: func(keys []int) ([][]*model.ConsumerLag, []error) {
+			r, err := repository.LagBySinks(ctx, keys)
+			if err != nil {
+				return nil, []error{errors.Wrap(err, "failed to get lag from repository")}
+			}
+
+			return r, nil
+		},
+	})
+
 	return loader
 }
 
@@ -89,3 +105,8 @@ func (l *SinkLoader) PodsBySink(sinkID int) ([]*model.Pod, error) {
 func (l *SinkLoader) TopicBySink(sinkID int) (*model.Topic, error) {
 	return l.topicBySink.Load(sinkID)
 }
+
+// LagBySink returns the consumer lag for the sink
+func (l *SinkLoader) LagBySink(sinkID int) ([]*model.ConsumerLag, error) {
+	return l.lagBySink.Load(sinkID)
+}
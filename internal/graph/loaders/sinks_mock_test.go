@@ -78,3 +78,33 @@ func (mr *MockSinkRepositoryMockRecorder) TopicBySinks(ctx, sinks interface{}) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopicBySinks", reflect.TypeOf((*MockSinkRepository)(nil).TopicBySinks), ctx, sinks)
 }
+
+// LagBySinks mocks base method
+func (m *MockSinkRepository) LagBySinks(ctx context.Context, sinks []int) ([][]*model.ConsumerLag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LagBySinks", ctx, sinks)
+	ret0, _ := ret[0].([][]*model.ConsumerLag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LagBySinks indicates an expected call of LagBySinks
+func (mr *MockSinkRepositoryMockRecorder) LagBySinks(ctx, sinks interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LagBySinks", reflect.TypeOf((*MockSinkRepository)(nil).LagBySinks), ctx, sinks)
+}
+
+// ByID mocks base method
+func (m *MockSinkRepository) ByID(arg0 context.Context, arg1 int) (*model.Sink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByID", arg0, arg1)
+	ret0, _ := ret[0].(*model.Sink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByID indicates an expected call of ByID
+func (mr *MockSinkRepositoryMockRecorder) ByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByID", reflect.TypeOf((*MockSinkRepository)(nil).ByID), arg0, arg1)
+}
@@ -93,3 +93,30 @@ func Test_Sinks_Topict(t *testing.T) {
 	_, err = loader.TopicBySink(13)
 	assert.ErrorContains(t, err, "failed to get topic from repository: boom")
 }
+
+func Test_Sinks_Lag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := NewMockSinkRepository(ctrl)
+	repository.EXPECT().
+		LagBySinks(gomock.Any(), []int{12}).
+		Return([][]*model.ConsumerLag{
+			[]*model.ConsumerLag{&model.ConsumerLag{}},
+		}, nil).
+		Times(1)
+
+	repository.EXPECT().
+		LagBySinks(gomock.Any(), []int{13}).
+		Return(nil, errors.Errorf("boom")).
+		Times(1)
+
+	loader := loaders.NewSinkLoader(context.Background(), repository, 10*time.Millisecond)
+
+	r, err := loader.LagBySink(12)
+	assert.NilError(t, err)
+	assert.Assert(t, r != nil)
+
+	_, err = loader.LagBySink(13)
+	assert.ErrorContains(t, err, "failed to get lag from repository: boom")
+}
@@ -25,6 +25,7 @@ type TopicRepository interface {
 	ViewSinksByTopics(ctx context.Context, topics []int) ([][]*model.ViewSink, error)
 	ViewSourcesByTopics(ctx context.Context, topics []int) ([][]*model.ViewSource, error)
 	ViewsByTopics(ctx context.Context, topics []int) ([][]*model.View, error)
+	LagByTopics(ctx context.Context, topics []int) ([][]*model.ConsumerLag, error)
 }
 
 var _ resolvers.TopicLoader = &TopicLoader{}
@@ -41,6 +42,7 @@ type TopicLoader struct {
 	viewSinksByTopic             *generated.ViewSinkSliceLoader
 	viewSourcesByTopic           *generated.ViewSourceSliceLoader
 	viewsByTopic                 *generated.ViewSliceLoader
+	lagByTopic                   *generated.ConsumerLagSliceLoader
 }
 
 // NewTopicLoader creates a new TopicLoader
@@ -177,6 +179,19 @@ func NewTopicLoader(ctx context.Context, repository TopicRepository, waitTime ti
 		},
 	})
 
+	loader.lagByTopic = generated.NewConsumerLagSliceLoader(generated.ConsumerLagSliceLoaderConfig{
+		Wait:     waitTime,
+		MaxBatch: 100,
+		Fetch: func(keys []int) ([][]*model.ConsumerLag, []error) {
+			r, err := repository.LagByTopics(ctx, keys)
+			if err != nil {
+				return nil, []error{errors.Wrap(err, "failed to get lag from repository")}
+			}
+
+			return r, nil
+		},
+	})
+
 	return loader
 }
 
@@ -229,3 +244,8 @@ func (l *TopicLoader) ViewSourcesByTopic(topicID int) ([]*model.ViewSource, erro
 func (l *TopicLoader) ViewsByTopic(topicID int) ([]*model.View, error) {
 	return l.viewsByTopic.Load(topicID)
 }
+
+// LagByTopic returns the consumer lag for the topic
+func (l *TopicLoader) LagByTopic(topicID int) ([]*model.ConsumerLag, error) {
+	return l.lagByTopic.Load(topicID)
+}
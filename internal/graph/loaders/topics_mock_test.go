@@ -183,3 +183,18 @@ func (mr *MockTopicRepositoryMockRecorder) ViewsByTopics(ctx, topics interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewsByTopics", reflect.TypeOf((*MockTopicRepository)(nil).ViewsByTopics), ctx, topics)
 }
+
+// LagByTopics mocks base method
+func (m *MockTopicRepository) LagByTopics(ctx context.Context, topics []int) ([][]*model.ConsumerLag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LagByTopics", ctx, topics)
+	ret0, _ := ret[0].([][]*model.ConsumerLag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LagByTopics indicates an expected call of LagByTopics
+func (mr *MockTopicRepositoryMockRecorder) LagByTopics(ctx, topics interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LagByTopics", reflect.TypeOf((*MockTopicRepository)(nil).LagByTopics), ctx, topics)
+}
@@ -282,3 +282,30 @@ func Test_Topics_Views(t *testing.T) {
 	_, err = loader.ViewsByTopic(13)
 	assert.ErrorContains(t, err, "failed to get views from repository: boom")
 }
+
+func Test_Topics_Lag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := NewMockTopicRepository(ctrl)
+	repository.EXPECT().
+		LagByTopics(gomock.Any(), []int{12}).
+		Return([][]*model.ConsumerLag{
+			[]*model.ConsumerLag{&model.ConsumerLag{}},
+		}, nil).
+		Times(1)
+
+	repository.EXPECT().
+		LagByTopics(gomock.Any(), []int{13}).
+		Return(nil, errors.Errorf("boom")).
+		Times(1)
+
+	loader := loaders.NewTopicLoader(context.Background(), repository, 10*time.Millisecond)
+
+	r, err := loader.LagByTopic(12)
+	assert.NilError(t, err)
+	assert.Assert(t, r != nil)
+
+	_, err = loader.LagByTopic(13)
+	assert.ErrorContains(t, err, "failed to get lag from repository: boom")
+}
@@ -18,6 +18,7 @@ type ViewSinkRepository interface {
 	ComponentByViewSinks(ctx context.Context, viewSinks []int) ([]*model.Component, error)
 	PodsByViewSinks(ctx context.Context, viewSinks []int) ([][]*model.Pod, error)
 	TopicByViewSinks(ctx context.Context, viewSinks []int) ([]*model.Topic, error)
+	ByID(context.Context, int) (*model.ViewSink, error)
 }
 
 var _ resolvers.ViewSinkLoader = &ViewSinkLoader{}
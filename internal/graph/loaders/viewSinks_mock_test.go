@@ -78,3 +78,18 @@ func (mr *MockViewSinkRepositoryMockRecorder) TopicByViewSinks(ctx, viewSinks in
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopicByViewSinks", reflect.TypeOf((*MockViewSinkRepository)(nil).TopicByViewSinks), ctx, viewSinks)
 }
+
+// ByID mocks base method
+func (m *MockViewSinkRepository) ByID(arg0 context.Context, arg1 int) (*model.ViewSink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByID", arg0, arg1)
+	ret0, _ := ret[0].(*model.ViewSink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByID indicates an expected call of ByID
+func (mr *MockViewSinkRepositoryMockRecorder) ByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByID", reflect.TypeOf((*MockViewSinkRepository)(nil).ByID), arg0, arg1)
+}
@@ -18,6 +18,7 @@ type ViewSourceRepository interface {
 	ComponentByViewSources(ctx context.Context, viewSources []int) ([]*model.Component, error)
 	PodsByViewSources(ctx context.Context, viewSources []int) ([][]*model.Pod, error)
 	TopicByViewSources(ctx context.Context, viewSources []int) ([]*model.Topic, error)
+	ByID(context.Context, int) (*model.ViewSource, error)
 }
 
 var _ resolvers.ViewSourceLoader = &ViewSourceLoader{}
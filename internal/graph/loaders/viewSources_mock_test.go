@@ -78,3 +78,18 @@ func (mr *MockViewSourceRepositoryMockRecorder) TopicByViewSources(ctx, viewSour
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopicByViewSources", reflect.TypeOf((*MockViewSourceRepository)(nil).TopicByViewSources), ctx, viewSources)
 }
+
+// ByID mocks base method
+func (m *MockViewSourceRepository) ByID(arg0 context.Context, arg1 int) (*model.ViewSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByID", arg0, arg1)
+	ret0, _ := ret[0].(*model.ViewSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByID indicates an expected call of ByID
+func (mr *MockViewSourceRepositoryMockRecorder) ByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByID", reflect.TypeOf((*MockViewSourceRepository)(nil).ByID), arg0, arg1)
+}
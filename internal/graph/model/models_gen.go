@@ -6,6 +6,10 @@ type Action interface {
 	IsAction()
 }
 
+type SinkEvent interface {
+	IsSinkEvent()
+}
+
 type Component struct {
 	ID          int           `json:"id"`
 	Service     *Service      `json:"service"`
@@ -20,6 +24,16 @@ type Component struct {
 	DependsOn   []*Component  `json:"dependsOn"`
 }
 
+type ConsumerLag struct {
+	ID              int    `json:"id"`
+	GroupName       string `json:"groupName"`
+	Topic           *Topic `json:"topic"`
+	Partition       int    `json:"partition"`
+	CommittedOffset int    `json:"committedOffset"`
+	HighWatermark   int    `json:"highWatermark"`
+	Lag             int    `json:"lag"`
+}
+
 type GetState struct {
 	Topic   string `json:"topic"`
 	Message string `json:"message"`
@@ -42,6 +56,11 @@ type Join struct {
 
 func (Join) IsAction() {}
 
+type Lagging struct {
+	Processors []*Processor `json:"processors"`
+	Sinks      []*Sink      `json:"sinks"`
+}
+
 type Lookup struct {
 	Topic   string `json:"topic"`
 	Message string `json:"message"`
@@ -67,6 +86,11 @@ type Output struct {
 
 func (Output) IsAction() {}
 
+type PartitionOffset struct {
+	Partition int `json:"partition"`
+	Offset    int `json:"offset"`
+}
+
 type Pod struct {
 	ID          int           `json:"id"`
 	Name        string        `json:"name"`
@@ -90,6 +114,7 @@ type Processor struct {
 	Joins       []*ProcessorJoin   `json:"joins"`
 	Lookups     []*ProcessorLookup `json:"lookups"`
 	Outputs     []*ProcessorOutput `json:"outputs"`
+	Lag         []*ConsumerLag     `json:"lag"`
 }
 
 type ProcessorInput struct {
@@ -133,14 +158,42 @@ type SetState struct {
 func (SetState) IsAction() {}
 
 type Sink struct {
-	ID          int        `json:"id"`
-	Component   *Component `json:"component"`
-	Name        string     `json:"name"`
-	Description string     `json:"description"`
-	Topic       *Topic     `json:"topic"`
-	Pods        []*Pod     `json:"pods"`
+	ID          int            `json:"id"`
+	Component   *Component     `json:"component"`
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	GroupName   string         `json:"groupName"`
+	Topic       *Topic         `json:"topic"`
+	Pods        []*Pod         `json:"pods"`
+	Lag         []*ConsumerLag `json:"lag"`
+}
+
+type SinkCollect struct {
+	Time      int    `json:"time"`
+	Partition int    `json:"partition"`
+	Offset    int    `json:"offset"`
+	Key       string `json:"key"`
+	Value     string `json:"value"`
 }
 
+func (SinkCollect) IsSinkEvent() {}
+
+type SinkCommit struct {
+	Time    int                `json:"time"`
+	Offsets []*PartitionOffset `json:"offsets"`
+}
+
+func (SinkCommit) IsSinkEvent() {}
+
+type SinkFlush struct {
+	Time     int     `json:"time"`
+	Count    int     `json:"count"`
+	Attempts int     `json:"attempts"`
+	Error    *string `json:"error"`
+}
+
+func (SinkFlush) IsSinkEvent() {}
+
 type Source struct {
 	ID        int        `json:"id"`
 	Component *Component `json:"component"`
@@ -148,6 +201,14 @@ type Source struct {
 	Pods      []*Pod     `json:"pods"`
 }
 
+type SourceEmit struct {
+	Time   int     `json:"time"`
+	Key    string  `json:"key"`
+	Value  *string `json:"value"`
+	Delete bool    `json:"delete"`
+	Error  *string `json:"error"`
+}
+
 type Topic struct {
 	ID                    int                `json:"id"`
 	Name                  string             `json:"name"`
@@ -162,6 +223,7 @@ type Topic struct {
 	ViewSinks             []*ViewSink        `json:"viewSinks"`
 	ViewSources           []*ViewSource      `json:"viewSources"`
 	Views                 []*View            `json:"views"`
+	Lag                   []*ConsumerLag     `json:"lag"`
 }
 
 type View struct {
@@ -180,6 +242,12 @@ type ViewSink struct {
 	Pods        []*Pod     `json:"pods"`
 }
 
+type ViewSinkSync struct {
+	StartTime int     `json:"startTime"`
+	EndTime   int     `json:"endTime"`
+	Error     *string `json:"error"`
+}
+
 type ViewSource struct {
 	ID          int        `json:"id"`
 	Component   *Component `json:"component"`
@@ -189,7 +257,39 @@ type ViewSource struct {
 	Pods        []*Pod     `json:"pods"`
 }
 
+type ViewSourceSync struct {
+	StartTime int                 `json:"startTime"`
+	EndTime   int                 `json:"endTime"`
+	Updates   []*ViewSourceUpdate `json:"updates"`
+	Deletes   []string            `json:"deletes"`
+	Error     *string             `json:"error"`
+}
+
+type ViewSourceUpdate struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
 type WatchProcessorInput struct {
-	ProcessorID int    `json:"processorId"`
-	Key         string `json:"key"`
+	ProcessorID int      `json:"processorId"`
+	Key         *string  `json:"key"`
+	KeyPrefix   *string  `json:"keyPrefix"`
+	KeyRegex    *string  `json:"keyRegex"`
+	SampleRate  *float64 `json:"sampleRate"`
+}
+
+type WatchSinkInput struct {
+	SinkID int `json:"sinkId"`
+}
+
+type WatchSourceInput struct {
+	SourceID int `json:"sourceId"`
+}
+
+type WatchViewSinkInput struct {
+	ViewSinkID int `json:"viewSinkId"`
+}
+
+type WatchViewSourceInput struct {
+	ViewSourceID int `json:"viewSourceId"`
 }
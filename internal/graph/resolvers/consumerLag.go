@@ -0,0 +1,33 @@
+package resolvers
+
+import (
+	"context"
+
+	"github.com/syncromatics/kafmesh/internal/graph/generated"
+	"github.com/syncromatics/kafmesh/internal/graph/model"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockgen -source=./consumerLag.go -destination=./consumerLag_mock_test.go -package=resolvers_test
+
+// ConsumerLagLoader is the dataloaders for a consumer lag
+type ConsumerLagLoader interface {
+	TopicByConsumerLag(int) (*model.Topic, error)
+}
+
+var _ generated.ConsumerLagResolver = &ConsumerLagResolver{}
+
+// ConsumerLagResolver resolves the consumer lag's relationships
+type ConsumerLagResolver struct {
+	*Resolver
+}
+
+// Topic returns the consumer lag's topic
+func (r *ConsumerLagResolver) Topic(ctx context.Context, lag *model.ConsumerLag) (*model.Topic, error) {
+	result, err := r.DataLoaders.ConsumerLagLoader(ctx).TopicByConsumerLag(lag.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get topic from loader")
+	}
+	return result, nil
+}
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./consumerLag.go
+
+// Package resolvers_test is a generated GoMock package.
+package resolvers_test
+
+import (
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/syncromatics/kafmesh/internal/graph/model"
+	reflect "reflect"
+)
+
+// MockConsumerLagLoader is a mock of ConsumerLagLoader interface
+type MockConsumerLagLoader struct {
+	ctrl     *gomock.Controller
+	recorder *MockConsumerLagLoaderMockRecorder
+}
+
+// MockConsumerLagLoaderMockRecorder is the mock recorder for MockConsumerLagLoader
+type MockConsumerLagLoaderMockRecorder struct {
+	mock *MockConsumerLagLoader
+}
+
+// NewMockConsumerLagLoader creates a new mock instance
+func NewMockConsumerLagLoader(ctrl *gomock.Controller) *MockConsumerLagLoader {
+	mock := &MockConsumerLagLoader{ctrl: ctrl}
+	mock.recorder = &MockConsumerLagLoaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockConsumerLagLoader) EXPECT() *MockConsumerLagLoaderMockRecorder {
+	return m.recorder
+}
+
+// TopicByConsumerLag mocks base method
+func (m *MockConsumerLagLoader) TopicByConsumerLag(arg0 int) (*model.Topic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TopicByConsumerLag", arg0)
+	ret0, _ := ret[0].(*model.Topic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TopicByConsumerLag indicates an expected call of TopicByConsumerLag
+func (mr *MockConsumerLagLoaderMockRecorder) TopicByConsumerLag(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopicByConsumerLag", reflect.TypeOf((*MockConsumerLagLoader)(nil).TopicByConsumerLag), arg0)
+}
@@ -0,0 +1,48 @@
+package resolvers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/syncromatics/kafmesh/internal/graph/model"
+	"github.com/syncromatics/kafmesh/internal/graph/resolvers"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"gotest.tools/assert"
+)
+
+func Test_ConsumerLag_Topic(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loader := NewMockConsumerLagLoader(ctrl)
+	loaders := NewMockDataLoaders(ctrl)
+	loaders.EXPECT().
+		ConsumerLagLoader(gomock.Any()).
+		Return(loader).
+		Times(2)
+
+	resolver := &resolvers.ConsumerLagResolver{
+		Resolver: &resolvers.Resolver{
+			DataLoaders: loaders,
+		},
+	}
+
+	loader.EXPECT().
+		TopicByConsumerLag(12).
+		Return(&model.Topic{}, nil).
+		Times(1)
+
+	loader.EXPECT().
+		TopicByConsumerLag(13).
+		Return(nil, errors.Errorf("boom")).
+		Times(1)
+
+	r, err := resolver.Topic(context.Background(), &model.ConsumerLag{ID: 12})
+	assert.NilError(t, err)
+	assert.Assert(t, r != nil)
+
+	_, err = resolver.Topic(context.Background(), &model.ConsumerLag{ID: 13})
+	assert.ErrorContains(t, err, "failed to get topic from loader: boom")
+}
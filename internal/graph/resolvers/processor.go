@@ -20,6 +20,7 @@ type ProcessorLoader interface {
 	OutputsByProcessor(int) ([]*model.ProcessorOutput, error)
 	PersistenceByProcessor(int) (*model.Topic, error)
 	PodsByProcessor(int) ([]*model.Pod, error)
+	LagByProcessor(int) ([]*model.ConsumerLag, error)
 }
 
 var _ generated.ProcessorResolver = &ProcessorResolver{}
@@ -91,3 +92,12 @@ func (r *ProcessorResolver) Pods(ctx context.Context, processor *model.Processor
 	}
 	return result, nil
 }
+
+// Lag returns the processor's consumer lag
+func (r *ProcessorResolver) Lag(ctx context.Context, processor *model.Processor) ([]*model.ConsumerLag, error) {
+	result, err := r.DataLoaders.ProcessorLoader(ctx).LagByProcessor(processor.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get lag from loader")
+	}
+	return result, nil
+}
@@ -137,3 +137,18 @@ func (mr *MockProcessorLoaderMockRecorder) PodsByProcessor(arg0 interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PodsByProcessor", reflect.TypeOf((*MockProcessorLoader)(nil).PodsByProcessor), arg0)
 }
+
+// LagByProcessor mocks base method
+func (m *MockProcessorLoader) LagByProcessor(arg0 int) ([]*model.ConsumerLag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LagByProcessor", arg0)
+	ret0, _ := ret[0].([]*model.ConsumerLag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LagByProcessor indicates an expected call of LagByProcessor
+func (mr *MockProcessorLoaderMockRecorder) LagByProcessor(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LagByProcessor", reflect.TypeOf((*MockProcessorLoader)(nil).LagByProcessor), arg0)
+}
@@ -256,3 +256,38 @@ func Test_Processor_Pods(t *testing.T) {
 	_, err = resolver.Pods(context.Background(), &model.Processor{ID: 13})
 	assert.ErrorContains(t, err, "failed to get pods from loader: boom")
 }
+
+func Test_Processor_Lag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loader := NewMockProcessorLoader(ctrl)
+	loaders := NewMockDataLoaders(ctrl)
+	loaders.EXPECT().
+		ProcessorLoader(gomock.Any()).
+		Return(loader).
+		Times(2)
+
+	resolver := &resolvers.ProcessorResolver{
+		Resolver: &resolvers.Resolver{
+			DataLoaders: loaders,
+		},
+	}
+
+	loader.EXPECT().
+		LagByProcessor(12).
+		Return([]*model.ConsumerLag{}, nil).
+		Times(1)
+
+	loader.EXPECT().
+		LagByProcessor(13).
+		Return(nil, errors.Errorf("boom")).
+		Times(1)
+
+	r, err := resolver.Lag(context.Background(), &model.Processor{ID: 12})
+	assert.NilError(t, err)
+	assert.Assert(t, r != nil)
+
+	_, err = resolver.Lag(context.Background(), &model.Processor{ID: 13})
+	assert.ErrorContains(t, err, "failed to get lag from loader: boom")
+}
@@ -18,6 +18,8 @@ type QueryLoader interface {
 	GetAllTopics() ([]*model.Topic, error)
 	ServiceByID(int) (*model.Service, error)
 	ComponentByID(int) (*model.Component, error)
+	LaggingProcessors(int) ([]*model.Processor, error)
+	LaggingSinks(int) ([]*model.Sink, error)
 }
 
 var _ generated.QueryResolver = &QueryResolver{}
@@ -71,3 +73,21 @@ func (r *QueryResolver) ComponentByID(ctx context.Context, id int) (*model.Compo
 	}
 	return result, nil
 }
+
+// Lagging gets the processors and sinks lagging by more messages than the threshold
+func (r *QueryResolver) Lagging(ctx context.Context, threshold int) (*model.Lagging, error) {
+	processors, err := r.DataLoaders.QueryLoader(ctx).LaggingProcessors(threshold)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get lagging processors from loader")
+	}
+
+	sinks, err := r.DataLoaders.QueryLoader(ctx).LaggingSinks(threshold)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get lagging sinks from loader")
+	}
+
+	return &model.Lagging{
+		Processors: processors,
+		Sinks:      sinks,
+	}, nil
+}
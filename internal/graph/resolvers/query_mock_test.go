@@ -107,3 +107,33 @@ func (mr *MockQueryLoaderMockRecorder) ComponentByID(arg0 interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComponentByID", reflect.TypeOf((*MockQueryLoader)(nil).ComponentByID), arg0)
 }
+
+// LaggingProcessors mocks base method
+func (m *MockQueryLoader) LaggingProcessors(arg0 int) ([]*model.Processor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LaggingProcessors", arg0)
+	ret0, _ := ret[0].([]*model.Processor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LaggingProcessors indicates an expected call of LaggingProcessors
+func (mr *MockQueryLoaderMockRecorder) LaggingProcessors(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LaggingProcessors", reflect.TypeOf((*MockQueryLoader)(nil).LaggingProcessors), arg0)
+}
+
+// LaggingSinks mocks base method
+func (m *MockQueryLoader) LaggingSinks(arg0 int) ([]*model.Sink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LaggingSinks", arg0)
+	ret0, _ := ret[0].([]*model.Sink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LaggingSinks indicates an expected call of LaggingSinks
+func (mr *MockQueryLoaderMockRecorder) LaggingSinks(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LaggingSinks", reflect.TypeOf((*MockQueryLoader)(nil).LaggingSinks), arg0)
+}
@@ -276,3 +276,77 @@ func Test_Query_ComponentByIDShouldReturnError(t *testing.T) {
 	_, err := resolver.ComponentByID(context.Background(), 12)
 	assert.ErrorContains(t, err, "failed to get component by id from loader: boom")
 }
+
+func Test_Query_Lagging(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loader := NewMockQueryLoader(ctrl)
+	loaders := NewMockDataLoaders(ctrl)
+	loaders.EXPECT().
+		QueryLoader(gomock.Any()).
+		Return(loader).
+		Times(2)
+
+	resolver := &resolvers.QueryResolver{
+		Resolver: &resolvers.Resolver{
+			DataLoaders: loaders,
+		},
+	}
+
+	loader.EXPECT().
+		LaggingProcessors(100).
+		Return([]*model.Processor{&model.Processor{ID: 1}}, nil).
+		Times(1)
+
+	loader.EXPECT().
+		LaggingSinks(100).
+		Return([]*model.Sink{&model.Sink{ID: 2}}, nil).
+		Times(1)
+
+	r, err := resolver.Lagging(context.Background(), 100)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, r, &model.Lagging{
+		Processors: []*model.Processor{&model.Processor{ID: 1}},
+		Sinks:      []*model.Sink{&model.Sink{ID: 2}},
+	})
+}
+
+func Test_Query_LaggingShouldReturnError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loader := NewMockQueryLoader(ctrl)
+	loaders := NewMockDataLoaders(ctrl)
+	loaders.EXPECT().
+		QueryLoader(gomock.Any()).
+		Return(loader).
+		Times(3)
+
+	resolver := &resolvers.QueryResolver{
+		Resolver: &resolvers.Resolver{
+			DataLoaders: loaders,
+		},
+	}
+
+	loader.EXPECT().
+		LaggingProcessors(100).
+		Return(nil, errors.Errorf("boom")).
+		Times(1)
+
+	_, err := resolver.Lagging(context.Background(), 100)
+	assert.ErrorContains(t, err, "failed to get lagging processors from loader: boom")
+
+	loader.EXPECT().
+		LaggingProcessors(100).
+		Return([]*model.Processor{}, nil).
+		Times(1)
+
+	loader.EXPECT().
+		LaggingSinks(100).
+		Return(nil, errors.Errorf("boom")).
+		Times(1)
+
+	_, err = resolver.Lagging(context.Background(), 100)
+	assert.ErrorContains(t, err, "failed to get lagging sinks from loader: boom")
+}
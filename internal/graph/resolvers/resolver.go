@@ -11,6 +11,7 @@ import (
 // DataLoaders provides data loaders for models from the context
 type DataLoaders interface {
 	ComponentLoader(context.Context) ComponentLoader
+	ConsumerLagLoader(context.Context) ConsumerLagLoader
 	PodLoader(context.Context) PodLoader
 	ProcessorLoader(context.Context) ProcessorLoader
 	ProcessorInputLoader(context.Context) ProcessorInputLoader
@@ -30,6 +31,10 @@ type DataLoaders interface {
 // Subscribers provides subcription handlers
 type Subscribers interface {
 	Processor() ProcessorWatcher
+	Sink() SinkWatcher
+	Source() SourceWatcher
+	ViewSource() ViewSourceWatcher
+	ViewSink() ViewSinkWatcher
 }
 
 var _ generated.ResolverRoot = &Resolver{}
@@ -63,6 +68,11 @@ func (r *Resolver) Component() generated.ComponentResolver {
 	return &ComponentResolver{r}
 }
 
+// ConsumerLag returns the consumer lag resolver
+func (r *Resolver) ConsumerLag() generated.ConsumerLagResolver {
+	return &ConsumerLagResolver{r}
+}
+
 // Pod returns the pod resolver
 func (r *Resolver) Pod() generated.PodResolver {
 	return &PodResolver{r}
@@ -48,6 +48,20 @@ func (mr *MockDataLoadersMockRecorder) ComponentLoader(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComponentLoader", reflect.TypeOf((*MockDataLoaders)(nil).ComponentLoader), arg0)
 }
 
+// ConsumerLagLoader mocks base method
+func (m *MockDataLoaders) ConsumerLagLoader(arg0 context.Context) resolvers.ConsumerLagLoader {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumerLagLoader", arg0)
+	ret0, _ := ret[0].(resolvers.ConsumerLagLoader)
+	return ret0
+}
+
+// ConsumerLagLoader indicates an expected call of ConsumerLagLoader
+func (mr *MockDataLoadersMockRecorder) ConsumerLagLoader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumerLagLoader", reflect.TypeOf((*MockDataLoaders)(nil).ConsumerLagLoader), arg0)
+}
+
 // PodLoader mocks base method
 func (m *MockDataLoaders) PodLoader(arg0 context.Context) resolvers.PodLoader {
 	m.ctrl.T.Helper()
@@ -280,3 +294,59 @@ func (mr *MockSubscribersMockRecorder) Processor() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Processor", reflect.TypeOf((*MockSubscribers)(nil).Processor))
 }
+
+// Sink mocks base method
+func (m *MockSubscribers) Sink() resolvers.SinkWatcher {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sink")
+	ret0, _ := ret[0].(resolvers.SinkWatcher)
+	return ret0
+}
+
+// Sink indicates an expected call of Sink
+func (mr *MockSubscribersMockRecorder) Sink() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sink", reflect.TypeOf((*MockSubscribers)(nil).Sink))
+}
+
+// Source mocks base method
+func (m *MockSubscribers) Source() resolvers.SourceWatcher {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Source")
+	ret0, _ := ret[0].(resolvers.SourceWatcher)
+	return ret0
+}
+
+// Source indicates an expected call of Source
+func (mr *MockSubscribersMockRecorder) Source() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Source", reflect.TypeOf((*MockSubscribers)(nil).Source))
+}
+
+// ViewSource mocks base method
+func (m *MockSubscribers) ViewSource() resolvers.ViewSourceWatcher {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ViewSource")
+	ret0, _ := ret[0].(resolvers.ViewSourceWatcher)
+	return ret0
+}
+
+// ViewSource indicates an expected call of ViewSource
+func (mr *MockSubscribersMockRecorder) ViewSource() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewSource", reflect.TypeOf((*MockSubscribers)(nil).ViewSource))
+}
+
+// ViewSink mocks base method
+func (m *MockSubscribers) ViewSink() resolvers.ViewSinkWatcher {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ViewSink")
+	ret0, _ := ret[0].(resolvers.ViewSinkWatcher)
+	return ret0
+}
+
+// ViewSink indicates an expected call of ViewSink
+func (mr *MockSubscribersMockRecorder) ViewSink() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewSink", reflect.TypeOf((*MockSubscribers)(nil).ViewSink))
+}
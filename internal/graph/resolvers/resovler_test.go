@@ -14,6 +14,7 @@ func Test_Resolver(t *testing.T) {
 	assert.Assert(t, resolver.Query() != nil)
 	assert.Assert(t, resolver.Service() != nil)
 	assert.Assert(t, resolver.Component() != nil)
+	assert.Assert(t, resolver.ConsumerLag() != nil)
 	assert.Assert(t, resolver.Pod() != nil)
 	assert.Assert(t, resolver.Processor() != nil)
 	assert.Assert(t, resolver.ProcessorInput() != nil)
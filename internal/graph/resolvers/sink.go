@@ -16,6 +16,7 @@ type SinkLoader interface {
 	ComponentBySink(int) (*model.Component, error)
 	PodsBySink(int) ([]*model.Pod, error)
 	TopicBySink(int) (*model.Topic, error)
+	LagBySink(int) ([]*model.ConsumerLag, error)
 }
 
 var _ generated.SinkResolver = &SinkResolver{}
@@ -51,3 +52,12 @@ func (r *SinkResolver) Topic(ctx context.Context, sink *model.Sink) (*model.Topi
 	}
 	return results, nil
 }
+
+// Lag returns the sink's consumer lag
+func (r *SinkResolver) Lag(ctx context.Context, sink *model.Sink) ([]*model.ConsumerLag, error) {
+	results, err := r.DataLoaders.SinkLoader(ctx).LagBySink(sink.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get lag from loader")
+	}
+	return results, nil
+}
@@ -77,3 +77,18 @@ func (mr *MockSinkLoaderMockRecorder) TopicBySink(arg0 interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopicBySink", reflect.TypeOf((*MockSinkLoader)(nil).TopicBySink), arg0)
 }
+
+// LagBySink mocks base method
+func (m *MockSinkLoader) LagBySink(arg0 int) ([]*model.ConsumerLag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LagBySink", arg0)
+	ret0, _ := ret[0].([]*model.ConsumerLag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LagBySink indicates an expected call of LagBySink
+func (mr *MockSinkLoaderMockRecorder) LagBySink(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LagBySink", reflect.TypeOf((*MockSinkLoader)(nil).LagBySink), arg0)
+}
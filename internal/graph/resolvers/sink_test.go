@@ -116,3 +116,38 @@ func Test_Sink_Topic(t *testing.T) {
 	_, err = resolver.Topic(context.Background(), &model.Sink{ID: 13})
 	assert.ErrorContains(t, err, "failed to get topic from loader: boom")
 }
+
+func Test_Sink_Lag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loader := NewMockSinkLoader(ctrl)
+	loaders := NewMockDataLoaders(ctrl)
+	loaders.EXPECT().
+		SinkLoader(gomock.Any()).
+		Return(loader).
+		Times(2)
+
+	resolver := &resolvers.SinkResolver{
+		Resolver: &resolvers.Resolver{
+			DataLoaders: loaders,
+		},
+	}
+
+	loader.EXPECT().
+		LagBySink(12).
+		Return([]*model.ConsumerLag{}, nil).
+		Times(1)
+
+	loader.EXPECT().
+		LagBySink(13).
+		Return(nil, errors.Errorf("boom")).
+		Times(1)
+
+	r, err := resolver.Lag(context.Background(), &model.Sink{ID: 12})
+	assert.NilError(t, err)
+	assert.Assert(t, r != nil)
+
+	_, err = resolver.Lag(context.Background(), &model.Sink{ID: 13})
+	assert.ErrorContains(t, err, "failed to get lag from loader: boom")
+}
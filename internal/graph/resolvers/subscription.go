@@ -14,6 +14,26 @@ type ProcessorWatcher interface {
 	WatchProcessor(context.Context, *model.WatchProcessorInput) (<-chan *model.Operation, error)
 }
 
+// SinkWatcher handles sink subscriptions
+type SinkWatcher interface {
+	WatchSink(context.Context, *model.WatchSinkInput) (<-chan model.SinkEvent, error)
+}
+
+// SourceWatcher handles source subscriptions
+type SourceWatcher interface {
+	WatchSource(context.Context, *model.WatchSourceInput) (<-chan *model.SourceEmit, error)
+}
+
+// ViewSourceWatcher handles view source subscriptions
+type ViewSourceWatcher interface {
+	WatchViewSource(context.Context, *model.WatchViewSourceInput) (<-chan *model.ViewSourceSync, error)
+}
+
+// ViewSinkWatcher handles view sink subscriptions
+type ViewSinkWatcher interface {
+	WatchViewSink(context.Context, *model.WatchViewSinkInput) (<-chan *model.ViewSinkSync, error)
+}
+
 var _ generated.SubscriptionResolver = &Subscription{}
 
 // Subscription is the subscription resolver
@@ -25,3 +45,23 @@ type Subscription struct {
 func (s *Subscription) WatchProcessor(ctx context.Context, input *model.WatchProcessorInput) (<-chan *model.Operation, error) {
 	return s.Subscribers.Processor().WatchProcessor(ctx, input)
 }
+
+// WatchSink observes the collects, flushes and commits of a sink
+func (s *Subscription) WatchSink(ctx context.Context, input *model.WatchSinkInput) (<-chan model.SinkEvent, error) {
+	return s.Subscribers.Sink().WatchSink(ctx, input)
+}
+
+// WatchSource observes the messages a source emits
+func (s *Subscription) WatchSource(ctx context.Context, input *model.WatchSourceInput) (<-chan *model.SourceEmit, error) {
+	return s.Subscribers.Source().WatchSource(ctx, input)
+}
+
+// WatchViewSource observes the sync runs of a view source
+func (s *Subscription) WatchViewSource(ctx context.Context, input *model.WatchViewSourceInput) (<-chan *model.ViewSourceSync, error) {
+	return s.Subscribers.ViewSource().WatchViewSource(ctx, input)
+}
+
+// WatchViewSink observes the sync runs of a view sink
+func (s *Subscription) WatchViewSink(ctx context.Context, input *model.WatchViewSinkInput) (<-chan *model.ViewSinkSync, error) {
+	return s.Subscribers.ViewSink().WatchViewSink(ctx, input)
+}
@@ -48,3 +48,155 @@ func (mr *MockProcessorWatcherMockRecorder) WatchProcessor(arg0, arg1 interface{
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchProcessor", reflect.TypeOf((*MockProcessorWatcher)(nil).WatchProcessor), arg0, arg1)
 }
+
+// MockSinkWatcher is a mock of SinkWatcher interface
+type MockSinkWatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockSinkWatcherMockRecorder
+}
+
+// MockSinkWatcherMockRecorder is the mock recorder for MockSinkWatcher
+type MockSinkWatcherMockRecorder struct {
+	mock *MockSinkWatcher
+}
+
+// NewMockSinkWatcher creates a new mock instance
+func NewMockSinkWatcher(ctrl *gomock.Controller) *MockSinkWatcher {
+	mock := &MockSinkWatcher{ctrl: ctrl}
+	mock.recorder = &MockSinkWatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSinkWatcher) EXPECT() *MockSinkWatcherMockRecorder {
+	return m.recorder
+}
+
+// WatchSink mocks base method
+func (m *MockSinkWatcher) WatchSink(arg0 context.Context, arg1 *model.WatchSinkInput) (<-chan model.SinkEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchSink", arg0, arg1)
+	ret0, _ := ret[0].(<-chan model.SinkEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchSink indicates an expected call of WatchSink
+func (mr *MockSinkWatcherMockRecorder) WatchSink(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchSink", reflect.TypeOf((*MockSinkWatcher)(nil).WatchSink), arg0, arg1)
+}
+
+// MockSourceWatcher is a mock of SourceWatcher interface
+type MockSourceWatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockSourceWatcherMockRecorder
+}
+
+// MockSourceWatcherMockRecorder is the mock recorder for MockSourceWatcher
+type MockSourceWatcherMockRecorder struct {
+	mock *MockSourceWatcher
+}
+
+// NewMockSourceWatcher creates a new mock instance
+func NewMockSourceWatcher(ctrl *gomock.Controller) *MockSourceWatcher {
+	mock := &MockSourceWatcher{ctrl: ctrl}
+	mock.recorder = &MockSourceWatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSourceWatcher) EXPECT() *MockSourceWatcherMockRecorder {
+	return m.recorder
+}
+
+// WatchSource mocks base method
+func (m *MockSourceWatcher) WatchSource(arg0 context.Context, arg1 *model.WatchSourceInput) (<-chan *model.SourceEmit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchSource", arg0, arg1)
+	ret0, _ := ret[0].(<-chan *model.SourceEmit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchSource indicates an expected call of WatchSource
+func (mr *MockSourceWatcherMockRecorder) WatchSource(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchSource", reflect.TypeOf((*MockSourceWatcher)(nil).WatchSource), arg0, arg1)
+}
+
+// MockViewSourceWatcher is a mock of ViewSourceWatcher interface
+type MockViewSourceWatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockViewSourceWatcherMockRecorder
+}
+
+// MockViewSourceWatcherMockRecorder is the mock recorder for MockViewSourceWatcher
+type MockViewSourceWatcherMockRecorder struct {
+	mock *MockViewSourceWatcher
+}
+
+// NewMockViewSourceWatcher creates a new mock instance
+func NewMockViewSourceWatcher(ctrl *gomock.Controller) *MockViewSourceWatcher {
+	mock := &MockViewSourceWatcher{ctrl: ctrl}
+	mock.recorder = &MockViewSourceWatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockViewSourceWatcher) EXPECT() *MockViewSourceWatcherMockRecorder {
+	return m.recorder
+}
+
+// WatchViewSource mocks base method
+func (m *MockViewSourceWatcher) WatchViewSource(arg0 context.Context, arg1 *model.WatchViewSourceInput) (<-chan *model.ViewSourceSync, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchViewSource", arg0, arg1)
+	ret0, _ := ret[0].(<-chan *model.ViewSourceSync)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchViewSource indicates an expected call of WatchViewSource
+func (mr *MockViewSourceWatcherMockRecorder) WatchViewSource(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchViewSource", reflect.TypeOf((*MockViewSourceWatcher)(nil).WatchViewSource), arg0, arg1)
+}
+
+// MockViewSinkWatcher is a mock of ViewSinkWatcher interface
+type MockViewSinkWatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockViewSinkWatcherMockRecorder
+}
+
+// MockViewSinkWatcherMockRecorder is the mock recorder for MockViewSinkWatcher
+type MockViewSinkWatcherMockRecorder struct {
+	mock *MockViewSinkWatcher
+}
+
+// NewMockViewSinkWatcher creates a new mock instance
+func NewMockViewSinkWatcher(ctrl *gomock.Controller) *MockViewSinkWatcher {
+	mock := &MockViewSinkWatcher{ctrl: ctrl}
+	mock.recorder = &MockViewSinkWatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockViewSinkWatcher) EXPECT() *MockViewSinkWatcherMockRecorder {
+	return m.recorder
+}
+
+// WatchViewSink mocks base method
+func (m *MockViewSinkWatcher) WatchViewSink(arg0 context.Context, arg1 *model.WatchViewSinkInput) (<-chan *model.ViewSinkSync, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchViewSink", arg0, arg1)
+	ret0, _ := ret[0].(<-chan *model.ViewSinkSync)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchViewSink indicates an expected call of WatchViewSink
+func (mr *MockViewSinkWatcherMockRecorder) WatchViewSink(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchViewSink", reflect.TypeOf((*MockViewSinkWatcher)(nil).WatchViewSink), arg0, arg1)
+}
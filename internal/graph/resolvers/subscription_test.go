@@ -36,3 +36,103 @@ func Test_Subscription_Processor(t *testing.T) {
 	_, err := resolver.Subscription().WatchProcessor(context.Background(), &model.WatchProcessorInput{})
 	assert.NilError(t, err)
 }
+
+func Test_Subscription_Sink(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	subscriptions := NewMockSubscribers(ctrl)
+	sinkSubscriber := NewMockSinkWatcher(ctrl)
+
+	subscriptions.EXPECT().
+		Sink().
+		Return(sinkSubscriber).
+		Times(1)
+
+	sinkSubscriber.EXPECT().
+		WatchSink(gomock.Any(), &model.WatchSinkInput{SinkID: 1}).
+		Return(nil, nil).
+		Times(1)
+
+	resolver := &resolvers.Resolver{
+		Subscribers: subscriptions,
+	}
+
+	_, err := resolver.Subscription().WatchSink(context.Background(), &model.WatchSinkInput{SinkID: 1})
+	assert.NilError(t, err)
+}
+
+func Test_Subscription_Source(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	subscriptions := NewMockSubscribers(ctrl)
+	sourceSubscriber := NewMockSourceWatcher(ctrl)
+
+	subscriptions.EXPECT().
+		Source().
+		Return(sourceSubscriber).
+		Times(1)
+
+	sourceSubscriber.EXPECT().
+		WatchSource(gomock.Any(), &model.WatchSourceInput{SourceID: 1}).
+		Return(nil, nil).
+		Times(1)
+
+	resolver := &resolvers.Resolver{
+		Subscribers: subscriptions,
+	}
+
+	_, err := resolver.Subscription().WatchSource(context.Background(), &model.WatchSourceInput{SourceID: 1})
+	assert.NilError(t, err)
+}
+
+func Test_Subscription_ViewSource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	subscriptions := NewMockSubscribers(ctrl)
+	viewSourceSubscriber := NewMockViewSourceWatcher(ctrl)
+
+	subscriptions.EXPECT().
+		ViewSource().
+		Return(viewSourceSubscriber).
+		Times(1)
+
+	viewSourceSubscriber.EXPECT().
+		WatchViewSource(gomock.Any(), &model.WatchViewSourceInput{ViewSourceID: 1}).
+		Return(nil, nil).
+		Times(1)
+
+	resolver := &resolvers.Resolver{
+		Subscribers: subscriptions,
+	}
+
+	_, err := resolver.Subscription().WatchViewSource(context.Background(), &model.WatchViewSourceInput{ViewSourceID: 1})
+	assert.NilError(t, err)
+}
+
+func Test_Subscription_ViewSink(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	subscriptions := NewMockSubscribers(ctrl)
+	viewSinkSubscriber := NewMockViewSinkWatcher(ctrl)
+
+	subscriptions.EXPECT().
+		ViewSink().
+		Return(viewSinkSubscriber).
+		Times(1)
+
+	viewSinkSubscriber.EXPECT().
+		WatchViewSink(gomock.Any(), &model.WatchViewSinkInput{ViewSinkID: 1}).
+		Return(nil, nil).
+		Times(1)
+
+	resolver := &resolvers.Resolver{
+		Subscribers: subscriptions,
+	}
+
+	_, err := resolver.Subscription().WatchViewSink(context.Background(), &model.WatchViewSinkInput{ViewSinkID: 1})
+	assert.NilError(t, err)
+}
@@ -23,6 +23,7 @@ type TopicLoader interface {
 	ViewSinksByTopic(int) ([]*model.ViewSink, error)
 	ViewSourcesByTopic(int) ([]*model.ViewSource, error)
 	ViewsByTopic(int) ([]*model.View, error)
+	LagByTopic(int) ([]*model.ConsumerLag, error)
 }
 
 var _ generated.TopicResolver = &TopicResolver{}
@@ -121,3 +122,12 @@ func (r *TopicResolver) Views(ctx context.Context, topic *model.Topic) ([]*model
 	}
 	return results, nil
 }
+
+// Lag returns the consumer lag on the topic
+func (r *TopicResolver) Lag(ctx context.Context, topic *model.Topic) ([]*model.ConsumerLag, error) {
+	results, err := r.DataLoaders.TopicLoader(ctx).LagByTopic(topic.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get lag from loader")
+	}
+	return results, nil
+}
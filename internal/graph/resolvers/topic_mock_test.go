@@ -182,3 +182,18 @@ func (mr *MockTopicLoaderMockRecorder) ViewsByTopic(arg0 interface{}) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewsByTopic", reflect.TypeOf((*MockTopicLoader)(nil).ViewsByTopic), arg0)
 }
+
+// LagByTopic mocks base method
+func (m *MockTopicLoader) LagByTopic(arg0 int) ([]*model.ConsumerLag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LagByTopic", arg0)
+	ret0, _ := ret[0].([]*model.ConsumerLag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LagByTopic indicates an expected call of LagByTopic
+func (mr *MockTopicLoaderMockRecorder) LagByTopic(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LagByTopic", reflect.TypeOf((*MockTopicLoader)(nil).LagByTopic), arg0)
+}
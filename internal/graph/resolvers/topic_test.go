@@ -360,3 +360,38 @@ func Test_Topic_Views(t *testing.T) {
 	_, err = resolver.Views(context.Background(), &model.Topic{ID: 13})
 	assert.ErrorContains(t, err, "failed to get views from loader: boom")
 }
+
+func Test_Topic_Lag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loader := NewMockTopicLoader(ctrl)
+	loaders := NewMockDataLoaders(ctrl)
+	loaders.EXPECT().
+		TopicLoader(gomock.Any()).
+		Return(loader).
+		Times(2)
+
+	resolver := &resolvers.TopicResolver{
+		Resolver: &resolvers.Resolver{
+			DataLoaders: loaders,
+		},
+	}
+
+	loader.EXPECT().
+		LagByTopic(12).
+		Return([]*model.ConsumerLag{}, nil).
+		Times(1)
+
+	loader.EXPECT().
+		LagByTopic(13).
+		Return(nil, errors.Errorf("boom")).
+		Times(1)
+
+	r, err := resolver.Lag(context.Background(), &model.Topic{ID: 12})
+	assert.NilError(t, err)
+	assert.Assert(t, r != nil)
+
+	_, err = resolver.Lag(context.Background(), &model.Topic{ID: 13})
+	assert.ErrorContains(t, err, "failed to get lag from loader: boom")
+}
@@ -54,7 +54,13 @@ func (s *Service) Run(ctx context.Context) func() error {
 	srv := &http.Server{Addr: fmt.Sprintf(":%d", s.port), Handler: router}
 	srv.SetKeepAlivesEnabled(true)
 
-	subscriber := subscription.NewSubscribers(s.podLister, repositories.Processor())
+	subscriber := subscription.NewSubscribers(
+		s.podLister,
+		repositories.Processor(),
+		repositories.Sink(),
+		repositories.Source(),
+		repositories.ViewSource(),
+		repositories.ViewSink())
 	resolver := resolvers.NewResolver(&loaders.LoaderFactory{}, subscriber)
 
 	server := handler.New(generated.NewExecutableSchema(generated.Config{
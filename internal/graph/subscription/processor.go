@@ -2,7 +2,6 @@ package subscription
 
 import (
 	"context"
-	"fmt"
 	"sync"
 
 	"github.com/syncromatics/kafmesh/internal/graph/model"
@@ -12,7 +11,6 @@ import (
 	"github.com/pkg/errors"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 //go:generate mockgen -source=./processor.go -destination=./processor_mock_test.go -package=subscription_test
@@ -21,9 +19,13 @@ const (
 	portAnnotation = "kafmesh/port"
 )
 
-// Watcher watches a processor by key
+// Watcher watches processors, sinks, sources, view sources and view sinks
 type Watcher interface {
 	Processor(ctx context.Context, in *watchv1.ProcessorRequest, opts ...grpc.CallOption) (watchv1.WatchAPI_ProcessorClient, error)
+	Sink(ctx context.Context, in *watchv1.SinkRequest, opts ...grpc.CallOption) (watchv1.WatchAPI_SinkClient, error)
+	Source(ctx context.Context, in *watchv1.SourceRequest, opts ...grpc.CallOption) (watchv1.WatchAPI_SourceClient, error)
+	ViewSource(ctx context.Context, in *watchv1.ViewSourceRequest, opts ...grpc.CallOption) (watchv1.WatchAPI_ViewSourceClient, error)
+	ViewSink(ctx context.Context, in *watchv1.ViewSinkRequest, opts ...grpc.CallOption) (watchv1.WatchAPI_ViewSinkClient, error)
 }
 
 // ProcessorRepository is the datastore repository for processors
@@ -44,6 +46,10 @@ type Processor struct {
 
 // WatchProcessor watches a processor by key
 func (p *Processor) WatchProcessor(ctx context.Context, input *model.WatchProcessorInput) (<-chan *model.Operation, error) {
+	if isEmpty(input.Key) && isEmpty(input.KeyPrefix) && isEmpty(input.KeyRegex) && (input.SampleRate == nil || *input.SampleRate == 0) {
+		return nil, errors.Errorf("processor watch needs a key, key prefix, key regex or sample rate")
+	}
+
 	processor, err := p.ProcessorRepository.ByID(ctx, input.ProcessorID)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get processor by id")
@@ -65,24 +71,9 @@ func (p *Processor) WatchProcessor(ctx context.Context, input *model.WatchProces
 		return nil, errors.Errorf("did not receive correct response from pods. len: %d ", len(pods))
 	}
 
-	l, err := p.PodLister.List(ctx, metav1.ListOptions{})
+	urls, err := podURLs(ctx, p.PodLister, pods[0])
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to list pods")
-	}
-
-	urls := []string{}
-	for _, pod := range pods[0] {
-		for _, p := range l.Items {
-			if p.Name != pod.Name {
-				continue
-			}
-			port, ok := p.Annotations[portAnnotation]
-			if !ok {
-				port = "443"
-			}
-
-			urls = append(urls, fmt.Sprintf("%s:%s", p.Status.PodIP, port))
-		}
+		return nil, err
 	}
 	if len(urls) == 0 {
 		return nil, errors.Errorf("no pods are serving this processor")
@@ -91,7 +82,18 @@ func (p *Processor) WatchProcessor(ctx context.Context, input *model.WatchProces
 	watchRequest := &watchv1.ProcessorRequest{
 		Component: components[0].Name,
 		Processor: processor.Name,
-		Key:       input.Key,
+	}
+	if input.Key != nil {
+		watchRequest.Key = *input.Key
+	}
+	if input.KeyPrefix != nil {
+		watchRequest.KeyPrefix = *input.KeyPrefix
+	}
+	if input.KeyRegex != nil {
+		watchRequest.KeyRegex = *input.KeyRegex
+	}
+	if input.SampleRate != nil {
+		watchRequest.SampleRate = *input.SampleRate
 	}
 
 	channels := []<-chan *watchv1.Operation{}
@@ -112,6 +114,10 @@ func (p *Processor) WatchProcessor(ctx context.Context, input *model.WatchProces
 	return merge(channels...), nil
 }
 
+func isEmpty(s *string) bool {
+	return s == nil || *s == ""
+}
+
 func processorWatch(ctx context.Context, client Watcher, request *watchv1.ProcessorRequest) (<-chan *watchv1.Operation, func() error, error) {
 	stream, err := client.Processor(ctx, request)
 	if err != nil {
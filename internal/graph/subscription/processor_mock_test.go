@@ -56,6 +56,86 @@ func (mr *MockWatcherMockRecorder) Processor(ctx, in interface{}, opts ...interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Processor", reflect.TypeOf((*MockWatcher)(nil).Processor), varargs...)
 }
 
+// Sink mocks base method
+func (m *MockWatcher) Sink(ctx context.Context, in *watchv1.SinkRequest, opts ...grpc.CallOption) (watchv1.WatchAPI_SinkClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Sink", varargs...)
+	ret0, _ := ret[0].(watchv1.WatchAPI_SinkClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sink indicates an expected call of Sink
+func (mr *MockWatcherMockRecorder) Sink(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sink", reflect.TypeOf((*MockWatcher)(nil).Sink), varargs...)
+}
+
+// Source mocks base method
+func (m *MockWatcher) Source(ctx context.Context, in *watchv1.SourceRequest, opts ...grpc.CallOption) (watchv1.WatchAPI_SourceClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Source", varargs...)
+	ret0, _ := ret[0].(watchv1.WatchAPI_SourceClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Source indicates an expected call of Source
+func (mr *MockWatcherMockRecorder) Source(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Source", reflect.TypeOf((*MockWatcher)(nil).Source), varargs...)
+}
+
+// ViewSource mocks base method
+func (m *MockWatcher) ViewSource(ctx context.Context, in *watchv1.ViewSourceRequest, opts ...grpc.CallOption) (watchv1.WatchAPI_ViewSourceClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ViewSource", varargs...)
+	ret0, _ := ret[0].(watchv1.WatchAPI_ViewSourceClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ViewSource indicates an expected call of ViewSource
+func (mr *MockWatcherMockRecorder) ViewSource(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewSource", reflect.TypeOf((*MockWatcher)(nil).ViewSource), varargs...)
+}
+
+// ViewSink mocks base method
+func (m *MockWatcher) ViewSink(ctx context.Context, in *watchv1.ViewSinkRequest, opts ...grpc.CallOption) (watchv1.WatchAPI_ViewSinkClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ViewSink", varargs...)
+	ret0, _ := ret[0].(watchv1.WatchAPI_ViewSinkClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ViewSink indicates an expected call of ViewSink
+func (mr *MockWatcherMockRecorder) ViewSink(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewSink", reflect.TypeOf((*MockWatcher)(nil).ViewSink), varargs...)
+}
+
 // MockProcessorRepository is a mock of ProcessorRepository interface
 type MockProcessorRepository struct {
 	ctrl     *gomock.Controller
@@ -246,9 +246,10 @@ func Test_Processor_WatchProcessor(t *testing.T) {
 
 	ctx, cancel := context.WithCancel(context.Background())
 
+	key := "tester"
 	r, err := watcher.WatchProcessor(ctx, &model.WatchProcessorInput{
 		ProcessorID: 12,
-		Key:         "tester",
+		Key:         &key,
 	})
 	assert.NilError(t, err)
 
@@ -353,3 +354,23 @@ func (*sub) SendMsg(interface{}) error {
 func (*sub) Trailer() metadata.MD {
 	return nil
 }
+
+func Test_Processor_WatchProcessor_NoFilter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	watcher := &subscription.Processor{
+		Factory:             NewMockFactory(ctrl),
+		ProcessorRepository: NewMockProcessorRepository(ctrl),
+		PodLister:           NewMockPodLister(ctrl),
+	}
+
+	empty := ""
+	rate := 0.0
+	_, err := watcher.WatchProcessor(context.Background(), &model.WatchProcessorInput{
+		ProcessorID: 12,
+		Key:         &empty,
+		SampleRate:  &rate,
+	})
+	assert.Error(t, err, "processor watch needs a key, key prefix, key regex or sample rate")
+}
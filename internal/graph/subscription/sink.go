@@ -0,0 +1,125 @@
+package subscription
+
+import (
+	"context"
+
+	"github.com/syncromatics/kafmesh/internal/graph/model"
+	"github.com/syncromatics/kafmesh/internal/graph/resolvers"
+	watchv1 "github.com/syncromatics/kafmesh/internal/protos/kafmesh/watch/v1"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockgen -source=./sink.go -destination=./sink_mock_test.go -package=subscription_test
+
+// SinkRepository is the datastore repository for sinks
+type SinkRepository interface {
+	ByID(context.Context, int) (*model.Sink, error)
+	ComponentBySinks(context.Context, []int) ([]*model.Component, error)
+	PodsBySinks(context.Context, []int) ([][]*model.Pod, error)
+}
+
+var _ resolvers.SinkWatcher = &Sink{}
+
+// Sink provides sink watches
+type Sink struct {
+	Factory        Factory
+	SinkRepository SinkRepository
+	PodLister      PodLister
+}
+
+// WatchSink watches the collects, flushes and commits of a sink
+func (s *Sink) WatchSink(ctx context.Context, input *model.WatchSinkInput) (<-chan model.SinkEvent, error) {
+	sink, err := s.SinkRepository.ByID(ctx, input.SinkID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get sink by id")
+	}
+	if sink == nil {
+		return nil, errors.Errorf("sink %d does not exist", input.SinkID)
+	}
+
+	component, pods, err := componentPods(ctx, input.SinkID, s.SinkRepository.ComponentBySinks, s.SinkRepository.PodsBySinks)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get sink pods")
+	}
+
+	urls, err := podURLs(ctx, s.PodLister, pods)
+	if err != nil {
+		return nil, err
+	}
+	if len(urls) == 0 {
+		return nil, errors.Errorf("no pods are serving this sink")
+	}
+
+	request := &watchv1.SinkRequest{
+		Component: component,
+		Sink:      sink.Name,
+	}
+
+	messages, err := watchURLs(ctx, s.Factory, urls, func(ctx context.Context, client Watcher) (receiver, error) {
+		stream, err := client.Sink(ctx, request)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to call sink on watch")
+		}
+
+		return func() (interface{}, error) {
+			m, err := stream.Recv()
+			if err != nil {
+				return nil, err
+			}
+			return m.Event, nil
+		}, nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create sink watch")
+	}
+
+	events := make(chan model.SinkEvent)
+	go func() {
+		defer close(events)
+		for m := range messages {
+			select {
+			case <-ctx.Done():
+				return
+			case events <- mapGrpcSinkEventToModel(m.(*watchv1.SinkEvent)):
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+func mapGrpcSinkEventToModel(event *watchv1.SinkEvent) model.SinkEvent {
+	time := milliseconds(event.Time)
+
+	switch e := event.Event.(type) {
+	case *watchv1.SinkEvent_Collect:
+		return &model.SinkCollect{
+			Time:      time,
+			Partition: int(e.Collect.Partition),
+			Offset:    int(e.Collect.Offset),
+			Key:       e.Collect.Key,
+			Value:     e.Collect.Value,
+		}
+	case *watchv1.SinkEvent_Flush:
+		return &model.SinkFlush{
+			Time:     time,
+			Count:    int(e.Flush.Count),
+			Attempts: int(e.Flush.Attempts),
+			Error:    optional(e.Flush.Error),
+		}
+	}
+
+	commit := &model.SinkCommit{
+		Time:    time,
+		Offsets: []*model.PartitionOffset{},
+	}
+	for _, o := range event.GetCommit().GetOffsets() {
+		commit.Offsets = append(commit.Offsets, &model.PartitionOffset{
+			Partition: int(o.Partition),
+			Offset:    int(o.Offset),
+		})
+	}
+
+	return commit
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./sink.go
+
+// Package subscription_test is a generated GoMock package.
+package subscription_test
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/syncromatics/kafmesh/internal/graph/model"
+	reflect "reflect"
+)
+
+// MockSinkRepository is a mock of SinkRepository interface
+type MockSinkRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSinkRepositoryMockRecorder
+}
+
+// MockSinkRepositoryMockRecorder is the mock recorder for MockSinkRepository
+type MockSinkRepositoryMockRecorder struct {
+	mock *MockSinkRepository
+}
+
+// NewMockSinkRepository creates a new mock instance
+func NewMockSinkRepository(ctrl *gomock.Controller) *MockSinkRepository {
+	mock := &MockSinkRepository{ctrl: ctrl}
+	mock.recorder = &MockSinkRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSinkRepository) EXPECT() *MockSinkRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ByID mocks base method
+func (m *MockSinkRepository) ByID(arg0 context.Context, arg1 int) (*model.Sink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByID", arg0, arg1)
+	ret0, _ := ret[0].(*model.Sink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByID indicates an expected call of ByID
+func (mr *MockSinkRepositoryMockRecorder) ByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByID", reflect.TypeOf((*MockSinkRepository)(nil).ByID), arg0, arg1)
+}
+
+// ComponentBySinks mocks base method
+func (m *MockSinkRepository) ComponentBySinks(arg0 context.Context, arg1 []int) ([]*model.Component, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ComponentBySinks", arg0, arg1)
+	ret0, _ := ret[0].([]*model.Component)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ComponentBySinks indicates an expected call of ComponentBySinks
+func (mr *MockSinkRepositoryMockRecorder) ComponentBySinks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComponentBySinks", reflect.TypeOf((*MockSinkRepository)(nil).ComponentBySinks), arg0, arg1)
+}
+
+// PodsBySinks mocks base method
+func (m *MockSinkRepository) PodsBySinks(arg0 context.Context, arg1 []int) ([][]*model.Pod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PodsBySinks", arg0, arg1)
+	ret0, _ := ret[0].([][]*model.Pod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PodsBySinks indicates an expected call of PodsBySinks
+func (mr *MockSinkRepositoryMockRecorder) PodsBySinks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PodsBySinks", reflect.TypeOf((*MockSinkRepository)(nil).PodsBySinks), arg0, arg1)
+}
@@ -0,0 +1,165 @@
+package subscription_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/syncromatics/kafmesh/internal/graph/model"
+	"github.com/syncromatics/kafmesh/internal/graph/subscription"
+	watchv1 "github.com/syncromatics/kafmesh/internal/protos/kafmesh/watch/v1"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/grpc"
+	"gotest.tools/assert"
+)
+
+func Test_Sink_WatchSink(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	factory := NewMockFactory(ctrl)
+	repository := NewMockSinkRepository(ctrl)
+	lister := NewMockPodLister(ctrl)
+
+	repository.EXPECT().
+		ByID(gomock.Any(), 3).
+		Return(&model.Sink{Name: "testSink"}, nil).
+		Times(1)
+
+	repository.EXPECT().
+		ComponentBySinks(gomock.Any(), []int{3}).
+		Return([]*model.Component{&model.Component{Name: "component1"}}, nil).
+		Times(1)
+
+	repository.EXPECT().
+		PodsBySinks(gomock.Any(), []int{3}).
+		Return([][]*model.Pod{[]*model.Pod{&model.Pod{Name: "pod1"}}}, nil).
+		Times(1)
+
+	lister.EXPECT().
+		List(gomock.Any(), gomock.Any()).
+		Return(podList("1"), nil).
+		Times(1)
+
+	client := NewMockWatcher(ctrl)
+	client.EXPECT().
+		Sink(gomock.Any(), &watchv1.SinkRequest{
+			Component: "component1",
+			Sink:      "testSink",
+		}, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, request *watchv1.SinkRequest, opts ...grpc.CallOption) (watchv1.WatchAPI_SinkClient, error) {
+			return &sinkStream{
+				stream: stream{ctx},
+				messages: []*watchv1.SinkResponse{
+					&watchv1.SinkResponse{Event: &watchv1.SinkEvent{
+						Time: &timestamp.Timestamp{Seconds: 1},
+						Event: &watchv1.SinkEvent_Collect{Collect: &watchv1.SinkCollect{
+							Partition: 2,
+							Offset:    10,
+							Key:       "key1",
+							Value:     "value1",
+						}},
+					}},
+					&watchv1.SinkResponse{Event: &watchv1.SinkEvent{
+						Time:  &timestamp.Timestamp{Seconds: 2},
+						Event: &watchv1.SinkEvent_Flush{Flush: &watchv1.SinkFlush{Count: 1, Attempts: 2, Error: "boom"}},
+					}},
+					&watchv1.SinkResponse{Event: &watchv1.SinkEvent{
+						Time: &timestamp.Timestamp{Seconds: 3},
+						Event: &watchv1.SinkEvent_Commit{Commit: &watchv1.SinkCommit{
+							Offsets: []*watchv1.PartitionOffset{&watchv1.PartitionOffset{Partition: 2, Offset: 11}},
+						}},
+					}},
+				},
+			}, nil
+		}).
+		Times(1)
+
+	factory.EXPECT().
+		Client(gomock.Any(), "1.1.1.1:7777").
+		Return(client, nil).
+		Times(1)
+
+	watcher := &subscription.Sink{
+		Factory:        factory,
+		SinkRepository: repository,
+		PodLister:      lister,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	r, err := watcher.WatchSink(ctx, &model.WatchSinkInput{SinkID: 3})
+	assert.NilError(t, err)
+
+	boom := "boom"
+	expected := []model.SinkEvent{
+		&model.SinkCollect{Time: 1000, Partition: 2, Offset: 10, Key: "key1", Value: "value1"},
+		&model.SinkFlush{Time: 2000, Count: 1, Attempts: 2, Error: &boom},
+		&model.SinkCommit{Time: 3000, Offsets: []*model.PartitionOffset{&model.PartitionOffset{Partition: 2, Offset: 11}}},
+	}
+	for _, e := range expected {
+		select {
+		case <-time.After(time.Second):
+			t.Fatal("failed waiting for event")
+		case m := <-r:
+			assert.DeepEqual(t, m, e)
+		}
+	}
+}
+
+func Test_Sink_WatchSinkWithoutPods(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := NewMockSinkRepository(ctrl)
+	lister := NewMockPodLister(ctrl)
+
+	repository.EXPECT().
+		ByID(gomock.Any(), 3).
+		Return(&model.Sink{Name: "testSink"}, nil).
+		Times(1)
+
+	repository.EXPECT().
+		ComponentBySinks(gomock.Any(), []int{3}).
+		Return([]*model.Component{&model.Component{Name: "component1"}}, nil).
+		Times(1)
+
+	repository.EXPECT().
+		PodsBySinks(gomock.Any(), []int{3}).
+		Return([][]*model.Pod{[]*model.Pod{}}, nil).
+		Times(1)
+
+	lister.EXPECT().
+		List(gomock.Any(), gomock.Any()).
+		Return(podList("1"), nil).
+		Times(1)
+
+	watcher := &subscription.Sink{
+		SinkRepository: repository,
+		PodLister:      lister,
+	}
+
+	_, err := watcher.WatchSink(context.Background(), &model.WatchSinkInput{SinkID: 3})
+	assert.ErrorContains(t, err, "no pods are serving this sink")
+}
+
+var _ watchv1.WatchAPI_SinkClient = &sinkStream{}
+
+type sinkStream struct {
+	stream
+	messages []*watchv1.SinkResponse
+	index    int
+}
+
+func (s *sinkStream) Recv() (*watchv1.SinkResponse, error) {
+	if s.index > len(s.messages)-1 {
+		<-s.ctx.Done()
+		return nil, context.Canceled
+	}
+	m := s.messages[s.index]
+	s.index++
+	return m, nil
+}
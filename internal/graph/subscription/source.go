@@ -0,0 +1,104 @@
+package subscription
+
+import (
+	"context"
+
+	"github.com/syncromatics/kafmesh/internal/graph/model"
+	"github.com/syncromatics/kafmesh/internal/graph/resolvers"
+	watchv1 "github.com/syncromatics/kafmesh/internal/protos/kafmesh/watch/v1"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockgen -source=./source.go -destination=./source_mock_test.go -package=subscription_test
+
+// SourceRepository is the datastore repository for sources
+type SourceRepository interface {
+	ComponentBySources(context.Context, []int) ([]*model.Component, error)
+	PodsBySources(context.Context, []int) ([][]*model.Pod, error)
+	TopicBySources(context.Context, []int) ([]*model.Topic, error)
+}
+
+var _ resolvers.SourceWatcher = &Source{}
+
+// Source provides source watches
+type Source struct {
+	Factory          Factory
+	SourceRepository SourceRepository
+	PodLister        PodLister
+}
+
+// WatchSource watches the messages a source emits
+func (s *Source) WatchSource(ctx context.Context, input *model.WatchSourceInput) (<-chan *model.SourceEmit, error) {
+	topics, err := s.SourceRepository.TopicBySources(ctx, []int{input.SourceID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get source topic")
+	}
+	if len(topics) != 1 || topics[0] == nil {
+		return nil, errors.Errorf("source %d does not exist", input.SourceID)
+	}
+
+	component, pods, err := componentPods(ctx, input.SourceID, s.SourceRepository.ComponentBySources, s.SourceRepository.PodsBySources)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get source pods")
+	}
+
+	urls, err := podURLs(ctx, s.PodLister, pods)
+	if err != nil {
+		return nil, err
+	}
+	if len(urls) == 0 {
+		return nil, errors.Errorf("no pods are serving this source")
+	}
+
+	request := &watchv1.SourceRequest{
+		Component: component,
+		Topic:     topics[0].Name,
+	}
+
+	messages, err := watchURLs(ctx, s.Factory, urls, func(ctx context.Context, client Watcher) (receiver, error) {
+		stream, err := client.Source(ctx, request)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to call source on watch")
+		}
+
+		return func() (interface{}, error) {
+			m, err := stream.Recv()
+			if err != nil {
+				return nil, err
+			}
+			return m.Emit, nil
+		}, nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create source watch")
+	}
+
+	emits := make(chan *model.SourceEmit)
+	go func() {
+		defer close(emits)
+		for m := range messages {
+			select {
+			case <-ctx.Done():
+				return
+			case emits <- mapGrpcSourceEmitToModel(m.(*watchv1.SourceEmit)):
+			}
+		}
+	}()
+
+	return emits, nil
+}
+
+func mapGrpcSourceEmitToModel(emit *watchv1.SourceEmit) *model.SourceEmit {
+	result := &model.SourceEmit{
+		Time:   milliseconds(emit.Time),
+		Key:    emit.Key,
+		Delete: emit.Delete,
+		Error:  optional(emit.Error),
+	}
+	if !emit.Delete {
+		result.Value = &emit.Value
+	}
+
+	return result
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./source.go
+
+// Package subscription_test is a generated GoMock package.
+package subscription_test
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/syncromatics/kafmesh/internal/graph/model"
+	reflect "reflect"
+)
+
+// MockSourceRepository is a mock of SourceRepository interface
+type MockSourceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSourceRepositoryMockRecorder
+}
+
+// MockSourceRepositoryMockRecorder is the mock recorder for MockSourceRepository
+type MockSourceRepositoryMockRecorder struct {
+	mock *MockSourceRepository
+}
+
+// NewMockSourceRepository creates a new mock instance
+func NewMockSourceRepository(ctrl *gomock.Controller) *MockSourceRepository {
+	mock := &MockSourceRepository{ctrl: ctrl}
+	mock.recorder = &MockSourceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSourceRepository) EXPECT() *MockSourceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ComponentBySources mocks base method
+func (m *MockSourceRepository) ComponentBySources(arg0 context.Context, arg1 []int) ([]*model.Component, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ComponentBySources", arg0, arg1)
+	ret0, _ := ret[0].([]*model.Component)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ComponentBySources indicates an expected call of ComponentBySources
+func (mr *MockSourceRepositoryMockRecorder) ComponentBySources(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComponentBySources", reflect.TypeOf((*MockSourceRepository)(nil).ComponentBySources), arg0, arg1)
+}
+
+// PodsBySources mocks base method
+func (m *MockSourceRepository) PodsBySources(arg0 context.Context, arg1 []int) ([][]*model.Pod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PodsBySources", arg0, arg1)
+	ret0, _ := ret[0].([][]*model.Pod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PodsBySources indicates an expected call of PodsBySources
+func (mr *MockSourceRepositoryMockRecorder) PodsBySources(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PodsBySources", reflect.TypeOf((*MockSourceRepository)(nil).PodsBySources), arg0, arg1)
+}
+
+// TopicBySources mocks base method
+func (m *MockSourceRepository) TopicBySources(arg0 context.Context, arg1 []int) ([]*model.Topic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TopicBySources", arg0, arg1)
+	ret0, _ := ret[0].([]*model.Topic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TopicBySources indicates an expected call of TopicBySources
+func (mr *MockSourceRepositoryMockRecorder) TopicBySources(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopicBySources", reflect.TypeOf((*MockSourceRepository)(nil).TopicBySources), arg0, arg1)
+}
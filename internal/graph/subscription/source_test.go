@@ -0,0 +1,140 @@
+package subscription_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/syncromatics/kafmesh/internal/graph/model"
+	"github.com/syncromatics/kafmesh/internal/graph/subscription"
+	watchv1 "github.com/syncromatics/kafmesh/internal/protos/kafmesh/watch/v1"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/grpc"
+	"gotest.tools/assert"
+)
+
+func Test_Source_WatchSource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	factory := NewMockFactory(ctrl)
+	repository := NewMockSourceRepository(ctrl)
+	lister := NewMockPodLister(ctrl)
+
+	repository.EXPECT().
+		TopicBySources(gomock.Any(), []int{4}).
+		Return([]*model.Topic{&model.Topic{Name: "topic1"}}, nil).
+		Times(1)
+
+	repository.EXPECT().
+		ComponentBySources(gomock.Any(), []int{4}).
+		Return([]*model.Component{&model.Component{Name: "component1"}}, nil).
+		Times(1)
+
+	repository.EXPECT().
+		PodsBySources(gomock.Any(), []int{4}).
+		Return([][]*model.Pod{[]*model.Pod{&model.Pod{Name: "pod1"}, &model.Pod{Name: "pod2"}}}, nil).
+		Times(1)
+
+	lister.EXPECT().
+		List(gomock.Any(), gomock.Any()).
+		Return(podList("1", "2"), nil).
+		Times(1)
+
+	request := &watchv1.SourceRequest{
+		Component: "component1",
+		Topic:     "topic1",
+	}
+
+	client1 := NewMockWatcher(ctrl)
+	client1.EXPECT().
+		Source(gomock.Any(), request, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, request *watchv1.SourceRequest, opts ...grpc.CallOption) (watchv1.WatchAPI_SourceClient, error) {
+			return &sourceStream{
+				stream: stream{ctx},
+				messages: []*watchv1.SourceResponse{
+					&watchv1.SourceResponse{Emit: &watchv1.SourceEmit{
+						Time:  &timestamp.Timestamp{Seconds: 1},
+						Key:   "key1",
+						Value: "value1",
+					}},
+				},
+			}, nil
+		}).
+		Times(1)
+
+	client2 := NewMockWatcher(ctrl)
+	client2.EXPECT().
+		Source(gomock.Any(), request, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, request *watchv1.SourceRequest, opts ...grpc.CallOption) (watchv1.WatchAPI_SourceClient, error) {
+			return &sourceStream{
+				stream: stream{ctx},
+				messages: []*watchv1.SourceResponse{
+					&watchv1.SourceResponse{Emit: &watchv1.SourceEmit{
+						Time:   &timestamp.Timestamp{Seconds: 2},
+						Key:    "key2",
+						Delete: true,
+						Error:  "boom",
+					}},
+				},
+			}, nil
+		}).
+		Times(1)
+
+	factory.EXPECT().
+		Client(gomock.Any(), "1.1.1.1:7777").
+		Return(client1, nil).
+		Times(1)
+
+	factory.EXPECT().
+		Client(gomock.Any(), "1.1.1.2:7777").
+		Return(client2, nil).
+		Times(1)
+
+	watcher := &subscription.Source{
+		Factory:          factory,
+		SourceRepository: repository,
+		PodLister:        lister,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	r, err := watcher.WatchSource(ctx, &model.WatchSourceInput{SourceID: 4})
+	assert.NilError(t, err)
+
+	value := "value1"
+	boom := "boom"
+	expected := map[string]*model.SourceEmit{
+		"key1": &model.SourceEmit{Time: 1000, Key: "key1", Value: &value},
+		"key2": &model.SourceEmit{Time: 2000, Key: "key2", Delete: true, Error: &boom},
+	}
+	for i := 0; i < 2; i++ {
+		select {
+		case <-time.After(time.Second):
+			t.Fatal("failed waiting for emit")
+		case m := <-r:
+			assert.DeepEqual(t, m, expected[m.Key])
+		}
+	}
+}
+
+var _ watchv1.WatchAPI_SourceClient = &sourceStream{}
+
+type sourceStream struct {
+	stream
+	messages []*watchv1.SourceResponse
+	index    int
+}
+
+func (s *sourceStream) Recv() (*watchv1.SourceResponse, error) {
+	if s.index > len(s.messages)-1 {
+		<-s.ctx.Done()
+		return nil, context.Canceled
+	}
+	m := s.messages[s.index]
+	s.index++
+	return m, nil
+}
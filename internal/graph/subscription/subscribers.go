@@ -25,17 +25,32 @@ var _ resolvers.Subscribers = &Subscribers{}
 
 // Subscribers provides real time subscription handlers
 type Subscribers struct {
-	PodLister           PodLister
-	Factory             Factory
-	ProcessorRepository ProcessorRepository
+	PodLister            PodLister
+	Factory              Factory
+	ProcessorRepository  ProcessorRepository
+	SinkRepository       SinkRepository
+	SourceRepository     SourceRepository
+	ViewSourceRepository ViewSourceRepository
+	ViewSinkRepository   ViewSinkRepository
 }
 
 // NewSubscribers creates new subscribers
-func NewSubscribers(podLister PodLister, processorRepository ProcessorRepository) *Subscribers {
+func NewSubscribers(
+	podLister PodLister,
+	processorRepository ProcessorRepository,
+	sinkRepository SinkRepository,
+	sourceRepository SourceRepository,
+	viewSourceRepository ViewSourceRepository,
+	viewSinkRepository ViewSinkRepository,
+) *Subscribers {
 	return &Subscribers{
-		PodLister:           podLister,
-		Factory:             &ClientFactory{},
-		ProcessorRepository: processorRepository,
+		PodLister:            podLister,
+		Factory:              &ClientFactory{},
+		ProcessorRepository:  processorRepository,
+		SinkRepository:       sinkRepository,
+		SourceRepository:     sourceRepository,
+		ViewSourceRepository: viewSourceRepository,
+		ViewSinkRepository:   viewSinkRepository,
 	}
 }
 
@@ -47,3 +62,39 @@ func (s *Subscribers) Processor() resolvers.ProcessorWatcher {
 		ProcessorRepository: s.ProcessorRepository,
 	}
 }
+
+// Sink returns the sink subscriber handler
+func (s *Subscribers) Sink() resolvers.SinkWatcher {
+	return &Sink{
+		Factory:        s.Factory,
+		PodLister:      s.PodLister,
+		SinkRepository: s.SinkRepository,
+	}
+}
+
+// Source returns the source subscriber handler
+func (s *Subscribers) Source() resolvers.SourceWatcher {
+	return &Source{
+		Factory:          s.Factory,
+		PodLister:        s.PodLister,
+		SourceRepository: s.SourceRepository,
+	}
+}
+
+// ViewSource returns the view source subscriber handler
+func (s *Subscribers) ViewSource() resolvers.ViewSourceWatcher {
+	return &ViewSource{
+		Factory:              s.Factory,
+		PodLister:            s.PodLister,
+		ViewSourceRepository: s.ViewSourceRepository,
+	}
+}
+
+// ViewSink returns the view sink subscriber handler
+func (s *Subscribers) ViewSink() resolvers.ViewSinkWatcher {
+	return &ViewSink{
+		Factory:            s.Factory,
+		PodLister:          s.PodLister,
+		ViewSinkRepository: s.ViewSinkRepository,
+	}
+}
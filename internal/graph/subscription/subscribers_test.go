@@ -15,9 +15,17 @@ func Test_Subscribers(t *testing.T) {
 
 	lister := NewMockPodLister(ctrl)
 	repo := NewMockProcessorRepository(ctrl)
+	sinks := NewMockSinkRepository(ctrl)
+	sources := NewMockSourceRepository(ctrl)
+	viewSources := NewMockViewSourceRepository(ctrl)
+	viewSinks := NewMockViewSinkRepository(ctrl)
 
-	subscriber := subscription.NewSubscribers(lister, repo)
+	subscriber := subscription.NewSubscribers(lister, repo, sinks, sources, viewSources, viewSinks)
 
 	proc := subscriber.Processor()
 	assert.Assert(t, proc != nil)
+	assert.Assert(t, subscriber.Sink() != nil)
+	assert.Assert(t, subscriber.Source() != nil)
+	assert.Assert(t, subscriber.ViewSource() != nil)
+	assert.Assert(t, subscriber.ViewSink() != nil)
 }
@@ -0,0 +1,98 @@
+package subscription
+
+import (
+	"context"
+
+	"github.com/syncromatics/kafmesh/internal/graph/model"
+	"github.com/syncromatics/kafmesh/internal/graph/resolvers"
+	watchv1 "github.com/syncromatics/kafmesh/internal/protos/kafmesh/watch/v1"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockgen -source=./viewSink.go -destination=./viewSink_mock_test.go -package=subscription_test
+
+// ViewSinkRepository is the datastore repository for view sinks
+type ViewSinkRepository interface {
+	ByID(context.Context, int) (*model.ViewSink, error)
+	ComponentByViewSinks(context.Context, []int) ([]*model.Component, error)
+	PodsByViewSinks(context.Context, []int) ([][]*model.Pod, error)
+}
+
+var _ resolvers.ViewSinkWatcher = &ViewSink{}
+
+// ViewSink provides view sink watches
+type ViewSink struct {
+	Factory            Factory
+	ViewSinkRepository ViewSinkRepository
+	PodLister          PodLister
+}
+
+// WatchViewSink watches the sync runs of a view sink
+func (s *ViewSink) WatchViewSink(ctx context.Context, input *model.WatchViewSinkInput) (<-chan *model.ViewSinkSync, error) {
+	viewSink, err := s.ViewSinkRepository.ByID(ctx, input.ViewSinkID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get view sink by id")
+	}
+	if viewSink == nil {
+		return nil, errors.Errorf("view sink %d does not exist", input.ViewSinkID)
+	}
+
+	component, pods, err := componentPods(ctx, input.ViewSinkID, s.ViewSinkRepository.ComponentByViewSinks, s.ViewSinkRepository.PodsByViewSinks)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get view sink pods")
+	}
+
+	urls, err := podURLs(ctx, s.PodLister, pods)
+	if err != nil {
+		return nil, err
+	}
+	if len(urls) == 0 {
+		return nil, errors.Errorf("no pods are serving this view sink")
+	}
+
+	request := &watchv1.ViewSinkRequest{
+		Component: component,
+		ViewSink:  viewSink.Name,
+	}
+
+	messages, err := watchURLs(ctx, s.Factory, urls, func(ctx context.Context, client Watcher) (receiver, error) {
+		stream, err := client.ViewSink(ctx, request)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to call view sink on watch")
+		}
+
+		return func() (interface{}, error) {
+			m, err := stream.Recv()
+			if err != nil {
+				return nil, err
+			}
+			return m.Sync, nil
+		}, nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create view sink watch")
+	}
+
+	syncs := make(chan *model.ViewSinkSync)
+	go func() {
+		defer close(syncs)
+		for m := range messages {
+			select {
+			case <-ctx.Done():
+				return
+			case syncs <- mapGrpcViewSinkSyncToModel(m.(*watchv1.ViewSinkSync)):
+			}
+		}
+	}()
+
+	return syncs, nil
+}
+
+func mapGrpcViewSinkSyncToModel(sync *watchv1.ViewSinkSync) *model.ViewSinkSync {
+	return &model.ViewSinkSync{
+		StartTime: milliseconds(sync.StartTime),
+		EndTime:   milliseconds(sync.EndTime),
+		Error:     optional(sync.Error),
+	}
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./viewSink.go
+
+// Package subscription_test is a generated GoMock package.
+package subscription_test
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/syncromatics/kafmesh/internal/graph/model"
+	reflect "reflect"
+)
+
+// MockViewSinkRepository is a mock of ViewSinkRepository interface
+type MockViewSinkRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockViewSinkRepositoryMockRecorder
+}
+
+// MockViewSinkRepositoryMockRecorder is the mock recorder for MockViewSinkRepository
+type MockViewSinkRepositoryMockRecorder struct {
+	mock *MockViewSinkRepository
+}
+
+// NewMockViewSinkRepository creates a new mock instance
+func NewMockViewSinkRepository(ctrl *gomock.Controller) *MockViewSinkRepository {
+	mock := &MockViewSinkRepository{ctrl: ctrl}
+	mock.recorder = &MockViewSinkRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockViewSinkRepository) EXPECT() *MockViewSinkRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ByID mocks base method
+func (m *MockViewSinkRepository) ByID(arg0 context.Context, arg1 int) (*model.ViewSink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByID", arg0, arg1)
+	ret0, _ := ret[0].(*model.ViewSink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByID indicates an expected call of ByID
+func (mr *MockViewSinkRepositoryMockRecorder) ByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByID", reflect.TypeOf((*MockViewSinkRepository)(nil).ByID), arg0, arg1)
+}
+
+// ComponentByViewSinks mocks base method
+func (m *MockViewSinkRepository) ComponentByViewSinks(arg0 context.Context, arg1 []int) ([]*model.Component, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ComponentByViewSinks", arg0, arg1)
+	ret0, _ := ret[0].([]*model.Component)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ComponentByViewSinks indicates an expected call of ComponentByViewSinks
+func (mr *MockViewSinkRepositoryMockRecorder) ComponentByViewSinks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComponentByViewSinks", reflect.TypeOf((*MockViewSinkRepository)(nil).ComponentByViewSinks), arg0, arg1)
+}
+
+// PodsByViewSinks mocks base method
+func (m *MockViewSinkRepository) PodsByViewSinks(arg0 context.Context, arg1 []int) ([][]*model.Pod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PodsByViewSinks", arg0, arg1)
+	ret0, _ := ret[0].([][]*model.Pod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PodsByViewSinks indicates an expected call of PodsByViewSinks
+func (mr *MockViewSinkRepositoryMockRecorder) PodsByViewSinks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PodsByViewSinks", reflect.TypeOf((*MockViewSinkRepository)(nil).PodsByViewSinks), arg0, arg1)
+}
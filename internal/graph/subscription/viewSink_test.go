@@ -0,0 +1,112 @@
+package subscription_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/syncromatics/kafmesh/internal/graph/model"
+	"github.com/syncromatics/kafmesh/internal/graph/subscription"
+	watchv1 "github.com/syncromatics/kafmesh/internal/protos/kafmesh/watch/v1"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/grpc"
+	"gotest.tools/assert"
+)
+
+func Test_ViewSink_WatchViewSink(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	factory := NewMockFactory(ctrl)
+	repository := NewMockViewSinkRepository(ctrl)
+	lister := NewMockPodLister(ctrl)
+
+	repository.EXPECT().
+		ByID(gomock.Any(), 6).
+		Return(&model.ViewSink{Name: "testViewSink"}, nil).
+		Times(1)
+
+	repository.EXPECT().
+		ComponentByViewSinks(gomock.Any(), []int{6}).
+		Return([]*model.Component{&model.Component{Name: "component1"}}, nil).
+		Times(1)
+
+	repository.EXPECT().
+		PodsByViewSinks(gomock.Any(), []int{6}).
+		Return([][]*model.Pod{[]*model.Pod{&model.Pod{Name: "pod1"}}}, nil).
+		Times(1)
+
+	lister.EXPECT().
+		List(gomock.Any(), gomock.Any()).
+		Return(podList("1"), nil).
+		Times(1)
+
+	client := NewMockWatcher(ctrl)
+	client.EXPECT().
+		ViewSink(gomock.Any(), &watchv1.ViewSinkRequest{
+			Component: "component1",
+			ViewSink:  "testViewSink",
+		}, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, request *watchv1.ViewSinkRequest, opts ...grpc.CallOption) (watchv1.WatchAPI_ViewSinkClient, error) {
+			return &viewSinkStream{
+				stream: stream{ctx},
+				messages: []*watchv1.ViewSinkResponse{
+					&watchv1.ViewSinkResponse{Sync: &watchv1.ViewSinkSync{
+						StartTime: &timestamp.Timestamp{Seconds: 1},
+						EndTime:   &timestamp.Timestamp{Seconds: 1, Nanos: 5000000},
+						Error:     "boom",
+					}},
+				},
+			}, nil
+		}).
+		Times(1)
+
+	factory.EXPECT().
+		Client(gomock.Any(), "1.1.1.1:7777").
+		Return(client, nil).
+		Times(1)
+
+	watcher := &subscription.ViewSink{
+		Factory:            factory,
+		ViewSinkRepository: repository,
+		PodLister:          lister,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	r, err := watcher.WatchViewSink(ctx, &model.WatchViewSinkInput{ViewSinkID: 6})
+	assert.NilError(t, err)
+
+	boom := "boom"
+	select {
+	case <-time.After(time.Second):
+		t.Fatal("failed waiting for sync")
+	case m := <-r:
+		assert.DeepEqual(t, m, &model.ViewSinkSync{
+			StartTime: 1000,
+			EndTime:   1005,
+			Error:     &boom,
+		})
+	}
+}
+
+var _ watchv1.WatchAPI_ViewSinkClient = &viewSinkStream{}
+
+type viewSinkStream struct {
+	stream
+	messages []*watchv1.ViewSinkResponse
+	index    int
+}
+
+func (s *viewSinkStream) Recv() (*watchv1.ViewSinkResponse, error) {
+	if s.index > len(s.messages)-1 {
+		<-s.ctx.Done()
+		return nil, context.Canceled
+	}
+	m := s.messages[s.index]
+	s.index++
+	return m, nil
+}
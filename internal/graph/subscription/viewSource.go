@@ -0,0 +1,110 @@
+package subscription
+
+import (
+	"context"
+
+	"github.com/syncromatics/kafmesh/internal/graph/model"
+	"github.com/syncromatics/kafmesh/internal/graph/resolvers"
+	watchv1 "github.com/syncromatics/kafmesh/internal/protos/kafmesh/watch/v1"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockgen -source=./viewSource.go -destination=./viewSource_mock_test.go -package=subscription_test
+
+// ViewSourceRepository is the datastore repository for view sources
+type ViewSourceRepository interface {
+	ByID(context.Context, int) (*model.ViewSource, error)
+	ComponentByViewSources(context.Context, []int) ([]*model.Component, error)
+	PodsByViewSources(context.Context, []int) ([][]*model.Pod, error)
+}
+
+var _ resolvers.ViewSourceWatcher = &ViewSource{}
+
+// ViewSource provides view source watches
+type ViewSource struct {
+	Factory              Factory
+	ViewSourceRepository ViewSourceRepository
+	PodLister            PodLister
+}
+
+// WatchViewSource watches the sync runs of a view source
+func (s *ViewSource) WatchViewSource(ctx context.Context, input *model.WatchViewSourceInput) (<-chan *model.ViewSourceSync, error) {
+	viewSource, err := s.ViewSourceRepository.ByID(ctx, input.ViewSourceID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get view source by id")
+	}
+	if viewSource == nil {
+		return nil, errors.Errorf("view source %d does not exist", input.ViewSourceID)
+	}
+
+	component, pods, err := componentPods(ctx, input.ViewSourceID, s.ViewSourceRepository.ComponentByViewSources, s.ViewSourceRepository.PodsByViewSources)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get view source pods")
+	}
+
+	urls, err := podURLs(ctx, s.PodLister, pods)
+	if err != nil {
+		return nil, err
+	}
+	if len(urls) == 0 {
+		return nil, errors.Errorf("no pods are serving this view source")
+	}
+
+	request := &watchv1.ViewSourceRequest{
+		Component:  component,
+		ViewSource: viewSource.Name,
+	}
+
+	messages, err := watchURLs(ctx, s.Factory, urls, func(ctx context.Context, client Watcher) (receiver, error) {
+		stream, err := client.ViewSource(ctx, request)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to call view source on watch")
+		}
+
+		return func() (interface{}, error) {
+			m, err := stream.Recv()
+			if err != nil {
+				return nil, err
+			}
+			return m.Sync, nil
+		}, nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create view source watch")
+	}
+
+	syncs := make(chan *model.ViewSourceSync)
+	go func() {
+		defer close(syncs)
+		for m := range messages {
+			select {
+			case <-ctx.Done():
+				return
+			case syncs <- mapGrpcViewSourceSyncToModel(m.(*watchv1.ViewSourceSync)):
+			}
+		}
+	}()
+
+	return syncs, nil
+}
+
+func mapGrpcViewSourceSyncToModel(sync *watchv1.ViewSourceSync) *model.ViewSourceSync {
+	result := &model.ViewSourceSync{
+		StartTime: milliseconds(sync.StartTime),
+		EndTime:   milliseconds(sync.EndTime),
+		Updates:   []*model.ViewSourceUpdate{},
+		Deletes:   []string{},
+		Error:     optional(sync.Error),
+	}
+
+	for _, u := range sync.Updates {
+		result.Updates = append(result.Updates, &model.ViewSourceUpdate{
+			Key:   u.Key,
+			Value: u.Value,
+		})
+	}
+	result.Deletes = append(result.Deletes, sync.Deletes...)
+
+	return result
+}
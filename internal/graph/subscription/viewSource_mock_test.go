@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./viewSource.go
+
+// Package subscription_test is a generated GoMock package.
+package subscription_test
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/syncromatics/kafmesh/internal/graph/model"
+	reflect "reflect"
+)
+
+// MockViewSourceRepository is a mock of ViewSourceRepository interface
+type MockViewSourceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockViewSourceRepositoryMockRecorder
+}
+
+// MockViewSourceRepositoryMockRecorder is the mock recorder for MockViewSourceRepository
+type MockViewSourceRepositoryMockRecorder struct {
+	mock *MockViewSourceRepository
+}
+
+// NewMockViewSourceRepository creates a new mock instance
+func NewMockViewSourceRepository(ctrl *gomock.Controller) *MockViewSourceRepository {
+	mock := &MockViewSourceRepository{ctrl: ctrl}
+	mock.recorder = &MockViewSourceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockViewSourceRepository) EXPECT() *MockViewSourceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ByID mocks base method
+func (m *MockViewSourceRepository) ByID(arg0 context.Context, arg1 int) (*model.ViewSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByID", arg0, arg1)
+	ret0, _ := ret[0].(*model.ViewSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByID indicates an expected call of ByID
+func (mr *MockViewSourceRepositoryMockRecorder) ByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByID", reflect.TypeOf((*MockViewSourceRepository)(nil).ByID), arg0, arg1)
+}
+
+// ComponentByViewSources mocks base method
+func (m *MockViewSourceRepository) ComponentByViewSources(arg0 context.Context, arg1 []int) ([]*model.Component, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ComponentByViewSources", arg0, arg1)
+	ret0, _ := ret[0].([]*model.Component)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ComponentByViewSources indicates an expected call of ComponentByViewSources
+func (mr *MockViewSourceRepositoryMockRecorder) ComponentByViewSources(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComponentByViewSources", reflect.TypeOf((*MockViewSourceRepository)(nil).ComponentByViewSources), arg0, arg1)
+}
+
+// PodsByViewSources mocks base method
+func (m *MockViewSourceRepository) PodsByViewSources(arg0 context.Context, arg1 []int) ([][]*model.Pod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PodsByViewSources", arg0, arg1)
+	ret0, _ := ret[0].([][]*model.Pod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PodsByViewSources indicates an expected call of PodsByViewSources
+func (mr *MockViewSourceRepositoryMockRecorder) PodsByViewSources(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PodsByViewSources", reflect.TypeOf((*MockViewSourceRepository)(nil).PodsByViewSources), arg0, arg1)
+}
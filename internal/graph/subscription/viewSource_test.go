@@ -0,0 +1,135 @@
+package subscription_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/syncromatics/kafmesh/internal/graph/model"
+	"github.com/syncromatics/kafmesh/internal/graph/subscription"
+	watchv1 "github.com/syncromatics/kafmesh/internal/protos/kafmesh/watch/v1"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/grpc"
+	"gotest.tools/assert"
+)
+
+func Test_ViewSource_WatchViewSource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	factory := NewMockFactory(ctrl)
+	repository := NewMockViewSourceRepository(ctrl)
+	lister := NewMockPodLister(ctrl)
+
+	repository.EXPECT().
+		ByID(gomock.Any(), 5).
+		Return(&model.ViewSource{Name: "testViewSource"}, nil).
+		Times(1)
+
+	repository.EXPECT().
+		ComponentByViewSources(gomock.Any(), []int{5}).
+		Return([]*model.Component{&model.Component{Name: "component1"}}, nil).
+		Times(1)
+
+	repository.EXPECT().
+		PodsByViewSources(gomock.Any(), []int{5}).
+		Return([][]*model.Pod{[]*model.Pod{&model.Pod{Name: "pod1"}}}, nil).
+		Times(1)
+
+	lister.EXPECT().
+		List(gomock.Any(), gomock.Any()).
+		Return(podList("1"), nil).
+		Times(1)
+
+	client := NewMockWatcher(ctrl)
+	client.EXPECT().
+		ViewSource(gomock.Any(), &watchv1.ViewSourceRequest{
+			Component:  "component1",
+			ViewSource: "testViewSource",
+		}, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, request *watchv1.ViewSourceRequest, opts ...grpc.CallOption) (watchv1.WatchAPI_ViewSourceClient, error) {
+			return &viewSourceStream{
+				stream: stream{ctx},
+				messages: []*watchv1.ViewSourceResponse{
+					&watchv1.ViewSourceResponse{Sync: &watchv1.ViewSourceSync{
+						StartTime: &timestamp.Timestamp{Seconds: 1},
+						EndTime:   &timestamp.Timestamp{Seconds: 2},
+						Updates: []*watchv1.ViewSourceUpdate{
+							&watchv1.ViewSourceUpdate{Key: "key1", Value: "value1"},
+						},
+						Deletes: []string{"key2"},
+					}},
+				},
+			}, nil
+		}).
+		Times(1)
+
+	factory.EXPECT().
+		Client(gomock.Any(), "1.1.1.1:7777").
+		Return(client, nil).
+		Times(1)
+
+	watcher := &subscription.ViewSource{
+		Factory:              factory,
+		ViewSourceRepository: repository,
+		PodLister:            lister,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	r, err := watcher.WatchViewSource(ctx, &model.WatchViewSourceInput{ViewSourceID: 5})
+	assert.NilError(t, err)
+
+	select {
+	case <-time.After(time.Second):
+		t.Fatal("failed waiting for sync")
+	case m := <-r:
+		assert.DeepEqual(t, m, &model.ViewSourceSync{
+			StartTime: 1000,
+			EndTime:   2000,
+			Updates: []*model.ViewSourceUpdate{
+				&model.ViewSourceUpdate{Key: "key1", Value: "value1"},
+			},
+			Deletes: []string{"key2"},
+		})
+	}
+}
+
+func Test_ViewSource_WatchMissingViewSource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := NewMockViewSourceRepository(ctrl)
+	repository.EXPECT().
+		ByID(gomock.Any(), 5).
+		Return(nil, nil).
+		Times(1)
+
+	watcher := &subscription.ViewSource{
+		ViewSourceRepository: repository,
+	}
+
+	_, err := watcher.WatchViewSource(context.Background(), &model.WatchViewSourceInput{ViewSourceID: 5})
+	assert.ErrorContains(t, err, "view source 5 does not exist")
+}
+
+var _ watchv1.WatchAPI_ViewSourceClient = &viewSourceStream{}
+
+type viewSourceStream struct {
+	stream
+	messages []*watchv1.ViewSourceResponse
+	index    int
+}
+
+func (s *viewSourceStream) Recv() (*watchv1.ViewSourceResponse, error) {
+	if s.index > len(s.messages)-1 {
+		<-s.ctx.Done()
+		return nil, context.Canceled
+	}
+	m := s.messages[s.index]
+	s.index++
+	return m, nil
+}
@@ -0,0 +1,132 @@
+package subscription
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/syncromatics/kafmesh/internal/graph/model"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// receiver receives the next message of a watch stream
+type receiver func() (interface{}, error)
+
+// componentPods gets the name of the component and the pods of the part of it with the id given
+func componentPods(
+	ctx context.Context,
+	id int,
+	components func(context.Context, []int) ([]*model.Component, error),
+	pods func(context.Context, []int) ([][]*model.Pod, error),
+) (string, []*model.Pod, error) {
+	c, err := components(ctx, []int{id})
+	if err != nil {
+		return "", nil, errors.Wrap(err, "failed to get component")
+	}
+	if len(c) != 1 {
+		return "", nil, errors.Errorf("did not receive correct response from components. len: %d ", len(c))
+	}
+
+	p, err := pods(ctx, []int{id})
+	if err != nil {
+		return "", nil, errors.Wrap(err, "failed to get pods")
+	}
+	if len(p) != 1 {
+		return "", nil, errors.Errorf("did not receive correct response from pods. len: %d ", len(p))
+	}
+
+	return c[0].Name, p[0], nil
+}
+
+// podURLs gets the urls of the watch api of the pods
+func podURLs(ctx context.Context, lister PodLister, pods []*model.Pod) ([]string, error) {
+	l, err := lister.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list pods")
+	}
+
+	urls := []string{}
+	for _, pod := range pods {
+		for _, p := range l.Items {
+			if p.Name != pod.Name {
+				continue
+			}
+			port, ok := p.Annotations[portAnnotation]
+			if !ok {
+				port = "443"
+			}
+
+			urls = append(urls, fmt.Sprintf("%s:%s", p.Status.PodIP, port))
+		}
+	}
+
+	return urls, nil
+}
+
+// watchURLs opens a watch on each of the urls and merges the messages they stream
+func watchURLs(ctx context.Context, factory Factory, urls []string, open func(context.Context, Watcher) (receiver, error)) (<-chan interface{}, error) {
+	receivers := []receiver{}
+	for _, url := range urls {
+		client, err := factory.Client(ctx, url)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create grpc client")
+		}
+
+		r, err := open(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		receivers = append(receivers, r)
+	}
+
+	out := make(chan interface{})
+	var wg sync.WaitGroup
+	wg.Add(len(receivers))
+	for _, r := range receivers {
+		go func(r receiver) {
+			defer wg.Done()
+			for {
+				m, err := r()
+				if err != nil {
+					return
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case out <- m:
+				}
+			}
+		}(r)
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out, nil
+}
+
+func milliseconds(t *timestamp.Timestamp) int {
+	if t == nil {
+		return 0
+	}
+
+	ts, err := ptypes.Timestamp(t)
+	if err != nil {
+		return 0
+	}
+
+	return int(ts.UnixNano() / 1e6)
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
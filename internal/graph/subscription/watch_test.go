@@ -0,0 +1,57 @@
+package subscription_test
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// podList lists pods serving the watch api on port 7777 at 1.1.1.x for each x given
+func podList(ips ...string) *corev1.PodList {
+	list := &corev1.PodList{}
+	for i, ip := range ips {
+		list.Items = append(list.Items, corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "pod" + string(rune('1'+i)),
+				Annotations: map[string]string{
+					"kafmesh/port": "7777",
+				},
+			},
+			Status: corev1.PodStatus{
+				PodIP: "1.1.1." + ip,
+			},
+		})
+	}
+	return list
+}
+
+// stream is the part of a watch client stream the tests do not use
+type stream struct {
+	ctx context.Context
+}
+
+func (*stream) CloseSend() error {
+	return nil
+}
+
+func (s *stream) Context() context.Context {
+	return s.ctx
+}
+
+func (*stream) Header() (metadata.MD, error) {
+	return nil, nil
+}
+
+func (*stream) RecvMsg(interface{}) error {
+	return nil
+}
+
+func (*stream) SendMsg(interface{}) error {
+	return nil
+}
+
+func (*stream) Trailer() metadata.MD {
+	return nil
+}
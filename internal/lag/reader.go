@@ -0,0 +1,106 @@
+package lag
+
+import (
+	"github.com/syncromatics/kafmesh/internal/storage"
+
+	"github.com/Shopify/sarama"
+	"github.com/pkg/errors"
+)
+
+// Reader reads the lag of consumer groups from the kafka brokers
+type Reader struct {
+	client sarama.Client
+	admin  sarama.ClusterAdmin
+}
+
+// NewReader creates a new reader connected to the brokers
+func NewReader(brokers []string) (*Reader, error) {
+	config := sarama.NewConfig()
+	config.Version = sarama.MaxVersion
+
+	client, err := sarama.NewClient(brokers, config)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create kafka client")
+	}
+
+	admin, err := sarama.NewClusterAdminFromClient(client)
+	if err != nil {
+		client.Close()
+		return nil, errors.Wrap(err, "failed to create cluster admin")
+	}
+
+	return &Reader{client, admin}, nil
+}
+
+// Read gets the committed offset, high watermark and lag of each partition the group consumes.
+// Partitions the group has not committed to lag by all the messages still in the topic.
+func (r *Reader) Read(group storage.ConsumerGroup) ([]storage.ConsumerLag, error) {
+	topics := []string{}
+	partitions := map[string][]int32{}
+	for _, topic := range group.Topics {
+		p, err := r.client.Partitions(topic)
+		if err == sarama.ErrUnknownTopicOrPartition {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get partitions of topic '%s'", topic)
+		}
+
+		topics = append(topics, topic)
+		partitions[topic] = p
+	}
+
+	if len(topics) == 0 {
+		return []storage.ConsumerLag{}, nil
+	}
+
+	offsets, err := r.admin.ListConsumerGroupOffsets(group.Name, partitions)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get offsets of group '%s'", group.Name)
+	}
+
+	lags := []storage.ConsumerLag{}
+	for _, topic := range topics {
+		for _, partition := range partitions[topic] {
+			highWatermark, err := r.client.GetOffset(topic, partition, sarama.OffsetNewest)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get high watermark of topic '%s' partition %d", topic, partition)
+			}
+
+			committed := int64(-1)
+			block := offsets.GetBlock(topic, partition)
+			if block != nil && block.Err == sarama.ErrNoError {
+				committed = block.Offset
+			}
+
+			start := committed
+			if committed < 0 {
+				start, err = r.client.GetOffset(topic, partition, sarama.OffsetOldest)
+				if err != nil {
+					return nil, errors.Wrapf(err, "failed to get oldest offset of topic '%s' partition %d", topic, partition)
+				}
+			}
+
+			lag := highWatermark - start
+			if lag < 0 {
+				lag = 0
+			}
+
+			lags = append(lags, storage.ConsumerLag{
+				Group:           group.Name,
+				Topic:           topic,
+				Partition:       partition,
+				CommittedOffset: committed,
+				HighWatermark:   highWatermark,
+				Lag:             lag,
+			})
+		}
+	}
+
+	return lags, nil
+}
+
+// Close the connection to the brokers
+func (r *Reader) Close() error {
+	return r.admin.Close()
+}
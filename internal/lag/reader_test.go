@@ -0,0 +1,50 @@
+package lag_test
+
+import (
+	"testing"
+
+	"github.com/syncromatics/kafmesh/internal/lag"
+	"github.com/syncromatics/kafmesh/internal/storage"
+
+	"github.com/Shopify/sarama"
+	"gotest.tools/assert"
+)
+
+func Test_Reader(t *testing.T) {
+	broker := sarama.NewMockBroker(t, 1)
+	defer broker.Close()
+
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetController(broker.BrokerID()).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetLeader("topic1", 0, broker.BrokerID()).
+			SetLeader("topic1", 1, broker.BrokerID()),
+		"FindCoordinatorRequest": sarama.NewMockFindCoordinatorResponse(t).
+			SetCoordinator(sarama.CoordinatorGroup, "group1", broker),
+		"OffsetFetchRequest": sarama.NewMockOffsetFetchResponse(t).
+			SetOffset("group1", "topic1", 0, 7, "", sarama.ErrNoError).
+			SetOffset("group1", "topic1", 1, -1, "", sarama.ErrNoError),
+		"OffsetRequest": sarama.NewMockOffsetResponse(t).
+			SetVersion(1).
+			SetOffset("topic1", 0, sarama.OffsetNewest, 10).
+			SetOffset("topic1", 0, sarama.OffsetOldest, 0).
+			SetOffset("topic1", 1, sarama.OffsetNewest, 20).
+			SetOffset("topic1", 1, sarama.OffsetOldest, 15),
+	})
+
+	reader, err := lag.NewReader([]string{broker.Addr()})
+	assert.NilError(t, err)
+	defer reader.Close()
+
+	lags, err := reader.Read(storage.ConsumerGroup{
+		Name:   "group1",
+		Topics: []string{"topic1", "missing"},
+	})
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, lags, []storage.ConsumerLag{
+		{Group: "group1", Topic: "topic1", Partition: 0, CommittedOffset: 7, HighWatermark: 10, Lag: 3},
+		{Group: "group1", Topic: "topic1", Partition: 1, CommittedOffset: -1, HighWatermark: 20, Lag: 5},
+	})
+}
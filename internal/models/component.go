@@ -23,6 +23,8 @@ type Component struct {
 
 	Sources     []Source
 	Processors  []Processor
+	Windows     []Window
+	Repartition []Repartition
 	Sinks       []Sink
 	Views       []View
 	ViewSources []ViewSource `yaml:"viewSources"`
@@ -86,6 +88,19 @@ func (t TopicDefinition) ToFullMessageType(service *Service) string {
 	return m
 }
 
+// ToSerializationType gets the serialization type of the topic message, falling back to the service default
+func (t TopicDefinition) ToSerializationType(service *Service) string {
+	if t.Type != nil {
+		return *t.Type
+	}
+
+	if service.Defaults.Type != "" {
+		return service.Defaults.Type
+	}
+
+	return "protobuf"
+}
+
 // ToSafeMessageTypeName generates a name that will pass go vet
 func (t TopicDefinition) ToSafeMessageTypeName() string {
 	builder := strings.Builder{}
@@ -155,6 +170,8 @@ type Source struct {
 type View struct {
 	TopicDefinition         `yaml:",inline"`
 	TopicCreationDefinition `yaml:",inline"`
+	ReadCommitted           bool `yaml:"readCommitted"`
+	Storage                 *Storage
 }
 
 // Processor processes kafka messages backed by a consumer group and sometimes with persistence
@@ -163,12 +180,30 @@ type Processor struct {
 	GroupNameOverride *string `yaml:"groupName"`
 	Description       string
 
-	Inputs  []Input
-	Lookups []Lookup
-	Joins   []Join
-	Outputs []Output
+	Inputs      []Input
+	Lookups     []Lookup
+	Joins       []Join
+	StreamJoins []StreamJoin `yaml:"streamJoins"`
+	Outputs     []Output
 
 	Persistence *Persistence
+	DeadLetter  *DeadLetter `yaml:"deadLetter"`
+	// ExactlyOnce commits outputs, state and offsets in a kafka transaction. It is not supported yet
+	// since goka and the sarama version it is built on have no transactional producer, so the
+	// generator rejects it.
+	ExactlyOnce bool `yaml:"exactlyOnce"`
+	// IdempotentProducer writes outputs and state with idempotent producers. It is not exactly once
+	// processing since outputs, state and offsets are not committed in a transaction.
+	IdempotentProducer bool `yaml:"idempotentProducer"`
+	// ReadCommitted only reads committed messages from the inputs, joins, lookups and state
+	ReadCommitted bool `yaml:"readCommitted"`
+	Timers        bool
+	// Standby keeps hot copies of the partitions of the group table owned by other instances so
+	// partitions that move to this instance are recovered from local storage
+	Standby bool
+	// StandbyReplicas is the number of instances that keep a copy of each partition. It defaults to 1.
+	StandbyReplicas int `yaml:"standbyReplicas"`
+	Storage         *Storage
 }
 
 // ToSafeName get a go safe name
@@ -189,6 +224,27 @@ func (p *Processor) GroupName(service *Service, component *Component) string {
 	return fmt.Sprintf("%s.%s.%s", service.ToTopicName(), component.ToGroupName(), strcase.ToLowerCamel(p.Name))
 }
 
+// DeadLetterTopicName gets the topic that failed messages are sent to
+func (p *Processor) DeadLetterTopicName(service *Service, component *Component) string {
+	if p.DeadLetter != nil && p.DeadLetter.Topic != nil {
+		return *p.DeadLetter.Topic
+	}
+
+	return p.GroupName(service, component) + "-dlq"
+}
+
+// RetryGroupName gets the consumer group that keeps the messages the processor retries until they are due.
+// It is also the name of the stream retries are scheduled on.
+func (p *Processor) RetryGroupName(service *Service, component *Component) string {
+	return p.GroupName(service, component) + "-retries"
+}
+
+// TimerGroupName gets the consumer group that keeps the timers the processor schedules.
+// It is also the name of the stream timers are scheduled on.
+func (p *Processor) TimerGroupName(service *Service, component *Component) string {
+	return p.GroupName(service, component) + "-timers"
+}
+
 // Input is an edge of a processor that will take in messages from a topic
 type Input struct {
 	TopicDefinition `yaml:",inline"`
@@ -198,6 +254,7 @@ type Input struct {
 // and makes it available to the processor by key
 type Lookup struct {
 	TopicDefinition `yaml:",inline"`
+	Storage         *Storage
 }
 
 // Join is an edge of a processor that takes in a co-partitioned topic and provides the value to
@@ -206,6 +263,23 @@ type Join struct {
 	TopicDefinition `yaml:",inline"`
 }
 
+// StreamJoin is an edge of a processor that reads a co-partitioned stream and provides the messages
+// of the stream within the window before the message being processed
+type StreamJoin struct {
+	TopicDefinition `yaml:",inline"`
+	Window          time.Duration
+}
+
+// StoreName gets the name of the windowed store the processor keeps the messages of the stream in
+func (j StreamJoin) StoreName(service *Service, component *Component, processor *Processor) string {
+	return fmt.Sprintf("%s-%s-join", processor.GroupName(service, component), strcase.ToLowerCamel(j.ToSafeMessageTypeName()))
+}
+
+// ChangelogName gets the compacted topic the windowed store of the stream is recovered from
+func (j StreamJoin) ChangelogName(service *Service, component *Component, processor *Processor) string {
+	return j.StoreName(service, component, processor) + "-table"
+}
+
 // Output is an edge of a processor that outputs into a kafka topic
 type Output struct {
 	TopicDefinition         `yaml:",inline"`
@@ -217,6 +291,108 @@ type Output struct {
 type Persistence struct {
 	TopicDefinition         `yaml:",inline"`
 	TopicCreationDefinition `yaml:",inline"`
+	Storage                 *Storage
+}
+
+// DeadLetter is where a processor sends input messages it failed to handle
+type DeadLetter struct {
+	Topic                   *string
+	TopicCreationDefinition `yaml:",inline"`
+	Retry                   Retry
+}
+
+// Storage overrides how the local table state of a processor or view is stored
+type Storage struct {
+	Backend            string
+	BlockCacheCapacity int `yaml:"blockCacheCapacity"`
+	WriteBuffer        int `yaml:"writeBuffer"`
+}
+
+// Retry describes how a failing message is retried before giving up on it
+type Retry struct {
+	MaxAttempts int `yaml:"maxAttempts"`
+	Backoff     time.Duration
+	MaxBackoff  time.Duration `yaml:"maxBackoff"`
+}
+
+// Window is a processor that aggregates its inputs into tumbling or hopping time windows.
+// The aggregates are kept in a compacted table until the window closes and is emitted.
+type Window struct {
+	Name              string
+	GroupNameOverride *string `yaml:"groupName"`
+	Description       string
+
+	Inputs    []Input
+	Outputs   []Output
+	Aggregate Persistence
+
+	Size    time.Duration
+	Advance time.Duration
+	Grace   time.Duration
+
+	Storage *Storage
+}
+
+// ToSafeName get a go safe name
+func (w *Window) ToSafeName() string {
+	builder := strings.Builder{}
+	for _, f := range strings.Split(w.Name, " ") {
+		builder.WriteString(strcase.ToCamel(f))
+	}
+	return builder.String()
+}
+
+// GroupName gets the consumer group name of the windowed processor
+func (w *Window) GroupName(service *Service, component *Component) string {
+	if w.GroupNameOverride != nil {
+		return *w.GroupNameOverride
+	}
+
+	return fmt.Sprintf("%s.%s.%s", service.ToTopicName(), component.ToGroupName(), strcase.ToLowerCamel(w.Name))
+}
+
+// TableName gets the compacted topic the window aggregates are stored in
+func (w *Window) TableName(service *Service, component *Component) string {
+	return w.GroupName(service, component) + "-table"
+}
+
+// TimerGroupName gets the consumer group that keeps the timers that close the windows of quiet keys.
+// It is also the name of the stream the timers are scheduled on.
+func (w *Window) TimerGroupName(service *Service, component *Component) string {
+	return w.GroupName(service, component) + "-timers"
+}
+
+// Repartition is a processor that re-keys the messages of a topic with a user supplied key function
+// and writes them to a repartition topic so they can be joined by the new key
+type Repartition struct {
+	Name                    string
+	GroupNameOverride       *string `yaml:"groupName"`
+	Description             string
+	TopicDefinition         `yaml:",inline"`
+	TopicCreationDefinition `yaml:",inline"`
+}
+
+// ToSafeName get a go safe name
+func (r *Repartition) ToSafeName() string {
+	builder := strings.Builder{}
+	for _, f := range strings.Split(r.Name, " ") {
+		builder.WriteString(strcase.ToCamel(f))
+	}
+	return builder.String()
+}
+
+// GroupName gets the consumer group name of the repartition processor
+func (r *Repartition) GroupName(service *Service, component *Component) string {
+	if r.GroupNameOverride != nil {
+		return *r.GroupNameOverride
+	}
+
+	return fmt.Sprintf("%s.%s.%s", service.ToTopicName(), component.ToGroupName(), strcase.ToLowerCamel(r.Name))
+}
+
+// RepartitionTopicName gets the topic the re-keyed messages are written to
+func (r *Repartition) RepartitionTopicName(service *Service, component *Component) string {
+	return r.GroupName(service, component) + "-repartition"
 }
 
 // Sink is a job that will sink a topic to an external source
@@ -224,6 +400,7 @@ type Sink struct {
 	Name            string
 	Description     string
 	TopicDefinition `yaml:",inline"`
+	ReadCommitted   bool `yaml:"readCommitted"`
 }
 
 // ToSafeName get a go safe name
@@ -235,12 +412,18 @@ func (p *Sink) ToSafeName() string {
 	return builder.String()
 }
 
+// GroupName gets the consumer group of the sink
+func (p *Sink) GroupName(service *Service, component *Component) string {
+	return fmt.Sprintf("%s.%s.%s-sink", service.Name, component.Name, strings.ToLower(p.ToSafeName()))
+}
+
 // ViewSource is a job that will sync an external source into a kafka view
 type ViewSource struct {
 	Name                    string
 	TopicDefinition         `yaml:",inline"`
 	TopicCreationDefinition `yaml:",inline"`
 	Description             string
+	Storage                 *Storage
 }
 
 // ToSafeName get a go safe name
@@ -258,6 +441,7 @@ type ViewSink struct {
 	TopicDefinition         `yaml:",inline"`
 	TopicCreationDefinition `yaml:",inline"`
 	Description             string
+	Storage                 *Storage
 }
 
 // ToSafeName get a go safe name
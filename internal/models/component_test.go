@@ -3,6 +3,7 @@ package models_test
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/syncromatics/kafmesh/internal/models"
 
@@ -30,9 +31,14 @@ processors:
     lookups:
       - message: kafmesh.customerId.details
         type: protobuf
+        storage:
+          backend: pebble
     joins:
       - message: kafmesh.customerId.details
         type: protobuf
+    streamJoins:
+      - message: kafmesh.deviceId.location
+        window: 30s
     outputs:
       - message: kafmesh.deviceId.enrichedDetail
         description: Enriched device details
@@ -41,12 +47,48 @@ processors:
     persistence:
       message: kafmesh.deviceId.enrichedDetailsState
       type: protobuf
+    deadLetter:
+      partitions: 10
+      retry:
+        maxAttempts: 3
+        backoff: 100ms
+        maxBackoff: 1s
+    idempotentProducer: true
+    readCommitted: true
+    timers: true
+    standby: true
+    standbyReplicas: 2
+    storage:
+      backend: leveldb
+      blockCacheCapacity: 8388608
+      writeBuffer: 4194304
+
+windows:
+  - name: detail counts
+    description: Counts device details every five minutes.
+    inputs:
+      - message: kafmesh.deviceId.detail
+    outputs:
+      - message: kafmesh.deviceId.detailCount
+    aggregate:
+      message: kafmesh.deviceId.detailCountState
+      partitions: 10
+    size: 5m
+    advance: 1m
+    grace: 30s
+
+repartition:
+  - name: details by vehicle
+    description: Re-keys device details by vehicle.
+    message: kafmesh.deviceId.detail
+    partitions: 10
 
 sinks:
   - message: kafmesh.deviceId.enrichedDetail
     name: Enriched Detail Warehouse Sink
     description: Sinks enriched device details to the warehouse database.
     type: protobuf
+    readCommitted: true
 
 viewSources:
   - message: kafmesh.deviceId.customer
@@ -111,6 +153,9 @@ viewSinks:
 							Message: "kafmesh.customerId.details",
 							Type:    &topicType,
 						},
+						Storage: &models.Storage{
+							Backend: "pebble",
+						},
 					},
 				},
 
@@ -123,6 +168,15 @@ viewSinks:
 					},
 				},
 
+				StreamJoins: []models.StreamJoin{
+					models.StreamJoin{
+						TopicDefinition: models.TopicDefinition{
+							Message: "kafmesh.deviceId.location",
+						},
+						Window: 30 * time.Second,
+					},
+				},
+
 				Outputs: []models.Output{
 					models.Output{
 						TopicDefinition: models.TopicDefinition{
@@ -142,6 +196,72 @@ viewSinks:
 						Type:    &topicType,
 					},
 				},
+
+				DeadLetter: &models.DeadLetter{
+					TopicCreationDefinition: models.TopicCreationDefinition{
+						Partitions: &partition,
+					},
+					Retry: models.Retry{
+						MaxAttempts: 3,
+						Backoff:     100 * time.Millisecond,
+						MaxBackoff:  time.Second,
+					},
+				},
+				IdempotentProducer: true,
+				ReadCommitted:      true,
+				Timers:             true,
+				Standby:            true,
+				StandbyReplicas:    2,
+				Storage: &models.Storage{
+					Backend:            "leveldb",
+					BlockCacheCapacity: 8388608,
+					WriteBuffer:        4194304,
+				},
+			},
+		},
+
+		Windows: []models.Window{
+			models.Window{
+				Name:        "detail counts",
+				Description: "Counts device details every five minutes.",
+				Inputs: []models.Input{
+					models.Input{
+						TopicDefinition: models.TopicDefinition{
+							Message: "kafmesh.deviceId.detail",
+						},
+					},
+				},
+				Outputs: []models.Output{
+					models.Output{
+						TopicDefinition: models.TopicDefinition{
+							Message: "kafmesh.deviceId.detailCount",
+						},
+					},
+				},
+				Aggregate: models.Persistence{
+					TopicDefinition: models.TopicDefinition{
+						Message: "kafmesh.deviceId.detailCountState",
+					},
+					TopicCreationDefinition: models.TopicCreationDefinition{
+						Partitions: &partition,
+					},
+				},
+				Size:    5 * time.Minute,
+				Advance: time.Minute,
+				Grace:   30 * time.Second,
+			},
+		},
+
+		Repartition: []models.Repartition{
+			models.Repartition{
+				Name:        "details by vehicle",
+				Description: "Re-keys device details by vehicle.",
+				TopicDefinition: models.TopicDefinition{
+					Message: "kafmesh.deviceId.detail",
+				},
+				TopicCreationDefinition: models.TopicCreationDefinition{
+					Partitions: &partition,
+				},
 			},
 		},
 
@@ -153,6 +273,7 @@ viewSinks:
 					Message: "kafmesh.deviceId.enrichedDetail",
 					Type:    &topicType,
 				},
+				ReadCommitted: true,
 			},
 		},
 
@@ -214,3 +335,37 @@ func Test_TopicDefinition_TopicName(t *testing.T) {
 	name = topic.ToTopicName(&models.Service{Name: "test service"})
 	assert.Equal(t, "testService.device.api", name)
 }
+
+func Test_TopicDefinition_ToSerializationType(t *testing.T) {
+	topic := models.TopicDefinition{
+		Message: "deviceId.customer",
+	}
+
+	assert.Equal(t, "protobuf", topic.ToSerializationType(&models.Service{}))
+
+	service := &models.Service{
+		Defaults: models.TopicDefaults{
+			Type: "avro",
+		},
+	}
+	assert.Equal(t, "avro", topic.ToSerializationType(service))
+
+	topicType := "protobuf"
+	topic.Type = &topicType
+	assert.Equal(t, "protobuf", topic.ToSerializationType(service))
+}
+
+func Test_Processor_DeadLetterTopicName(t *testing.T) {
+	service := &models.Service{Name: "test service"}
+	component := &models.Component{Name: "details"}
+	processor := &models.Processor{
+		Name:       "enricher",
+		DeadLetter: &models.DeadLetter{},
+	}
+
+	assert.Equal(t, "testService.details.enricher-dlq", processor.DeadLetterTopicName(service, component))
+
+	topic := "details.failed"
+	processor.DeadLetter.Topic = &topic
+	assert.Equal(t, "details.failed", processor.DeadLetterTopicName(service, component))
+}
@@ -18,6 +18,7 @@ type Service struct {
 	Output      OutputSettings
 	Defaults    TopicDefaults
 	Messages    MessageDefinitions
+	Registry    RegistrySettings
 }
 
 // OutputSettings define how the service is generated
@@ -25,6 +26,8 @@ type OutputSettings struct {
 	Package string
 	Path    string
 	Module  string
+	// Fakes generates recording fakes of the component interfaces for unit tests
+	Fakes bool
 }
 
 // TopicDefaults are the default kafka settings for the service
@@ -42,6 +45,19 @@ type MessageDefinitions struct {
 	Avro     []string
 }
 
+// RegistrySettings define which schema registry the service registers protobuf schemas with
+type RegistrySettings struct {
+	Type string
+}
+
+// ToRegistryType gets the schema registry type, defaulting to the proto schema registry
+func (r RegistrySettings) ToRegistryType() string {
+	if r.Type == "" {
+		return "protoSchemaRegistry"
+	}
+	return r.Type
+}
+
 // ParseService the reader to a Service
 func ParseService(reader io.Reader) (*Service, error) {
 	service := &Service{}
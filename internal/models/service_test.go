@@ -34,6 +34,9 @@ defaults:
   type : "protobuf"
   retention: 240h
   segment: 24h
+
+registry:
+  type: confluent
 `
 
 	service, err := models.ParseService(bytes.NewBuffer([]byte(schema)))
@@ -66,6 +69,9 @@ defaults:
 			Retention:   10 * 24 * time.Hour,
 			Segment:     24 * time.Hour,
 		},
+		Registry: models.RegistrySettings{
+			Type: "confluent",
+		},
 	}, service)
 }
 
@@ -77,3 +83,11 @@ func Test_Service_ToTopicName(t *testing.T) {
 	name := service.ToTopicName()
 	assert.Equal(t, "enplugService", name)
 }
+
+func Test_RegistrySettings_ToRegistryType(t *testing.T) {
+	registry := models.RegistrySettings{}
+	assert.Equal(t, "protoSchemaRegistry", registry.ToRegistryType())
+
+	registry.Type = "confluent"
+	assert.Equal(t, "confluent", registry.ToRegistryType())
+}
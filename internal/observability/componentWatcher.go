@@ -0,0 +1,163 @@
+package observability
+
+import (
+	"context"
+	"sync/atomic"
+
+	watchv1 "github.com/syncromatics/kafmesh/internal/protos/kafmesh/watch/v1"
+)
+
+// componentKind is the kind of component a watch observes
+type componentKind int
+
+const (
+	sinkComponent componentKind = iota
+	sourceComponent
+	viewSourceComponent
+	viewSinkComponent
+)
+
+// componentName names a sink, source, view source or view sink of a component. Sources are
+// named by their topic.
+type componentName struct {
+	kind      componentKind
+	component string
+	name      string
+}
+
+type componentWatch struct {
+	send   func(interface{}) error
+	cancel func()
+}
+
+// WatchSink registers an observer for the collects, flushes and commits of a sink
+func (w *Watcher) WatchSink(ctx context.Context, request *watchv1.SinkRequest, send func(*watchv1.SinkResponse) error) error {
+	return w.watchComponent(ctx, componentName{sinkComponent, request.Component, request.Sink}, func(m interface{}) error {
+		return send(&watchv1.SinkResponse{Event: m.(*watchv1.SinkEvent)})
+	})
+}
+
+// WatchingSink returns true if the sink has observers
+func (w *Watcher) WatchingSink(component, sink string) bool {
+	return w.watchingComponent(componentName{sinkComponent, component, sink})
+}
+
+// SendSink notifies observers about an event of a sink
+func (w *Watcher) SendSink(component, sink string, event *watchv1.SinkEvent) {
+	w.sendComponent(componentName{sinkComponent, component, sink}, event)
+}
+
+// WatchSource registers an observer for the messages a source emits
+func (w *Watcher) WatchSource(ctx context.Context, request *watchv1.SourceRequest, send func(*watchv1.SourceResponse) error) error {
+	return w.watchComponent(ctx, componentName{sourceComponent, request.Component, request.Topic}, func(m interface{}) error {
+		return send(&watchv1.SourceResponse{Emit: m.(*watchv1.SourceEmit)})
+	})
+}
+
+// WatchingSource returns true if the source has observers
+func (w *Watcher) WatchingSource(component, topic string) bool {
+	return w.watchingComponent(componentName{sourceComponent, component, topic})
+}
+
+// SendSource notifies observers about a message a source emitted
+func (w *Watcher) SendSource(component, topic string, emit *watchv1.SourceEmit) {
+	w.sendComponent(componentName{sourceComponent, component, topic}, emit)
+}
+
+// WatchViewSource registers an observer for the sync runs of a view source
+func (w *Watcher) WatchViewSource(ctx context.Context, request *watchv1.ViewSourceRequest, send func(*watchv1.ViewSourceResponse) error) error {
+	return w.watchComponent(ctx, componentName{viewSourceComponent, request.Component, request.ViewSource}, func(m interface{}) error {
+		return send(&watchv1.ViewSourceResponse{Sync: m.(*watchv1.ViewSourceSync)})
+	})
+}
+
+// WatchingViewSource returns true if the view source has observers
+func (w *Watcher) WatchingViewSource(component, viewSource string) bool {
+	return w.watchingComponent(componentName{viewSourceComponent, component, viewSource})
+}
+
+// SendViewSource notifies observers about a sync run of a view source
+func (w *Watcher) SendViewSource(component, viewSource string, sync *watchv1.ViewSourceSync) {
+	w.sendComponent(componentName{viewSourceComponent, component, viewSource}, sync)
+}
+
+// WatchViewSink registers an observer for the sync runs of a view sink
+func (w *Watcher) WatchViewSink(ctx context.Context, request *watchv1.ViewSinkRequest, send func(*watchv1.ViewSinkResponse) error) error {
+	return w.watchComponent(ctx, componentName{viewSinkComponent, request.Component, request.ViewSink}, func(m interface{}) error {
+		return send(&watchv1.ViewSinkResponse{Sync: m.(*watchv1.ViewSinkSync)})
+	})
+}
+
+// WatchingViewSink returns true if the view sink has observers
+func (w *Watcher) WatchingViewSink(component, viewSink string) bool {
+	return w.watchingComponent(componentName{viewSinkComponent, component, viewSink})
+}
+
+// SendViewSink notifies observers about a sync run of a view sink
+func (w *Watcher) SendViewSink(component, viewSink string, sync *watchv1.ViewSinkSync) {
+	w.sendComponent(componentName{viewSinkComponent, component, viewSink}, sync)
+}
+
+func (w *Watcher) watchComponent(ctx context.Context, name componentName, send func(interface{}) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	id, err := makeKey()
+	if err != nil {
+		return err
+	}
+
+	w.mtx.Lock()
+	if w.components == nil {
+		w.components = map[componentName]map[string]*componentWatch{}
+	}
+	_, ok := w.components[name]
+	if !ok {
+		w.components[name] = map[string]*componentWatch{}
+	}
+	w.components[name][id] = &componentWatch{
+		send:   send,
+		cancel: cancel,
+	}
+	atomic.AddInt64(&w.active, 1)
+	w.mtx.Unlock()
+
+	<-ctx.Done()
+
+	w.mtx.Lock()
+	delete(w.components[name], id)
+	if len(w.components[name]) == 0 {
+		delete(w.components, name)
+	}
+	atomic.AddInt64(&w.active, -1)
+	w.mtx.Unlock()
+
+	return nil
+}
+
+func (w *Watcher) watchingComponent(name componentName) bool {
+	if atomic.LoadInt64(&w.active) == 0 {
+		return false
+	}
+
+	w.mtx.RLock()
+	defer w.mtx.RUnlock()
+
+	return len(w.components[name]) > 0
+}
+
+func (w *Watcher) sendComponent(name componentName, message interface{}) {
+	if atomic.LoadInt64(&w.active) == 0 {
+		return
+	}
+
+	w.mtx.RLock()
+	defer w.mtx.RUnlock()
+
+	for _, s := range w.components[name] {
+		err := s.send(message)
+		if err != nil {
+			s.cancel()
+		}
+	}
+}
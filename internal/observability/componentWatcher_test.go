@@ -0,0 +1,93 @@
+package observability_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/syncromatics/kafmesh/internal/observability"
+	watchv1 "github.com/syncromatics/kafmesh/internal/protos/kafmesh/watch/v1"
+
+	"gotest.tools/assert"
+)
+
+func Test_Watcher_Components(t *testing.T) {
+	watcher := &observability.Watcher{}
+
+	assert.Assert(t, !watcher.WatchingSink("com1", "sink1"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	sinks := make(chan *watchv1.SinkResponse, 10)
+	sources := make(chan *watchv1.SourceResponse, 10)
+	viewSources := make(chan *watchv1.ViewSourceResponse, 10)
+	viewSinks := make(chan *watchv1.ViewSinkResponse, 10)
+
+	done := make(chan struct{}, 4)
+	go func() {
+		watcher.WatchSink(ctx, &watchv1.SinkRequest{Component: "com1", Sink: "sink1"}, func(m *watchv1.SinkResponse) error {
+			sinks <- m
+			return nil
+		})
+		done <- struct{}{}
+	}()
+	go func() {
+		watcher.WatchSource(ctx, &watchv1.SourceRequest{Component: "com1", Topic: "topic1"}, func(m *watchv1.SourceResponse) error {
+			sources <- m
+			return nil
+		})
+		done <- struct{}{}
+	}()
+	go func() {
+		watcher.WatchViewSource(ctx, &watchv1.ViewSourceRequest{Component: "com1", ViewSource: "viewSource1"}, func(m *watchv1.ViewSourceResponse) error {
+			viewSources <- m
+			return nil
+		})
+		done <- struct{}{}
+	}()
+	go func() {
+		watcher.WatchViewSink(ctx, &watchv1.ViewSinkRequest{Component: "com1", ViewSink: "viewSink1"}, func(m *watchv1.ViewSinkResponse) error {
+			viewSinks <- m
+			return nil
+		})
+		done <- struct{}{}
+	}()
+
+	for !watcher.WatchingSink("com1", "sink1") ||
+		!watcher.WatchingSource("com1", "topic1") ||
+		!watcher.WatchingViewSource("com1", "viewSource1") ||
+		!watcher.WatchingViewSink("com1", "viewSink1") {
+		time.Sleep(time.Millisecond)
+	}
+
+	assert.Assert(t, !watcher.WatchingSink("com1", "sink2"))
+	assert.Assert(t, !watcher.WatchingSource("com2", "topic1"))
+	assert.Assert(t, !watcher.WatchingViewSink("com1", "viewSource1"))
+
+	sinkEvent := &watchv1.SinkEvent{Event: &watchv1.SinkEvent_Flush{Flush: &watchv1.SinkFlush{Count: 3, Attempts: 1}}}
+	watcher.SendSink("com1", "sink1", sinkEvent)
+	watcher.SendSink("com1", "sink2", &watchv1.SinkEvent{})
+
+	emit := &watchv1.SourceEmit{Key: "key1", Delete: true}
+	watcher.SendSource("com1", "topic1", emit)
+
+	viewSourceSync := &watchv1.ViewSourceSync{Deletes: []string{"key1"}}
+	watcher.SendViewSource("com1", "viewSource1", viewSourceSync)
+
+	viewSinkSync := &watchv1.ViewSinkSync{Error: "boom"}
+	watcher.SendViewSink("com1", "viewSink1", viewSinkSync)
+
+	assert.DeepEqual(t, <-sinks, &watchv1.SinkResponse{Event: sinkEvent})
+	assert.DeepEqual(t, <-sources, &watchv1.SourceResponse{Emit: emit})
+	assert.DeepEqual(t, <-viewSources, &watchv1.ViewSourceResponse{Sync: viewSourceSync})
+	assert.DeepEqual(t, <-viewSinks, &watchv1.ViewSinkResponse{Sync: viewSinkSync})
+	assert.Equal(t, len(sinks), 0)
+
+	cancel()
+	for i := 0; i < 4; i++ {
+		<-done
+	}
+
+	assert.Assert(t, !watcher.WatchingSink("com1", "sink1"))
+	assert.Assert(t, !watcher.WatchingViewSink("com1", "viewSink1"))
+}
@@ -4,32 +4,114 @@ import (
 	"context"
 	"crypto/rand"
 	"fmt"
+	"hash/fnv"
+	"regexp"
+	"strings"
 	"sync"
+	"sync/atomic"
 
 	watchv1 "github.com/syncromatics/kafmesh/internal/protos/kafmesh/watch/v1"
 
 	"github.com/pkg/errors"
 )
 
+const (
+	sampleBuckets = 10000
+)
+
 type processorWatchKey struct {
 	component string
 	processor string
 	key       string
 }
 
+type processorName struct {
+	component string
+	processor string
+}
+
 type watch struct {
 	send   func(*watchv1.ProcessorResponse) error
 	cancel func()
+	filter *watchFilter
+}
+
+// watchFilter selects the keys a prefix, regex or sampled watch observes
+type watchFilter struct {
+	prefix string
+	regex  *regexp.Regexp
+	rate   float64
+}
+
+func newWatchFilter(request *watchv1.ProcessorRequest) (*watchFilter, error) {
+	if request.KeyPrefix == "" && request.KeyRegex == "" && request.SampleRate == 0 {
+		return nil, nil
+	}
+
+	if request.Key != "" {
+		return nil, errors.Errorf("a watch by key cannot also have a key prefix, regex or sample rate")
+	}
+
+	if request.SampleRate < 0 || request.SampleRate > 1 {
+		return nil, errors.Errorf("sample rate %v must be between 0 and 1", request.SampleRate)
+	}
+
+	filter := &watchFilter{
+		prefix: request.KeyPrefix,
+		rate:   request.SampleRate,
+	}
+
+	if request.KeyRegex != "" {
+		regex, err := regexp.Compile(request.KeyRegex)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to compile key regex")
+		}
+		filter.regex = regex
+	}
+
+	return filter, nil
+}
+
+func (f *watchFilter) matches(key string) bool {
+	if !strings.HasPrefix(key, f.prefix) {
+		return false
+	}
+
+	if f.regex != nil && !f.regex.MatchString(key) {
+		return false
+	}
+
+	if f.rate > 0 {
+		h := fnv.New32a()
+		h.Write([]byte(key))
+		if float64(h.Sum32()%sampleBuckets) >= f.rate*sampleBuckets {
+			return false
+		}
+	}
+
+	return true
 }
 
 // Watcher sends observers information about the running system
 type Watcher struct {
-	mtx     sync.RWMutex
-	watches map[processorWatchKey]map[string]*watch
+	// active is the number of watches. Checking it first keeps processors from taking the lock
+	// when nobody is watching.
+	active int64
+
+	mtx        sync.RWMutex
+	watches    map[processorWatchKey]map[string]*watch
+	filters    map[processorName]map[string]*watch
+	components map[componentName]map[string]*componentWatch
 }
 
-// WatchProcessor registers an observer for the processor and key
+// WatchProcessor registers an observer for the processor and key, or for the keys matching
+// the prefix, regex and sample rate of the request
 func (w *Watcher) WatchProcessor(ctx context.Context, request *watchv1.ProcessorRequest, send func(*watchv1.ProcessorResponse) error) error {
+	filter, err := newWatchFilter(request)
+	if err != nil {
+		return errors.Wrap(err, "invalid watch request")
+	}
+
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -46,80 +128,127 @@ func (w *Watcher) WatchProcessor(ctx context.Context, request *watchv1.Processor
 	w.registerWatcher(key, id, &watch{
 		send:   send,
 		cancel: cancel,
+		filter: filter,
 	})
 
 	<-ctx.Done()
 
-	w.removeWatcher(key, id)
+	w.removeWatcher(key, id, filter != nil)
 
 	return nil
 }
 
 // WatchCount returns the number of observers for the processor/key combo
 func (w *Watcher) WatchCount(component, processor, key string) (int, bool) {
+	if atomic.LoadInt64(&w.active) == 0 {
+		return 0, false
+	}
+
 	w.mtx.RLock()
 	defer w.mtx.RUnlock()
 
-	m, ok := w.watches[processorWatchKey{component, processor, key}]
-	if !ok {
-		return 0, false
+	count := len(w.watches[processorWatchKey{component, processor, key}])
+	for _, f := range w.filters[processorName{component, processor}] {
+		if f.filter.matches(key) {
+			count++
+		}
 	}
-	return len(m), true
+
+	return count, count > 0
 }
 
 // Send notifies observers about an event of a processor/key
 func (w *Watcher) Send(component, processor, key string, message *watchv1.Operation) {
+	if atomic.LoadInt64(&w.active) == 0 {
+		return
+	}
+
 	w.mtx.RLock()
 	defer w.mtx.RUnlock()
 
-	m, ok := w.watches[processorWatchKey{
-		component: component,
-		processor: processor,
-		key:       key,
-	}]
-	if !ok {
-		return
+	response := &watchv1.ProcessorResponse{
+		Operation: message,
 	}
 
-	for _, s := range m {
-		err := s.send(&watchv1.ProcessorResponse{
-			Operation: message,
-		})
+	send := func(s *watch) {
+		err := s.send(response)
 		if err != nil {
 			s.cancel()
 		}
 	}
 
-	return
+	for _, s := range w.watches[processorWatchKey{component, processor, key}] {
+		send(s)
+	}
+
+	for _, s := range w.filters[processorName{component, processor}] {
+		if s.filter.matches(key) {
+			send(s)
+		}
+	}
 }
 
 func (w *Watcher) registerWatcher(key processorWatchKey, id string, processorWatch *watch) {
 	w.mtx.Lock()
 	defer w.mtx.Unlock()
 
-	if w.watches == nil {
-		w.watches = map[processorWatchKey]map[string]*watch{}
-	}
+	var m map[string]*watch
+	if processorWatch.filter != nil {
+		if w.filters == nil {
+			w.filters = map[processorName]map[string]*watch{}
+		}
 
-	_, ok := w.watches[key]
-	if !ok {
-		w.watches[key] = map[string]*watch{}
-	}
+		name := processorName{key.component, key.processor}
+		_, ok := w.filters[name]
+		if !ok {
+			w.filters[name] = map[string]*watch{}
+		}
+		m = w.filters[name]
+	} else {
+		if w.watches == nil {
+			w.watches = map[processorWatchKey]map[string]*watch{}
+		}
 
-	m := w.watches[key]
+		_, ok := w.watches[key]
+		if !ok {
+			w.watches[key] = map[string]*watch{}
+		}
+		m = w.watches[key]
+	}
 
-	_, ok = m[id]
+	_, ok := m[id]
 	if ok {
 		panic("key exists")
 	}
 
 	m[id] = processorWatch
+	atomic.AddInt64(&w.active, 1)
 }
 
-func (w *Watcher) removeWatcher(key processorWatchKey, id string) {
+func (w *Watcher) removeWatcher(key processorWatchKey, id string, filtered bool) {
 	w.mtx.Lock()
 	defer w.mtx.Unlock()
 
+	if filtered {
+		name := processorName{key.component, key.processor}
+		m, ok := w.filters[name]
+		if !ok {
+			return
+		}
+		_, ok = m[id]
+		if !ok {
+			return
+		}
+
+		delete(m, id)
+		atomic.AddInt64(&w.active, -1)
+
+		if len(m) == 0 {
+			delete(w.filters, name)
+		}
+		return
+	}
+
 	m, ok := w.watches[key]
 	if !ok {
 		return
@@ -130,6 +259,7 @@ func (w *Watcher) removeWatcher(key processorWatchKey, id string) {
 	}
 
 	delete(m, id)
+	atomic.AddInt64(&w.active, -1)
 
 	if len(m) == 0 {
 		delete(w.watches, key)
@@ -2,6 +2,8 @@ package observability_test
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"testing"
 	"time"
 
@@ -62,3 +64,106 @@ func Test_Watcher(t *testing.T) {
 	assert.Assert(t, watch1)
 	assert.Assert(t, watch2)
 }
+
+func Test_Watcher_Filters(t *testing.T) {
+	watcher := &observability.Watcher{}
+
+	_, ok := watcher.WatchCount("com1", "proc1", "bus-12")
+	assert.Assert(t, !ok)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	received := make(chan string, 10)
+	watch := func(request *watchv1.ProcessorRequest, name string) {
+		go func() {
+			err := watcher.WatchProcessor(ctx, request, func(m *watchv1.ProcessorResponse) error {
+				received <- name
+				return nil
+			})
+			assert.NilError(t, err)
+		}()
+	}
+
+	watch(&watchv1.ProcessorRequest{Component: "com1", Processor: "proc1", KeyPrefix: "bus-12"}, "prefix")
+	watch(&watchv1.ProcessorRequest{Component: "com1", Processor: "proc1", KeyRegex: "^bus-[0-9]+3$"}, "regex")
+	watch(&watchv1.ProcessorRequest{Component: "com1", Processor: "proc1", KeyPrefix: "bus-", SampleRate: 1}, "sampled")
+
+	for {
+		i, _ := watcher.WatchCount("com1", "proc1", "bus-123")
+		if i == 3 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	count, ok := watcher.WatchCount("com1", "proc1", "bus-124")
+	assert.Assert(t, ok)
+	assert.Equal(t, count, 2)
+
+	count, ok = watcher.WatchCount("com1", "proc2", "bus-123")
+	assert.Assert(t, !ok)
+	assert.Equal(t, count, 0)
+
+	watcher.Send("com1", "proc1", "bus-203", &watchv1.Operation{})
+
+	names := []string{<-received, <-received}
+	sort.Strings(names)
+	assert.DeepEqual(t, names, []string{"regex", "sampled"})
+}
+
+func Test_Watcher_SampleRate(t *testing.T) {
+	watcher := &observability.Watcher{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go watcher.WatchProcessor(ctx, &watchv1.ProcessorRequest{
+		Component:  "com1",
+		Processor:  "proc1",
+		SampleRate: 0.01,
+	}, func(m *watchv1.ProcessorResponse) error {
+		return nil
+	})
+
+	// the watch is registered once any of the keys is sampled
+	registered := func() bool {
+		for k := 0; k < 1000; k++ {
+			_, ok := watcher.WatchCount("com1", "proc1", fmt.Sprintf("bus-%d", k))
+			if ok {
+				return true
+			}
+		}
+		return false
+	}
+	for !registered() {
+		time.Sleep(time.Millisecond)
+	}
+
+	sampled := 0
+	for k := 0; k < 100000; k++ {
+		key := fmt.Sprintf("bus-%d", k)
+		_, ok := watcher.WatchCount("com1", "proc1", key)
+		if ok {
+			sampled++
+
+			_, again := watcher.WatchCount("com1", "proc1", key)
+			assert.Assert(t, again)
+		}
+	}
+
+	assert.Assert(t, sampled > 800 && sampled < 1200, "sampled %d of 100000 keys", sampled)
+}
+
+func Test_Watcher_InvalidRequests(t *testing.T) {
+	watcher := &observability.Watcher{}
+
+	err := watcher.WatchProcessor(context.Background(), &watchv1.ProcessorRequest{KeyRegex: "("}, nil)
+	assert.ErrorContains(t, err, "failed to compile key regex")
+
+	err = watcher.WatchProcessor(context.Background(), &watchv1.ProcessorRequest{SampleRate: 2}, nil)
+	assert.ErrorContains(t, err, "sample rate 2 must be between 0 and 1")
+
+	err = watcher.WatchProcessor(context.Background(), &watchv1.ProcessorRequest{Key: "12", KeyPrefix: "1"}, nil)
+	assert.ErrorContains(t, err, "a watch by key cannot also have a key prefix")
+}
@@ -92,9 +92,180 @@ func (m *GetServiceInfoResponse) GetService() *Service {
 	return nil
 }
 
+type GetRecoveryProgressRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRecoveryProgressRequest) Reset()         { *m = GetRecoveryProgressRequest{} }
+func (m *GetRecoveryProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRecoveryProgressRequest) ProtoMessage()    {}
+func (*GetRecoveryProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87f32b5f68aaa02c, []int{2}
+}
+
+func (m *GetRecoveryProgressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRecoveryProgressRequest.Unmarshal(m, b)
+}
+func (m *GetRecoveryProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRecoveryProgressRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRecoveryProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRecoveryProgressRequest.Merge(m, src)
+}
+func (m *GetRecoveryProgressRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRecoveryProgressRequest.Size(m)
+}
+func (m *GetRecoveryProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRecoveryProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRecoveryProgressRequest proto.InternalMessageInfo
+
+type GetRecoveryProgressResponse struct {
+	Partitions           []*PartitionRecovery `protobuf:"bytes,1,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetRecoveryProgressResponse) Reset()         { *m = GetRecoveryProgressResponse{} }
+func (m *GetRecoveryProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRecoveryProgressResponse) ProtoMessage()    {}
+func (*GetRecoveryProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87f32b5f68aaa02c, []int{3}
+}
+
+func (m *GetRecoveryProgressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRecoveryProgressResponse.Unmarshal(m, b)
+}
+func (m *GetRecoveryProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRecoveryProgressResponse.Marshal(b, m, deterministic)
+}
+func (m *GetRecoveryProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRecoveryProgressResponse.Merge(m, src)
+}
+func (m *GetRecoveryProgressResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRecoveryProgressResponse.Size(m)
+}
+func (m *GetRecoveryProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRecoveryProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRecoveryProgressResponse proto.InternalMessageInfo
+
+func (m *GetRecoveryProgressResponse) GetPartitions() []*PartitionRecovery {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
+}
+
+// PartitionRecovery is the recovery progress of a partition of a table kept in
+// local storage.
+type PartitionRecovery struct {
+	Component     string `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
+	Processor     string `protobuf:"bytes,2,opt,name=processor,proto3" json:"processor,omitempty"`
+	Table         string `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
+	Partition     int32  `protobuf:"varint,4,opt,name=partition,proto3" json:"partition,omitempty"`
+	Offset        int64  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	HighWatermark int64  `protobuf:"varint,6,opt,name=high_watermark,json=highWatermark,proto3" json:"high_watermark,omitempty"`
+	Recovered     bool   `protobuf:"varint,7,opt,name=recovered,proto3" json:"recovered,omitempty"`
+	// standby is set for the standby copies of a processor group table.
+	Standby              bool     `protobuf:"varint,8,opt,name=standby,proto3" json:"standby,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PartitionRecovery) Reset()         { *m = PartitionRecovery{} }
+func (m *PartitionRecovery) String() string { return proto.CompactTextString(m) }
+func (*PartitionRecovery) ProtoMessage()    {}
+func (*PartitionRecovery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87f32b5f68aaa02c, []int{4}
+}
+
+func (m *PartitionRecovery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PartitionRecovery.Unmarshal(m, b)
+}
+func (m *PartitionRecovery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PartitionRecovery.Marshal(b, m, deterministic)
+}
+func (m *PartitionRecovery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionRecovery.Merge(m, src)
+}
+func (m *PartitionRecovery) XXX_Size() int {
+	return xxx_messageInfo_PartitionRecovery.Size(m)
+}
+func (m *PartitionRecovery) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionRecovery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionRecovery proto.InternalMessageInfo
+
+func (m *PartitionRecovery) GetComponent() string {
+	if m != nil {
+		return m.Component
+	}
+	return ""
+}
+
+func (m *PartitionRecovery) GetProcessor() string {
+	if m != nil {
+		return m.Processor
+	}
+	return ""
+}
+
+func (m *PartitionRecovery) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+func (m *PartitionRecovery) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+func (m *PartitionRecovery) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *PartitionRecovery) GetHighWatermark() int64 {
+	if m != nil {
+		return m.HighWatermark
+	}
+	return 0
+}
+
+func (m *PartitionRecovery) GetRecovered() bool {
+	if m != nil {
+		return m.Recovered
+	}
+	return false
+}
+
+func (m *PartitionRecovery) GetStandby() bool {
+	if m != nil {
+		return m.Standby
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*GetServiceInfoRequest)(nil), "kafmesh.discovery.v1.GetServiceInfoRequest")
 	proto.RegisterType((*GetServiceInfoResponse)(nil), "kafmesh.discovery.v1.GetServiceInfoResponse")
+	proto.RegisterType((*GetRecoveryProgressRequest)(nil), "kafmesh.discovery.v1.GetRecoveryProgressRequest")
+	proto.RegisterType((*GetRecoveryProgressResponse)(nil), "kafmesh.discovery.v1.GetRecoveryProgressResponse")
+	proto.RegisterType((*PartitionRecovery)(nil), "kafmesh.discovery.v1.PartitionRecovery")
 }
 
 func init() {
@@ -102,21 +273,34 @@ func init() {
 }
 
 var fileDescriptor_87f32b5f68aaa02c = []byte{
-	// 217 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xc8, 0x4e, 0x4c, 0xcb,
-	0x4d, 0x2d, 0xce, 0xd0, 0x4f, 0xc9, 0x2c, 0x4e, 0xce, 0x2f, 0x4b, 0x2d, 0xaa, 0xd4, 0x2f, 0x33,
-	0x44, 0x70, 0xe2, 0x13, 0x0b, 0x32, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x44, 0xa0, 0x2a,
-	0xf5, 0xe0, 0x92, 0x7a, 0x65, 0x86, 0x52, 0x4a, 0x58, 0xf5, 0x17, 0xa7, 0x16, 0x95, 0x65, 0x26,
-	0xa7, 0x42, 0x74, 0x2a, 0x89, 0x73, 0x89, 0xba, 0xa7, 0x96, 0x04, 0x43, 0xc4, 0x3c, 0xf3, 0xd2,
-	0xf2, 0x83, 0x52, 0x0b, 0x4b, 0x53, 0x8b, 0x4b, 0x94, 0x02, 0xb9, 0xc4, 0xd0, 0x25, 0x8a, 0x0b,
-	0xf2, 0xf3, 0x8a, 0x53, 0x85, 0xcc, 0xb9, 0xd8, 0xa1, 0x66, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70,
-	0x1b, 0xc9, 0xea, 0x61, 0xb3, 0x5e, 0x0f, 0xaa, 0x37, 0x08, 0xa6, 0xda, 0xa8, 0x9a, 0x8b, 0xc7,
-	0x05, 0xa6, 0xc0, 0x31, 0xc0, 0x53, 0x28, 0x9b, 0x8b, 0x0f, 0xd5, 0x0a, 0x21, 0x6d, 0xec, 0x26,
-	0x61, 0x75, 0xa1, 0x94, 0x0e, 0x71, 0x8a, 0x21, 0xae, 0x76, 0x8a, 0xe4, 0x92, 0x48, 0xce, 0xcf,
-	0xc5, 0xaa, 0xc5, 0x49, 0x10, 0xe1, 0xac, 0x82, 0xcc, 0x00, 0x50, 0xb8, 0x04, 0x30, 0x46, 0x71,
-	0xc3, 0x95, 0x94, 0x19, 0x2e, 0x62, 0x62, 0xf6, 0x76, 0x89, 0x58, 0xc5, 0x24, 0xe2, 0x0d, 0xd5,
-	0x0e, 0xd7, 0xa0, 0x17, 0x66, 0x98, 0xc4, 0x06, 0x0e, 0x4a, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x55, 0x19, 0xaf, 0x65, 0xb0, 0x01, 0x00, 0x00,
+	// 424 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4b, 0x6f, 0xd3, 0x40,
+	0x10, 0xd6, 0x26, 0x24, 0x69, 0x27, 0x50, 0xa9, 0x4b, 0x28, 0xab, 0x50, 0x24, 0xcb, 0x12, 0xc2,
+	0x12, 0xc8, 0xc5, 0xe1, 0xc0, 0x99, 0xaa, 0x52, 0x54, 0xf5, 0x62, 0x16, 0x89, 0xd7, 0xa5, 0x72,
+	0x9c, 0x71, 0x63, 0x05, 0x7b, 0xcd, 0xee, 0x62, 0x54, 0x7e, 0x0e, 0x47, 0xfe, 0x20, 0x12, 0x27,
+	0xe4, 0xc7, 0xda, 0x3c, 0x36, 0x12, 0x3d, 0xce, 0xf7, 0x98, 0xcf, 0x9e, 0xd9, 0x01, 0x6f, 0x1b,
+	0x25, 0x19, 0xaa, 0xcd, 0xc9, 0x3a, 0x55, 0xb1, 0x28, 0x51, 0x5e, 0x9f, 0x94, 0x41, 0x5f, 0x5c,
+	0x46, 0x45, 0xea, 0x17, 0x52, 0x68, 0x41, 0x67, 0xad, 0xd2, 0xef, 0x48, 0xbf, 0x0c, 0xe6, 0xae,
+	0xd5, 0xaf, 0x50, 0x96, 0x69, 0x8c, 0x8d, 0xd3, 0xbd, 0x0f, 0xf7, 0x96, 0xa8, 0x5f, 0x37, 0xd8,
+	0x79, 0x9e, 0x08, 0x8e, 0x9f, 0x3e, 0xa3, 0xd2, 0xee, 0x2b, 0x38, 0xfa, 0x9b, 0x50, 0x85, 0xc8,
+	0x15, 0xd2, 0x17, 0x30, 0x69, 0x7b, 0x30, 0xe2, 0x10, 0x6f, 0xba, 0x78, 0xe8, 0xdb, 0xe2, 0xfd,
+	0xd6, 0xcb, 0x8d, 0xda, 0x3d, 0x86, 0xf9, 0x12, 0x35, 0xc7, 0x46, 0x12, 0x4a, 0x71, 0x25, 0x51,
+	0x29, 0x13, 0x98, 0xc0, 0x03, 0x2b, 0xdb, 0xa6, 0x2e, 0x01, 0x8a, 0x48, 0xea, 0x54, 0xa7, 0x22,
+	0x57, 0x8c, 0x38, 0x43, 0x6f, 0xba, 0x78, 0x6c, 0x0f, 0x0e, 0x8d, 0xce, 0x34, 0xe3, 0xbf, 0x59,
+	0xdd, 0x9f, 0x04, 0x0e, 0xff, 0x51, 0xd0, 0x63, 0xd8, 0x8f, 0x45, 0x56, 0x88, 0x1c, 0x73, 0x5d,
+	0xff, 0xd6, 0x3e, 0xef, 0x81, 0x8a, 0x2d, 0xa4, 0x88, 0x51, 0x29, 0x21, 0xd9, 0xa0, 0x61, 0x3b,
+	0x80, 0xce, 0x60, 0xa4, 0xa3, 0xd5, 0x47, 0x64, 0xc3, 0x9a, 0x69, 0x8a, 0xda, 0x63, 0x62, 0xd8,
+	0x2d, 0x87, 0x78, 0x23, 0xde, 0x03, 0xf4, 0x08, 0xc6, 0x22, 0x49, 0x14, 0x6a, 0x36, 0x72, 0x88,
+	0x37, 0xe4, 0x6d, 0x45, 0x1f, 0xc1, 0xc1, 0x26, 0xbd, 0xda, 0x5c, 0x7e, 0x89, 0x34, 0xca, 0x2c,
+	0x92, 0x5b, 0x36, 0xae, 0xf9, 0x3b, 0x15, 0xfa, 0xd6, 0x80, 0x55, 0x73, 0xd9, 0x7c, 0x3a, 0xae,
+	0xd9, 0xc4, 0x21, 0xde, 0x1e, 0xef, 0x01, 0xca, 0x60, 0xa2, 0x74, 0x94, 0xaf, 0x57, 0xd7, 0x6c,
+	0xaf, 0xe6, 0x4c, 0xb9, 0xf8, 0x41, 0xe0, 0xf6, 0x99, 0x99, 0xd5, 0xcb, 0xf0, 0x9c, 0x6e, 0xe1,
+	0xe0, 0xcf, 0x35, 0xd3, 0x27, 0xf6, 0xa1, 0x5a, 0x5f, 0xc9, 0xfc, 0xe9, 0xff, 0x89, 0xdb, 0x1d,
+	0x7e, 0x85, 0xbb, 0x96, 0x15, 0xd3, 0x67, 0x3b, 0x9b, 0xec, 0x78, 0x2b, 0xf3, 0xe0, 0x06, 0x8e,
+	0x26, 0xfb, 0xf4, 0x3d, 0xb0, 0x58, 0x64, 0x56, 0xdf, 0xe9, 0x61, 0x3f, 0x92, 0x22, 0x0d, 0xab,
+	0xbb, 0x08, 0xc9, 0x87, 0x69, 0x27, 0x29, 0x83, 0x6f, 0x83, 0xe1, 0xc5, 0xd9, 0xbb, 0xef, 0x83,
+	0xd9, 0x45, 0x6b, 0xef, 0x0c, 0xfe, 0x9b, 0x60, 0x35, 0xae, 0x4f, 0xe9, 0xf9, 0xaf, 0x01, 0x00,
+	0x08, 0x2b, 0x4b, 0x53, 0xb0, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -134,6 +318,9 @@ type DiscoveryAPIClient interface {
 	// GetServiceInfo retreives information about the kafmesh service from the
 	// node.
 	GetServiceInfo(ctx context.Context, in *GetServiceInfoRequest, opts ...grpc.CallOption) (*GetServiceInfoResponse, error)
+	// GetRecoveryProgress retreives the recovery progress of every table
+	// partition kept in local storage by the node.
+	GetRecoveryProgress(ctx context.Context, in *GetRecoveryProgressRequest, opts ...grpc.CallOption) (*GetRecoveryProgressResponse, error)
 }
 
 type discoveryAPIClient struct {
@@ -153,11 +340,23 @@ func (c *discoveryAPIClient) GetServiceInfo(ctx context.Context, in *GetServiceI
 	return out, nil
 }
 
+func (c *discoveryAPIClient) GetRecoveryProgress(ctx context.Context, in *GetRecoveryProgressRequest, opts ...grpc.CallOption) (*GetRecoveryProgressResponse, error) {
+	out := new(GetRecoveryProgressResponse)
+	err := c.cc.Invoke(ctx, "/kafmesh.discovery.v1.DiscoveryAPI/GetRecoveryProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DiscoveryAPIServer is the server API for DiscoveryAPI service.
 type DiscoveryAPIServer interface {
 	// GetServiceInfo retreives information about the kafmesh service from the
 	// node.
 	GetServiceInfo(context.Context, *GetServiceInfoRequest) (*GetServiceInfoResponse, error)
+	// GetRecoveryProgress retreives the recovery progress of every table
+	// partition kept in local storage by the node.
+	GetRecoveryProgress(context.Context, *GetRecoveryProgressRequest) (*GetRecoveryProgressResponse, error)
 }
 
 func RegisterDiscoveryAPIServer(s *grpc.Server, srv DiscoveryAPIServer) {
@@ -182,6 +381,24 @@ func _DiscoveryAPI_GetServiceInfo_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DiscoveryAPI_GetRecoveryProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecoveryProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryAPIServer).GetRecoveryProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kafmesh.discovery.v1.DiscoveryAPI/GetRecoveryProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryAPIServer).GetRecoveryProgress(ctx, req.(*GetRecoveryProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DiscoveryAPI_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kafmesh.discovery.v1.DiscoveryAPI",
 	HandlerType: (*DiscoveryAPIServer)(nil),
@@ -190,6 +407,10 @@ var _DiscoveryAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetServiceInfo",
 			Handler:    _DiscoveryAPI_GetServiceInfo_Handler,
 		},
+		{
+			MethodName: "GetRecoveryProgress",
+			Handler:    _DiscoveryAPI_GetRecoveryProgress_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kafmesh/discovery/v1/discovery_api.proto",
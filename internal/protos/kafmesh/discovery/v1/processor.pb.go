@@ -22,17 +22,19 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 // Processor is a stateful kafmesh processor.
 type Processor struct {
-	Name                 string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	GroupName            string       `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
-	Description          string       `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Inputs               []*Input     `protobuf:"bytes,4,rep,name=inputs,proto3" json:"inputs,omitempty"`
-	Lookups              []*Lookup    `protobuf:"bytes,5,rep,name=lookups,proto3" json:"lookups,omitempty"`
-	Joins                []*Join      `protobuf:"bytes,6,rep,name=joins,proto3" json:"joins,omitempty"`
-	Outputs              []*Output    `protobuf:"bytes,7,rep,name=outputs,proto3" json:"outputs,omitempty"`
-	Persistence          *Persistence `protobuf:"bytes,8,opt,name=persistence,proto3" json:"persistence,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Name                 string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	GroupName            string        `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	Description          string        `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Inputs               []*Input      `protobuf:"bytes,4,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	Lookups              []*Lookup     `protobuf:"bytes,5,rep,name=lookups,proto3" json:"lookups,omitempty"`
+	Joins                []*Join       `protobuf:"bytes,6,rep,name=joins,proto3" json:"joins,omitempty"`
+	Outputs              []*Output     `protobuf:"bytes,7,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	Persistence          *Persistence  `protobuf:"bytes,8,opt,name=persistence,proto3" json:"persistence,omitempty"`
+	DeadLetter           *DeadLetter   `protobuf:"bytes,9,opt,name=dead_letter,json=deadLetter,proto3" json:"dead_letter,omitempty"`
+	StreamJoins          []*StreamJoin `protobuf:"bytes,10,rep,name=stream_joins,json=streamJoins,proto3" json:"stream_joins,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *Processor) Reset()         { *m = Processor{} }
@@ -116,6 +118,20 @@ func (m *Processor) GetPersistence() *Persistence {
 	return nil
 }
 
+func (m *Processor) GetDeadLetter() *DeadLetter {
+	if m != nil {
+		return m.DeadLetter
+	}
+	return nil
+}
+
+func (m *Processor) GetStreamJoins() []*StreamJoin {
+	if m != nil {
+		return m.StreamJoins
+	}
+	return nil
+}
+
 // Input is the input to a processor.
 type Input struct {
 	Topic                *TopicDefinition `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
@@ -324,6 +340,95 @@ func (m *Persistence) GetTopic() *TopicDefinition {
 	return nil
 }
 
+// DeadLetter is where a processor sends messages it failed to handle.
+type DeadLetter struct {
+	Topic                *TopicDefinition `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *DeadLetter) Reset()         { *m = DeadLetter{} }
+func (m *DeadLetter) String() string { return proto.CompactTextString(m) }
+func (*DeadLetter) ProtoMessage()    {}
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_698127296455087b, []int{6}
+}
+
+func (m *DeadLetter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeadLetter.Unmarshal(m, b)
+}
+func (m *DeadLetter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeadLetter.Marshal(b, m, deterministic)
+}
+func (m *DeadLetter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadLetter.Merge(m, src)
+}
+func (m *DeadLetter) XXX_Size() int {
+	return xxx_messageInfo_DeadLetter.Size(m)
+}
+func (m *DeadLetter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadLetter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadLetter proto.InternalMessageInfo
+
+func (m *DeadLetter) GetTopic() *TopicDefinition {
+	if m != nil {
+		return m.Topic
+	}
+	return nil
+}
+
+// StreamJoin is a stream a processor joins within a window. The windowed store of the stream is
+// recovered from the changelog topic.
+type StreamJoin struct {
+	Topic                *TopicDefinition `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Changelog            string           `protobuf:"bytes,2,opt,name=changelog,proto3" json:"changelog,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *StreamJoin) Reset()         { *m = StreamJoin{} }
+func (m *StreamJoin) String() string { return proto.CompactTextString(m) }
+func (*StreamJoin) ProtoMessage()    {}
+func (*StreamJoin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_698127296455087b, []int{7}
+}
+
+func (m *StreamJoin) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamJoin.Unmarshal(m, b)
+}
+func (m *StreamJoin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamJoin.Marshal(b, m, deterministic)
+}
+func (m *StreamJoin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamJoin.Merge(m, src)
+}
+func (m *StreamJoin) XXX_Size() int {
+	return xxx_messageInfo_StreamJoin.Size(m)
+}
+func (m *StreamJoin) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamJoin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamJoin proto.InternalMessageInfo
+
+func (m *StreamJoin) GetTopic() *TopicDefinition {
+	if m != nil {
+		return m.Topic
+	}
+	return nil
+}
+
+func (m *StreamJoin) GetChangelog() string {
+	if m != nil {
+		return m.Changelog
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Processor)(nil), "kafmesh.discovery.v1.Processor")
 	proto.RegisterType((*Input)(nil), "kafmesh.discovery.v1.Input")
@@ -331,6 +436,8 @@ func init() {
 	proto.RegisterType((*Lookup)(nil), "kafmesh.discovery.v1.Lookup")
 	proto.RegisterType((*Output)(nil), "kafmesh.discovery.v1.Output")
 	proto.RegisterType((*Persistence)(nil), "kafmesh.discovery.v1.Persistence")
+	proto.RegisterType((*DeadLetter)(nil), "kafmesh.discovery.v1.DeadLetter")
+	proto.RegisterType((*StreamJoin)(nil), "kafmesh.discovery.v1.StreamJoin")
 }
 
 func init() {
@@ -338,30 +445,34 @@ func init() {
 }
 
 var fileDescriptor_698127296455087b = []byte{
-	// 386 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x41, 0x4b, 0xe3, 0x40,
-	0x14, 0xc7, 0x49, 0xda, 0xa4, 0xdb, 0x17, 0xd8, 0xc3, 0xd0, 0x43, 0xe8, 0xee, 0x42, 0x36, 0x28,
-	0x14, 0x84, 0xd4, 0xb4, 0xe0, 0xc5, 0x5b, 0x1b, 0x0f, 0xb6, 0xa2, 0x21, 0x48, 0x11, 0x2f, 0xa5,
-	0xa6, 0xd3, 0x3a, 0xd6, 0x66, 0x86, 0x4c, 0x12, 0xf0, 0xeb, 0x78, 0xf4, 0x33, 0x7a, 0x90, 0xbc,
-	0xb4, 0xb1, 0x68, 0xf0, 0x92, 0x5b, 0xc8, 0xfb, 0xfd, 0xfe, 0x33, 0xf3, 0x66, 0x1e, 0x1c, 0x6d,
-	0x16, 0xab, 0x2d, 0x95, 0x8f, 0xfd, 0x25, 0x93, 0x21, 0xcf, 0x68, 0xfc, 0xd2, 0xcf, 0xdc, 0xbe,
-	0x88, 0x79, 0x48, 0xa5, 0xe4, 0xb1, 0x23, 0x62, 0x9e, 0x70, 0xd2, 0xd9, 0x51, 0x4e, 0x49, 0x39,
-	0x99, 0xdb, 0x3d, 0xa9, 0x74, 0x13, 0x2e, 0x58, 0x38, 0x5f, 0xd2, 0x15, 0x8b, 0x58, 0xc2, 0x78,
-	0x54, 0x44, 0xd8, 0xef, 0x2a, 0xb4, 0xfd, 0x7d, 0x2c, 0x21, 0xd0, 0x8c, 0x16, 0x5b, 0x6a, 0x2a,
-	0x96, 0xd2, 0x6b, 0x07, 0xf8, 0x4d, 0xfe, 0x01, 0xac, 0x63, 0x9e, 0x8a, 0x39, 0x56, 0x54, 0xac,
-	0xb4, 0xf1, 0xcf, 0x75, 0x5e, 0xb6, 0xc0, 0x58, 0x52, 0x19, 0xc6, 0x4c, 0xe4, 0xa9, 0x66, 0x03,
-	0xeb, 0x87, 0xbf, 0xc8, 0x10, 0x74, 0x16, 0x89, 0x34, 0x91, 0x66, 0xd3, 0x6a, 0xf4, 0x8c, 0xc1,
-	0x1f, 0xa7, 0x6a, 0xdb, 0xce, 0x65, 0xce, 0x04, 0x3b, 0x94, 0x9c, 0x41, 0xeb, 0x99, 0xf3, 0x4d,
-	0x2a, 0xa4, 0xa9, 0xa1, 0xf5, 0xb7, 0xda, 0xba, 0x42, 0x28, 0xd8, 0xc3, 0xe4, 0x14, 0xb4, 0x27,
-	0xce, 0x22, 0x69, 0xea, 0x68, 0x75, 0xab, 0xad, 0x09, 0x67, 0x51, 0x50, 0x80, 0xf9, 0x4a, 0x3c,
-	0x4d, 0x70, 0x7f, 0xad, 0x9f, 0x56, 0xba, 0x41, 0x28, 0xd8, 0xc3, 0x64, 0x0c, 0x86, 0xa0, 0xb1,
-	0x64, 0x32, 0xa1, 0x51, 0x48, 0xcd, 0x5f, 0x96, 0xd2, 0x33, 0x06, 0xff, 0xab, 0x5d, 0xff, 0x13,
-	0x0c, 0x0e, 0x2d, 0xdb, 0x03, 0x0d, 0xcf, 0x4d, 0xce, 0x41, 0xc3, 0x1b, 0xc2, 0xd6, 0x1b, 0x83,
-	0xe3, 0xea, 0x9c, 0xdb, 0x1c, 0xf1, 0xca, 0x3b, 0x0c, 0x0a, 0xc7, 0x1e, 0x43, 0x33, 0x3f, 0x51,
-	0xbd, 0x90, 0x0b, 0xd0, 0x8b, 0x66, 0xd6, 0x8b, 0x59, 0x83, 0x5e, 0x74, 0xaa, 0x56, 0xcc, 0xd7,
-	0x67, 0xa5, 0x7e, 0x7b, 0x56, 0xf6, 0x04, 0x8c, 0x83, 0xb6, 0xd6, 0x5a, 0x6d, 0x34, 0x03, 0x33,
-	0xe4, 0xdb, 0x4a, 0x65, 0xf4, 0xbb, 0x1c, 0x0f, 0x3f, 0x9f, 0x18, 0x5f, 0xb9, 0x37, 0xca, 0x7a,
-	0xe6, 0xbe, 0xaa, 0x8d, 0xa9, 0x77, 0xf7, 0xa6, 0x76, 0xa6, 0x3b, 0xd7, 0x2b, 0xdd, 0x99, 0xfb,
-	0xa0, 0xe3, 0x90, 0x0d, 0x3f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x7d, 0x24, 0xa0, 0xcf, 0x03,
-	0x00, 0x00,
+	// 460 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcf, 0x8b, 0x13, 0x31,
+	0x14, 0xc7, 0xe9, 0xaf, 0x59, 0xe7, 0x8d, 0x78, 0x08, 0x7b, 0x18, 0xd6, 0x15, 0xc6, 0xa2, 0x50,
+	0x10, 0xa6, 0xb6, 0x0b, 0x5e, 0x3c, 0xb9, 0x1d, 0x0f, 0xfb, 0x03, 0x2d, 0xa3, 0x2c, 0xe2, 0xa5,
+	0x8c, 0x33, 0xd9, 0x6e, 0xdc, 0x36, 0x09, 0x49, 0x5a, 0xf0, 0xee, 0x5f, 0xe2, 0xd1, 0xbf, 0x52,
+	0xf2, 0xa6, 0x93, 0x16, 0x8d, 0x7b, 0x99, 0x5b, 0xc8, 0xfb, 0x7c, 0xbf, 0xc9, 0xfb, 0xc1, 0x83,
+	0x17, 0xf7, 0xc5, 0xed, 0x9a, 0xea, 0xbb, 0x71, 0xc5, 0x74, 0x29, 0xb6, 0x54, 0xfd, 0x18, 0x6f,
+	0x27, 0x63, 0xa9, 0x44, 0x49, 0xb5, 0x16, 0x2a, 0x95, 0x4a, 0x18, 0x41, 0x8e, 0x77, 0x54, 0xea,
+	0xa8, 0x74, 0x3b, 0x39, 0x79, 0xe5, 0xd5, 0x1a, 0x21, 0x59, 0xb9, 0xa8, 0xe8, 0x2d, 0xe3, 0xcc,
+	0x30, 0xc1, 0x6b, 0x8b, 0xe1, 0xcf, 0x3e, 0x84, 0xf3, 0xc6, 0x96, 0x10, 0xe8, 0xf3, 0x62, 0x4d,
+	0xe3, 0x4e, 0xd2, 0x19, 0x85, 0x39, 0x9e, 0xc9, 0x33, 0x80, 0xa5, 0x12, 0x1b, 0xb9, 0xc0, 0x48,
+	0x17, 0x23, 0x21, 0xde, 0x7c, 0xb0, 0xe1, 0x04, 0xa2, 0x8a, 0xea, 0x52, 0x31, 0x69, 0x5d, 0xe3,
+	0x1e, 0xc6, 0x0f, 0xaf, 0xc8, 0x19, 0x04, 0x8c, 0xcb, 0x8d, 0xd1, 0x71, 0x3f, 0xe9, 0x8d, 0xa2,
+	0xe9, 0xd3, 0xd4, 0xf7, 0xed, 0xf4, 0xc2, 0x32, 0xf9, 0x0e, 0x25, 0x6f, 0xe0, 0x68, 0x25, 0xc4,
+	0xfd, 0x46, 0xea, 0x78, 0x80, 0xaa, 0x53, 0xbf, 0xea, 0x1a, 0xa1, 0xbc, 0x81, 0xc9, 0x6b, 0x18,
+	0x7c, 0x17, 0x8c, 0xeb, 0x38, 0x40, 0xd5, 0x89, 0x5f, 0x75, 0x29, 0x18, 0xcf, 0x6b, 0xd0, 0xbe,
+	0x24, 0x36, 0x06, 0xff, 0x77, 0xf4, 0xd0, 0x4b, 0x1f, 0x11, 0xca, 0x1b, 0x98, 0xcc, 0x20, 0x92,
+	0x54, 0x69, 0xa6, 0x0d, 0xe5, 0x25, 0x8d, 0x1f, 0x25, 0x9d, 0x51, 0x34, 0x7d, 0xee, 0xd7, 0xce,
+	0xf7, 0x60, 0x7e, 0xa8, 0x22, 0xef, 0x6c, 0xf5, 0x8a, 0x6a, 0xb1, 0xa2, 0xc6, 0x50, 0x15, 0x87,
+	0x68, 0x92, 0xf8, 0x4d, 0x32, 0x5a, 0x54, 0xd7, 0xc8, 0xe5, 0x50, 0xb9, 0x33, 0x99, 0xc1, 0x63,
+	0x6d, 0x14, 0x2d, 0xd6, 0x8b, 0x3a, 0x71, 0x48, 0x7a, 0xff, 0xf7, 0xf8, 0x84, 0x24, 0xa6, 0x1f,
+	0x69, 0x77, 0xd6, 0xc3, 0x0c, 0x06, 0x58, 0x7f, 0xf2, 0x16, 0x06, 0x38, 0x29, 0x38, 0x02, 0xd1,
+	0xf4, 0xa5, 0xdf, 0xe6, 0xb3, 0x45, 0x32, 0x37, 0x4b, 0x79, 0xad, 0x19, 0xce, 0xa0, 0x6f, 0xed,
+	0xda, 0x99, 0xbc, 0x87, 0xa0, 0x6e, 0x6a, 0x3b, 0x9b, 0x25, 0x04, 0x75, 0xc7, 0x5a, 0xd9, 0xfc,
+	0x3d, 0xde, 0xdd, 0x7f, 0xc6, 0x7b, 0x78, 0x09, 0xd1, 0x41, 0x7b, 0xdb, 0x7d, 0xfa, 0x02, 0x60,
+	0xdf, 0xe5, 0xb6, 0xf9, 0xc3, 0xbe, 0xd9, 0xed, 0x6a, 0x70, 0x0a, 0x61, 0x79, 0x57, 0xf0, 0x25,
+	0x5d, 0x89, 0x65, 0xb3, 0x00, 0xdc, 0xc5, 0xf9, 0x0d, 0xc4, 0xa5, 0x58, 0x7b, 0x0d, 0xcf, 0x9f,
+	0xb8, 0xd5, 0x32, 0xb7, 0xdb, 0x66, 0xde, 0xf9, 0x1a, 0xb9, 0xf8, 0x76, 0xf2, 0xab, 0xdb, 0xbb,
+	0xca, 0xbe, 0xfc, 0xee, 0x1e, 0x5f, 0xed, 0xb4, 0x99, 0xd3, 0xde, 0x4c, 0xbe, 0x05, 0xb8, 0xa0,
+	0xce, 0xfe, 0x0c, 0x00, 0x3b, 0x1b, 0xfd, 0x4b, 0x0b, 0x05, 0x00, 0x00,
 }
@@ -25,6 +25,7 @@ type Sink struct {
 	Name                 string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Description          string           `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	Topic                *TopicDefinition `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic,omitempty"`
+	GroupName            string           `protobuf:"bytes,4,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -76,6 +77,13 @@ func (m *Sink) GetTopic() *TopicDefinition {
 	return nil
 }
 
+func (m *Sink) GetGroupName() string {
+	if m != nil {
+		return m.GroupName
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Sink)(nil), "kafmesh.discovery.v1.Sink")
 }
@@ -83,18 +91,20 @@ func init() {
 func init() { proto.RegisterFile("kafmesh/discovery/v1/sink.proto", fileDescriptor_30b216d099b95e9a) }
 
 var fileDescriptor_30b216d099b95e9a = []byte{
-	// 208 bytes of a gzipped FileDescriptorProto
+	// 228 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x4e, 0x4c, 0xcb,
 	0x4d, 0x2d, 0xce, 0xd0, 0x4f, 0xc9, 0x2c, 0x4e, 0xce, 0x2f, 0x4b, 0x2d, 0xaa, 0xd4, 0x2f, 0x33,
 	0xd4, 0x2f, 0xce, 0xcc, 0xcb, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x81, 0x2a, 0xd0,
 	0x83, 0x2b, 0xd0, 0x2b, 0x33, 0x94, 0xd2, 0xc6, 0xaa, 0xad, 0x24, 0xbf, 0x20, 0x33, 0x39, 0x3e,
-	0x25, 0x35, 0x2d, 0x33, 0x2f, 0xb3, 0x24, 0x33, 0x3f, 0x0f, 0x62, 0x84, 0x52, 0x25, 0x17, 0x4b,
-	0x70, 0x66, 0x5e, 0xb6, 0x90, 0x10, 0x17, 0x4b, 0x5e, 0x62, 0x6e, 0xaa, 0x04, 0xa3, 0x02, 0xa3,
-	0x06, 0x67, 0x10, 0x98, 0x2d, 0xa4, 0xc0, 0xc5, 0x9d, 0x92, 0x5a, 0x9c, 0x5c, 0x94, 0x59, 0x00,
-	0xd2, 0x20, 0xc1, 0x04, 0x96, 0x42, 0x16, 0x12, 0xb2, 0xe6, 0x62, 0x05, 0x9b, 0x2b, 0xc1, 0xac,
-	0xc0, 0xa8, 0xc1, 0x6d, 0xa4, 0xaa, 0x87, 0xcd, 0x41, 0x7a, 0x21, 0x20, 0x25, 0x2e, 0x70, 0x9b,
-	0x83, 0x20, 0x7a, 0x9c, 0x02, 0xb9, 0x24, 0x92, 0xf3, 0x73, 0xb1, 0x6a, 0x71, 0xe2, 0x04, 0x39,
-	0x2a, 0x00, 0xe4, 0xc2, 0x00, 0xc6, 0x28, 0x6e, 0xb8, 0x54, 0x99, 0xe1, 0x22, 0x26, 0x66, 0x6f,
-	0x97, 0x88, 0x55, 0x4c, 0x22, 0xde, 0x50, 0x6d, 0x2e, 0x70, 0x6d, 0x61, 0x86, 0x49, 0x6c, 0x60,
-	0x4f, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xfe, 0xb1, 0x69, 0xdf, 0x3a, 0x01, 0x00, 0x00,
+	0x25, 0x35, 0x2d, 0x33, 0x2f, 0xb3, 0x24, 0x33, 0x3f, 0x0f, 0x62, 0x84, 0xd2, 0x0c, 0x46, 0x2e,
+	0x96, 0xe0, 0xcc, 0xbc, 0x6c, 0x21, 0x21, 0x2e, 0x96, 0xbc, 0xc4, 0xdc, 0x54, 0x09, 0x46, 0x05,
+	0x46, 0x0d, 0xce, 0x20, 0x30, 0x5b, 0x48, 0x81, 0x8b, 0x3b, 0x25, 0xb5, 0x38, 0xb9, 0x28, 0xb3,
+	0x00, 0xa4, 0x43, 0x82, 0x09, 0x2c, 0x85, 0x2c, 0x24, 0x64, 0xcd, 0xc5, 0x0a, 0x36, 0x58, 0x82,
+	0x59, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x55, 0x0f, 0x9b, 0x8b, 0xf4, 0x42, 0x40, 0x4a, 0x5c, 0xe0,
+	0x56, 0x07, 0x41, 0xf4, 0x08, 0xc9, 0x72, 0x71, 0xa5, 0x17, 0xe5, 0x97, 0x16, 0xc4, 0x83, 0x2d,
+	0x66, 0x01, 0x9b, 0xce, 0x09, 0x16, 0xf1, 0x4b, 0xcc, 0x4d, 0x75, 0x0a, 0xe4, 0x92, 0x48, 0xce,
+	0xcf, 0xc5, 0x6a, 0xa2, 0x13, 0x27, 0xc8, 0xcd, 0x01, 0x20, 0x1f, 0x04, 0x30, 0x46, 0x71, 0xc3,
+	0xa5, 0xca, 0x0c, 0x17, 0x31, 0x31, 0x7b, 0xbb, 0x44, 0xac, 0x62, 0x12, 0xf1, 0x86, 0x6a, 0x73,
+	0x81, 0x6b, 0x0b, 0x33, 0x4c, 0x62, 0x03, 0x7b, 0xda, 0x18, 0x30, 0x00, 0xa1, 0x1b, 0xa1, 0x9b,
+	0x5a, 0x01, 0x00, 0x00,
 }
@@ -26,16 +26,19 @@ type TopicType int32
 const (
 	TopicType_TOPIC_TYPE_INVALID  TopicType = 0
 	TopicType_TOPIC_TYPE_PROTOBUF TopicType = 1
+	TopicType_TOPIC_TYPE_AVRO     TopicType = 2
 )
 
 var TopicType_name = map[int32]string{
 	0: "TOPIC_TYPE_INVALID",
 	1: "TOPIC_TYPE_PROTOBUF",
+	2: "TOPIC_TYPE_AVRO",
 }
 
 var TopicType_value = map[string]int32{
 	"TOPIC_TYPE_INVALID":  0,
 	"TOPIC_TYPE_PROTOBUF": 1,
+	"TOPIC_TYPE_AVRO":     2,
 }
 
 func (x TopicType) String() string {
@@ -112,7 +115,7 @@ func init() {
 }
 
 var fileDescriptor_8bae9ce406235a0f = []byte{
-	// 244 bytes of a gzipped FileDescriptorProto
+	// 253 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xce, 0x4e, 0x4c, 0xcb,
 	0x4d, 0x2d, 0xce, 0xd0, 0x4f, 0xc9, 0x2c, 0x4e, 0xce, 0x2f, 0x4b, 0x2d, 0xaa, 0xd4, 0x2f, 0x33,
 	0xd4, 0x2f, 0xc9, 0x2f, 0xc8, 0x4c, 0x8e, 0x4f, 0x49, 0x4d, 0xcb, 0xcc, 0xcb, 0x2c, 0xc9, 0xcc,
@@ -121,12 +124,12 @@ var fileDescriptor_8bae9ce406235a0f = []byte{
 	0x1b, 0x21, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x19, 0x04, 0xe1, 0x08, 0x49, 0x70, 0xb1, 0xe7, 0xa6,
 	0x16, 0x17, 0x27, 0xa6, 0xa7, 0x4a, 0x30, 0x81, 0xc5, 0x61, 0x5c, 0x21, 0x63, 0x2e, 0x96, 0x92,
 	0xca, 0x82, 0x54, 0x09, 0x66, 0x05, 0x46, 0x0d, 0x3e, 0x23, 0x79, 0x3d, 0x6c, 0xf6, 0xe8, 0x81,
-	0x2d, 0x09, 0xa9, 0x2c, 0x48, 0x0d, 0x02, 0x2b, 0xd6, 0xb2, 0xe1, 0xe2, 0x84, 0x0b, 0x09, 0x89,
+	0x2d, 0x09, 0xa9, 0x2c, 0x48, 0x0d, 0x02, 0x2b, 0xd6, 0x0a, 0xe4, 0xe2, 0x84, 0x0b, 0x09, 0x89,
 	0x71, 0x09, 0x85, 0xf8, 0x07, 0x78, 0x3a, 0xc7, 0x87, 0x44, 0x06, 0xb8, 0xc6, 0x7b, 0xfa, 0x85,
 	0x39, 0xfa, 0x78, 0xba, 0x08, 0x30, 0x08, 0x89, 0x73, 0x09, 0x23, 0x89, 0x07, 0x04, 0xf9, 0x87,
-	0xf8, 0x3b, 0x85, 0xba, 0x09, 0x30, 0x3a, 0xc5, 0x70, 0x49, 0x24, 0xe7, 0xe7, 0x62, 0xb5, 0xc9,
-	0x49, 0x04, 0xcd, 0x3f, 0x01, 0x20, 0xdf, 0x07, 0x30, 0x46, 0x71, 0xc3, 0x55, 0x95, 0x19, 0x2e,
-	0x62, 0x62, 0xf6, 0x76, 0x89, 0x58, 0xc5, 0x24, 0xe2, 0x0d, 0x35, 0xc1, 0x05, 0x6e, 0x42, 0x98,
-	0x61, 0x12, 0x1b, 0x38, 0xc0, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x22, 0xb1, 0x8c, 0x14,
-	0x5f, 0x01, 0x00, 0x00,
+	0xf8, 0x3b, 0x85, 0xba, 0x09, 0x30, 0x0a, 0x09, 0x73, 0xf1, 0x23, 0x49, 0x38, 0x86, 0x05, 0xf9,
+	0x0b, 0x30, 0x39, 0xc5, 0x70, 0x49, 0x24, 0xe7, 0xe7, 0x62, 0xb5, 0xde, 0x49, 0x04, 0xcd, 0x93,
+	0x01, 0xa0, 0x20, 0x09, 0x60, 0x8c, 0xe2, 0x86, 0xab, 0x2a, 0x33, 0x5c, 0xc4, 0xc4, 0xec, 0xed,
+	0x12, 0xb1, 0x8a, 0x49, 0xc4, 0x1b, 0x6a, 0x82, 0x0b, 0xdc, 0x84, 0x30, 0xc3, 0x24, 0x36, 0x70,
+	0x28, 0x1a, 0x03, 0x06, 0x00, 0x19, 0x72, 0x17, 0x05, 0x74, 0x01, 0x00, 0x00,
 }
@@ -0,0 +1,636 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: kafmesh/watch/v1/events.proto
+
+package watchv1
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// SinkEvent is something a sink did.
+type SinkEvent struct {
+	Time *timestamp.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// Types that are valid to be assigned to Event:
+	//	*SinkEvent_Collect
+	//	*SinkEvent_Flush
+	//	*SinkEvent_Commit
+	Event                isSinkEvent_Event `protobuf_oneof:"event"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SinkEvent) Reset()         { *m = SinkEvent{} }
+func (m *SinkEvent) String() string { return proto.CompactTextString(m) }
+func (*SinkEvent) ProtoMessage()    {}
+func (*SinkEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10042cc52ce24261, []int{0}
+}
+
+func (m *SinkEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SinkEvent.Unmarshal(m, b)
+}
+func (m *SinkEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SinkEvent.Marshal(b, m, deterministic)
+}
+func (m *SinkEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SinkEvent.Merge(m, src)
+}
+func (m *SinkEvent) XXX_Size() int {
+	return xxx_messageInfo_SinkEvent.Size(m)
+}
+func (m *SinkEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SinkEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SinkEvent proto.InternalMessageInfo
+
+func (m *SinkEvent) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+type isSinkEvent_Event interface {
+	isSinkEvent_Event()
+}
+
+type SinkEvent_Collect struct {
+	Collect *SinkCollect `protobuf:"bytes,2,opt,name=collect,proto3,oneof"`
+}
+
+type SinkEvent_Flush struct {
+	Flush *SinkFlush `protobuf:"bytes,3,opt,name=flush,proto3,oneof"`
+}
+
+type SinkEvent_Commit struct {
+	Commit *SinkCommit `protobuf:"bytes,4,opt,name=commit,proto3,oneof"`
+}
+
+func (*SinkEvent_Collect) isSinkEvent_Event() {}
+
+func (*SinkEvent_Flush) isSinkEvent_Event() {}
+
+func (*SinkEvent_Commit) isSinkEvent_Event() {}
+
+func (m *SinkEvent) GetEvent() isSinkEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *SinkEvent) GetCollect() *SinkCollect {
+	if x, ok := m.GetEvent().(*SinkEvent_Collect); ok {
+		return x.Collect
+	}
+	return nil
+}
+
+func (m *SinkEvent) GetFlush() *SinkFlush {
+	if x, ok := m.GetEvent().(*SinkEvent_Flush); ok {
+		return x.Flush
+	}
+	return nil
+}
+
+func (m *SinkEvent) GetCommit() *SinkCommit {
+	if x, ok := m.GetEvent().(*SinkEvent_Commit); ok {
+		return x.Commit
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*SinkEvent) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*SinkEvent_Collect)(nil),
+		(*SinkEvent_Flush)(nil),
+		(*SinkEvent_Commit)(nil),
+	}
+}
+
+// SinkCollect is a message the sink collected.
+type SinkCollect struct {
+	Partition            int32    `protobuf:"varint,1,opt,name=partition,proto3" json:"partition,omitempty"`
+	Offset               int64    `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Key                  string   `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Value                string   `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SinkCollect) Reset()         { *m = SinkCollect{} }
+func (m *SinkCollect) String() string { return proto.CompactTextString(m) }
+func (*SinkCollect) ProtoMessage()    {}
+func (*SinkCollect) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10042cc52ce24261, []int{1}
+}
+
+func (m *SinkCollect) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SinkCollect.Unmarshal(m, b)
+}
+func (m *SinkCollect) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SinkCollect.Marshal(b, m, deterministic)
+}
+func (m *SinkCollect) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SinkCollect.Merge(m, src)
+}
+func (m *SinkCollect) XXX_Size() int {
+	return xxx_messageInfo_SinkCollect.Size(m)
+}
+func (m *SinkCollect) XXX_DiscardUnknown() {
+	xxx_messageInfo_SinkCollect.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SinkCollect proto.InternalMessageInfo
+
+func (m *SinkCollect) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+func (m *SinkCollect) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *SinkCollect) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *SinkCollect) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// SinkFlush is a flush of the messages the sink collected.
+type SinkFlush struct {
+	Count                int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Attempts             int32    `protobuf:"varint,2,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SinkFlush) Reset()         { *m = SinkFlush{} }
+func (m *SinkFlush) String() string { return proto.CompactTextString(m) }
+func (*SinkFlush) ProtoMessage()    {}
+func (*SinkFlush) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10042cc52ce24261, []int{2}
+}
+
+func (m *SinkFlush) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SinkFlush.Unmarshal(m, b)
+}
+func (m *SinkFlush) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SinkFlush.Marshal(b, m, deterministic)
+}
+func (m *SinkFlush) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SinkFlush.Merge(m, src)
+}
+func (m *SinkFlush) XXX_Size() int {
+	return xxx_messageInfo_SinkFlush.Size(m)
+}
+func (m *SinkFlush) XXX_DiscardUnknown() {
+	xxx_messageInfo_SinkFlush.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SinkFlush proto.InternalMessageInfo
+
+func (m *SinkFlush) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *SinkFlush) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *SinkFlush) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// SinkCommit is a commit of the offsets the sink flushed.
+type SinkCommit struct {
+	Offsets              []*PartitionOffset `protobuf:"bytes,1,rep,name=offsets,proto3" json:"offsets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SinkCommit) Reset()         { *m = SinkCommit{} }
+func (m *SinkCommit) String() string { return proto.CompactTextString(m) }
+func (*SinkCommit) ProtoMessage()    {}
+func (*SinkCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10042cc52ce24261, []int{3}
+}
+
+func (m *SinkCommit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SinkCommit.Unmarshal(m, b)
+}
+func (m *SinkCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SinkCommit.Marshal(b, m, deterministic)
+}
+func (m *SinkCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SinkCommit.Merge(m, src)
+}
+func (m *SinkCommit) XXX_Size() int {
+	return xxx_messageInfo_SinkCommit.Size(m)
+}
+func (m *SinkCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SinkCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SinkCommit proto.InternalMessageInfo
+
+func (m *SinkCommit) GetOffsets() []*PartitionOffset {
+	if m != nil {
+		return m.Offsets
+	}
+	return nil
+}
+
+// PartitionOffset is the offset of a partition.
+type PartitionOffset struct {
+	Partition            int32    `protobuf:"varint,1,opt,name=partition,proto3" json:"partition,omitempty"`
+	Offset               int64    `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PartitionOffset) Reset()         { *m = PartitionOffset{} }
+func (m *PartitionOffset) String() string { return proto.CompactTextString(m) }
+func (*PartitionOffset) ProtoMessage()    {}
+func (*PartitionOffset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10042cc52ce24261, []int{4}
+}
+
+func (m *PartitionOffset) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PartitionOffset.Unmarshal(m, b)
+}
+func (m *PartitionOffset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PartitionOffset.Marshal(b, m, deterministic)
+}
+func (m *PartitionOffset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionOffset.Merge(m, src)
+}
+func (m *PartitionOffset) XXX_Size() int {
+	return xxx_messageInfo_PartitionOffset.Size(m)
+}
+func (m *PartitionOffset) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionOffset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionOffset proto.InternalMessageInfo
+
+func (m *PartitionOffset) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+func (m *PartitionOffset) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+// SourceEmit is a message a source emitted. Deletes have no value.
+type SourceEmit struct {
+	Time                 *timestamp.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Key                  string               `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value                string               `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Delete               bool                 `protobuf:"varint,4,opt,name=delete,proto3" json:"delete,omitempty"`
+	Error                string               `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SourceEmit) Reset()         { *m = SourceEmit{} }
+func (m *SourceEmit) String() string { return proto.CompactTextString(m) }
+func (*SourceEmit) ProtoMessage()    {}
+func (*SourceEmit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10042cc52ce24261, []int{5}
+}
+
+func (m *SourceEmit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SourceEmit.Unmarshal(m, b)
+}
+func (m *SourceEmit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SourceEmit.Marshal(b, m, deterministic)
+}
+func (m *SourceEmit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceEmit.Merge(m, src)
+}
+func (m *SourceEmit) XXX_Size() int {
+	return xxx_messageInfo_SourceEmit.Size(m)
+}
+func (m *SourceEmit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceEmit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SourceEmit proto.InternalMessageInfo
+
+func (m *SourceEmit) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *SourceEmit) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *SourceEmit) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *SourceEmit) GetDelete() bool {
+	if m != nil {
+		return m.Delete
+	}
+	return false
+}
+
+func (m *SourceEmit) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// ViewSourceSync is a sync run of a view source with the keys it updated and
+// deleted.
+type ViewSourceSync struct {
+	StartTime            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              *timestamp.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Updates              []*ViewSourceUpdate  `protobuf:"bytes,3,rep,name=updates,proto3" json:"updates,omitempty"`
+	Deletes              []string             `protobuf:"bytes,4,rep,name=deletes,proto3" json:"deletes,omitempty"`
+	Error                string               `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ViewSourceSync) Reset()         { *m = ViewSourceSync{} }
+func (m *ViewSourceSync) String() string { return proto.CompactTextString(m) }
+func (*ViewSourceSync) ProtoMessage()    {}
+func (*ViewSourceSync) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10042cc52ce24261, []int{6}
+}
+
+func (m *ViewSourceSync) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ViewSourceSync.Unmarshal(m, b)
+}
+func (m *ViewSourceSync) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ViewSourceSync.Marshal(b, m, deterministic)
+}
+func (m *ViewSourceSync) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ViewSourceSync.Merge(m, src)
+}
+func (m *ViewSourceSync) XXX_Size() int {
+	return xxx_messageInfo_ViewSourceSync.Size(m)
+}
+func (m *ViewSourceSync) XXX_DiscardUnknown() {
+	xxx_messageInfo_ViewSourceSync.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ViewSourceSync proto.InternalMessageInfo
+
+func (m *ViewSourceSync) GetStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *ViewSourceSync) GetEndTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *ViewSourceSync) GetUpdates() []*ViewSourceUpdate {
+	if m != nil {
+		return m.Updates
+	}
+	return nil
+}
+
+func (m *ViewSourceSync) GetDeletes() []string {
+	if m != nil {
+		return m.Deletes
+	}
+	return nil
+}
+
+func (m *ViewSourceSync) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// ViewSourceUpdate is a key a view source sync run updated.
+type ViewSourceUpdate struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ViewSourceUpdate) Reset()         { *m = ViewSourceUpdate{} }
+func (m *ViewSourceUpdate) String() string { return proto.CompactTextString(m) }
+func (*ViewSourceUpdate) ProtoMessage()    {}
+func (*ViewSourceUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10042cc52ce24261, []int{7}
+}
+
+func (m *ViewSourceUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ViewSourceUpdate.Unmarshal(m, b)
+}
+func (m *ViewSourceUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ViewSourceUpdate.Marshal(b, m, deterministic)
+}
+func (m *ViewSourceUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ViewSourceUpdate.Merge(m, src)
+}
+func (m *ViewSourceUpdate) XXX_Size() int {
+	return xxx_messageInfo_ViewSourceUpdate.Size(m)
+}
+func (m *ViewSourceUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ViewSourceUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ViewSourceUpdate proto.InternalMessageInfo
+
+func (m *ViewSourceUpdate) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ViewSourceUpdate) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// ViewSinkSync is a sync run of a view sink.
+type ViewSinkSync struct {
+	StartTime            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              *timestamp.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Error                string               `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ViewSinkSync) Reset()         { *m = ViewSinkSync{} }
+func (m *ViewSinkSync) String() string { return proto.CompactTextString(m) }
+func (*ViewSinkSync) ProtoMessage()    {}
+func (*ViewSinkSync) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10042cc52ce24261, []int{8}
+}
+
+func (m *ViewSinkSync) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ViewSinkSync.Unmarshal(m, b)
+}
+func (m *ViewSinkSync) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ViewSinkSync.Marshal(b, m, deterministic)
+}
+func (m *ViewSinkSync) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ViewSinkSync.Merge(m, src)
+}
+func (m *ViewSinkSync) XXX_Size() int {
+	return xxx_messageInfo_ViewSinkSync.Size(m)
+}
+func (m *ViewSinkSync) XXX_DiscardUnknown() {
+	xxx_messageInfo_ViewSinkSync.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ViewSinkSync proto.InternalMessageInfo
+
+func (m *ViewSinkSync) GetStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *ViewSinkSync) GetEndTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *ViewSinkSync) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*SinkEvent)(nil), "kafmesh.watch.v1.SinkEvent")
+	proto.RegisterType((*SinkCollect)(nil), "kafmesh.watch.v1.SinkCollect")
+	proto.RegisterType((*SinkFlush)(nil), "kafmesh.watch.v1.SinkFlush")
+	proto.RegisterType((*SinkCommit)(nil), "kafmesh.watch.v1.SinkCommit")
+	proto.RegisterType((*PartitionOffset)(nil), "kafmesh.watch.v1.PartitionOffset")
+	proto.RegisterType((*SourceEmit)(nil), "kafmesh.watch.v1.SourceEmit")
+	proto.RegisterType((*ViewSourceSync)(nil), "kafmesh.watch.v1.ViewSourceSync")
+	proto.RegisterType((*ViewSourceUpdate)(nil), "kafmesh.watch.v1.ViewSourceUpdate")
+	proto.RegisterType((*ViewSinkSync)(nil), "kafmesh.watch.v1.ViewSinkSync")
+}
+
+func init() { proto.RegisterFile("kafmesh/watch/v1/events.proto", fileDescriptor_10042cc52ce24261) }
+
+var fileDescriptor_10042cc52ce24261 = []byte{
+	// 550 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x94, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc5, 0x6b, 0x3b, 0x8e, 0xe3, 0x9b, 0x4f, 0x1f, 0xd1, 0xa8, 0x42, 0x56, 0x68, 0x45, 0xf0,
+	0x2a, 0x2b, 0x47, 0x69, 0x05, 0x52, 0x81, 0x55, 0x50, 0x69, 0x50, 0x17, 0x44, 0x13, 0x68, 0x11,
+	0x1b, 0xe4, 0x3a, 0x93, 0xc6, 0x8a, 0xed, 0x89, 0x3c, 0xe3, 0x54, 0x7d, 0x0d, 0x16, 0x3c, 0x00,
+	0x4b, 0x9e, 0x8d, 0x05, 0x8f, 0x80, 0xe6, 0x8e, 0xdd, 0x44, 0x49, 0x2a, 0xfe, 0x6c, 0xd8, 0xe5,
+	0xe6, 0x9e, 0xdf, 0xdc, 0x73, 0xcf, 0x8c, 0x0c, 0x87, 0xf3, 0x70, 0x9a, 0x32, 0x31, 0xeb, 0xdd,
+	0x84, 0x32, 0x9a, 0xf5, 0x96, 0xfd, 0x1e, 0x5b, 0xb2, 0x4c, 0x8a, 0x60, 0x91, 0x73, 0xc9, 0x49,
+	0xab, 0x6c, 0x07, 0xd8, 0x0e, 0x96, 0xfd, 0xf6, 0xe3, 0x6b, 0xce, 0xaf, 0x13, 0xd6, 0xc3, 0xfe,
+	0x55, 0x31, 0xed, 0xc9, 0x38, 0x65, 0x42, 0x86, 0xe9, 0x42, 0x23, 0xfe, 0x77, 0x03, 0xdc, 0x71,
+	0x9c, 0xcd, 0x4f, 0xd5, 0x39, 0x24, 0x80, 0x9a, 0x12, 0x78, 0x46, 0xc7, 0xe8, 0x36, 0x8f, 0xda,
+	0x81, 0xa6, 0x83, 0x8a, 0x0e, 0xde, 0x55, 0x34, 0x45, 0x1d, 0x39, 0x01, 0x27, 0xe2, 0x49, 0xc2,
+	0x22, 0xe9, 0x99, 0x88, 0x1c, 0x06, 0x9b, 0x16, 0x02, 0x75, 0xfa, 0x2b, 0x2d, 0x1a, 0xee, 0xd1,
+	0x4a, 0x4f, 0x8e, 0xc1, 0x9e, 0x26, 0x85, 0x98, 0x79, 0x16, 0x82, 0x8f, 0x76, 0x83, 0xaf, 0x95,
+	0x64, 0xb8, 0x47, 0xb5, 0x96, 0x3c, 0x83, 0x7a, 0xc4, 0xd3, 0x34, 0x96, 0x5e, 0x0d, 0xa9, 0x83,
+	0xfb, 0xc6, 0x29, 0xcd, 0x70, 0x8f, 0x96, 0xea, 0x81, 0x03, 0x36, 0x06, 0xe5, 0xcf, 0xa1, 0xb9,
+	0xe6, 0x87, 0x1c, 0x80, 0xbb, 0x08, 0x73, 0x19, 0xcb, 0x98, 0x67, 0xb8, 0xb4, 0x4d, 0x57, 0x7f,
+	0x90, 0x87, 0x50, 0xe7, 0xd3, 0xa9, 0x60, 0x7a, 0x39, 0x8b, 0x96, 0x15, 0x69, 0x81, 0x35, 0x67,
+	0xb7, 0x68, 0xdc, 0xa5, 0xea, 0x27, 0xd9, 0x07, 0x7b, 0x19, 0x26, 0x05, 0x43, 0x5b, 0x2e, 0xd5,
+	0x85, 0x3f, 0x06, 0xf7, 0x6e, 0x07, 0x25, 0x89, 0x78, 0x91, 0x49, 0x1c, 0x63, 0x51, 0x5d, 0x90,
+	0x36, 0x34, 0x42, 0x29, 0x59, 0xba, 0x90, 0x02, 0x87, 0xd8, 0xf4, 0xae, 0x56, 0x04, 0xcb, 0x73,
+	0x9e, 0x97, 0x83, 0x74, 0xe1, 0xbf, 0x01, 0x58, 0xad, 0x48, 0x5e, 0x80, 0xa3, 0x4d, 0x09, 0xcf,
+	0xe8, 0x58, 0xdd, 0xe6, 0xd1, 0x93, 0xed, 0x44, 0x46, 0xd5, 0x42, 0x6f, 0x51, 0x49, 0x2b, 0xc2,
+	0x3f, 0x83, 0x07, 0x1b, 0xbd, 0xbf, 0x0b, 0xc4, 0xff, 0x6c, 0x00, 0x8c, 0x79, 0x91, 0x47, 0xec,
+	0x34, 0x8d, 0xff, 0xfc, 0x15, 0x95, 0x79, 0x9a, 0x3b, 0xf2, 0xb4, 0xd6, 0xf2, 0x54, 0xe3, 0x27,
+	0x2c, 0x61, 0x52, 0xc7, 0xdc, 0xa0, 0x65, 0xb5, 0x0a, 0xca, 0x5e, 0x0f, 0xea, 0x87, 0x01, 0xff,
+	0x5f, 0xc4, 0xec, 0x46, 0x1b, 0x1b, 0xdf, 0x66, 0x11, 0x39, 0x01, 0x10, 0x32, 0xcc, 0xe5, 0xa7,
+	0xdf, 0xb4, 0xe7, 0xa2, 0x5a, 0xd5, 0xe4, 0x29, 0x34, 0x58, 0x36, 0xd1, 0xa0, 0xf9, 0x4b, 0xd0,
+	0x61, 0xd9, 0x04, 0xb1, 0x97, 0xe0, 0x14, 0x8b, 0x49, 0x28, 0x99, 0xf0, 0x2c, 0xbc, 0x1f, 0x7f,
+	0xfb, 0x7e, 0x56, 0x26, 0xdf, 0xa3, 0x94, 0x56, 0x08, 0xf1, 0xc0, 0xd1, 0x2b, 0x0a, 0xaf, 0xd6,
+	0xb1, 0xba, 0x2e, 0xad, 0xca, 0x7b, 0x56, 0x7e, 0x0e, 0xad, 0xcd, 0xc3, 0xaa, 0x70, 0x8d, 0x1d,
+	0xe1, 0x9a, 0xeb, 0x8f, 0xf5, 0x8b, 0x01, 0xff, 0x21, 0x1c, 0x67, 0xf3, 0x7f, 0x14, 0xd6, 0xce,
+	0x07, 0x3f, 0x38, 0x83, 0xfd, 0x88, 0xa7, 0x5b, 0xb1, 0x0d, 0x9a, 0xf8, 0xc9, 0x12, 0x23, 0x75,
+	0xe0, 0xc8, 0xf8, 0xe8, 0x60, 0x63, 0xd9, 0xff, 0x6a, 0x5a, 0xe7, 0x97, 0x1f, 0xbe, 0x99, 0xad,
+	0xf3, 0x12, 0xb8, 0x44, 0xe0, 0xa2, 0x7f, 0x55, 0xc7, 0xd9, 0xc7, 0x3f, 0x07, 0x00, 0xf6, 0x6b,
+	0xa1, 0xf1, 0x45, 0x05, 0x00, 0x00,
+}
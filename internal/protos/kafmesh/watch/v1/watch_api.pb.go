@@ -22,10 +22,20 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// ProcessorRequest selects the keys of a processor to watch. A request either
+// watches a single key or every key matching all of the prefix, regex and
+// sample rate that are set.
 type ProcessorRequest struct {
-	Component            string   `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
-	Processor            string   `protobuf:"bytes,2,opt,name=processor,proto3" json:"processor,omitempty"`
-	Key                  string   `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Component string `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
+	Processor string `protobuf:"bytes,2,opt,name=processor,proto3" json:"processor,omitempty"`
+	Key       string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// key_prefix watches every key starting with the prefix.
+	KeyPrefix string `protobuf:"bytes,4,opt,name=key_prefix,json=keyPrefix,proto3" json:"key_prefix,omitempty"`
+	// key_regex watches every key matching the regular expression.
+	KeyRegex string `protobuf:"bytes,5,opt,name=key_regex,json=keyRegex,proto3" json:"key_regex,omitempty"`
+	// sample_rate watches a share of the keys between 0 and 1. Keys are sampled
+	// by their hash so every operation of a sampled key is watched.
+	SampleRate           float64  `protobuf:"fixed64,6,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -77,6 +87,27 @@ func (m *ProcessorRequest) GetKey() string {
 	return ""
 }
 
+func (m *ProcessorRequest) GetKeyPrefix() string {
+	if m != nil {
+		return m.KeyPrefix
+	}
+	return ""
+}
+
+func (m *ProcessorRequest) GetKeyRegex() string {
+	if m != nil {
+		return m.KeyRegex
+	}
+	return ""
+}
+
+func (m *ProcessorRequest) GetSampleRate() float64 {
+	if m != nil {
+		return m.SampleRate
+	}
+	return 0
+}
+
 type ProcessorResponse struct {
 	Operation            *Operation `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
@@ -116,32 +147,404 @@ func (m *ProcessorResponse) GetOperation() *Operation {
 	return nil
 }
 
+type SinkRequest struct {
+	Component            string   `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
+	Sink                 string   `protobuf:"bytes,2,opt,name=sink,proto3" json:"sink,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SinkRequest) Reset()         { *m = SinkRequest{} }
+func (m *SinkRequest) String() string { return proto.CompactTextString(m) }
+func (*SinkRequest) ProtoMessage()    {}
+func (*SinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c576eeab6cb310d3, []int{2}
+}
+
+func (m *SinkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SinkRequest.Unmarshal(m, b)
+}
+func (m *SinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SinkRequest.Marshal(b, m, deterministic)
+}
+func (m *SinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SinkRequest.Merge(m, src)
+}
+func (m *SinkRequest) XXX_Size() int {
+	return xxx_messageInfo_SinkRequest.Size(m)
+}
+func (m *SinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SinkRequest proto.InternalMessageInfo
+
+func (m *SinkRequest) GetComponent() string {
+	if m != nil {
+		return m.Component
+	}
+	return ""
+}
+
+func (m *SinkRequest) GetSink() string {
+	if m != nil {
+		return m.Sink
+	}
+	return ""
+}
+
+type SinkResponse struct {
+	Event                *SinkEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *SinkResponse) Reset()         { *m = SinkResponse{} }
+func (m *SinkResponse) String() string { return proto.CompactTextString(m) }
+func (*SinkResponse) ProtoMessage()    {}
+func (*SinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c576eeab6cb310d3, []int{3}
+}
+
+func (m *SinkResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SinkResponse.Unmarshal(m, b)
+}
+func (m *SinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SinkResponse.Marshal(b, m, deterministic)
+}
+func (m *SinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SinkResponse.Merge(m, src)
+}
+func (m *SinkResponse) XXX_Size() int {
+	return xxx_messageInfo_SinkResponse.Size(m)
+}
+func (m *SinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SinkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SinkResponse proto.InternalMessageInfo
+
+func (m *SinkResponse) GetEvent() *SinkEvent {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+// SourceRequest selects a source by the topic it emits to.
+type SourceRequest struct {
+	Component            string   `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
+	Topic                string   `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SourceRequest) Reset()         { *m = SourceRequest{} }
+func (m *SourceRequest) String() string { return proto.CompactTextString(m) }
+func (*SourceRequest) ProtoMessage()    {}
+func (*SourceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c576eeab6cb310d3, []int{4}
+}
+
+func (m *SourceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SourceRequest.Unmarshal(m, b)
+}
+func (m *SourceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SourceRequest.Marshal(b, m, deterministic)
+}
+func (m *SourceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceRequest.Merge(m, src)
+}
+func (m *SourceRequest) XXX_Size() int {
+	return xxx_messageInfo_SourceRequest.Size(m)
+}
+func (m *SourceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SourceRequest proto.InternalMessageInfo
+
+func (m *SourceRequest) GetComponent() string {
+	if m != nil {
+		return m.Component
+	}
+	return ""
+}
+
+func (m *SourceRequest) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+type SourceResponse struct {
+	Emit                 *SourceEmit `protobuf:"bytes,1,opt,name=emit,proto3" json:"emit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *SourceResponse) Reset()         { *m = SourceResponse{} }
+func (m *SourceResponse) String() string { return proto.CompactTextString(m) }
+func (*SourceResponse) ProtoMessage()    {}
+func (*SourceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c576eeab6cb310d3, []int{5}
+}
+
+func (m *SourceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SourceResponse.Unmarshal(m, b)
+}
+func (m *SourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SourceResponse.Marshal(b, m, deterministic)
+}
+func (m *SourceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceResponse.Merge(m, src)
+}
+func (m *SourceResponse) XXX_Size() int {
+	return xxx_messageInfo_SourceResponse.Size(m)
+}
+func (m *SourceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SourceResponse proto.InternalMessageInfo
+
+func (m *SourceResponse) GetEmit() *SourceEmit {
+	if m != nil {
+		return m.Emit
+	}
+	return nil
+}
+
+type ViewSourceRequest struct {
+	Component            string   `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
+	ViewSource           string   `protobuf:"bytes,2,opt,name=view_source,json=viewSource,proto3" json:"view_source,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ViewSourceRequest) Reset()         { *m = ViewSourceRequest{} }
+func (m *ViewSourceRequest) String() string { return proto.CompactTextString(m) }
+func (*ViewSourceRequest) ProtoMessage()    {}
+func (*ViewSourceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c576eeab6cb310d3, []int{6}
+}
+
+func (m *ViewSourceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ViewSourceRequest.Unmarshal(m, b)
+}
+func (m *ViewSourceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ViewSourceRequest.Marshal(b, m, deterministic)
+}
+func (m *ViewSourceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ViewSourceRequest.Merge(m, src)
+}
+func (m *ViewSourceRequest) XXX_Size() int {
+	return xxx_messageInfo_ViewSourceRequest.Size(m)
+}
+func (m *ViewSourceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ViewSourceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ViewSourceRequest proto.InternalMessageInfo
+
+func (m *ViewSourceRequest) GetComponent() string {
+	if m != nil {
+		return m.Component
+	}
+	return ""
+}
+
+func (m *ViewSourceRequest) GetViewSource() string {
+	if m != nil {
+		return m.ViewSource
+	}
+	return ""
+}
+
+type ViewSourceResponse struct {
+	Sync                 *ViewSourceSync `protobuf:"bytes,1,opt,name=sync,proto3" json:"sync,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ViewSourceResponse) Reset()         { *m = ViewSourceResponse{} }
+func (m *ViewSourceResponse) String() string { return proto.CompactTextString(m) }
+func (*ViewSourceResponse) ProtoMessage()    {}
+func (*ViewSourceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c576eeab6cb310d3, []int{7}
+}
+
+func (m *ViewSourceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ViewSourceResponse.Unmarshal(m, b)
+}
+func (m *ViewSourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ViewSourceResponse.Marshal(b, m, deterministic)
+}
+func (m *ViewSourceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ViewSourceResponse.Merge(m, src)
+}
+func (m *ViewSourceResponse) XXX_Size() int {
+	return xxx_messageInfo_ViewSourceResponse.Size(m)
+}
+func (m *ViewSourceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ViewSourceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ViewSourceResponse proto.InternalMessageInfo
+
+func (m *ViewSourceResponse) GetSync() *ViewSourceSync {
+	if m != nil {
+		return m.Sync
+	}
+	return nil
+}
+
+type ViewSinkRequest struct {
+	Component            string   `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
+	ViewSink             string   `protobuf:"bytes,2,opt,name=view_sink,json=viewSink,proto3" json:"view_sink,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ViewSinkRequest) Reset()         { *m = ViewSinkRequest{} }
+func (m *ViewSinkRequest) String() string { return proto.CompactTextString(m) }
+func (*ViewSinkRequest) ProtoMessage()    {}
+func (*ViewSinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c576eeab6cb310d3, []int{8}
+}
+
+func (m *ViewSinkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ViewSinkRequest.Unmarshal(m, b)
+}
+func (m *ViewSinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ViewSinkRequest.Marshal(b, m, deterministic)
+}
+func (m *ViewSinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ViewSinkRequest.Merge(m, src)
+}
+func (m *ViewSinkRequest) XXX_Size() int {
+	return xxx_messageInfo_ViewSinkRequest.Size(m)
+}
+func (m *ViewSinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ViewSinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ViewSinkRequest proto.InternalMessageInfo
+
+func (m *ViewSinkRequest) GetComponent() string {
+	if m != nil {
+		return m.Component
+	}
+	return ""
+}
+
+func (m *ViewSinkRequest) GetViewSink() string {
+	if m != nil {
+		return m.ViewSink
+	}
+	return ""
+}
+
+type ViewSinkResponse struct {
+	Sync                 *ViewSinkSync `protobuf:"bytes,1,opt,name=sync,proto3" json:"sync,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ViewSinkResponse) Reset()         { *m = ViewSinkResponse{} }
+func (m *ViewSinkResponse) String() string { return proto.CompactTextString(m) }
+func (*ViewSinkResponse) ProtoMessage()    {}
+func (*ViewSinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c576eeab6cb310d3, []int{9}
+}
+
+func (m *ViewSinkResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ViewSinkResponse.Unmarshal(m, b)
+}
+func (m *ViewSinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ViewSinkResponse.Marshal(b, m, deterministic)
+}
+func (m *ViewSinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ViewSinkResponse.Merge(m, src)
+}
+func (m *ViewSinkResponse) XXX_Size() int {
+	return xxx_messageInfo_ViewSinkResponse.Size(m)
+}
+func (m *ViewSinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ViewSinkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ViewSinkResponse proto.InternalMessageInfo
+
+func (m *ViewSinkResponse) GetSync() *ViewSinkSync {
+	if m != nil {
+		return m.Sync
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ProcessorRequest)(nil), "kafmesh.watch.v1.ProcessorRequest")
 	proto.RegisterType((*ProcessorResponse)(nil), "kafmesh.watch.v1.ProcessorResponse")
+	proto.RegisterType((*SinkRequest)(nil), "kafmesh.watch.v1.SinkRequest")
+	proto.RegisterType((*SinkResponse)(nil), "kafmesh.watch.v1.SinkResponse")
+	proto.RegisterType((*SourceRequest)(nil), "kafmesh.watch.v1.SourceRequest")
+	proto.RegisterType((*SourceResponse)(nil), "kafmesh.watch.v1.SourceResponse")
+	proto.RegisterType((*ViewSourceRequest)(nil), "kafmesh.watch.v1.ViewSourceRequest")
+	proto.RegisterType((*ViewSourceResponse)(nil), "kafmesh.watch.v1.ViewSourceResponse")
+	proto.RegisterType((*ViewSinkRequest)(nil), "kafmesh.watch.v1.ViewSinkRequest")
+	proto.RegisterType((*ViewSinkResponse)(nil), "kafmesh.watch.v1.ViewSinkResponse")
 }
 
 func init() { proto.RegisterFile("kafmesh/watch/v1/watch_api.proto", fileDescriptor_c576eeab6cb310d3) }
 
 var fileDescriptor_c576eeab6cb310d3 = []byte{
-	// 257 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc8, 0x4e, 0x4c, 0xcb,
-	0x4d, 0x2d, 0xce, 0xd0, 0x2f, 0x4f, 0x2c, 0x49, 0xce, 0xd0, 0x2f, 0x33, 0x84, 0x30, 0xe2, 0x13,
-	0x0b, 0x32, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x04, 0xa0, 0x2a, 0xf4, 0xc0, 0x12, 0x7a,
-	0x65, 0x86, 0x52, 0x98, 0x7a, 0xf2, 0x0b, 0x52, 0x8b, 0x12, 0x4b, 0x32, 0xf3, 0xf3, 0x20, 0x7a,
-	0x94, 0x12, 0xb8, 0x04, 0x02, 0x8a, 0xf2, 0x93, 0x53, 0x8b, 0x8b, 0xf3, 0x8b, 0x82, 0x52, 0x0b,
-	0x4b, 0x53, 0x8b, 0x4b, 0x84, 0x64, 0xb8, 0x38, 0x93, 0xf3, 0x73, 0x0b, 0xf2, 0xf3, 0x52, 0xf3,
-	0x4a, 0x24, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0x10, 0x02, 0x20, 0xd9, 0x02, 0x98, 0x0e, 0x09,
-	0x26, 0x88, 0x2c, 0x5c, 0x40, 0x48, 0x80, 0x8b, 0x39, 0x3b, 0xb5, 0x52, 0x82, 0x19, 0x2c, 0x0e,
-	0x62, 0x2a, 0xf9, 0x71, 0x09, 0x22, 0xd9, 0x50, 0x5c, 0x90, 0x9f, 0x57, 0x9c, 0x2a, 0x64, 0xc9,
-	0xc5, 0x09, 0x77, 0x09, 0xd8, 0x0a, 0x6e, 0x23, 0x69, 0x3d, 0x74, 0xe7, 0xeb, 0xf9, 0xc3, 0x94,
-	0x04, 0x21, 0x54, 0x1b, 0x25, 0x71, 0x71, 0x84, 0x83, 0x14, 0x38, 0x06, 0x78, 0x0a, 0x85, 0x71,
-	0x71, 0xc2, 0xcd, 0x16, 0x52, 0xc2, 0x34, 0x00, 0xdd, 0x6b, 0x52, 0xca, 0x78, 0xd5, 0x40, 0x1c,
-	0x67, 0xc0, 0xe8, 0xe4, 0xc9, 0x25, 0x92, 0x9c, 0x9f, 0x8b, 0xa1, 0xd6, 0x89, 0x17, 0x62, 0x73,
-	0x41, 0x66, 0x00, 0x28, 0xf0, 0x02, 0x18, 0xa3, 0xd8, 0xc1, 0x52, 0x65, 0x86, 0x8b, 0x98, 0x98,
-	0xbd, 0xc3, 0x23, 0x56, 0x31, 0x09, 0x78, 0x43, 0xb5, 0x80, 0x15, 0xea, 0x85, 0x19, 0x26, 0xb1,
-	0x81, 0xc3, 0xd9, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xb5, 0x7e, 0x20, 0xcf, 0xbf, 0x01, 0x00,
-	0x00,
+	// 566 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x86, 0xe5, 0x26, 0x0d, 0xc9, 0x84, 0x42, 0xba, 0xea, 0xc1, 0x4a, 0x9a, 0x26, 0x6c, 0x39,
+	0xf4, 0xe4, 0x36, 0x81, 0x0b, 0x27, 0xd4, 0xa0, 0x82, 0x4a, 0xf9, 0x88, 0x1c, 0x29, 0x05, 0x2e,
+	0x91, 0xb1, 0xa6, 0x74, 0x65, 0xec, 0x35, 0xb6, 0xeb, 0xd4, 0x7f, 0x87, 0x63, 0xff, 0x03, 0xff,
+	0x0d, 0x79, 0x3f, 0xec, 0xd2, 0x7c, 0x28, 0xdc, 0x76, 0x67, 0xde, 0xf7, 0xed, 0xd3, 0x99, 0x8d,
+	0xa1, 0xef, 0x39, 0x57, 0x3e, 0xc6, 0xd7, 0xc7, 0x73, 0x27, 0x71, 0xaf, 0x8f, 0xd3, 0x81, 0x3c,
+	0xcc, 0x9c, 0x90, 0x59, 0x61, 0xc4, 0x13, 0x4e, 0x5a, 0x4a, 0x61, 0x89, 0x86, 0x95, 0x0e, 0xda,
+	0xdd, 0x05, 0x0f, 0xa6, 0x18, 0x24, 0xb1, 0x34, 0xb4, 0x17, 0x23, 0x79, 0x88, 0x91, 0x93, 0x30,
+	0x1e, 0x48, 0x05, 0xfd, 0x63, 0x40, 0x6b, 0x1c, 0x71, 0x17, 0xe3, 0x98, 0x47, 0x36, 0xfe, 0xba,
+	0xc1, 0x38, 0x21, 0xfb, 0xd0, 0x70, 0xb9, 0x1f, 0xf2, 0x00, 0x83, 0xc4, 0x34, 0xfa, 0xc6, 0x51,
+	0xc3, 0x2e, 0x0b, 0x79, 0x37, 0xd4, 0x0e, 0x73, 0x4b, 0x76, 0x8b, 0x02, 0x69, 0x41, 0xc5, 0xc3,
+	0xcc, 0xac, 0x88, 0x7a, 0x7e, 0x24, 0x5d, 0x00, 0x0f, 0xb3, 0x59, 0x18, 0xe1, 0x15, 0xbb, 0x35,
+	0xab, 0xd2, 0xe0, 0x61, 0x36, 0x16, 0x05, 0xd2, 0x81, 0xfc, 0x32, 0x8b, 0xf0, 0x07, 0xde, 0x9a,
+	0xdb, 0xa2, 0x5b, 0xf7, 0x30, 0xb3, 0xf3, 0x3b, 0xe9, 0x41, 0x33, 0x76, 0xfc, 0xf0, 0x27, 0xce,
+	0x22, 0x27, 0x41, 0xb3, 0xd6, 0x37, 0x8e, 0x0c, 0x1b, 0x64, 0xc9, 0x76, 0x12, 0xa4, 0x9f, 0x60,
+	0xf7, 0x1e, 0x7e, 0x1c, 0xf2, 0x20, 0x46, 0xf2, 0x0a, 0x1a, 0xc5, 0xff, 0x29, 0xf8, 0x9b, 0xc3,
+	0x8e, 0xf5, 0x70, 0x76, 0xd6, 0x67, 0x2d, 0xb1, 0x4b, 0x35, 0x7d, 0x0d, 0xcd, 0x09, 0x0b, 0xbc,
+	0xcd, 0x26, 0x41, 0xa0, 0x1a, 0xb3, 0xc0, 0x53, 0x43, 0x10, 0x67, 0x7a, 0x0a, 0x8f, 0x65, 0x80,
+	0x62, 0x19, 0xc0, 0x36, 0xa6, 0xda, 0xbd, 0x94, 0x23, 0x97, 0x9f, 0xe5, 0x12, 0x5b, 0x2a, 0xe9,
+	0x1b, 0xd8, 0x99, 0xf0, 0x9b, 0xc8, 0xc5, 0xcd, 0x28, 0xf6, 0x60, 0x3b, 0xe1, 0x21, 0x73, 0x15,
+	0x86, 0xbc, 0xd0, 0x11, 0x3c, 0xd1, 0x21, 0x8a, 0xe4, 0x04, 0xaa, 0xe8, 0x33, 0x0d, 0xb2, 0xbf,
+	0x04, 0x44, 0xe8, 0xcf, 0x7c, 0x96, 0xd8, 0x42, 0x49, 0x6d, 0xd8, 0x9d, 0x32, 0x9c, 0xff, 0x0f,
+	0x4c, 0x0f, 0x9a, 0x29, 0xc3, 0xf9, 0x2c, 0x16, 0x1e, 0x85, 0x04, 0x69, 0x91, 0x42, 0xdf, 0x03,
+	0xb9, 0x9f, 0xa9, 0xd8, 0x5e, 0x42, 0x35, 0xce, 0x02, 0x57, 0xb1, 0xf5, 0x17, 0xd9, 0x4a, 0xcf,
+	0x24, 0x0b, 0x5c, 0x5b, 0xa8, 0xe9, 0x07, 0x78, 0x2a, 0xea, 0x1b, 0x2f, 0xac, 0x03, 0x0d, 0x49,
+	0x57, 0x6e, 0xad, 0x9e, 0xaa, 0x04, 0xfa, 0x16, 0x5a, 0x65, 0x9a, 0xe2, 0x1a, 0xfe, 0xc3, 0x75,
+	0xb0, 0x82, 0x8b, 0x05, 0x5e, 0x49, 0x35, 0xbc, 0xab, 0x40, 0xfd, 0x32, 0xef, 0x9f, 0x8e, 0xcf,
+	0xc9, 0x14, 0x1a, 0xc5, 0xfb, 0x24, 0x74, 0xd1, 0xff, 0xf0, 0xb7, 0xd7, 0x3e, 0x5c, 0xab, 0x91,
+	0x58, 0x27, 0x06, 0x79, 0x07, 0xd5, 0xfc, 0xcf, 0x92, 0xee, 0xf2, 0xf7, 0xa4, 0xd3, 0x0e, 0x56,
+	0xb5, 0x8b, 0xa0, 0x8f, 0x50, 0x93, 0x73, 0x25, 0xbd, 0x55, 0x2f, 0x42, 0x87, 0xf5, 0x57, 0x0b,
+	0x8a, 0xb8, 0xaf, 0x00, 0xe5, 0xaa, 0xc8, 0xe1, 0xba, 0x45, 0xea, 0xd8, 0xe7, 0xeb, 0x45, 0x45,
+	0xf4, 0x04, 0xea, 0x7a, 0xda, 0xe4, 0xd9, 0xea, 0x4d, 0xe8, 0x58, 0xba, 0x4e, 0xa2, 0x43, 0x47,
+	0xe7, 0xb0, 0xe7, 0x72, 0x7f, 0x41, 0x3a, 0xda, 0x91, 0x1b, 0x0c, 0xd9, 0x38, 0xff, 0x4c, 0x8e,
+	0x8d, 0x6f, 0x8f, 0x44, 0x2b, 0x1d, 0xfc, 0xde, 0xaa, 0x5c, 0x5c, 0x7e, 0xb9, 0xdb, 0x6a, 0x5d,
+	0x28, 0x8b, 0x10, 0x5a, 0xd3, 0xc1, 0xf7, 0x9a, 0xf8, 0xa2, 0xbe, 0xf8, 0x3b, 0x00, 0x0f, 0xd6,
+	0xa3, 0x5c, 0xc8, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -158,6 +561,14 @@ const _ = grpc.SupportPackageIsVersion4
 type WatchAPIClient interface {
 	// Processor will return operations from a processor based on key.
 	Processor(ctx context.Context, in *ProcessorRequest, opts ...grpc.CallOption) (WatchAPI_ProcessorClient, error)
+	// Sink will return the messages a sink collects, its flushes and commits.
+	Sink(ctx context.Context, in *SinkRequest, opts ...grpc.CallOption) (WatchAPI_SinkClient, error)
+	// Source will return the messages a source emits.
+	Source(ctx context.Context, in *SourceRequest, opts ...grpc.CallOption) (WatchAPI_SourceClient, error)
+	// ViewSource will return the updates and deletes of each view source sync.
+	ViewSource(ctx context.Context, in *ViewSourceRequest, opts ...grpc.CallOption) (WatchAPI_ViewSourceClient, error)
+	// ViewSink will return the sync runs of a view sink.
+	ViewSink(ctx context.Context, in *ViewSinkRequest, opts ...grpc.CallOption) (WatchAPI_ViewSinkClient, error)
 }
 
 type watchAPIClient struct {
@@ -200,10 +611,146 @@ func (x *watchAPIProcessorClient) Recv() (*ProcessorResponse, error) {
 	return m, nil
 }
 
+func (c *watchAPIClient) Sink(ctx context.Context, in *SinkRequest, opts ...grpc.CallOption) (WatchAPI_SinkClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WatchAPI_serviceDesc.Streams[1], "/kafmesh.watch.v1.WatchAPI/Sink", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &watchAPISinkClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WatchAPI_SinkClient interface {
+	Recv() (*SinkResponse, error)
+	grpc.ClientStream
+}
+
+type watchAPISinkClient struct {
+	grpc.ClientStream
+}
+
+func (x *watchAPISinkClient) Recv() (*SinkResponse, error) {
+	m := new(SinkResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *watchAPIClient) Source(ctx context.Context, in *SourceRequest, opts ...grpc.CallOption) (WatchAPI_SourceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WatchAPI_serviceDesc.Streams[2], "/kafmesh.watch.v1.WatchAPI/Source", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &watchAPISourceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WatchAPI_SourceClient interface {
+	Recv() (*SourceResponse, error)
+	grpc.ClientStream
+}
+
+type watchAPISourceClient struct {
+	grpc.ClientStream
+}
+
+func (x *watchAPISourceClient) Recv() (*SourceResponse, error) {
+	m := new(SourceResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *watchAPIClient) ViewSource(ctx context.Context, in *ViewSourceRequest, opts ...grpc.CallOption) (WatchAPI_ViewSourceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WatchAPI_serviceDesc.Streams[3], "/kafmesh.watch.v1.WatchAPI/ViewSource", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &watchAPIViewSourceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WatchAPI_ViewSourceClient interface {
+	Recv() (*ViewSourceResponse, error)
+	grpc.ClientStream
+}
+
+type watchAPIViewSourceClient struct {
+	grpc.ClientStream
+}
+
+func (x *watchAPIViewSourceClient) Recv() (*ViewSourceResponse, error) {
+	m := new(ViewSourceResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *watchAPIClient) ViewSink(ctx context.Context, in *ViewSinkRequest, opts ...grpc.CallOption) (WatchAPI_ViewSinkClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WatchAPI_serviceDesc.Streams[4], "/kafmesh.watch.v1.WatchAPI/ViewSink", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &watchAPIViewSinkClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WatchAPI_ViewSinkClient interface {
+	Recv() (*ViewSinkResponse, error)
+	grpc.ClientStream
+}
+
+type watchAPIViewSinkClient struct {
+	grpc.ClientStream
+}
+
+func (x *watchAPIViewSinkClient) Recv() (*ViewSinkResponse, error) {
+	m := new(ViewSinkResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // WatchAPIServer is the server API for WatchAPI service.
 type WatchAPIServer interface {
 	// Processor will return operations from a processor based on key.
 	Processor(*ProcessorRequest, WatchAPI_ProcessorServer) error
+	// Sink will return the messages a sink collects, its flushes and commits.
+	Sink(*SinkRequest, WatchAPI_SinkServer) error
+	// Source will return the messages a source emits.
+	Source(*SourceRequest, WatchAPI_SourceServer) error
+	// ViewSource will return the updates and deletes of each view source sync.
+	ViewSource(*ViewSourceRequest, WatchAPI_ViewSourceServer) error
+	// ViewSink will return the sync runs of a view sink.
+	ViewSink(*ViewSinkRequest, WatchAPI_ViewSinkServer) error
 }
 
 func RegisterWatchAPIServer(s *grpc.Server, srv WatchAPIServer) {
@@ -231,6 +778,90 @@ func (x *watchAPIProcessorServer) Send(m *ProcessorResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _WatchAPI_Sink_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SinkRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WatchAPIServer).Sink(m, &watchAPISinkServer{stream})
+}
+
+type WatchAPI_SinkServer interface {
+	Send(*SinkResponse) error
+	grpc.ServerStream
+}
+
+type watchAPISinkServer struct {
+	grpc.ServerStream
+}
+
+func (x *watchAPISinkServer) Send(m *SinkResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WatchAPI_Source_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SourceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WatchAPIServer).Source(m, &watchAPISourceServer{stream})
+}
+
+type WatchAPI_SourceServer interface {
+	Send(*SourceResponse) error
+	grpc.ServerStream
+}
+
+type watchAPISourceServer struct {
+	grpc.ServerStream
+}
+
+func (x *watchAPISourceServer) Send(m *SourceResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WatchAPI_ViewSource_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ViewSourceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WatchAPIServer).ViewSource(m, &watchAPIViewSourceServer{stream})
+}
+
+type WatchAPI_ViewSourceServer interface {
+	Send(*ViewSourceResponse) error
+	grpc.ServerStream
+}
+
+type watchAPIViewSourceServer struct {
+	grpc.ServerStream
+}
+
+func (x *watchAPIViewSourceServer) Send(m *ViewSourceResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WatchAPI_ViewSink_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ViewSinkRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WatchAPIServer).ViewSink(m, &watchAPIViewSinkServer{stream})
+}
+
+type WatchAPI_ViewSinkServer interface {
+	Send(*ViewSinkResponse) error
+	grpc.ServerStream
+}
+
+type watchAPIViewSinkServer struct {
+	grpc.ServerStream
+}
+
+func (x *watchAPIViewSinkServer) Send(m *ViewSinkResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _WatchAPI_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kafmesh.watch.v1.WatchAPI",
 	HandlerType: (*WatchAPIServer)(nil),
@@ -241,6 +872,26 @@ var _WatchAPI_serviceDesc = grpc.ServiceDesc{
 			Handler:       _WatchAPI_Processor_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Sink",
+			Handler:       _WatchAPI_Sink_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Source",
+			Handler:       _WatchAPI_Source_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ViewSource",
+			Handler:       _WatchAPI_ViewSource_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ViewSink",
+			Handler:       _WatchAPI_ViewSink_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "kafmesh/watch/v1/watch_api.proto",
 }
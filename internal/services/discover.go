@@ -9,6 +9,8 @@ import (
 // DiscoverAPI provides methods for discovering and reporting kafmesh enabled pod info
 type DiscoverAPI struct {
 	DiscoverInfo *discoveryv1.Service
+	// Recovery reports the recovery progress of the table partitions kept in local storage
+	Recovery func(context.Context) []*discoveryv1.PartitionRecovery
 }
 
 // GetServiceInfo is a RPC method that returns service info for discovery
@@ -18,3 +20,13 @@ func (s *DiscoverAPI) GetServiceInfo(ctx context.Context, request *discoveryv1.G
 		Service: s.DiscoverInfo,
 	}, nil
 }
+
+// GetRecoveryProgress is a RPC method that returns the recovery progress of the local tables
+func (s *DiscoverAPI) GetRecoveryProgress(ctx context.Context, request *discoveryv1.GetRecoveryProgressRequest) (*discoveryv1.GetRecoveryProgressResponse, error) {
+	response := &discoveryv1.GetRecoveryProgressResponse{}
+	if s.Recovery != nil {
+		response.Partitions = s.Recovery(ctx)
+	}
+
+	return response, nil
+}
@@ -12,7 +12,7 @@ import (
 
 func Test_Discover(t *testing.T) {
 	service := services.DiscoverAPI{
-		&discoveryv1.Service{
+		DiscoverInfo: &discoveryv1.Service{
 			Name: "test service",
 		},
 	}
@@ -25,3 +25,26 @@ func Test_Discover(t *testing.T) {
 		},
 	})
 }
+
+func Test_Discover_RecoveryProgress(t *testing.T) {
+	service := services.DiscoverAPI{
+		Recovery: func(ctx context.Context) []*discoveryv1.PartitionRecovery {
+			return []*discoveryv1.PartitionRecovery{
+				{Component: "details", Processor: "enricher", Table: "details.enricher-table", Partition: 2, Offset: 10, HighWatermark: 20},
+			}
+		},
+	}
+
+	r, err := service.GetRecoveryProgress(context.Background(), &discoveryv1.GetRecoveryProgressRequest{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, r, &discoveryv1.GetRecoveryProgressResponse{
+		Partitions: []*discoveryv1.PartitionRecovery{
+			{Component: "details", Processor: "enricher", Table: "details.enricher-table", Partition: 2, Offset: 10, HighWatermark: 20},
+		},
+	})
+
+	empty := services.DiscoverAPI{}
+	r, err = empty.GetRecoveryProgress(context.Background(), &discoveryv1.GetRecoveryProgressRequest{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, r, &discoveryv1.GetRecoveryProgressResponse{})
+}
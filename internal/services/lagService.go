@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/syncromatics/kafmesh/internal/storage"
+
+	"github.com/pkg/errors"
+	"github.com/syncromatics/go-kit/log"
+)
+
+//go:generate mockgen -source=./lagService.go -destination=./lagService_mock_test.go -package=services_test
+
+// LagReader reads the lag of a consumer group from kafka
+type LagReader interface {
+	Read(storage.ConsumerGroup) ([]storage.ConsumerLag, error)
+}
+
+// ConsumerGroupGetter gets the consumer groups of the processors and sinks in storage
+type ConsumerGroupGetter interface {
+	GetConsumerGroups(context.Context) ([]storage.ConsumerGroup, error)
+}
+
+// LagUpdater updates the consumer lags in storage
+type LagUpdater interface {
+	UpdateConsumerLags(context.Context, []storage.ConsumerLag) error
+}
+
+// LagService periodically reads the lag of the known consumer groups from kafka
+type LagService struct {
+	reader   LagReader
+	groups   ConsumerGroupGetter
+	updater  LagUpdater
+	interval time.Duration
+}
+
+// NewLagService creates a new lag service
+func NewLagService(reader LagReader, groups ConsumerGroupGetter, updater LagUpdater, interval time.Duration) *LagService {
+	return &LagService{reader, groups, updater, interval}
+}
+
+// Run the lag service. A failed measurement is logged and measured again on the next tick.
+func (s *LagService) Run(ctx context.Context) func() error {
+	return func() error {
+		timer := time.NewTimer(0 * time.Second)
+		defer timer.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+
+			case <-timer.C:
+				err := s.Measure(ctx)
+				if err != nil {
+					log.Error("measuring consumer lag failed", "error", err)
+				}
+
+				timer = time.NewTimer(s.interval)
+			}
+		}
+	}
+}
+
+// Measure reads the lag of each consumer group and stores it
+func (s *LagService) Measure(ctx context.Context) error {
+	groups, err := s.groups.GetConsumerGroups(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed getting consumer groups from storage")
+	}
+
+	lags := []storage.ConsumerLag{}
+	for _, group := range groups {
+		l, err := s.reader.Read(group)
+		if err != nil {
+			log.Error("reading consumer group lag failed", "group", group.Name, "error", err)
+			continue
+		}
+
+		lags = append(lags, l...)
+	}
+
+	err = s.updater.UpdateConsumerLags(ctx, lags)
+	if err != nil {
+		return errors.Wrap(err, "failed to update consumer lags")
+	}
+
+	return nil
+}
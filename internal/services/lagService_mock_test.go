@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./lagService.go
+
+// Package services_test is a generated GoMock package.
+package services_test
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	storage "github.com/syncromatics/kafmesh/internal/storage"
+	reflect "reflect"
+)
+
+// MockLagReader is a mock of LagReader interface
+type MockLagReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockLagReaderMockRecorder
+}
+
+// MockLagReaderMockRecorder is the mock recorder for MockLagReader
+type MockLagReaderMockRecorder struct {
+	mock *MockLagReader
+}
+
+// NewMockLagReader creates a new mock instance
+func NewMockLagReader(ctrl *gomock.Controller) *MockLagReader {
+	mock := &MockLagReader{ctrl: ctrl}
+	mock.recorder = &MockLagReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockLagReader) EXPECT() *MockLagReaderMockRecorder {
+	return m.recorder
+}
+
+// Read mocks base method
+func (m *MockLagReader) Read(arg0 storage.ConsumerGroup) ([]storage.ConsumerLag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", arg0)
+	ret0, _ := ret[0].([]storage.ConsumerLag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read
+func (mr *MockLagReaderMockRecorder) Read(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockLagReader)(nil).Read), arg0)
+}
+
+// MockConsumerGroupGetter is a mock of ConsumerGroupGetter interface
+type MockConsumerGroupGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockConsumerGroupGetterMockRecorder
+}
+
+// MockConsumerGroupGetterMockRecorder is the mock recorder for MockConsumerGroupGetter
+type MockConsumerGroupGetterMockRecorder struct {
+	mock *MockConsumerGroupGetter
+}
+
+// NewMockConsumerGroupGetter creates a new mock instance
+func NewMockConsumerGroupGetter(ctrl *gomock.Controller) *MockConsumerGroupGetter {
+	mock := &MockConsumerGroupGetter{ctrl: ctrl}
+	mock.recorder = &MockConsumerGroupGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockConsumerGroupGetter) EXPECT() *MockConsumerGroupGetterMockRecorder {
+	return m.recorder
+}
+
+// GetConsumerGroups mocks base method
+func (m *MockConsumerGroupGetter) GetConsumerGroups(arg0 context.Context) ([]storage.ConsumerGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConsumerGroups", arg0)
+	ret0, _ := ret[0].([]storage.ConsumerGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConsumerGroups indicates an expected call of GetConsumerGroups
+func (mr *MockConsumerGroupGetterMockRecorder) GetConsumerGroups(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsumerGroups", reflect.TypeOf((*MockConsumerGroupGetter)(nil).GetConsumerGroups), arg0)
+}
+
+// MockLagUpdater is a mock of LagUpdater interface
+type MockLagUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockLagUpdaterMockRecorder
+}
+
+// MockLagUpdaterMockRecorder is the mock recorder for MockLagUpdater
+type MockLagUpdaterMockRecorder struct {
+	mock *MockLagUpdater
+}
+
+// NewMockLagUpdater creates a new mock instance
+func NewMockLagUpdater(ctrl *gomock.Controller) *MockLagUpdater {
+	mock := &MockLagUpdater{ctrl: ctrl}
+	mock.recorder = &MockLagUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockLagUpdater) EXPECT() *MockLagUpdaterMockRecorder {
+	return m.recorder
+}
+
+// UpdateConsumerLags mocks base method
+func (m *MockLagUpdater) UpdateConsumerLags(arg0 context.Context, arg1 []storage.ConsumerLag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateConsumerLags", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateConsumerLags indicates an expected call of UpdateConsumerLags
+func (mr *MockLagUpdaterMockRecorder) UpdateConsumerLags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConsumerLags", reflect.TypeOf((*MockLagUpdater)(nil).UpdateConsumerLags), arg0, arg1)
+}
@@ -0,0 +1,136 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/syncromatics/kafmesh/internal/services"
+	"github.com/syncromatics/kafmesh/internal/storage"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"gotest.tools/assert"
+)
+
+func Test_LagService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reader := NewMockLagReader(ctrl)
+	groups := NewMockConsumerGroupGetter(ctrl)
+	updater := NewMockLagUpdater(ctrl)
+
+	group1 := storage.ConsumerGroup{Name: "group1", Topics: []string{"topic1"}}
+	group2 := storage.ConsumerGroup{Name: "group2", Topics: []string{"topic2"}}
+	group3 := storage.ConsumerGroup{Name: "group3", Topics: []string{"topic1", "topic2"}}
+
+	groups.EXPECT().
+		GetConsumerGroups(gomock.Any()).
+		Return([]storage.ConsumerGroup{group1, group2, group3}, nil).
+		Times(1)
+
+	reader.EXPECT().
+		Read(group1).
+		Return([]storage.ConsumerLag{
+			{Group: "group1", Topic: "topic1", Partition: 0, CommittedOffset: 5, HighWatermark: 10, Lag: 5},
+		}, nil).
+		Times(1)
+
+	reader.EXPECT().
+		Read(group2).
+		Return(nil, errors.Errorf("boom")).
+		Times(1)
+
+	reader.EXPECT().
+		Read(group3).
+		Return([]storage.ConsumerLag{
+			{Group: "group3", Topic: "topic1", Partition: 0, CommittedOffset: 10, HighWatermark: 10, Lag: 0},
+			{Group: "group3", Topic: "topic2", Partition: 0, CommittedOffset: -1, HighWatermark: 3, Lag: 3},
+		}, nil).
+		Times(1)
+
+	updater.EXPECT().
+		UpdateConsumerLags(gomock.Any(), []storage.ConsumerLag{
+			{Group: "group1", Topic: "topic1", Partition: 0, CommittedOffset: 5, HighWatermark: 10, Lag: 5},
+			{Group: "group3", Topic: "topic1", Partition: 0, CommittedOffset: 10, HighWatermark: 10, Lag: 0},
+			{Group: "group3", Topic: "topic2", Partition: 0, CommittedOffset: -1, HighWatermark: 3, Lag: 3},
+		}).
+		Return(nil).
+		Times(1)
+
+	service := services.NewLagService(reader, groups, updater, 1*time.Second)
+
+	err := service.Measure(context.Background())
+	assert.NilError(t, err)
+}
+
+func Test_LagService_GetConsumerGroupsShouldReturnErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reader := NewMockLagReader(ctrl)
+	groups := NewMockConsumerGroupGetter(ctrl)
+	updater := NewMockLagUpdater(ctrl)
+
+	groups.EXPECT().
+		GetConsumerGroups(gomock.Any()).
+		Return(nil, errors.Errorf("boom"))
+
+	service := services.NewLagService(reader, groups, updater, 1*time.Second)
+	err := service.Measure(context.Background())
+	assert.ErrorContains(t, err, "failed getting consumer groups from storage: boom")
+}
+
+func Test_LagService_UpdateShouldReturnErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reader := NewMockLagReader(ctrl)
+	groups := NewMockConsumerGroupGetter(ctrl)
+	updater := NewMockLagUpdater(ctrl)
+
+	groups.EXPECT().
+		GetConsumerGroups(gomock.Any()).
+		Return([]storage.ConsumerGroup{}, nil)
+
+	updater.EXPECT().
+		UpdateConsumerLags(gomock.Any(), []storage.ConsumerLag{}).
+		Return(errors.Errorf("boom"))
+
+	service := services.NewLagService(reader, groups, updater, 1*time.Second)
+	err := service.Measure(context.Background())
+	assert.ErrorContains(t, err, "failed to update consumer lags: boom")
+}
+
+func Test_LagService_RunShouldRetryAfterErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reader := NewMockLagReader(ctrl)
+	groups := NewMockConsumerGroupGetter(ctrl)
+	updater := NewMockLagUpdater(ctrl)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	gomock.InOrder(
+		groups.EXPECT().
+			GetConsumerGroups(gomock.Any()).
+			Return(nil, errors.Errorf("boom")),
+		groups.EXPECT().
+			GetConsumerGroups(gomock.Any()).
+			Return([]storage.ConsumerGroup{}, nil),
+	)
+
+	updater.EXPECT().
+		UpdateConsumerLags(gomock.Any(), []storage.ConsumerLag{}).
+		DoAndReturn(func(context.Context, []storage.ConsumerLag) error {
+			cancel()
+			return nil
+		})
+
+	service := services.NewLagService(reader, groups, updater, 10*time.Millisecond)
+	err := service.Run(ctx)()
+	assert.NilError(t, err)
+}
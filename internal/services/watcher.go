@@ -13,6 +13,10 @@ import (
 // Watcher watches parts of the kafmesh system
 type Watcher interface {
 	WatchProcessor(context.Context, *watchv1.ProcessorRequest, func(*watchv1.ProcessorResponse) error) error
+	WatchSink(context.Context, *watchv1.SinkRequest, func(*watchv1.SinkResponse) error) error
+	WatchSource(context.Context, *watchv1.SourceRequest, func(*watchv1.SourceResponse) error) error
+	WatchViewSource(context.Context, *watchv1.ViewSourceRequest, func(*watchv1.ViewSourceResponse) error) error
+	WatchViewSink(context.Context, *watchv1.ViewSinkRequest, func(*watchv1.ViewSinkResponse) error) error
 }
 
 var _ watchv1.WatchAPIServer = &WatcherService{}
@@ -30,3 +34,39 @@ func (s *WatcherService) Processor(request *watchv1.ProcessorRequest, stream wat
 	}
 	return nil
 }
+
+// Sink watches a sink
+func (s *WatcherService) Sink(request *watchv1.SinkRequest, stream watchv1.WatchAPI_SinkServer) error {
+	err := s.Watcher.WatchSink(stream.Context(), request, stream.Send)
+	if err != nil {
+		return errors.Wrap(err, "failed to watch sink")
+	}
+	return nil
+}
+
+// Source watches a source
+func (s *WatcherService) Source(request *watchv1.SourceRequest, stream watchv1.WatchAPI_SourceServer) error {
+	err := s.Watcher.WatchSource(stream.Context(), request, stream.Send)
+	if err != nil {
+		return errors.Wrap(err, "failed to watch source")
+	}
+	return nil
+}
+
+// ViewSource watches a view source
+func (s *WatcherService) ViewSource(request *watchv1.ViewSourceRequest, stream watchv1.WatchAPI_ViewSourceServer) error {
+	err := s.Watcher.WatchViewSource(stream.Context(), request, stream.Send)
+	if err != nil {
+		return errors.Wrap(err, "failed to watch view source")
+	}
+	return nil
+}
+
+// ViewSink watches a view sink
+func (s *WatcherService) ViewSink(request *watchv1.ViewSinkRequest, stream watchv1.WatchAPI_ViewSinkServer) error {
+	err := s.Watcher.WatchViewSink(stream.Context(), request, stream.Send)
+	if err != nil {
+		return errors.Wrap(err, "failed to watch view sink")
+	}
+	return nil
+}
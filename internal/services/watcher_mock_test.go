@@ -47,3 +47,59 @@ func (mr *MockWatcherMockRecorder) WatchProcessor(arg0, arg1, arg2 interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchProcessor", reflect.TypeOf((*MockWatcher)(nil).WatchProcessor), arg0, arg1, arg2)
 }
+
+// WatchSink mocks base method
+func (m *MockWatcher) WatchSink(arg0 context.Context, arg1 *watchv1.SinkRequest, arg2 func(*watchv1.SinkResponse) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchSink", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchSink indicates an expected call of WatchSink
+func (mr *MockWatcherMockRecorder) WatchSink(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchSink", reflect.TypeOf((*MockWatcher)(nil).WatchSink), arg0, arg1, arg2)
+}
+
+// WatchSource mocks base method
+func (m *MockWatcher) WatchSource(arg0 context.Context, arg1 *watchv1.SourceRequest, arg2 func(*watchv1.SourceResponse) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchSource", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchSource indicates an expected call of WatchSource
+func (mr *MockWatcherMockRecorder) WatchSource(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchSource", reflect.TypeOf((*MockWatcher)(nil).WatchSource), arg0, arg1, arg2)
+}
+
+// WatchViewSource mocks base method
+func (m *MockWatcher) WatchViewSource(arg0 context.Context, arg1 *watchv1.ViewSourceRequest, arg2 func(*watchv1.ViewSourceResponse) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchViewSource", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchViewSource indicates an expected call of WatchViewSource
+func (mr *MockWatcherMockRecorder) WatchViewSource(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchViewSource", reflect.TypeOf((*MockWatcher)(nil).WatchViewSource), arg0, arg1, arg2)
+}
+
+// WatchViewSink mocks base method
+func (m *MockWatcher) WatchViewSink(arg0 context.Context, arg1 *watchv1.ViewSinkRequest, arg2 func(*watchv1.ViewSinkResponse) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchViewSink", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchViewSink indicates an expected call of WatchViewSink
+func (mr *MockWatcherMockRecorder) WatchViewSink(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchViewSink", reflect.TypeOf((*MockWatcher)(nil).WatchViewSink), arg0, arg1, arg2)
+}
@@ -93,3 +93,64 @@ func (s *stream) SetHeader(m metadata.MD) error {
 func (s *stream) SetTrailer(m metadata.MD) {
 	return
 }
+
+func Test_WatcherComponents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	watcher := NewMockWatcher(ctrl)
+	watcher.EXPECT().
+		WatchSink(gomock.Any(), &watchv1.SinkRequest{Component: "component1", Sink: "sink1"}, gomock.Any()).
+		Times(1)
+	watcher.EXPECT().
+		WatchSource(gomock.Any(), &watchv1.SourceRequest{Component: "component1", Topic: "topic1"}, gomock.Any()).
+		Times(1)
+	watcher.EXPECT().
+		WatchViewSource(gomock.Any(), &watchv1.ViewSourceRequest{Component: "component1", ViewSource: "viewSource1"}, gomock.Any()).
+		Times(1)
+	watcher.EXPECT().
+		WatchViewSink(gomock.Any(), &watchv1.ViewSinkRequest{Component: "component1", ViewSink: "viewSink1"}, gomock.Any()).
+		Return(errors.Errorf("boom")).
+		Times(1)
+
+	service := services.WatcherService{watcher}
+
+	err := service.Sink(&watchv1.SinkRequest{Component: "component1", Sink: "sink1"}, &sinkStream{stream{ctx}})
+	assert.NilError(t, err)
+
+	err = service.Source(&watchv1.SourceRequest{Component: "component1", Topic: "topic1"}, &sourceStream{stream{ctx}})
+	assert.NilError(t, err)
+
+	err = service.ViewSource(&watchv1.ViewSourceRequest{Component: "component1", ViewSource: "viewSource1"}, &viewSourceStream{stream{ctx}})
+	assert.NilError(t, err)
+
+	err = service.ViewSink(&watchv1.ViewSinkRequest{Component: "component1", ViewSink: "viewSink1"}, &viewSinkStream{stream{ctx}})
+	assert.ErrorContains(t, err, "failed to watch view sink: boom")
+}
+
+type sinkStream struct{ stream }
+
+func (s *sinkStream) Send(response *watchv1.SinkResponse) error {
+	return nil
+}
+
+type sourceStream struct{ stream }
+
+func (s *sourceStream) Send(response *watchv1.SourceResponse) error {
+	return nil
+}
+
+type viewSourceStream struct{ stream }
+
+func (s *viewSourceStream) Send(response *watchv1.ViewSourceResponse) error {
+	return nil
+}
+
+type viewSinkStream struct{ stream }
+
+func (s *viewSinkStream) Send(response *watchv1.ViewSinkResponse) error {
+	return nil
+}
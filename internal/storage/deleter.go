@@ -113,6 +113,15 @@ where not exists
 	(select 1 from pod_view_sources where pod_view_sources.view_source=view_sources.id);
 		`,
 		`
+delete from
+	consumer_lags
+where not exists
+	(
+		select 1 from consumer_group_topics
+		where consumer_group_topics.group_name=consumer_lags.group_name and consumer_group_topics.topic=consumer_lags.topic
+	);
+		`,
+		`
 delete from
 	topics
 where not exists
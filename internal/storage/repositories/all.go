@@ -11,6 +11,7 @@ var _ loaders.Repositories = &AllRepositories{}
 // AllRepositories contains all repositories
 type AllRepositories struct {
 	component       *Component
+	consumerLag     *ConsumerLag
 	pod             *Pod
 	processor       *Processor
 	processorInput  *ProcessorInput
@@ -31,6 +32,7 @@ type AllRepositories struct {
 func All(db *sql.DB) *AllRepositories {
 	return &AllRepositories{
 		component:       &Component{db},
+		consumerLag:     &ConsumerLag{db},
 		pod:             &Pod{db},
 		processor:       &Processor{db},
 		processorInput:  &ProcessorInput{db},
@@ -53,6 +55,11 @@ func (a *AllRepositories) Component() loaders.ComponentRepository {
 	return a.component
 }
 
+// ConsumerLag returns the consumer lag repository
+func (a *AllRepositories) ConsumerLag() loaders.ConsumerLagRepository {
+	return a.consumerLag
+}
+
 // Pod returns the pod repository
 func (a *AllRepositories) Pod() loaders.PodRepository {
 	return a.pod
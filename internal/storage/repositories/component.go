@@ -114,7 +114,8 @@ func (r *Component) SinksByComponents(ctx context.Context, components []int) ([]
 		component,
 		id,
 		name,
-		description
+		description,
+		group_name
 	from
 		sinks
 	where
@@ -129,7 +130,7 @@ func (r *Component) SinksByComponents(ctx context.Context, components []int) ([]
 	var componentID int
 	for rows.Next() {
 		sink := &model.Sink{}
-		err = rows.Scan(&componentID, &sink.ID, &sink.Name, &sink.Description)
+		err = rows.Scan(&componentID, &sink.ID, &sink.Name, &sink.Description, &sink.GroupName)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to scan sink")
 		}
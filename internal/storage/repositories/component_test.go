@@ -64,12 +64,12 @@ func Test_Component_Sinks(t *testing.T) {
 	assert.NilError(t, err)
 	assert.DeepEqual(t, r, [][]*model.Sink{
 		[]*model.Sink{
-			&model.Sink{ID: 1, Name: "sink1", Description: "sink1 description"},
-			&model.Sink{ID: 2, Name: "sink2", Description: "sink2 description"},
+			&model.Sink{ID: 1, Name: "sink1", Description: "sink1 description", GroupName: "sink1.group"},
+			&model.Sink{ID: 2, Name: "sink2", Description: "sink2 description", GroupName: "sink2.group"},
 		},
 		[]*model.Sink{
-			&model.Sink{ID: 3, Name: "sink3", Description: "sink3 description"},
-			&model.Sink{ID: 4, Name: "sink4", Description: "sink4 description"},
+			&model.Sink{ID: 3, Name: "sink3", Description: "sink3 description", GroupName: "sink3.group"},
+			&model.Sink{ID: 4, Name: "sink4", Description: "sink4 description", GroupName: "sink4.group"},
 		},
 		[]*model.Sink{},
 		[]*model.Sink{},
@@ -0,0 +1,88 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/syncromatics/kafmesh/internal/graph/loaders"
+	"github.com/syncromatics/kafmesh/internal/graph/model"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+var _ loaders.ConsumerLagRepository = &ConsumerLag{}
+
+// ConsumerLag is the repository for consumer lags
+type ConsumerLag struct {
+	db *sql.DB
+}
+
+// TopicByConsumerLags returns the topics for consumer lags
+func (r *ConsumerLag) TopicByConsumerLags(ctx context.Context, lags []int) ([]*model.Topic, error) {
+	rows, err := r.db.QueryContext(ctx, `
+	select
+		consumer_lags.id,
+		topics.id,
+		topics.name,
+		topics.message
+	from
+		topics
+	inner join
+		consumer_lags on consumer_lags.topic=topics.id
+	where
+		consumer_lags.id = ANY ($1)
+	`, pq.Array(lags))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query for consumer lag topic")
+	}
+	defer rows.Close()
+
+	topics := map[int]*model.Topic{}
+	var id int
+	for rows.Next() {
+		topic := &model.Topic{}
+		err = rows.Scan(&id, &topic.ID, &topic.Name, &topic.Message)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan topic row")
+		}
+		topics[id] = topic
+	}
+
+	results := []*model.Topic{}
+	for _, c := range lags {
+		s, ok := topics[c]
+		if !ok {
+			return nil, errors.Errorf("did not find topic for consumer lag %d", c)
+		}
+		results = append(results, s)
+	}
+
+	return results, nil
+}
+
+// scanConsumerLags scans rows of a key followed by consumer lag columns into a slice of lags for each key
+func scanConsumerLags(rows *sql.Rows, keys []int) ([][]*model.ConsumerLag, error) {
+	lags := map[int][]*model.ConsumerLag{}
+	var id int
+	for rows.Next() {
+		lag := &model.ConsumerLag{}
+		err := rows.Scan(&id, &lag.ID, &lag.GroupName, &lag.Partition, &lag.CommittedOffset, &lag.HighWatermark, &lag.Lag)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan consumer lag")
+		}
+
+		lags[id] = append(lags[id], lag)
+	}
+
+	results := [][]*model.ConsumerLag{}
+	for _, k := range keys {
+		_, ok := lags[k]
+		if !ok {
+			results = append(results, []*model.ConsumerLag{})
+		} else {
+			results = append(results, lags[k])
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,22 @@
+package repositories_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/syncromatics/kafmesh/internal/graph/model"
+
+	"gotest.tools/assert"
+)
+
+func Test_ConsumerLag_Topic(t *testing.T) {
+	repo := repos.ConsumerLag()
+
+	r, err := repo.TopicByConsumerLags(context.Background(), []int{1, 2, 3})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, r, []*model.Topic{
+		&model.Topic{ID: 1, Name: "topic1", Message: "topic1.message"},
+		&model.Topic{ID: 2, Name: "topic2", Message: "topic2.message"},
+		&model.Topic{ID: 1, Name: "topic1", Message: "topic1.message"},
+	})
+}
@@ -140,12 +140,21 @@ insert into
 
 insert into
 	sinks
-		(id, component, topic, name, description)
+		(id, component, topic, name, description, group_name)
+	values
+		(1, 1, 1, 'sink1', 'sink1 description', 'sink1.group'),
+		(2, 1, 2, 'sink2', 'sink2 description', 'sink2.group'),
+		(3, 2, 1, 'sink3', 'sink3 description', 'sink3.group'),
+		(4, 2, 2, 'sink4', 'sink4 description', 'sink4.group');
+
+insert into
+	consumer_lags
+		(id, group_name, topic, partition, committed_offset, high_watermark, lag, updated)
 	values
-		(1, 1, 1, 'sink1', 'sink1 description'),
-		(2, 1, 2, 'sink2', 'sink2 description'),
-		(3, 2, 1, 'sink3', 'sink3 description'),
-		(4, 2, 2, 'sink4', 'sink4 description');
+		(1, 'processor1.group', 1, 0, 5, 10, 5, now()),
+		(2, 'processor1.group', 2, 0, 20, 20, 0, now()),
+		(3, 'sink1.group', 1, 0, 1, 10, 9, now()),
+		(4, 'processor2.group', 1, 0, 10, 10, 0, now());
 
 insert into
 	view_sinks
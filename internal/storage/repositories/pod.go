@@ -73,7 +73,8 @@ func (r *Pod) SinksByPods(ctx context.Context, pods []int) ([][]*model.Sink, err
 		pod_sinks.pod,
 		sinks.id,
 		sinks.name,
-		sinks.description
+		sinks.description,
+		sinks.group_name
 	from
 		sinks
 	inner join
@@ -90,7 +91,7 @@ func (r *Pod) SinksByPods(ctx context.Context, pods []int) ([][]*model.Sink, err
 	var id int
 	for rows.Next() {
 		sink := &model.Sink{}
-		err = rows.Scan(&id, &sink.ID, &sink.Name, &sink.Description)
+		err = rows.Scan(&id, &sink.ID, &sink.Name, &sink.Description, &sink.GroupName)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to scan sink")
 		}
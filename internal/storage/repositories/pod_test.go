@@ -35,12 +35,12 @@ func Test_Pod_Sinks(t *testing.T) {
 	assert.NilError(t, err)
 	assert.DeepEqual(t, r, [][]*model.Sink{
 		[]*model.Sink{
-			&model.Sink{ID: 1, Name: "sink1", Description: "sink1 description"},
-			&model.Sink{ID: 2, Name: "sink2", Description: "sink2 description"},
+			&model.Sink{ID: 1, Name: "sink1", Description: "sink1 description", GroupName: "sink1.group"},
+			&model.Sink{ID: 2, Name: "sink2", Description: "sink2 description", GroupName: "sink2.group"},
 		},
 		[]*model.Sink{
-			&model.Sink{ID: 1, Name: "sink1", Description: "sink1 description"},
-			&model.Sink{ID: 2, Name: "sink2", Description: "sink2 description"},
+			&model.Sink{ID: 1, Name: "sink1", Description: "sink1 description", GroupName: "sink1.group"},
+			&model.Sink{ID: 2, Name: "sink2", Description: "sink2 description", GroupName: "sink2.group"},
 		},
 		[]*model.Sink{},
 		[]*model.Sink{},
@@ -347,3 +347,32 @@ where
 
 	return processor, nil
 }
+
+// LagByProcessors returns the consumer lag for processors
+func (r *Processor) LagByProcessors(ctx context.Context, processors []int) ([][]*model.ConsumerLag, error) {
+	rows, err := r.db.QueryContext(ctx, `
+	select
+		processors.id,
+		consumer_lags.id,
+		consumer_lags.group_name,
+		consumer_lags.partition,
+		consumer_lags.committed_offset,
+		consumer_lags.high_watermark,
+		consumer_lags.lag
+	from
+		consumer_lags
+	inner join
+		processors on processors.group_name=consumer_lags.group_name
+	where
+		processors.id = ANY ($1)
+	order by
+		consumer_lags.topic,
+		consumer_lags.partition
+	`, pq.Array(processors))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query for processor lag")
+	}
+	defer rows.Close()
+
+	return scanConsumerLags(rows, processors)
+}
@@ -137,3 +137,20 @@ func Test_Processor_ByID(t *testing.T) {
 	assert.NilError(t, err)
 	assert.DeepEqual(t, r, &model.Processor{ID: 2, Name: "processor2", Description: "processor2 description"})
 }
+
+func Test_Processor_Lag(t *testing.T) {
+	repo := repos.Processor()
+
+	r, err := repo.LagByProcessors(context.Background(), []int{1, 2, 3})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, r, [][]*model.ConsumerLag{
+		[]*model.ConsumerLag{
+			&model.ConsumerLag{ID: 1, GroupName: "processor1.group", Partition: 0, CommittedOffset: 5, HighWatermark: 10, Lag: 5},
+			&model.ConsumerLag{ID: 2, GroupName: "processor1.group", Partition: 0, CommittedOffset: 20, HighWatermark: 20, Lag: 0},
+		},
+		[]*model.ConsumerLag{
+			&model.ConsumerLag{ID: 4, GroupName: "processor2.group", Partition: 0, CommittedOffset: 10, HighWatermark: 10, Lag: 0},
+		},
+		[]*model.ConsumerLag{},
+	})
+}
@@ -127,3 +127,77 @@ where
 
 	return component, nil
 }
+
+// LaggingProcessors returns the processors lagging by more messages than the threshold
+func (r *Query) LaggingProcessors(ctx context.Context, threshold int) ([]*model.Processor, error) {
+	rows, err := r.db.QueryContext(ctx, `
+	select
+		processors.id,
+		processors.name,
+		processors.description,
+		processors.group_name
+	from
+		processors
+	inner join
+		consumer_lags on consumer_lags.group_name=processors.group_name
+	group by
+		processors.id
+	having
+		sum(consumer_lags.lag) > $1
+	order by
+		sum(consumer_lags.lag) desc
+	`, threshold)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query lagging processors")
+	}
+	defer rows.Close()
+
+	results := []*model.Processor{}
+	for rows.Next() {
+		processor := &model.Processor{}
+		err = rows.Scan(&processor.ID, &processor.Name, &processor.Description, &processor.GroupName)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan processor")
+		}
+		results = append(results, processor)
+	}
+
+	return results, nil
+}
+
+// LaggingSinks returns the sinks lagging by more messages than the threshold
+func (r *Query) LaggingSinks(ctx context.Context, threshold int) ([]*model.Sink, error) {
+	rows, err := r.db.QueryContext(ctx, `
+	select
+		sinks.id,
+		sinks.name,
+		sinks.description,
+		sinks.group_name
+	from
+		sinks
+	inner join
+		consumer_lags on consumer_lags.group_name=sinks.group_name and consumer_lags.topic=sinks.topic
+	group by
+		sinks.id
+	having
+		sum(consumer_lags.lag) > $1
+	order by
+		sum(consumer_lags.lag) desc
+	`, threshold)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query lagging sinks")
+	}
+	defer rows.Close()
+
+	results := []*model.Sink{}
+	for rows.Next() {
+		sink := &model.Sink{}
+		err = rows.Scan(&sink.ID, &sink.Name, &sink.Description, &sink.GroupName)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan sink")
+		}
+		results = append(results, sink)
+	}
+
+	return results, nil
+}
@@ -61,3 +61,27 @@ func Test_Query_ComponentByID(t *testing.T) {
 	assert.NilError(t, err)
 	assert.DeepEqual(t, r, &model.Component{ID: 2, Name: "component2", Description: "component2 description"})
 }
+
+func Test_Query_LaggingProcessors(t *testing.T) {
+	repo := repos.Query()
+
+	r, err := repo.LaggingProcessors(context.Background(), 4)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, r, []*model.Processor{
+		&model.Processor{ID: 1, Name: "processor1", Description: "processor1 description", GroupName: "processor1.group"},
+	})
+
+	r, err = repo.LaggingProcessors(context.Background(), 5)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, r, []*model.Processor{})
+}
+
+func Test_Query_LaggingSinks(t *testing.T) {
+	repo := repos.Query()
+
+	r, err := repo.LaggingSinks(context.Background(), 4)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, r, []*model.Sink{
+		&model.Sink{ID: 1, Name: "sink1", Description: "sink1 description", GroupName: "sink1.group"},
+	})
+}
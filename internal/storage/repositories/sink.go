@@ -147,3 +147,56 @@ func (r *Sink) TopicBySinks(ctx context.Context, sinks []int) ([]*model.Topic, e
 
 	return results, nil
 }
+
+// ByID returns a sink with the id given
+func (r *Sink) ByID(ctx context.Context, id int) (*model.Sink, error) {
+	row := r.db.QueryRowContext(ctx, `
+select
+	id,
+	name,
+	description,
+	group_name
+from
+	sinks
+where
+	id=$1`, id)
+
+	sink := &model.Sink{}
+	err := row.Scan(&sink.ID, &sink.Name, &sink.Description, &sink.GroupName)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to scan for sink")
+	}
+
+	return sink, nil
+}
+
+// LagBySinks returns the consumer lag for sinks
+func (r *Sink) LagBySinks(ctx context.Context, sinks []int) ([][]*model.ConsumerLag, error) {
+	rows, err := r.db.QueryContext(ctx, `
+	select
+		sinks.id,
+		consumer_lags.id,
+		consumer_lags.group_name,
+		consumer_lags.partition,
+		consumer_lags.committed_offset,
+		consumer_lags.high_watermark,
+		consumer_lags.lag
+	from
+		consumer_lags
+	inner join
+		sinks on sinks.group_name=consumer_lags.group_name and sinks.topic=consumer_lags.topic
+	where
+		sinks.id = ANY ($1)
+	order by
+		consumer_lags.partition
+	`, pq.Array(sinks))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query for sink lag")
+	}
+	defer rows.Close()
+
+	return scanConsumerLags(rows, sinks)
+}
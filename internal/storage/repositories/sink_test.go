@@ -53,3 +53,24 @@ func Test_Sink_Topic(t *testing.T) {
 		&model.Topic{ID: 2, Name: "topic2", Message: "topic2.message"},
 	})
 }
+
+func Test_Sink_ByID(t *testing.T) {
+	repo := repos.Sink()
+
+	r, err := repo.ByID(context.Background(), 2)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, r, &model.Sink{ID: 2, Name: "sink2", Description: "sink2 description", GroupName: "sink2.group"})
+}
+
+func Test_Sink_Lag(t *testing.T) {
+	repo := repos.Sink()
+
+	r, err := repo.LagBySinks(context.Background(), []int{1, 2})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, r, [][]*model.ConsumerLag{
+		[]*model.ConsumerLag{
+			&model.ConsumerLag{ID: 3, GroupName: "sink1.group", Partition: 0, CommittedOffset: 1, HighWatermark: 10, Lag: 9},
+		},
+		[]*model.ConsumerLag{},
+	})
+}
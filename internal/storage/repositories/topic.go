@@ -258,7 +258,8 @@ func (r *Topic) SinksByTopics(ctx context.Context, topics []int) ([][]*model.Sin
 		topics.id,
 		sinks.id,
 		sinks.name,
-		sinks.description
+		sinks.description,
+		sinks.group_name
 	from
 		sinks
 	inner join
@@ -275,7 +276,7 @@ func (r *Topic) SinksByTopics(ctx context.Context, topics []int) ([][]*model.Sin
 	var id int
 	for rows.Next() {
 		sink := &model.Sink{}
-		err = rows.Scan(&id, &sink.ID, &sink.Name, &sink.Description)
+		err = rows.Scan(&id, &sink.ID, &sink.Name, &sink.Description, &sink.GroupName)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to scan sink")
 		}
@@ -486,3 +487,30 @@ func (r *Topic) ViewsByTopics(ctx context.Context, topics []int) ([][]*model.Vie
 	}
 	return results, nil
 }
+
+// LagByTopics returns the consumer lag on topics
+func (r *Topic) LagByTopics(ctx context.Context, topics []int) ([][]*model.ConsumerLag, error) {
+	rows, err := r.db.QueryContext(ctx, `
+	select
+		consumer_lags.topic,
+		consumer_lags.id,
+		consumer_lags.group_name,
+		consumer_lags.partition,
+		consumer_lags.committed_offset,
+		consumer_lags.high_watermark,
+		consumer_lags.lag
+	from
+		consumer_lags
+	where
+		consumer_lags.topic = ANY ($1)
+	order by
+		consumer_lags.group_name,
+		consumer_lags.partition
+	`, pq.Array(topics))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query for topic lag")
+	}
+	defer rows.Close()
+
+	return scanConsumerLags(rows, topics)
+}
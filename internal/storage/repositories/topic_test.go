@@ -110,12 +110,12 @@ func Test_Topic_Sinks(t *testing.T) {
 	assert.NilError(t, err)
 	assert.DeepEqual(t, r, [][]*model.Sink{
 		[]*model.Sink{
-			&model.Sink{ID: 1, Name: "sink1", Description: "sink1 description"},
-			&model.Sink{ID: 3, Name: "sink3", Description: "sink3 description"},
+			&model.Sink{ID: 1, Name: "sink1", Description: "sink1 description", GroupName: "sink1.group"},
+			&model.Sink{ID: 3, Name: "sink3", Description: "sink3 description", GroupName: "sink3.group"},
 		},
 		[]*model.Sink{
-			&model.Sink{ID: 2, Name: "sink2", Description: "sink2 description"},
-			&model.Sink{ID: 4, Name: "sink4", Description: "sink4 description"},
+			&model.Sink{ID: 2, Name: "sink2", Description: "sink2 description", GroupName: "sink2.group"},
+			&model.Sink{ID: 4, Name: "sink4", Description: "sink4 description", GroupName: "sink4.group"},
 		},
 		[]*model.Sink{},
 		[]*model.Sink{},
@@ -198,3 +198,21 @@ func Test_Topic_Views(t *testing.T) {
 		[]*model.View{},
 	})
 }
+
+func Test_Topic_Lag(t *testing.T) {
+	repo := repos.Topic()
+
+	r, err := repo.LagByTopics(context.Background(), []int{1, 2, 3})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, r, [][]*model.ConsumerLag{
+		[]*model.ConsumerLag{
+			&model.ConsumerLag{ID: 1, GroupName: "processor1.group", Partition: 0, CommittedOffset: 5, HighWatermark: 10, Lag: 5},
+			&model.ConsumerLag{ID: 4, GroupName: "processor2.group", Partition: 0, CommittedOffset: 10, HighWatermark: 10, Lag: 0},
+			&model.ConsumerLag{ID: 3, GroupName: "sink1.group", Partition: 0, CommittedOffset: 1, HighWatermark: 10, Lag: 9},
+		},
+		[]*model.ConsumerLag{
+			&model.ConsumerLag{ID: 2, GroupName: "processor1.group", Partition: 0, CommittedOffset: 20, HighWatermark: 20, Lag: 0},
+		},
+		[]*model.ConsumerLag{},
+	})
+}
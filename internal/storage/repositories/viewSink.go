@@ -147,3 +147,27 @@ func (r *ViewSink) TopicByViewSinks(ctx context.Context, viewSinks []int) ([]*mo
 
 	return results, nil
 }
+
+// ByID returns a view sink with the id given
+func (r *ViewSink) ByID(ctx context.Context, id int) (*model.ViewSink, error) {
+	row := r.db.QueryRowContext(ctx, `
+select
+	id,
+	name,
+	description
+from
+	view_sinks
+where
+	id=$1`, id)
+
+	viewSink := &model.ViewSink{}
+	err := row.Scan(&viewSink.ID, &viewSink.Name, &viewSink.Description)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to scan for view sink")
+	}
+
+	return viewSink, nil
+}
@@ -53,3 +53,11 @@ func Test_ViewSink_Topic(t *testing.T) {
 		&model.Topic{ID: 2, Name: "topic2", Message: "topic2.message"},
 	})
 }
+
+func Test_ViewSink_ByID(t *testing.T) {
+	repo := repos.ViewSink()
+
+	r, err := repo.ByID(context.Background(), 2)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, r, &model.ViewSink{ID: 2, Name: "viewSink2", Description: "viewSink2 description"})
+}
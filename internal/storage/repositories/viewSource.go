@@ -147,3 +147,27 @@ func (r *ViewSource) TopicByViewSources(ctx context.Context, viewSources []int)
 
 	return results, nil
 }
+
+// ByID returns a view source with the id given
+func (r *ViewSource) ByID(ctx context.Context, id int) (*model.ViewSource, error) {
+	row := r.db.QueryRowContext(ctx, `
+select
+	id,
+	name,
+	description
+from
+	view_sources
+where
+	id=$1`, id)
+
+	viewSource := &model.ViewSource{}
+	err := row.Scan(&viewSource.ID, &viewSource.Name, &viewSource.Description)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to scan for view source")
+	}
+
+	return viewSource, nil
+}
@@ -53,3 +53,11 @@ func Test_ViewSource_Topic(t *testing.T) {
 		&model.Topic{ID: 2, Name: "topic2", Message: "topic2.message"},
 	})
 }
+
+func Test_ViewSource_ByID(t *testing.T) {
+	repo := repos.ViewSource()
+
+	r, err := repo.ByID(context.Background(), 2)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, r, &model.ViewSource{ID: 2, Name: "viewSource2", Description: "viewSource2 description"})
+}
@@ -44,6 +44,47 @@ FROM
 	return result, nil
 }
 
+// ConsumerGroup is a kafka consumer group and the topics it consumes
+type ConsumerGroup struct {
+	Name   string
+	Topics []string
+}
+
+// GetConsumerGroups retrieves the consumer groups of the processors and sinks in storage
+func (r *Retriever) GetConsumerGroups(ctx context.Context) ([]ConsumerGroup, error) {
+	rows, err := r.db.QueryContext(ctx, `
+SELECT
+	consumer_group_topics.group_name,
+	topics.name
+FROM
+	consumer_group_topics
+JOIN
+	topics on topics.id=consumer_group_topics.topic
+ORDER BY
+	consumer_group_topics.group_name,
+	topics.name
+`)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get consumer groups")
+	}
+	defer rows.Close()
+
+	result := []ConsumerGroup{}
+	for rows.Next() {
+		var group, topic string
+		err := rows.Scan(&group, &topic)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan row")
+		}
+
+		if len(result) == 0 || result[len(result)-1].Name != group {
+			result = append(result, ConsumerGroup{Name: group})
+		}
+		result[len(result)-1].Topics = append(result[len(result)-1].Topics, topic)
+	}
+	return result, nil
+}
+
 // GetServiceForPod gets the kafmesh service for a pod
 func (r *Retriever) GetServiceForPod(ctx context.Context, pod string) (*discoveryv1.Service, error) {
 	row := r.db.QueryRowContext(ctx, `
@@ -619,6 +660,7 @@ func (r *Retriever) getSinks(ctx context.Context, service *discoveryv1.Service,
 	SELECT
 		sinks.name as sink_name,
 		sinks.description as sink_description,
+		sinks.group_name as sink_group_name,
 		topics.name as topic_name,
 		topics.message as topic_message,
 		components.name as component_name
@@ -640,8 +682,8 @@ func (r *Retriever) getSinks(ctx context.Context, service *discoveryv1.Service,
 	defer rows.Close()
 
 	for rows.Next() {
-		var name, description, topicName, topicMessage, componentName string
-		err = rows.Scan(&name, &description, &topicName, &topicMessage, &componentName)
+		var name, description, groupName, topicName, topicMessage, componentName string
+		err = rows.Scan(&name, &description, &groupName, &topicName, &topicMessage, &componentName)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan source")
 		}
@@ -654,6 +696,7 @@ func (r *Retriever) getSinks(ctx context.Context, service *discoveryv1.Service,
 		sinks[componentName] = append(sinks[componentName], &discoveryv1.Sink{
 			Name:        name,
 			Description: description,
+			GroupName:   groupName,
 			Topic: &discoveryv1.TopicDefinition{
 				Topic:   topicName,
 				Message: topicMessage,
@@ -8,7 +8,7 @@ import (
 
 
 func init() {
-	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\x02!\xf2P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x001_initialize_schema.up.sqlUT\x05\x00\x01\xa4u\x12_\xec\x96\xcb\x8e\x9b0\x14\x86\xf7<\x85\x97\x89\xc4\x1btE#WE\xa5\xb4\xe3\x90\x91\xb2B\x11X\x91'\x13\x1f\x0bC\xe6\xf5G\\\x02\xb9\xd8\xd8&Q4\x8a\x86M\x16\x9c\xcb\xff\xfd\xc7\x9cxAp\x90`\x94\x04?#\x8cJ\x10,\x93h\xe6!\x84\x10\xcbQ\xf3,1	\x83\x08\xfd'\xe1\xdf\x80\xac\xd1\x1f\xbc\xf6\x9b\xf7|\xb3\xa7\xf5\xefk@\x16\xbf\x03\x82\xe2\x7f	\x8aWQ\xd4\xbe\xddS)7[\xaay\xbb\x8a\xc3\x97\x15\x9e\xd5%\xe6\xde\xfc\x87\xe7\x9d\xc9\x90\xb48\xb0\x8c^\n\xb1\x11\xa3\x17\x94S\x99\x15L\x94\x0c\xf8$Q\x19\xec\x05p\xca\xcb\xfb\xca\xeaX\x9b\x880N\x10\xc1\xbf0\xc1\xf1\x02/{\x1bf,\x9f\xdf\x02\xe3\x1f+\xa9\xac\x86\xaaps\xba\xf7\xe1Z\xf0`\x91Brs\xb6\xba\xda\x17y\xed\xb1S\xe4t\x10}]\xbf\x0dUp\x88\x022*%\x14.(\xe6\xe9\x98}\x9ej\xc7\xb6\x80J\xa4\xad\x02ueA\x0b\xc9dIyF\xf5\x86)\x86\xdd\xb7\x1d\xb3)e\\TNG\xb9O\xbd\x06\x1d\xcc\xbf\xef\xdc\xfb\xba\x16sO\xdf\x80\xf1g\xe2y\x07\xd8U\xe2\x99\x88\xa0*\x9f\xe2\xcc\x1d\x18\xfdp\xa1\x98\xba\"n\xa0\xb0\xd8\x98\x92\xf1\xdd#(\xccKv\n\xa7y1_;\xa1\xf9c\xaf\xc7\x99~\xbbq\xe6\xc6\xc3n\x05_\xde\x0f\x01\xf9}.\xc4\xe3\xb7K\x01y:\xe9\x0e#`\x08\xba\\\x91\x90\xab\xcc\x99\xbaT\x8f\x0b\x12r\x7f\x08\xd4\xa0\xb8\x1f w\x8e\xb6\x872\xa3ko\x80h\xa34\x04\xaeK\xde]\x7f\xddA\x93\xd147\xa8\xafct\xee;.3w\xedu\x07MF\xd3\xdc\xe4<\xe3\xbb\x11\xdf\xb5\x00\xb6\x10\xf6 };u\xd6\xa0\xc6b\x1a\xa9\x11K\xfbU\x1c\x1f[8\x85\xd4Q\xc0\x93OE\x07i\xf5\xc9\x9c\x846\xa0\x9f\x01\x00\x00\xff\xffPK\x07\x08\xeb\xbf\xa0\xce\xd3\x01\x00\x00>\x10\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x02!\xf2P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1b\x00	\x002_add_service_topics.up.sqlUT\x05\x00\x01\xa4u\x12_\xcc\x94An\xc20\x10E\xf7>\xc5\x1c\xa0\xe2\x02\x15\x0bT\xb1\xe8\xb6\xad\xdae\x16f\xaaN	3\x96\xc7\x86\xebW\x18;!\x12I\xa5\x84\xa0\xb0\"\x13\xff?O?\xc9\x7fy\xdbn>\xb6\xf0\xf9\xba\xfd\x02E\x7f$\x8bU\x10G\xb6\xda\xa1C\xde![B\x85\xcd\xbb\x01\x00P\xac\xd1\x86\xf4\xf7\xfc\xb3rp\xc2\xc8AWY\xfb\xd4\xdc;\x12\x9et\x95\xac\xd2\xec\xdb\xcb\xe1\x860\x8d\x88\x19=\xfc\nqW\x0e\xc2\xd9\xa7\x11\xac\xafv\xd2\xce\xa4\xf3\x91I\xd8\x8c\x01\xac\x94x?\x91\xf2\xe2QP\xb3\xe3\x0c\xbc\x13Q\x1b\xcaa\xc0r|\x02\xa8\xf3bQU|E\xecb\x98\xc0\xdc8%\xf0\xf6j\x90~\xc8'\x13u\xdc\ne3X_-\xbaw\"\xb5\xc8>\xbaEE\x92\x91\xba\x99\x14\xce\xbeP\xee\x12\xc6\x19hQQ$\xa0n\x10\x17\xc6\x9e\x18\x9e\x8d\xe9\xefO\x95\xe8\xed\xa8\xea\xcc\xca\xf1\xc9\x94\xd5\xe7\xaf={\xcdPH\x97\xba\x1b\xc9\n7m\xda\x16\x9d\x0f\xbb}\xb6\x12\xc3\xd2\xfa)#u\xdf\xc1\xc2\xf9\x88\x86\xd2\x95C\xaf\xa4\x01\xd9\xe2\xc329\xfd\xa0\xc7\x7f`\x80\x14X\x02p\xack\xf3\x17\x00\x00\xff\xffPK\x07\x08\xf0l\xe0\x18-\x01\x00\x00\xb7\x08\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x02!\xf2P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x00	\x003_add_component_topics.up.sqlUT\x05\x00\x01\xa4u\x12_\xcc\x94Aj\xf30\x10\x85\xf7:\xc5\x1c\xe0'\x17\xf8\xc9\"\x94,\xbamK\xbb4E\x9eR5\xce\x8c\xd0H\xcd\xf5\x8beI\xae q!\x8e\x83\xbd\xb2\x87\x99\xa7o\x9e\xc5{x\xda\xef^\xf6\xf0\xfa\xb8\x7f\x03\xcdG\xcb\x84\xe4\x1b\xcf\xd6\xe8\xa6E\x8b\xd4\"i\x83\x02\xbbg\x05\x00 \xd8\xa1\xf6\xf1\xb5\x7f\xca\x88lL\x0b\xef2\x16\xfe\x95\x9eo\x83'\xd9D\xc9X\xfbp|<#\x10K\x86\x08\x1d|\xb1\xa1z\x1c\x98\x92N\x19\xd8Vg\xab\xd8\x1f\xc80\xa99\xa0\x8d\x18:\xcc\xa4\x1d42rR\\\x90{&r\xa1\x9d\x06\xcd\xed7\x00\xb6\x8e5\x8a\xb0k\x0c\xd9\xe0g\xb0\x17\xa5\xb8\xc0\xf85\xb9\xc5\x94N\"\xaa\xd42e)l\x7f\x1d\xb4\x943\x1d\xf3!\xd8UY\x93\x90jo2\xe7%snjJ\x0f\xb6*K\"Pm\xc8\xc0x\xc1\x8e\xffJM%\xaeppzV\xd8&\x85\xeb=\xca\x08}\x1e$\xad\x05\xa3k\x08\xc8+\x99\xe1\xac\xcc\x98\xbb\xcb\xe3\x8f\x7f\x9d\x83_[\x92%\xa4\xfavf\xce{f\x99l,:1\xe2\x914\xf6\xcd\xf75\xe9\xf4\x89\x0e\xff\xa22\x02\xc4\x1e(t\x9d\xfa	\x00\x00\xff\xffPK\x07\x08\x9a\xb8\xcd\xd5/\x01\x00\x00\x14	\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x02!\xf2P\xeb\xbf\xa0\xce\xd3\x01\x00\x00>\x10\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x00\x00\x00\x001_initialize_schema.up.sqlUT\x05\x00\x01\xa4u\x12_PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x02!\xf2P\xf0l\xe0\x18-\x01\x00\x00\xb7\x08\x00\x00\x1b\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81$\x02\x00\x002_add_service_topics.up.sqlUT\x05\x00\x01\xa4u\x12_PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x02!\xf2P\x9a\xb8\xcd\xd5/\x01\x00\x00\x14	\x00\x00\x1d\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xa3\x03\x00\x003_add_component_topics.up.sqlUT\x05\x00\x01\xa4u\x12_PK\x05\x06\x00\x00\x00\x00\x03\x00\x03\x00\xf7\x00\x00\x00&\x05\x00\x00\x00\x00"
+	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00<\xbd	S\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x001_initialize_schema.up.sqlUT\x05\x00\x01D\xbd\x11a\xecV\xcd\x8e\x9b0\x10\xbe\xf3\x14s\x0c\x12o\xb0'\x1a\xb9jTJ[/\xa9\xb4'\xb4\x02k\xe5\xd2x,\x0c\xdb\xd7\xaf\x0c\x89\xc9\x06\x1b0E\xab*\xeae\xf70\xc3\xcc\xf733\xf1\x9e\x928#\x90\xc5\x1f\x12\x02\x0dJ^(\xd8\x05\x00\x00\xbc\xd4\x7f\x01\x1e	=\xc4	|\xa3\x87/1}\x82\xcf\xe4)\xea\xe2\xe2\xf9\xc4\xf4\xff\x1f1\xdd\x7f\x8a)\xa4_3H\x8fI\xd2GOL\xa9\xe7\x17\xe6\x88\x1e\xd3\xc3\xf7#\xd9\xe9\x12a\x10>\x04\xc1\x1b\x18\x8a\xd5\xaf\xbc`\xb7@\x96\x80q\x03*\x99*j.\x1b\x8eb\x15\xa8\x02O\x12\x05\x13\xcd\xb6\xb0\xce\\\xbb\x8cC\x9a\x01%\x1f	%\xe9\x9e<\x1a\x19v\xbc\x0c\xff\x86Lt\xa9d\x93\x1a\xdb\xdaOi\xa3\xc3\x18\xf0 \x91\x05r7[\x006\xa2]\xc8\xf6\xcd\xd9\x11S7\xeaS-<d\x8d\x05S\n\xebm\xdd\x99\x1f\x1a\x83\xcdS\x8e\x97\x1a[\x99\xebYs\x0d\xacd\xb5\xe2\xaaa\xa2`n\xc1F\x93\x1b\x0dm\xa7d\xca\xb9\x90\xad\xd7(\x1b\x85\xc7D\x07\xf1\xb7\xf5\xdd\xd4]\xe0{\xfe\x13\xb9\xb8'>\xbf\x10\xabV\xde\x13#l\x9b\xbb\x98\xb9W\xce~\xfb\xf8b\x16r\xcc\xc2\x846fa\xea\xba7GqQ\xbd\x07\x8b\xf9#\xbb\x86\xe7\xe2_s\x83-\x02\xc7kC\xdb\x99\xffW\xe3\x8d\x1a\xef\xf6*\xf8\xe7\xf5\x90X\xde\xea\xe0\xda\x90\x0b\x97\xc9\x8e\x0e\xd9%\x96\xf9p\xd2<\x06Q\xe2\x90t\xb3\x96\x1a\xbaEr\xd3f\xbc\xc8\x03\x02\xcbw\x97\x03\x89e4$\xda\xde\x18X\xae\x18 \x7f\x1e}\x0f\xeby<\xb7\x9f!\xd1g9\x18\xf8\x1ey\x7f\xfc\xba\x83\xe3\x0b\x1d\x9aC\xafs\\\xea{\x1e\xb3\x15\xdasQ9\xb0\xeb\xd0\xac\xf2\\T\x13\xba;\xaf\xf1R\x03\xfcL\xc8\xaf\xd8X\x8c\xc8\x9702\xb0'i9\xb7\xc2\xc7!\xb0Cul\xfbUc\x80)\x92\x8bV\xe6\xaaZ\x18\x84\x0f\xc1\x9f\x01\x00PK\x07\x08\xeb\xbf\xa0\xce\xeb\x01\x00\x00>\x10\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00<\xbd	S\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1b\x00	\x002_add_service_topics.up.sqlUT\x05\x00\x01D\xbd\x11a\xcc\x95\xc1N\xc30\x0c\x86\xefy\n?\x00\xda\x0b\xa0\x1d&\xb4\x03W@p\xec!3\xc2\xac\xb3\xa38\xd9^\x1f-$\x19E\xed$\xda\x15\xb5\xa74\x8d\x7f\x7f\xf9\x13\xfd}x\xdan^\xb6\xf0\xfa\xb8}\x03E\x7f$\x8bM\x10G\xb6\xd9\xa1C\xde![B\x85\xcd\xb3\x01\x00Pl\xd1\x864<?V\x0eN\x189\xe8*\xd7\xde\xd5oG\xc2\x93\xae\x92T\x9a{\xf7r\xe8)LS\xc4\x8c\x1e>\x85\xb8[\x0e\xc2Y\xa7vZ\xff\xe8I;\x93\xd6G&a3\x06\xb0Q\xe2\xfdD\xcao\x8d\x82\x9a\x15g\xe0\x9d\x88Z)\xaf\x03\x16\x92	\xc6:/\x16U\xc57\xc4.\x86	\xf6V\xa5d\xef\xe5\xed*}\xcf\xde\x7f\x13u\xd4\ne]\xb4\xae\xa3\xaay3GZ\x91}t\x8b\xb2$#u=)\x9cC\xa6\xdc\xe4z\x9c\xcfhQV$\xa0\xae\x11ij\xc8\x86{c\x86\xf3S%z;*:s\xe5xgJk\xe1\xaa5[\x80\x8ed\x85^\x99K\x8a\xce\x87]O\xb0\x91\x18\x96\x96O\x19\xa9{\x07\x0b\xe7\x7f$\x94\xae\x1cz%\x0d\xc8\x16\xc7\xfcg*\xe4_<9}\xa0\xc7\xba\x8f~\x18 \x05\x96\x00\x1c\xdb\xd6|\x0d\x00PK\x07\x08\xf0l\xe0\x181\x01\x00\x00\xb7\x08\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00<\xbd	S\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x00	\x003_add_component_topics.up.sqlUT\x05\x00\x01D\xbd\x11a\xcc\x94\xc1N\xc30\x0c\x86\xefy\n?\x00\xda\x0b\xa0\x1d&\xb4\x03W@p\xacPfDXgGq\xc2^\x1f54.Ek\x0fk;u\xa7\xccJ~\x7f\xfd\x12\xf9\xe1i\xbf{\xd9\xc3\xeb\xe3\xfe\x0d,\x9f<\x13R\xac\"{g\xab\x03z\xa4\x03\x92u(\xb0{6\x00\x00\x825\xda\x98\x97\xcdO\x8f\xc8\xc6\x1d\xe0]\xba\xc2\x9d\xee\xf9vx\x96M\x8e\xcc\xb5\x8f\xc0\xa7\x0b\x01\xb9\xe4\x880\xc0\x17;\xea\x1f\x07\xa66G\x1blu\xd5\xf46y\x7f\"\xc7d\xa6\x80V\xe2\xe88\x91\xf67\xa3 \xb7\x89\x0brODV\xdaq\xd0\xd2m\x06\xd1>\xb0E\x11\x0e\x95#\x9f\xe2\x04\xdd\x9a\x94uw\xffF\xbf\xe2\x82\x83\xffD\xbd\xb4B\xa9\x9b\xb6\xba\xd2\xcc\xd9\xcd\xd4\xcc\xc7\xe4W\xa5\xa6E\xea\xbb)\x9cCrf}.\xcd\x9d\xadJI\x06\xea\x0b\xc9\xa5!\x1d\xf7\xc6\x8cM\\\xe1\x14\xec\xa4a\xdb&\\\xef\xa8 0i\xd6\xe2#\xf7Jf\xd0\x0b\xf9\x1b\xd3\xcd\xdd\xe5\xf1\xf5n+Nqm\x93\xacE\xea\xbf\xce\xc2y\xcbY&\x1b\x8fA\x9cD$\x8b\x8d\xc9\xdbJ:\x7fb@m2@\xe5\x04\x88#P\xaak\xf33\x00PK\x07\x08\x9a\xb8\xcd\xd5/\x01\x00\x00\x14	\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00m'R]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x19\x00	\x004_add_consumer_lag.up.sqlUT\x05\x00\x01/R\xd4jt\x92\xc1\x8e\x9b0\x10\x86\xef~\x8a\xe9\x89D\x8ax\x81\xd5\x1e\xbc\xc4\xdb\xa2\x02\xbb5f\xab\x9c\x10\x02\x87\xb8	6\xb2\x8d\xf2\xfa\xd5\x1a\x02I\x809!\xcf\xf7\xff\xff\x8c\x0d\x8e\x18\xa1\xc0\xf0[D\xc0\x08y6\x80\xf7{\x08>\xa2,N\xa0\xd6\xaaksY4\x1c\xbe0\x0d~a\n\xc9\x07\x83$\x8b\"\xd8\x93w\x9cE\x0c<\xef\x05\xa1\x80\x12\xcc\xc8`S*i\xba\x86\xeb\xfcR\xd4\x066\x08\x00@T0\xab\x94\xd0\x10G\xf0I\xc3\x18\xd3\x03\xfc&\x87\x9dc\xefR\xc7z\x8e\xefI\xabZQ\xde\x90\xa1\xc2\x84\x01%\xef\x84\x92$ i\x8f\x98\x8d\xa8\xb6\xe3\xe8\xbd\xb6-\xb4\x15V(y\x13\x0e\xdaG\xaaTM#\xac\xe5U\xae\x8eG\xc3\xed7\xf5\x16\xfe\x9c\x83'Q\x9f\xf2ka\xb9n\n}\x06X\x05/E=\x05\x0e\xb5\xe8\xd8\xb5Ua\xf9\xe3\xbd\xb10&)\xc3\xf1\xe7\xd3\x98Y\x12\xfe\xc9\xc8f\xba\xb9]\xbf\xf8nZs\x8b\xb6\xd3C}\x85\xe4\xef\xf4N\xbd\xcc	\x0c\xe0\xd4-n\xf8\x85\x97\x16\xdd\x92[\xadJn\x8c\xd2\xc6\xbf\x0b\x99\xb7s!\xdb\xce\x1a\xdf\x99\xb9\xf6Q\xabf\xc1\xc6\x1d	)\xb9\x86\x7fJ\xc8U'Prv\xe6\x8f\x07\xaf\xe3\x97\xf1E\x85\x9cK'\x85\x92hi	\xf7\x7f/\xce\xdfwV\x86vM\x87^O\\\xf3U;\xf8\xf1\n\x9e\xf7\x82\xfe\x0f\x00PK\x07\x08\xb8\xc0v\xf2a\x01\x00\x00S\x03\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00<\xbd	S\xeb\xbf\xa0\xce\xeb\x01\x00\x00>\x10\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x00\x00\x00\x001_initialize_schema.up.sqlUT\x05\x00\x01D\xbd\x11aPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00<\xbd	S\xf0l\xe0\x181\x01\x00\x00\xb7\x08\x00\x00\x1b\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81<\x02\x00\x002_add_service_topics.up.sqlUT\x05\x00\x01D\xbd\x11aPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00<\xbd	S\x9a\xb8\xcd\xd5/\x01\x00\x00\x14	\x00\x00\x1d\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xbf\x03\x00\x003_add_component_topics.up.sqlUT\x05\x00\x01D\xbd\x11aPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00m'R]\xb8\xc0v\xf2a\x01\x00\x00S\x03\x00\x00\x19\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81B\x05\x00\x004_add_consumer_lag.up.sqlUT\x05\x00\x01/R\xd4jPK\x05\x06\x00\x00\x00\x00\x04\x00\x04\x00G\x01\x00\x00\xf3\x06\x00\x00\x00\x00"
 		fs.Register(data)
 	}
 	
\ No newline at end of file
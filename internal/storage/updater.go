@@ -3,6 +3,7 @@ package storage
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	discoveryv1 "github.com/syncromatics/kafmesh/internal/protos/kafmesh/discovery/v1"
 
@@ -34,6 +35,55 @@ func (u *Updater) Update(ctx context.Context, pod Pod, service *discoveryv1.Serv
 	return nil
 }
 
+// ConsumerLag is the lag of a consumer group on a topic partition
+type ConsumerLag struct {
+	Group           string
+	Topic           string
+	Partition       int32
+	CommittedOffset int64
+	HighWatermark   int64
+	Lag             int64
+}
+
+// UpdateConsumerLags replaces the consumer lags in storage
+func (u *Updater) UpdateConsumerLags(ctx context.Context, lags []ConsumerLag) error {
+	txn, err := u.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to start transaction")
+	}
+	defer txn.Rollback()
+
+	updated := time.Now().UTC()
+	for _, lag := range lags {
+		_, err = txn.ExecContext(ctx, `
+		INSERT INTO
+			consumer_lags(group_name, topic, partition, committed_offset, high_watermark, lag, updated)
+			select $1, topics.id, $3, $4, $5, $6, $7 from topics where topics.name=$2
+		ON CONFLICT(group_name, topic, partition)
+			DO UPDATE SET
+				committed_offset = EXCLUDED.committed_offset,
+				high_watermark = EXCLUDED.high_watermark,
+				lag = EXCLUDED.lag,
+				updated = EXCLUDED.updated
+		`, lag.Group, lag.Topic, lag.Partition, lag.CommittedOffset, lag.HighWatermark, lag.Lag, updated)
+		if err != nil {
+			return errors.Wrapf(err, "failed to update lag of group '%s' on topic '%s'", lag.Group, lag.Topic)
+		}
+	}
+
+	_, err = txn.ExecContext(ctx, "delete from consumer_lags where updated < $1;", updated)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete stale consumer lags")
+	}
+
+	err = txn.Commit()
+	if err != nil {
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+
+	return nil
+}
+
 func (u *Updater) updateService(ctx context.Context, service *discoveryv1.Service, pod Pod) error {
 	txn, err := u.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -501,12 +551,13 @@ func (u *Updater) updateSink(ctx context.Context, txn *sql.Tx, componentID int64
 		RETURNING id
 	), sink AS(
 		INSERT INTO
-			sinks(component, name, topic, description)
-			select $3, $4, new_topic.id, $5 from new_topic
+			sinks(component, name, topic, description, group_name)
+			select $3, $4, new_topic.id, $5, $7 from new_topic
 		ON CONFLICT(component, name)
 			DO UPDATE SET
 				description = EXCLUDED.description,
-				topic = EXCLUDED.topic
+				topic = EXCLUDED.topic,
+				group_name = EXCLUDED.group_name
 		RETURNING id
 	)
 	INSERT INTO
@@ -517,7 +568,7 @@ func (u *Updater) updateSink(ctx context.Context, txn *sql.Tx, componentID int64
 	FROM
 		pod, sink
 	RETURNING sink;
-	`, sink.Topic.Topic, sink.Topic.Message, componentID, sink.Name, sink.Description, pod.Name)
+	`, sink.Topic.Topic, sink.Topic.Message, componentID, sink.Name, sink.Description, pod.Name, sink.GroupName)
 
 	var id int64
 	err := row.Scan(&id)
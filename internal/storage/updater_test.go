@@ -38,7 +38,8 @@ func Test_Updater(t *testing.T) {
 				},
 				Sinks: []*discoveryv1.Sink{
 					&discoveryv1.Sink{
-						Name: "sink1",
+						Name:      "sink1",
+						GroupName: "service1.component1.sink1-sink",
 						Topic: &discoveryv1.TopicDefinition{
 							Topic:   "sink1.topic",
 							Message: "sink1.message",
@@ -156,7 +157,8 @@ func Test_Updater(t *testing.T) {
 				},
 				Sinks: []*discoveryv1.Sink{
 					&discoveryv1.Sink{
-						Name: "sink1",
+						Name:      "sink1",
+						GroupName: "service1.component1.sink1-sink",
 						Topic: &discoveryv1.TopicDefinition{
 							Topic:   "sink1.topic",
 							Message: "sink1.message",
@@ -192,4 +194,28 @@ func Test_Updater(t *testing.T) {
 	response, err = retriever.GetServiceForPod(context.Background(), "pod2")
 	assert.NilError(t, err)
 	assert.DeepEqual(t, response, pod2Service)
+
+	groups, err := retriever.GetConsumerGroups(context.Background())
+	assert.NilError(t, err)
+	assert.DeepEqual(t, groups, []storage.ConsumerGroup{
+		{Name: "group.1.processor", Topics: []string{"processor1.input2.topic", "processor1.topic"}},
+		{Name: "service1.component1.sink1-sink", Topics: []string{"sink1.topic"}},
+	})
+
+	err = updater.UpdateConsumerLags(context.Background(), []storage.ConsumerLag{
+		{Group: "group.1.processor", Topic: "processor1.topic", Partition: 0, CommittedOffset: 5, HighWatermark: 10, Lag: 5},
+		{Group: "group.1.processor", Topic: "processor1.topic", Partition: 1, CommittedOffset: 8, HighWatermark: 10, Lag: 2},
+	})
+	assert.NilError(t, err)
+
+	err = updater.UpdateConsumerLags(context.Background(), []storage.ConsumerLag{
+		{Group: "group.1.processor", Topic: "processor1.topic", Partition: 0, CommittedOffset: 10, HighWatermark: 12, Lag: 2},
+	})
+	assert.NilError(t, err)
+
+	var count, lag int64
+	err = db.QueryRow("select count(*), sum(lag) from consumer_lags").Scan(&count, &lag)
+	assert.NilError(t, err)
+	assert.Equal(t, count, int64(1))
+	assert.Equal(t, lag, int64(2))
 }
@@ -0,0 +1,112 @@
+package runner
+
+import (
+	"encoding/binary"
+	"reflect"
+
+	"github.com/hamba/avro"
+	"github.com/pkg/errors"
+)
+
+// AvroMessage is an avro record generated by kafmesh-gen
+type AvroMessage interface {
+	AvroSchema() string
+}
+
+// AvroWrapper is a codec generator for avro schema codecs
+type AvroWrapper struct {
+	client *AvroRegistry
+}
+
+// NewAvroWrapper creates a new avro schema codec AvroWrapper
+func NewAvroWrapper(registry *AvroRegistry) *AvroWrapper {
+	return &AvroWrapper{
+		client: registry,
+	}
+}
+
+// AvroCodec is a goka codec for avro records. Records written with another version of the schema are
+// resolved to the schema of the record using the writer schema registered with their schema id.
+type AvroCodec struct {
+	id          uint32
+	schema      avro.Schema
+	constructor func() AvroMessage
+	registry    *AvroRegistry
+}
+
+// Codec returns a codec for the avro record
+func (w *AvroWrapper) Codec(topic string, message AvroMessage) (*AvroCodec, error) {
+	if w == nil || w.client == nil {
+		return nil, errors.Errorf("no avro registry configured for topic '%s'", topic)
+	}
+
+	t := reflect.ValueOf(message).Elem()
+	constructor := func() AvroMessage {
+		v := reflect.New(t.Type())
+		return v.Interface().(AvroMessage)
+	}
+
+	schema, err := avro.Parse(message.AvroSchema())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse avro schema")
+	}
+
+	id, err := w.client.RegisterSchema(topic, message.AvroSchema())
+	if err != nil {
+		return nil, err
+	}
+
+	return &AvroCodec{
+		id:          id,
+		schema:      schema,
+		constructor: constructor,
+		registry:    w.client,
+	}, nil
+}
+
+// Decode decodes the bytes into an avro record
+func (c *AvroCodec) Decode(data []byte) (interface{}, error) {
+	if len(data) < 5 {
+		return nil, errors.Errorf("avro message is too short to contain a schema id")
+	}
+
+	if data[0] != confluentMagicByte {
+		return nil, errors.Errorf("unknown magic byte '%d'", data[0])
+	}
+
+	payload := data[5:]
+	id := binary.BigEndian.Uint32(data[1:5])
+	if id != c.id {
+		writer, err := c.registry.Schema(id)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get writer schema")
+		}
+
+		if writer.Fingerprint() != c.schema.Fingerprint() {
+			payload, err = resolveAvro(writer, c.schema, payload)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to resolve writer schema '%d'", id)
+			}
+		}
+	}
+
+	obj := c.constructor()
+	err := avro.Unmarshal(c.schema, payload, obj)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal bytes")
+	}
+	return obj, nil
+}
+
+// Encode encodes the avro record into bytes
+func (c *AvroCodec) Encode(message interface{}) ([]byte, error) {
+	bytes := make([]byte, 5)
+	bytes[0] = confluentMagicByte
+	binary.BigEndian.PutUint32(bytes[1:], c.id)
+
+	b, err := avro.Marshal(c.schema, message)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal object")
+	}
+	return append(bytes, b...), nil
+}
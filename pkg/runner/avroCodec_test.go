@@ -0,0 +1,137 @@
+package runner_test
+
+import (
+	"encoding/binary"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/syncromatics/kafmesh/pkg/runner"
+
+	"github.com/hamba/avro"
+	"github.com/stretchr/testify/assert"
+)
+
+const positionSchema = `{
+	"type": "record",
+	"name": "Position",
+	"namespace": "testMesh.testSerial",
+	"fields": [
+		{ "name": "serial", "type": "string" },
+		{ "name": "speed", "type": "long" },
+		{ "name": "tags", "type": { "type": "array", "items": "string" } },
+		{ "name": "status", "type": { "type": "enum", "name": "Status", "symbols": ["STOPPED", "MOVING", "PARKED"] } },
+		{ "name": "heading", "type": ["null", "double"], "default": null },
+		{ "name": "source", "type": "string", "default": "gps" }
+	]
+}`
+
+// positionV1Schema is an older version of the schema written by another producer
+const positionV1Schema = `{
+	"type": "record",
+	"name": "Position",
+	"namespace": "testMesh.testSerial",
+	"fields": [
+		{ "name": "serial", "type": "string" },
+		{ "name": "odometer", "type": { "type": "map", "values": "int" } },
+		{ "name": "speed", "type": "int" },
+		{ "name": "status", "type": { "type": "enum", "name": "Status", "symbols": ["MOVING", "STOPPED"] } },
+		{ "name": "tags", "type": { "type": "array", "items": "string" } }
+	]
+}`
+
+type position struct {
+	Serial  string   `avro:"serial"`
+	Speed   int64    `avro:"speed"`
+	Tags    []string `avro:"tags"`
+	Status  string   `avro:"status"`
+	Heading *float64 `avro:"heading"`
+	Source  string   `avro:"source"`
+}
+
+func (*position) AvroSchema() string {
+	return positionSchema
+}
+
+func Test_AvroCodec_ResolvesWriterSchema(t *testing.T) {
+	fake := &fakeConfluentRegistry{
+		subjects: map[string]fakeSchema{},
+		ids:      map[string]int{},
+	}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	registry := runner.NewAvroRegistry(server.URL)
+
+	writerID, err := registry.RegisterSchema("testMesh.testSerial.position", positionV1Schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	codec, err := runner.NewAvroWrapper(registry).Codec("testMesh.testSerial.position", &position{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the payload is written field by field in the order of the writer schema
+	w := avro.NewWriter(nil, 64)
+	w.WriteString("abc")
+	w.WriteBlockHeader(2, 0)
+	w.WriteString("trip")
+	w.WriteInt(5)
+	w.WriteString("total")
+	w.WriteInt(1200)
+	w.WriteBlockHeader(0, 0)
+	w.WriteInt(12)
+	w.WriteInt(1)
+	w.WriteBlockHeader(2, 0)
+	w.WriteString("a")
+	w.WriteString("b")
+	w.WriteBlockHeader(0, 0)
+	payload := w.Buffer()
+
+	data := make([]byte, 5)
+	binary.BigEndian.PutUint32(data[1:], writerID)
+	data = append(data, payload...)
+
+	decoded, err := codec.Decode(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, &position{
+		Serial: "abc",
+		Speed:  12,
+		Tags:   []string{"a", "b"},
+		Status: "STOPPED",
+		Source: "gps",
+	}, decoded)
+
+	// records written with the schema of the codec are decoded as they are
+	heading := 90.5
+	message := &position{Serial: "def", Speed: 3, Tags: []string{"c"}, Status: "PARKED", Heading: &heading, Source: "can"}
+	b, err := codec.Encode(message)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoded, err = codec.Decode(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, message, decoded)
+
+	incompatible, err := registry.RegisterSchema("testMesh.testSerial.other", `{
+	"type": "record",
+	"name": "Position",
+	"fields": [
+		{ "name": "serial", "type": "int" }
+	]
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	binary.BigEndian.PutUint32(data[1:], incompatible)
+	_, err = codec.Decode(append(data[:5], 2))
+	assert.EqualError(t, err, "failed to resolve writer schema '3': failed to resolve field 'serial': int can not be read as string")
+}
@@ -0,0 +1,60 @@
+package runner
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hamba/avro"
+	"github.com/pkg/errors"
+)
+
+// AvroRegistry is a client for a confluent compatible schema registry that stores avro schemas
+type AvroRegistry struct {
+	registry *ConfluentRegistry
+
+	mtx     sync.Mutex
+	schemas map[uint32]avro.Schema
+}
+
+// NewAvroRegistry creates a new avro schema registry client
+func NewAvroRegistry(registryURL string) *AvroRegistry {
+	return &AvroRegistry{
+		registry: NewConfluentRegistry(registryURL),
+		schemas:  map[uint32]avro.Schema{},
+	}
+}
+
+// WaitForRegistryToBeReady waits for the register to start responding
+func (r *AvroRegistry) WaitForRegistryToBeReady(timeout time.Duration) error {
+	return r.registry.WaitForRegistryToBeReady(timeout)
+}
+
+// RegisterSchema registers the avro schema for the topic's value subject and returns the schema id
+func (r *AvroRegistry) RegisterSchema(topic, schema string) (uint32, error) {
+	return r.registry.registerSchema(fmt.Sprintf("%s-value", topic), confluentSchema{Schema: schema})
+}
+
+// Schema gets the avro schema registered with the id. Schemas never change once registered so they are cached.
+func (r *AvroRegistry) Schema(id uint32) (avro.Schema, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	schema, ok := r.schemas[id]
+	if ok {
+		return schema, nil
+	}
+
+	text, err := r.registry.schemaByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err = avro.Parse(text)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse avro schema '%d'", id)
+	}
+	r.schemas[id] = schema
+
+	return schema, nil
+}
@@ -0,0 +1,367 @@
+package runner
+
+import (
+	"github.com/hamba/avro"
+	"github.com/pkg/errors"
+)
+
+// resolveAvro rewrites data written with the writer schema into the encoding of the reader schema following
+// the avro schema resolution rules. Fields only the writer has are dropped, fields only the reader has get
+// their default, numbers are promoted and unions are matched by type.
+func resolveAvro(writer, reader avro.Schema, data []byte) ([]byte, error) {
+	r := avro.NewReader(nil, 0).Reset(data)
+	w := avro.NewWriter(nil, len(data))
+
+	err := transcodeAvro(r, w, writer, reader)
+	if err != nil {
+		return nil, err
+	}
+	if r.Error != nil {
+		return nil, errors.Wrap(r.Error, "failed to read avro data")
+	}
+	if w.Error != nil {
+		return nil, errors.Wrap(w.Error, "failed to write avro data")
+	}
+
+	return w.Buffer(), nil
+}
+
+func transcodeAvro(r *avro.Reader, w *avro.Writer, writer, reader avro.Schema) error {
+	writer = derefAvro(writer)
+	reader = derefAvro(reader)
+
+	if writer.Type() == avro.Union {
+		types := writer.(*avro.UnionSchema).Types()
+		index := int(r.ReadLong())
+		if index < 0 || index >= len(types) {
+			return errors.Errorf("union index %d is out of range", index)
+		}
+		return transcodeAvro(r, w, types[index], reader)
+	}
+
+	if reader.Type() == avro.Union {
+		index, branch := matchAvroUnion(writer, reader.(*avro.UnionSchema))
+		if branch == nil {
+			return errors.Errorf("%s does not match any type of the reader union", writer.Type())
+		}
+		w.WriteLong(int64(index))
+		return transcodeAvro(r, w, writer, branch)
+	}
+
+	if !promotableAvro(writer, reader) {
+		return errors.Errorf("%s can not be read as %s", avroTypeName(writer), avroTypeName(reader))
+	}
+
+	switch writer.Type() {
+	case avro.Null:
+	case avro.Boolean:
+		w.WriteBool(r.ReadBool())
+	case avro.Int:
+		writeAvroInteger(w, reader.Type(), int64(r.ReadInt()))
+	case avro.Long:
+		writeAvroInteger(w, reader.Type(), r.ReadLong())
+	case avro.Float:
+		f := r.ReadFloat()
+		if reader.Type() == avro.Float {
+			w.WriteFloat(f)
+		} else {
+			w.WriteDouble(float64(f))
+		}
+	case avro.Double:
+		w.WriteDouble(r.ReadDouble())
+	case avro.String, avro.Bytes:
+		b := r.ReadBytes()
+		if reader.Type() == avro.String {
+			w.WriteString(string(b))
+		} else {
+			w.WriteBytes(b)
+		}
+	case avro.Fixed:
+		b := make([]byte, writer.(*avro.FixedSchema).Size())
+		r.Read(b)
+		w.Write(b)
+	case avro.Enum:
+		return transcodeAvroEnum(r, w, writer.(*avro.EnumSchema), reader.(*avro.EnumSchema))
+	case avro.Array:
+		return transcodeAvroBlocks(r, w, func() error {
+			return transcodeAvro(r, w, writer.(*avro.ArraySchema).Items(), reader.(*avro.ArraySchema).Items())
+		})
+	case avro.Map:
+		return transcodeAvroBlocks(r, w, func() error {
+			w.WriteString(r.ReadString())
+			return transcodeAvro(r, w, writer.(*avro.MapSchema).Values(), reader.(*avro.MapSchema).Values())
+		})
+	case avro.Record:
+		return transcodeAvroRecord(r, w, writer.(*avro.RecordSchema), reader.(*avro.RecordSchema))
+	default:
+		return errors.Errorf("unsupported avro type %s", writer.Type())
+	}
+
+	return nil
+}
+
+// transcodeAvroRecord reads the writer fields in their order and writes the reader fields in theirs
+func transcodeAvroRecord(r *avro.Reader, w *avro.Writer, writer, reader *avro.RecordSchema) error {
+	readerFields := map[string]*avro.Field{}
+	for _, f := range reader.Fields() {
+		readerFields[f.Name()] = f
+	}
+
+	values := map[string][]byte{}
+	for _, f := range writer.Fields() {
+		rf, ok := readerFields[f.Name()]
+		if !ok {
+			// the field is read to skip it
+			err := transcodeAvro(r, avro.NewWriter(nil, 0), f.Type(), f.Type())
+			if err != nil {
+				return errors.Wrapf(err, "failed to skip field '%s'", f.Name())
+			}
+			continue
+		}
+
+		fw := avro.NewWriter(nil, 64)
+		err := transcodeAvro(r, fw, f.Type(), rf.Type())
+		if err != nil {
+			return errors.Wrapf(err, "failed to resolve field '%s'", f.Name())
+		}
+		values[f.Name()] = fw.Buffer()
+	}
+
+	for _, f := range reader.Fields() {
+		value, ok := values[f.Name()]
+		if ok {
+			w.Write(value)
+			continue
+		}
+
+		if !f.HasDefault() {
+			return errors.Errorf("field '%s' is missing from the writer schema and has no default", f.Name())
+		}
+
+		err := writeAvroDefault(w, f.Type(), f.Default())
+		if err != nil {
+			return errors.Wrapf(err, "failed to write default of field '%s'", f.Name())
+		}
+	}
+
+	return nil
+}
+
+func transcodeAvroEnum(r *avro.Reader, w *avro.Writer, writer, reader *avro.EnumSchema) error {
+	index := int(r.ReadInt())
+	if index < 0 || index >= len(writer.Symbols()) {
+		return errors.Errorf("enum index %d is out of range", index)
+	}
+
+	symbol := writer.Symbols()[index]
+	for i, s := range reader.Symbols() {
+		if s == symbol {
+			w.WriteInt(int32(i))
+			return nil
+		}
+	}
+
+	return errors.Errorf("enum symbol '%s' is not in the reader schema", symbol)
+}
+
+// transcodeAvroBlocks copies the blocks of an array or map, calling item for each of their items
+func transcodeAvroBlocks(r *avro.Reader, w *avro.Writer, item func() error) error {
+	for {
+		count, _ := r.ReadBlockHeader()
+		if r.Error != nil {
+			return errors.Wrap(r.Error, "failed to read block header")
+		}
+		w.WriteBlockHeader(count, 0)
+		if count == 0 {
+			return nil
+		}
+
+		for i := int64(0); i < count; i++ {
+			err := item()
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// writeAvroInteger writes an int or long as the reader type it is promoted to
+func writeAvroInteger(w *avro.Writer, reader avro.Type, v int64) {
+	switch reader {
+	case avro.Int:
+		w.WriteInt(int32(v))
+	case avro.Long:
+		w.WriteLong(v)
+	case avro.Float:
+		w.WriteFloat(float32(v))
+	case avro.Double:
+		w.WriteDouble(float64(v))
+	}
+}
+
+// writeAvroDefault writes the default of a field, which the schema parser has already converted to the go type of the schema
+func writeAvroDefault(w *avro.Writer, schema avro.Schema, value interface{}) error {
+	schema = derefAvro(schema)
+
+	var ok bool
+	switch schema.Type() {
+	case avro.Null:
+		ok = true
+	case avro.Boolean:
+		var v bool
+		v, ok = value.(bool)
+		w.WriteBool(v)
+	case avro.Int:
+		var v int
+		v, ok = value.(int)
+		w.WriteInt(int32(v))
+	case avro.Long:
+		var v int64
+		v, ok = value.(int64)
+		w.WriteLong(v)
+	case avro.Float:
+		var v float32
+		v, ok = value.(float32)
+		w.WriteFloat(v)
+	case avro.Double:
+		var v float64
+		v, ok = value.(float64)
+		w.WriteDouble(v)
+	case avro.String:
+		var v string
+		v, ok = value.(string)
+		w.WriteString(v)
+	case avro.Bytes, avro.Fixed:
+		var v string
+		v, ok = value.(string)
+		b := avroDefaultBytes(v)
+		if schema.Type() == avro.Bytes {
+			w.WriteBytes(b)
+		} else {
+			w.Write(b)
+		}
+	case avro.Enum:
+		var v string
+		v, ok = value.(string)
+		for i, s := range schema.(*avro.EnumSchema).Symbols() {
+			if s == v {
+				w.WriteInt(int32(i))
+				return nil
+			}
+		}
+		ok = false
+	case avro.Array:
+		var items []interface{}
+		items, ok = value.([]interface{})
+		if len(items) > 0 {
+			w.WriteBlockHeader(int64(len(items)), 0)
+		}
+		for _, item := range items {
+			err := writeAvroDefault(w, schema.(*avro.ArraySchema).Items(), item)
+			if err != nil {
+				return err
+			}
+		}
+		w.WriteBlockHeader(0, 0)
+	case avro.Map:
+		var values map[string]interface{}
+		values, ok = value.(map[string]interface{})
+		if len(values) > 0 {
+			w.WriteBlockHeader(int64(len(values)), 0)
+		}
+		for k, v := range values {
+			w.WriteString(k)
+			err := writeAvroDefault(w, schema.(*avro.MapSchema).Values(), v)
+			if err != nil {
+				return err
+			}
+		}
+		w.WriteBlockHeader(0, 0)
+	case avro.Union:
+		// the default of a union is always of its first type
+		w.WriteLong(0)
+		return writeAvroDefault(w, schema.(*avro.UnionSchema).Types()[0], value)
+	case avro.Record:
+		var values map[string]interface{}
+		values, ok = value.(map[string]interface{})
+		for _, f := range schema.(*avro.RecordSchema).Fields() {
+			err := writeAvroDefault(w, f.Type(), values[f.Name()])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if !ok {
+		return errors.Errorf("default %v is not a %s", value, schema.Type())
+	}
+
+	return nil
+}
+
+// avroDefaultBytes converts the default of a bytes or fixed field, where each character is a byte
+func avroDefaultBytes(value string) []byte {
+	b := []byte{}
+	for _, c := range value {
+		b = append(b, byte(c))
+	}
+	return b
+}
+
+// matchAvroUnion picks the first type of the reader union the writer type matches exactly, or else the first it
+// can be promoted to
+func matchAvroUnion(writer avro.Schema, reader *avro.UnionSchema) (int, avro.Schema) {
+	for i, t := range reader.Types() {
+		t = derefAvro(t)
+		if t.Type() == writer.Type() && avroTypeName(t) == avroTypeName(writer) {
+			return i, t
+		}
+	}
+
+	for i, t := range reader.Types() {
+		if promotableAvro(writer, derefAvro(t)) {
+			return i, t
+		}
+	}
+
+	return -1, nil
+}
+
+// promotableAvro checks the writer type can be read as the reader type
+func promotableAvro(writer, reader avro.Schema) bool {
+	switch writer.Type() {
+	case avro.Int:
+		return reader.Type() == avro.Int || reader.Type() == avro.Long || reader.Type() == avro.Float || reader.Type() == avro.Double
+	case avro.Long:
+		return reader.Type() == avro.Long || reader.Type() == avro.Float || reader.Type() == avro.Double
+	case avro.Float:
+		return reader.Type() == avro.Float || reader.Type() == avro.Double
+	case avro.String, avro.Bytes:
+		return reader.Type() == avro.String || reader.Type() == avro.Bytes
+	case avro.Fixed:
+		return reader.Type() == avro.Fixed &&
+			avroTypeName(writer) == avroTypeName(reader) &&
+			writer.(*avro.FixedSchema).Size() == reader.(*avro.FixedSchema).Size()
+	case avro.Enum, avro.Record:
+		return reader.Type() == writer.Type() && avroTypeName(writer) == avroTypeName(reader)
+	default:
+		return reader.Type() == writer.Type()
+	}
+}
+
+// avroTypeName is the unqualified name of named types, which is what resolution matches on, or the type
+func avroTypeName(schema avro.Schema) string {
+	named, ok := schema.(avro.NamedSchema)
+	if ok {
+		return named.Name()
+	}
+	return string(schema.Type())
+}
+
+func derefAvro(schema avro.Schema) avro.Schema {
+	ref, ok := schema.(*avro.RefSchema)
+	if ok {
+		return ref.Schema()
+	}
+	return schema
+}
@@ -0,0 +1,64 @@
+package runner
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/lovoo/goka"
+	"github.com/pkg/errors"
+)
+
+// AvroViewSourceJob executes an avro synchronize
+type AvroViewSourceJob struct {
+	context.Context
+	view     *goka.View
+	emitter  *Emitter
+	keysSeen map[string]struct{}
+	run      *viewSourceRecorder
+}
+
+// NewAvroViewSourceJob creates a new avro view source job
+func NewAvroViewSourceJob(ctx context.Context, view *goka.View, emitter *Emitter, watch *ViewSourceWatch, metrics *ViewSourceMetrics) *AvroViewSourceJob {
+	keysSeen := map[string]struct{}{}
+	return &AvroViewSourceJob{
+		ctx,
+		view,
+		emitter,
+		keysSeen,
+		newViewSourceRecorder(watch, metrics),
+	}
+}
+
+// Update adds a key/value pair to the job
+func (s *AvroViewSourceJob) Update(key string, msg AvroMessage) error {
+	s.keysSeen[key] = struct{}{}
+
+	current, err := s.view.Get(key)
+	if err != nil {
+		return errors.Wrap(err, "failed to get object")
+	}
+
+	if current != nil && reflect.DeepEqual(current, msg) {
+		return nil
+	}
+
+	err = s.emitter.EmitContext(s.Context, key, msg)
+	if err != nil {
+		return errors.Wrap(err, "failed to emit update")
+	}
+	s.run.updated(key, msg)
+
+	return nil
+}
+
+// Finish the job and run deletes
+func (s *AvroViewSourceJob) Finish() error {
+	err := deleteUnseenKeys(s.Context, s.view, s.emitter, s.keysSeen, s.run)
+	s.run.finished(err)
+	return err
+}
+
+// Failed reports a sync run that failed before it finished
+func (s *AvroViewSourceJob) Failed(err error) {
+	s.run.finished(err)
+}
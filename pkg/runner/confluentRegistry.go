@@ -0,0 +1,223 @@
+package runner
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/protoprint"
+	"github.com/pkg/errors"
+)
+
+const (
+	confluentMagicByte        byte = 0
+	schemaRegistryContentType      = "application/vnd.schemaregistry.v1+json"
+)
+
+// ConfluentRegistry is a client for the confluent schema registry rest api
+type ConfluentRegistry struct {
+	url    string
+	client *http.Client
+
+	mtx        sync.Mutex
+	references map[string]confluentReference
+}
+
+type confluentReference struct {
+	Name    string `json:"name"`
+	Subject string `json:"subject"`
+	Version int    `json:"version"`
+}
+
+type confluentSchema struct {
+	Schema     string               `json:"schema"`
+	SchemaType string               `json:"schemaType,omitempty"`
+	References []confluentReference `json:"references,omitempty"`
+}
+
+// NewConfluentRegistry creates a new confluent schema registry client
+func NewConfluentRegistry(registryURL string) *ConfluentRegistry {
+	return &ConfluentRegistry{
+		url:        strings.TrimRight(registryURL, "/"),
+		client:     &http.Client{Timeout: 30 * time.Second},
+		references: map[string]confluentReference{},
+	}
+}
+
+// WaitForRegistryToBeReady waits for the register to start responding
+func (r *ConfluentRegistry) WaitForRegistryToBeReady(timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+
+	var err error
+	for time.Now().Before(deadline) {
+		var resp *http.Response
+		resp, err = r.client.Get(r.url + "/subjects")
+		if err == nil {
+			resp.Body.Close()
+			if resp.StatusCode == http.StatusOK {
+				return nil
+			}
+			err = errors.Errorf("registry responded with status '%d'", resp.StatusCode)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return errors.Wrap(err, "timed out waiting for registry to become ready")
+}
+
+// Register registers the protobuf schema, and the files it imports, for the topic's value subject
+func (r *ConfluentRegistry) Register(topic string, message Message) (WireFormat, error) {
+	md, err := desc.LoadMessageDescriptorForMessage(message)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load message descriptor")
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	schema, err := r.protobufSchema(md.GetFile())
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := r.registerSchema(fmt.Sprintf("%s-value", topic), schema)
+	if err != nil {
+		return nil, err
+	}
+
+	_, indexes := message.Descriptor()
+
+	return confluentProtobufFormat{
+		id:      id,
+		indexes: indexes,
+	}, nil
+}
+
+func (r *ConfluentRegistry) protobufSchema(file *desc.FileDescriptor) (confluentSchema, error) {
+	printer := &protoprint.Printer{}
+	text, err := printer.PrintProtoToString(file)
+	if err != nil {
+		return confluentSchema{}, errors.Wrapf(err, "failed to print proto file '%s'", file.GetName())
+	}
+
+	schema := confluentSchema{
+		Schema:     text,
+		SchemaType: "PROTOBUF",
+	}
+
+	for _, dependency := range file.GetDependencies() {
+		reference, err := r.registerReference(dependency)
+		if err != nil {
+			return confluentSchema{}, err
+		}
+		schema.References = append(schema.References, reference)
+	}
+
+	return schema, nil
+}
+
+// registerReference registers an imported proto file under a subject named after the file
+func (r *ConfluentRegistry) registerReference(file *desc.FileDescriptor) (confluentReference, error) {
+	reference, ok := r.references[file.GetName()]
+	if ok {
+		return reference, nil
+	}
+
+	schema, err := r.protobufSchema(file)
+	if err != nil {
+		return confluentReference{}, err
+	}
+
+	_, err = r.registerSchema(file.GetName(), schema)
+	if err != nil {
+		return confluentReference{}, err
+	}
+
+	result := struct {
+		Version int `json:"version"`
+	}{}
+	err = r.post(fmt.Sprintf("/subjects/%s", url.PathEscape(file.GetName())), schema, &result)
+	if err != nil {
+		return confluentReference{}, errors.Wrapf(err, "failed to lookup version of subject '%s'", file.GetName())
+	}
+
+	reference = confluentReference{
+		Name:    file.GetName(),
+		Subject: file.GetName(),
+		Version: result.Version,
+	}
+	r.references[file.GetName()] = reference
+
+	return reference, nil
+}
+
+func (r *ConfluentRegistry) registerSchema(subject string, schema confluentSchema) (uint32, error) {
+	result := struct {
+		ID uint32 `json:"id"`
+	}{}
+	err := r.post(fmt.Sprintf("/subjects/%s/versions", url.PathEscape(subject)), schema, &result)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to register schema for subject '%s'", subject)
+	}
+
+	return result.ID, nil
+}
+
+// schemaByID gets the schema registered with the id
+func (r *ConfluentRegistry) schemaByID(id uint32) (string, error) {
+	resp, err := r.client.Get(fmt.Sprintf("%s/schemas/ids/%d", r.url, id))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to send request to registry")
+	}
+
+	result := struct {
+		Schema string `json:"schema"`
+	}{}
+	err = readResponse(resp, &result)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get schema '%d'", id)
+	}
+
+	return result.Schema, nil
+}
+
+func (r *ConfluentRegistry) post(path string, request interface{}, response interface{}) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request")
+	}
+
+	resp, err := r.client.Post(r.url+path, schemaRegistryContentType, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "failed to send request to registry")
+	}
+
+	return readResponse(resp, response)
+}
+
+func readResponse(resp *http.Response, response interface{}) error {
+	defer resp.Body.Close()
+
+	content, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrap(err, "failed to read registry response")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("registry responded with status '%d': %s", resp.StatusCode, string(content))
+	}
+
+	err = json.Unmarshal(content, response)
+	if err != nil {
+		return errors.Wrap(err, "failed to unmarshal registry response")
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package runner_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	discoveryv1 "github.com/syncromatics/kafmesh/internal/protos/kafmesh/discovery/v1"
+	"github.com/syncromatics/kafmesh/pkg/runner"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeSchema struct {
+	Schema     string `json:"schema"`
+	SchemaType string `json:"schemaType"`
+	References []struct {
+		Name    string `json:"name"`
+		Subject string `json:"subject"`
+		Version int    `json:"version"`
+	} `json:"references"`
+}
+
+type fakeConfluentRegistry struct {
+	mtx      sync.Mutex
+	subjects map[string]fakeSchema
+	ids      map[string]int
+	schemas  []string
+}
+
+func (f *fakeConfluentRegistry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	if r.Method == http.MethodGet && r.URL.Path == "/subjects" {
+		json.NewEncoder(w).Encode([]string{})
+		return
+	}
+
+	if r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/schemas/ids/") {
+		id, err := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/schemas/ids/"))
+		if err != nil || id < 1 || id > len(f.schemas) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"schema": f.schemas[id-1]})
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/subjects/")
+	var schema fakeSchema
+	err := json.NewDecoder(r.Body).Decode(&schema)
+	if err != nil || r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if strings.HasSuffix(path, "/versions") {
+		subject := strings.TrimSuffix(path, "/versions")
+		f.subjects[subject] = schema
+		f.ids[subject] = f.schemaID(schema.Schema)
+		json.NewEncoder(w).Encode(map[string]int{"id": f.ids[subject]})
+		return
+	}
+
+	_, ok := f.subjects[path]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{"subject": path, "id": f.ids[path], "version": 1})
+}
+
+// schemaID gets the id of the schema, registering it if it is new
+func (f *fakeConfluentRegistry) schemaID(schema string) int {
+	for i, s := range f.schemas {
+		if s == schema {
+			return i + 1
+		}
+	}
+
+	f.schemas = append(f.schemas, schema)
+	return len(f.schemas)
+}
+
+func Test_ConfluentRegistry_Codec(t *testing.T) {
+	fake := &fakeConfluentRegistry{
+		subjects: map[string]fakeSchema{},
+		ids:      map[string]int{},
+	}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	registry := runner.NewConfluentRegistry(server.URL)
+	err := registry.WaitForRegistryToBeReady(time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wrapper := runner.NewProtoWrapper(registry)
+	codec, err := wrapper.Codec("testMesh.testSerial.join", &discoveryv1.Join{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reference, ok := fake.subjects["kafmesh/discovery/v1/topic_definition.proto"]
+	assert.True(t, ok)
+	assert.Equal(t, "PROTOBUF", reference.SchemaType)
+
+	schema, ok := fake.subjects["testMesh.testSerial.join-value"]
+	assert.True(t, ok)
+	assert.Equal(t, "PROTOBUF", schema.SchemaType)
+	assert.Contains(t, schema.Schema, "message Join {")
+	assert.Len(t, schema.References, 1)
+	assert.Equal(t, "kafmesh/discovery/v1/topic_definition.proto", schema.References[0].Subject)
+	assert.Equal(t, 1, schema.References[0].Version)
+
+	message := &discoveryv1.Join{
+		Topic: &discoveryv1.TopicDefinition{
+			Topic:   "testMesh.testSerial.details",
+			Message: "testSerial.details",
+		},
+	}
+
+	b, err := codec.Encode(message)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// magic byte, schema id, one message index of 2 as zig zag varints
+	assert.Equal(t, []byte{0, 0, 0, 0, 2, 2, 4}, b[:7])
+
+	decoded, err := codec.Decode(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, proto.Equal(message, decoded.(*discoveryv1.Join)))
+
+	_, err = codec.Decode([]byte{2, 0, 0, 0, 2, 0})
+	assert.EqualError(t, err, "failed to unframe bytes: unknown magic byte '2'")
+}
@@ -0,0 +1,421 @@
+package runner
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/lovoo/goka"
+	"github.com/lovoo/goka/codec"
+	"github.com/lovoo/goka/storage"
+	"github.com/pkg/errors"
+)
+
+// Headers set on messages sent to a dead letter topic
+const (
+	DeadLetterErrorHeader     = "kafmesh-error"
+	DeadLetterTopicHeader     = "kafmesh-source-topic"
+	DeadLetterPartitionHeader = "kafmesh-source-partition"
+	DeadLetterOffsetHeader    = "kafmesh-source-offset"
+	DeadLetterAttemptsHeader  = "kafmesh-attempts"
+)
+
+// DeadLetter sends messages a processor fails to handle to a dead letter topic. Failed messages are
+// handed to the retry group of the processor, which sends them back to the processor once their backoff
+// has passed, so a failing message does not hold up the other messages of its partition.
+type DeadLetter struct {
+	topic   string
+	policy  RetryPolicy
+	now     func() time.Time
+	retries *retries
+	inputs  map[string]deadLetterInput
+}
+
+type deadLetterInput struct {
+	codec    goka.Codec
+	callback goka.ProcessCallback
+}
+
+// NewDeadLetter creates the dead letter topic of the processor group. Messages are only retried if
+// the policy allows more than one attempt.
+func NewDeadLetter(brokers []string, group string, topic string, policy RetryPolicy, builder storage.Builder) *DeadLetter {
+	d := &DeadLetter{
+		topic:  topic,
+		policy: policy,
+		now:    time.Now,
+		inputs: map[string]deadLetterInput{},
+	}
+
+	if policy.MaxAttempts > 1 {
+		d.retries = newRetries(brokers, group, builder)
+	}
+
+	return d
+}
+
+// Topic is the dead letter topic
+func (d *DeadLetter) Topic() string {
+	return d.topic
+}
+
+// Input is the processor input edge of a topic whose messages are dead lettered. Retried messages of
+// the topic are sent to the callback as well.
+func (d *DeadLetter) Input(topic string, c goka.Codec, callback goka.ProcessCallback) goka.Edge {
+	d.inputs[topic] = deadLetterInput{
+		codec:    c,
+		callback: callback,
+	}
+
+	return goka.Input(goka.Stream(topic), c, callback)
+}
+
+// Edges are the processor edges of the dead letter topic and the retries
+func (d *DeadLetter) Edges() []goka.Edge {
+	edges := []goka.Edge{
+		goka.Output(goka.Stream(d.topic), new(codec.Bytes)),
+	}
+
+	if d.retries == nil {
+		return edges
+	}
+
+	return append(edges,
+		goka.Output(goka.Stream(d.retries.Topic()), new(RetryCodec)),
+		goka.Input(goka.Stream(d.retries.FiredTopic()), new(RetryCodec), d.retried),
+	)
+}
+
+// Handle runs the handler for a message of an input. The side effects of the handler are buffered and
+// only applied if it succeeds, so a failed attempt leaves nothing behind. A failed message is scheduled
+// to be handled again with the retry policy and once the policy gives up it is encoded with the input
+// codec and sent to the dead letter topic with headers describing the failure. An error is only returned
+// if the message could not be retried or dead lettered.
+func (d *DeadLetter) Handle(ctx goka.Context, inputCodec goka.Codec, message interface{}, handler func(ctx goka.Context) error) error {
+	attempts := 1
+	retried, ok := ctx.(*retryContext)
+	if ok {
+		attempts = retried.retry.Attempts + 1
+	}
+
+	attempt := &attemptContext{gokaContext: ctx}
+	err := handler(attempt)
+	if err == nil {
+		attempt.apply()
+		return nil
+	}
+
+	if ctx.Context().Err() != nil {
+		return errors.Wrap(err, "processor stopped before the message was handled")
+	}
+
+	value, encodeErr := inputCodec.Encode(message)
+	if encodeErr != nil {
+		return errors.Wrapf(encodeErr, "failed to encode message for dead letter topic '%s'", d.topic)
+	}
+
+	if d.retries != nil && d.policy.retryable(attempts, err) {
+		ctx.Emit(goka.Stream(d.retries.Topic()), ctx.Key(), &Retry{
+			At:        d.now().Add(d.policy.backoff(attempts)),
+			Topic:     string(ctx.Topic()),
+			Partition: ctx.Partition(),
+			Offset:    ctx.Offset(),
+			Timestamp: ctx.Timestamp(),
+			Headers:   ctx.Headers(),
+			Value:     value,
+			Attempts:  attempts,
+			Error:     err.Error(),
+		})
+
+		return nil
+	}
+
+	headers := map[string][]byte{
+		DeadLetterErrorHeader:     []byte(err.Error()),
+		DeadLetterTopicHeader:     []byte(ctx.Topic()),
+		DeadLetterPartitionHeader: []byte(strconv.FormatInt(int64(ctx.Partition()), 10)),
+		DeadLetterOffsetHeader:    []byte(strconv.FormatInt(ctx.Offset(), 10)),
+		DeadLetterAttemptsHeader:  []byte(strconv.Itoa(attempts)),
+	}
+
+	ctx.Emit(goka.Stream(d.topic), ctx.Key(), value, goka.WithCtxEmitHeaders(headers))
+
+	return nil
+}
+
+// retried sends a retried message to the callback of the input it came from
+func (d *DeadLetter) retried(ctx goka.Context, m interface{}) {
+	retry := m.(*Retry)
+
+	input, ok := d.inputs[retry.Topic]
+	if !ok {
+		ctx.Fail(errors.Errorf("retried message is from unknown input '%s'", retry.Topic))
+	}
+
+	message, err := input.codec.Decode(retry.Value)
+	if err != nil {
+		ctx.Fail(errors.Wrapf(err, "failed to decode retried message from '%s'", retry.Topic))
+	}
+
+	input.callback(&retryContext{gokaContext: ctx, retry: retry}, message)
+}
+
+// test connects the retries to the tester so backoffs pass on the tester clock
+func (d *DeadLetter) test(tester Tester) error {
+	d.now = tester.Now
+	if d.retries == nil {
+		return nil
+	}
+
+	return d.retries.test(tester)
+}
+
+// gokaContext names the embedded goka context of the contexts wrapping it, since goka.Context has a
+// Context method
+type gokaContext = goka.Context
+
+// retryContext is the context of a retried message. It describes the message as it was received
+// from its input.
+type retryContext struct {
+	gokaContext
+	retry *Retry
+}
+
+func (c *retryContext) Topic() goka.Stream {
+	return goka.Stream(c.retry.Topic)
+}
+
+func (c *retryContext) Partition() int32 {
+	return c.retry.Partition
+}
+
+func (c *retryContext) Offset() int64 {
+	return c.retry.Offset
+}
+
+func (c *retryContext) Timestamp() time.Time {
+	return c.retry.Timestamp
+}
+
+func (c *retryContext) Headers() map[string][]byte {
+	return c.retry.Headers
+}
+
+// attemptContext buffers the side effects of an attempt to handle a message so they can be applied
+// once the attempt succeeded. Reads of the group table see the buffered value.
+type attemptContext struct {
+	gokaContext
+	actions  []func()
+	value    interface{}
+	valueSet bool
+}
+
+func (c *attemptContext) Value() interface{} {
+	if c.valueSet {
+		return c.value
+	}
+
+	return c.gokaContext.Value()
+}
+
+func (c *attemptContext) SetValue(value interface{}, options ...goka.ContextOption) {
+	c.value = value
+	c.valueSet = true
+	c.actions = append(c.actions, func() {
+		c.gokaContext.SetValue(value, options...)
+	})
+}
+
+func (c *attemptContext) Delete(options ...goka.ContextOption) {
+	c.value = nil
+	c.valueSet = true
+	c.actions = append(c.actions, func() {
+		c.gokaContext.Delete(options...)
+	})
+}
+
+func (c *attemptContext) Emit(topic goka.Stream, key string, value interface{}, options ...goka.ContextOption) {
+	c.actions = append(c.actions, func() {
+		c.gokaContext.Emit(topic, key, value, options...)
+	})
+}
+
+func (c *attemptContext) Loopback(key string, value interface{}, options ...goka.ContextOption) {
+	c.actions = append(c.actions, func() {
+		c.gokaContext.Loopback(key, value, options...)
+	})
+}
+
+// OnCommit runs the function once the side effects of the context are applied. Side effects of an attempt
+// to handle a message are only applied if the attempt succeeds, any other context applies them right away.
+func OnCommit(ctx goka.Context, fn func()) {
+	attempt, ok := ctx.(*attemptContext)
+	if !ok {
+		fn()
+		return
+	}
+
+	attempt.actions = append(attempt.actions, fn)
+}
+
+func (c *attemptContext) apply() {
+	for _, action := range c.actions {
+		action()
+	}
+}
+
+// Retry is a message a processor failed to handle that is scheduled to be handled again
+type Retry struct {
+	At        time.Time         `json:"at"`
+	Topic     string            `json:"topic,omitempty"`
+	Partition int32             `json:"partition,omitempty"`
+	Offset    int64             `json:"offset,omitempty"`
+	Timestamp time.Time         `json:"timestamp,omitempty"`
+	Headers   map[string][]byte `json:"headers,omitempty"`
+	Value     []byte            `json:"value,omitempty"`
+	Attempts  int               `json:"attempts,omitempty"`
+	Error     string            `json:"error,omitempty"`
+	// Fire is set when the retries of the key due at At should be sent to the processor
+	Fire bool `json:"fire,omitempty"`
+}
+
+// RetryCodec encodes retries
+type RetryCodec struct{}
+
+// Encode encodes a retry
+func (c *RetryCodec) Encode(value interface{}) ([]byte, error) {
+	retry, ok := value.(*Retry)
+	if !ok {
+		return nil, errors.Errorf("expecting value of type '*runner.Retry' got type '%T'", value)
+	}
+
+	return json.Marshal(retry)
+}
+
+// Decode decodes a retry
+func (c *RetryCodec) Decode(data []byte) (interface{}, error) {
+	retry := &Retry{}
+	err := json.Unmarshal(data, retry)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode retry")
+	}
+
+	return retry, nil
+}
+
+// pendingRetriesCodec encodes the retries waiting for their backoff to pass for a key
+type pendingRetriesCodec struct{}
+
+func (c *pendingRetriesCodec) Encode(value interface{}) ([]byte, error) {
+	retries, ok := value.([]*Retry)
+	if !ok {
+		return nil, errors.Errorf("expecting value of type '[]*runner.Retry' got type '%T'", value)
+	}
+
+	return json.Marshal(retries)
+}
+
+func (c *pendingRetriesCodec) Decode(data []byte) (interface{}, error) {
+	retries := []*Retry{}
+	err := json.Unmarshal(data, &retries)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode pending retries")
+	}
+
+	return retries, nil
+}
+
+// retries keeps the messages a processor retries in a compacted table owned by its own consumer group
+// and sends them back to the processor when they are due. Unlike timers a key can have any number of
+// retries pending.
+type retries struct {
+	*scheduledGroup
+}
+
+func newRetries(brokers []string, group string, builder storage.Builder) *retries {
+	r := &retries{}
+	r.scheduledGroup = newScheduledGroup(brokers, group+"-retries", builder, new(RetryCodec), new(pendingRetriesCodec), r.handle, r.fire)
+
+	return r
+}
+
+// handle stores scheduled retries and forwards the retries of the key that are due
+func (r *retries) handle(ctx goka.Context, m interface{}) {
+	retry := m.(*Retry)
+
+	pending, _ := ctx.Value().([]*Retry)
+	if !retry.Fire {
+		ctx.SetValue(append(pending, retry))
+		return
+	}
+
+	due := []*Retry{}
+	waiting := []*Retry{}
+	for _, p := range pending {
+		if p.At.After(retry.At) {
+			waiting = append(waiting, p)
+			continue
+		}
+		due = append(due, p)
+	}
+
+	if len(due) == 0 {
+		return
+	}
+
+	if len(waiting) == 0 {
+		ctx.Delete()
+	} else {
+		ctx.SetValue(waiting)
+	}
+
+	sort.SliceStable(due, func(i, j int) bool {
+		return due[i].At.Before(due[j].At)
+	})
+	for _, d := range due {
+		ctx.Emit(goka.Stream(r.FiredTopic()), ctx.Key(), d)
+	}
+}
+
+// fire sends a fire message for every key with due retries. Keys whose fire message was not handled
+// before the next scan are fired again, which does nothing once the retries were forwarded.
+func (r *retries) fire(emitter *goka.Emitter, storages []storage.Storage, now time.Time) error {
+	due := []string{}
+	for _, s := range storages {
+		it, err := s.Iterator()
+		if err != nil {
+			// the partition was revoked and its storage closed
+			continue
+		}
+
+		for it.Next() {
+			value, err := it.Value()
+			if err != nil {
+				break
+			}
+
+			pending := []*Retry{}
+			err = json.Unmarshal(value, &pending)
+			if err != nil {
+				continue
+			}
+
+			for _, p := range pending {
+				if !p.At.After(now) {
+					due = append(due, string(it.Key()))
+					break
+				}
+			}
+		}
+		it.Release()
+	}
+
+	for _, key := range due {
+		err := emitter.EmitSync(key, &Retry{At: now, Fire: true})
+		if err != nil {
+			return errors.Wrapf(err, "failed to fire retries for key '%s'", key)
+		}
+	}
+
+	return nil
+}
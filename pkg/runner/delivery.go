@@ -0,0 +1,40 @@
+package runner
+
+import (
+	"github.com/Shopify/sarama"
+	"github.com/lovoo/goka"
+)
+
+// ConfigureReadCommitted configures consumers to skip messages from aborted transactions
+// and to only read up to the last stable offset of a partition
+func ConfigureReadCommitted(config *sarama.Config) {
+	config.Consumer.IsolationLevel = sarama.ReadCommitted
+}
+
+// ReadCommittedConfig creates the goka default consumer config that only reads committed messages
+func ReadCommittedConfig() *sarama.Config {
+	config := goka.DefaultConfig()
+	ConfigureReadCommitted(config)
+	return config
+}
+
+// ConfigureIdempotentProducer configures a producer to write each message once to its
+// partition even when it retries, waiting for all in sync replicas to acknowledge it.
+//
+// It is not a transactional producer, sarama does not expose the kafka transaction api
+// yet, so outputs, state and offsets are not committed atomically.
+func ConfigureIdempotentProducer(config *sarama.Config) {
+	config.Producer.Idempotent = true
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Net.MaxOpenRequests = 1
+	if config.Producer.Retry.Max < 1 {
+		config.Producer.Retry.Max = 1
+	}
+}
+
+// IdempotentProducerBuilder builds goka producers configured by ConfigureIdempotentProducer
+func IdempotentProducerBuilder() goka.ProducerBuilder {
+	config := goka.DefaultConfig()
+	ConfigureIdempotentProducer(config)
+	return goka.ProducerBuilderWithConfig(config)
+}
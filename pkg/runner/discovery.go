@@ -30,6 +30,8 @@ type MessageType int
 const (
 	// MessageTypeProtobuf uses protobuf serialization
 	MessageTypeProtobuf MessageType = iota
+	// MessageTypeAvro uses avro serialization
+	MessageTypeAvro
 )
 
 // TopicDiscovery provides topic information for discovery
@@ -54,6 +56,7 @@ type SinkDiscovery struct {
 
 	Name        string
 	Description string
+	GroupName   string
 }
 
 // ViewDiscovery adds view information for discovery
@@ -97,6 +100,8 @@ type ProcessorDiscovery struct {
 	Lookups     []LookupDiscovery
 	Outputs     []OutputDiscovery
 	Persistence *PersistentDiscovery
+	DeadLetter  *DeadLetterDiscovery
+	StreamJoins []StreamJoinDiscovery
 }
 
 // InputDiscovery provides input information for discovery
@@ -124,6 +129,17 @@ type PersistentDiscovery struct {
 	TopicDiscovery
 }
 
+// DeadLetterDiscovery provides dead letter information for discovery
+type DeadLetterDiscovery struct {
+	TopicDiscovery
+}
+
+// StreamJoinDiscovery provides stream join information for discovery
+type StreamJoinDiscovery struct {
+	TopicDiscovery
+	Changelog string
+}
+
 func (s *Service) registerService(service ServiceDiscovery) {
 
 	s.mtx.Lock()
@@ -226,6 +242,37 @@ func (s *Service) RegisterProcessor(processor ProcessorDiscovery) error {
 		}
 	}
 
+	if processor.DeadLetter != nil {
+		t, err := convertMessageType(processor.DeadLetter.Type)
+		if err != nil {
+			return errors.Wrapf(err, "processor '%s' dead letter '%s' has invalid message type", processor.Name, processor.DeadLetter.Topic)
+		}
+
+		proc.DeadLetter = &discoveryv1.DeadLetter{
+			Topic: &discoveryv1.TopicDefinition{
+				Message: processor.DeadLetter.Message,
+				Topic:   processor.DeadLetter.Topic,
+				Type:    t,
+			},
+		}
+	}
+
+	for _, join := range processor.StreamJoins {
+		t, err := convertMessageType(join.Type)
+		if err != nil {
+			return errors.Wrapf(err, "processor '%s' stream join '%s' has invalid message type", processor.Name, join.Topic)
+		}
+
+		proc.StreamJoins = append(proc.StreamJoins, &discoveryv1.StreamJoin{
+			Topic: &discoveryv1.TopicDefinition{
+				Message: join.Message,
+				Topic:   join.Topic,
+				Type:    t,
+			},
+			Changelog: join.Changelog,
+		})
+	}
+
 	component.Processors = append(component.Processors, proc)
 
 	return nil
@@ -281,6 +328,7 @@ func (s *Service) RegisterSink(sink SinkDiscovery) error {
 		},
 		Name:        sink.Name,
 		Description: sink.Description,
+		GroupName:   sink.GroupName,
 	})
 	return nil
 }
@@ -368,6 +416,8 @@ func convertMessageType(messageType MessageType) (discoveryv1.TopicType, error)
 	switch messageType {
 	case MessageTypeProtobuf:
 		return discoveryv1.TopicType_TOPIC_TYPE_PROTOBUF, nil
+	case MessageTypeAvro:
+		return discoveryv1.TopicType_TOPIC_TYPE_AVRO, nil
 	}
 
 	return discoveryv1.TopicType_TOPIC_TYPE_INVALID, errors.Errorf("unknown message type '%d'", messageType)
@@ -20,22 +20,55 @@ type EmitMessage interface {
 type Emitter struct {
 	emitter *goka.Emitter
 	sem     *semaphore.Weighted
+	watch   *SourceWatch
+	tracing *SourceTracing
 
 	criticalFailure chan error
 }
 
+// EmitterOption configures an emitter
+type EmitterOption func(*Emitter)
+
+// WithSourceWatch reports the messages the emitter emits to the observers of the source
+func WithSourceWatch(watch *SourceWatch) EmitterOption {
+	return func(e *Emitter) {
+		e.watch = watch
+	}
+}
+
+// WithSourceTracing creates spans for the messages the emitter emits and sends their trace context in the record headers
+func WithSourceTracing(tracing *SourceTracing) EmitterOption {
+	return func(e *Emitter) {
+		e.tracing = tracing
+	}
+}
+
 // NewEmitter creates a new wrapped goka emitter
-func NewEmitter(emitter *goka.Emitter) *Emitter {
-	return &Emitter{
+func NewEmitter(emitter *goka.Emitter, options ...EmitterOption) *Emitter {
+	e := &Emitter{
 		emitter:         emitter,
 		sem:             semaphore.NewWeighted(1000),
 		criticalFailure: make(chan error),
 	}
+
+	for _, option := range options {
+		option(e)
+	}
+
+	return e
 }
 
 // Emit emits a message and waits for the ack
 func (e *Emitter) Emit(key string, msg interface{}) error {
-	err := e.emitter.EmitSync(key, msg)
+	return e.EmitContext(context.Background(), key, msg)
+}
+
+// EmitContext emits a message and waits for the ack. The span of the message is a child of the span in the context.
+func (e *Emitter) EmitContext(ctx context.Context, key string, msg interface{}) error {
+	headers, done := e.tracing.emit(ctx, key)
+	err := e.emitter.EmitSyncWithHeaders(key, msg, headers)
+	done(err)
+	e.watch.emitted(key, msg, err)
 	if err != nil {
 		var critical error
 		switch err {
@@ -68,21 +101,27 @@ func (e *Emitter) EmitBulk(ctx context.Context, msgs []EmitMessage) error {
 
 	go func() {
 		for _, msg := range msgs {
+			msg := msg
 			err := e.sem.Acquire(ctx, 1)
 			if err != nil {
 				done <- errors.Wrap(err, "failed acquiring semaphore")
 				break
 			}
 
-			p, err := e.emitter.Emit(msg.Key(), msg.Value())
+			headers, sent := e.tracing.emit(ctx, msg.Key())
+			p, err := e.emitter.EmitWithHeaders(msg.Key(), msg.Value(), headers)
 			if err != nil {
 				e.sem.Release(1)
+				sent(err)
+				e.watch.emitted(msg.Key(), msg.Value(), err)
 				done <- errors.Wrap(err, "failed emitting message")
 				break
 			}
 
 			p.Then(func(asyncErr error) {
 				e.sem.Release(1)
+				sent(asyncErr)
+				e.watch.emitted(msg.Key(), msg.Value(), asyncErr)
 				promises <- asyncErr
 			})
 		}
@@ -133,7 +172,15 @@ func (e *Emitter) EmitBulk(ctx context.Context, msgs []EmitMessage) error {
 
 // Delete produces a nil for the key to kafka
 func (e *Emitter) Delete(key string) error {
-	err := e.emitter.EmitSync(key, nil)
+	return e.DeleteContext(context.Background(), key)
+}
+
+// DeleteContext produces a nil for the key to kafka. The span of the message is a child of the span in the context.
+func (e *Emitter) DeleteContext(ctx context.Context, key string) error {
+	headers, done := e.tracing.emit(ctx, key)
+	err := e.emitter.EmitSyncWithHeaders(key, nil, headers)
+	done(err)
+	e.watch.emitted(key, nil, err)
 	if err != nil {
 		var critical error
 		switch err {
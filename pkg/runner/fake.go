@@ -0,0 +1,16 @@
+package runner
+
+import "time"
+
+// FakeOutput is a message a generated fake context recorded being output
+type FakeOutput struct {
+	Topic   string
+	Key     string
+	Message interface{}
+}
+
+// FakeTimer is a timer a generated fake processor context recorded being scheduled
+type FakeTimer struct {
+	Key string
+	At  time.Time
+}
@@ -0,0 +1,252 @@
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+var (
+	healthReportInterval = time.Second
+)
+
+// RunnerState is the lifecycle state of a runner registered with the service
+type RunnerState string
+
+const (
+	// RunnerConfiguring is the state of runners until the service runs them
+	RunnerConfiguring RunnerState = "configuring"
+	// RunnerRecovering is the state of runners that are started but have not caught up yet
+	RunnerRecovering RunnerState = "recovering"
+	// RunnerRunning is the state of runners that are caught up and ready to serve
+	RunnerRunning RunnerState = "running"
+	// RunnerFailed is the state of runners that stopped with an error
+	RunnerFailed RunnerState = "failed"
+)
+
+// RunnerStatus is the state of a runner registered with the service
+type RunnerStatus struct {
+	Name  string      `json:"name"`
+	State RunnerState `json:"state"`
+	Error string      `json:"error,omitempty"`
+}
+
+// RunnerOption configures a runner registered with the service
+type RunnerOption func(*serviceRunner)
+
+// WithRunnerName names the runner in health reports
+func WithRunnerName(name string) RunnerOption {
+	return func(r *serviceRunner) {
+		r.name = name
+	}
+}
+
+// WithHealthPort serves /healthz and /readyz on the port while the service runs
+func WithHealthPort(port int) ServiceOption {
+	return func(s *Service) {
+		s.healthPort = port
+	}
+}
+
+type runnerHealthKey struct{}
+
+// ReportReadiness tells the service how to check whether the runner started with the context has
+// caught up and is ready to serve. Runners that never report readiness are ready once they start.
+func ReportReadiness(ctx context.Context, ready func() bool) {
+	r, ok := ctx.Value(runnerHealthKey{}).(*serviceRunner)
+	if !ok {
+		return
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.ready = ready
+}
+
+type serviceRunner struct {
+	run   func(context.Context) func() error
+	name  string
+	stage RunnerStage
+
+	mtx     sync.Mutex
+	started bool
+	ready   func() bool
+	err     error
+}
+
+func (r *serviceRunner) start(ctx context.Context) func() error {
+	r.mtx.Lock()
+	r.started = true
+	r.mtx.Unlock()
+
+	run := r.run(context.WithValue(ctx, runnerHealthKey{}, r))
+
+	return func() error {
+		err := run()
+		if err != nil {
+			r.mtx.Lock()
+			r.err = err
+			r.mtx.Unlock()
+		}
+
+		return err
+	}
+}
+
+func (r *serviceRunner) status() RunnerStatus {
+	r.mtx.Lock()
+	started, ready, err := r.started, r.ready, r.err
+	r.mtx.Unlock()
+
+	status := RunnerStatus{
+		Name:  r.name,
+		State: RunnerRunning,
+	}
+
+	switch {
+	case err != nil:
+		status.State = RunnerFailed
+		status.Error = err.Error()
+	case !started:
+		status.State = RunnerConfiguring
+	case ready != nil && !ready():
+		status.State = RunnerRecovering
+	}
+
+	return status
+}
+
+// Health gets the state of every runner registered with the service
+func (s *Service) Health() []RunnerStatus {
+	s.mtx.Lock()
+	runners := append([]*serviceRunner{}, s.runners...)
+	s.mtx.Unlock()
+
+	statuses := make([]RunnerStatus, len(runners))
+	for i, r := range runners {
+		statuses[i] = r.status()
+	}
+
+	return statuses
+}
+
+// State gets the state of the service. The service is configuring until it runs, failed when any of
+// its runners failed and recovering until every runner is running.
+func (s *Service) State() RunnerState {
+	return s.state(s.Health())
+}
+
+// HealthHandler serves /healthz, which fails once a runner failed, and /readyz, which only succeeds
+// once every runner is running
+func (s *Service) HealthHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		statuses := s.Health()
+		state := s.state(statuses)
+		writeHealth(w, state, statuses, state != RunnerFailed)
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		statuses := s.Health()
+		state := s.state(statuses)
+		writeHealth(w, state, statuses, state == RunnerRunning)
+	})
+
+	return mux
+}
+
+func (s *Service) serveHealth(ctx context.Context) func() error {
+	return func() error {
+		server := &http.Server{
+			Addr:    fmt.Sprintf(":%d", s.healthPort),
+			Handler: s.HealthHandler(),
+		}
+
+		failed := make(chan error, 1)
+		go func() {
+			err := server.ListenAndServe()
+			if err != nil && err != http.ErrServerClosed {
+				failed <- errors.Wrap(err, "failed to serve health")
+			}
+		}()
+
+		select {
+		case <-ctx.Done():
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			server.Shutdown(shutdownCtx)
+			return nil
+		case err := <-failed:
+			return err
+		}
+	}
+}
+
+func (s *Service) reportHealth(ctx context.Context) func() error {
+	return func() error {
+		ticker := time.NewTicker(healthReportInterval)
+		defer ticker.Stop()
+
+		for {
+			status := grpc_health_v1.HealthCheckResponse_NOT_SERVING
+			if s.State() == RunnerRunning {
+				status = grpc_health_v1.HealthCheckResponse_SERVING
+			}
+			s.health.SetServingStatus("", status)
+
+			select {
+			case <-ctx.Done():
+				s.health.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+				return nil
+			case <-ticker.C:
+			}
+		}
+	}
+}
+
+func (s *Service) state(statuses []RunnerStatus) RunnerState {
+	s.mtx.Lock()
+	running := s.running
+	s.mtx.Unlock()
+
+	state := RunnerRunning
+	if !running {
+		state = RunnerConfiguring
+	}
+
+	for _, status := range statuses {
+		switch status.State {
+		case RunnerFailed:
+			return RunnerFailed
+		case RunnerConfiguring:
+			state = RunnerConfiguring
+		case RunnerRecovering:
+			if state != RunnerConfiguring {
+				state = RunnerRecovering
+			}
+		}
+	}
+
+	return state
+}
+
+func writeHealth(w http.ResponseWriter, state RunnerState, statuses []RunnerStatus, ok bool) {
+	w.Header().Set("Content-Type", "application/json")
+	if !ok {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+
+	json.NewEncoder(w).Encode(struct {
+		State   RunnerState    `json:"state"`
+		Runners []RunnerStatus `json:"runners"`
+	}{
+		State:   state,
+		Runners: statuses,
+	})
+}
@@ -0,0 +1,83 @@
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Service_Health(t *testing.T) {
+	service := &Service{}
+
+	recovered := false
+	failed := make(chan error, 1)
+
+	assert.Nil(t, service.RegisterRunner(func(ctx context.Context) func() error {
+		ReportReadiness(ctx, func() bool { return recovered })
+		return func() error {
+			<-ctx.Done()
+			return nil
+		}
+	}, WithRunnerName("view")))
+	assert.Nil(t, service.RegisterRunner(func(ctx context.Context) func() error {
+		return func() error {
+			return <-failed
+		}
+	}))
+
+	assert.Equal(t, RunnerConfiguring, service.State())
+	assert.Equal(t, []RunnerStatus{
+		{Name: "view", State: RunnerConfiguring},
+		{Name: "runner-1", State: RunnerConfiguring},
+	}, service.Health())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan error)
+	for _, r := range service.runners {
+		go func(run func() error) {
+			done <- run()
+		}(r.start(ctx))
+	}
+	service.running = true
+
+	assert.Equal(t, RunnerRecovering, service.State())
+	assertHealth(t, service, "/healthz", http.StatusOK, RunnerRecovering)
+	assertHealth(t, service, "/readyz", http.StatusServiceUnavailable, RunnerRecovering)
+
+	recovered = true
+
+	assert.Equal(t, RunnerRunning, service.State())
+	assertHealth(t, service, "/readyz", http.StatusOK, RunnerRunning)
+
+	failed <- errors.New("boom")
+	<-done
+
+	assert.Equal(t, []RunnerStatus{
+		{Name: "view", State: RunnerRunning},
+		{Name: "runner-1", State: RunnerFailed, Error: "boom"},
+	}, service.Health())
+	assertHealth(t, service, "/healthz", http.StatusServiceUnavailable, RunnerFailed)
+	assertHealth(t, service, "/readyz", http.StatusServiceUnavailable, RunnerFailed)
+}
+
+func assertHealth(t *testing.T, service *Service, path string, code int, state RunnerState) {
+	t.Helper()
+
+	response := httptest.NewRecorder()
+	service.HealthHandler().ServeHTTP(response, httptest.NewRequest(http.MethodGet, path, nil))
+
+	assert.Equal(t, code, response.Code)
+
+	var body struct {
+		State RunnerState `json:"state"`
+	}
+	assert.Nil(t, json.NewDecoder(response.Body).Decode(&body))
+	assert.Equal(t, state, body.State)
+}
@@ -0,0 +1,116 @@
+package runner
+
+import (
+	"sync"
+
+	"github.com/lovoo/goka/storage"
+)
+
+// memoryStorage is an in memory table storage that can be used from several goroutines at once.
+// Goka's memory storage is not safe to read from a view while the view is updating it.
+type memoryStorage struct {
+	mtx     sync.Mutex
+	storage storage.Storage
+}
+
+// NewMemoryStorage creates an in memory table storage that a processor, its views and tests can
+// use at the same time
+func NewMemoryStorage() storage.Storage {
+	return &memoryStorage{
+		storage: storage.NewMemory(),
+	}
+}
+
+func (s *memoryStorage) Open() error {
+	return nil
+}
+
+func (s *memoryStorage) Close() error {
+	return nil
+}
+
+func (s *memoryStorage) Has(key string) (bool, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.storage.Has(key)
+}
+
+func (s *memoryStorage) Get(key string) ([]byte, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.storage.Get(key)
+}
+
+func (s *memoryStorage) Set(key string, value []byte) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.storage.Set(key, value)
+}
+
+func (s *memoryStorage) Delete(key string) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.storage.Delete(key)
+}
+
+func (s *memoryStorage) GetOffset(def int64) (int64, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.storage.GetOffset(def)
+}
+
+func (s *memoryStorage) SetOffset(offset int64) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.storage.SetOffset(offset)
+}
+
+func (s *memoryStorage) MarkRecovered() error {
+	return nil
+}
+
+// Iterator iterates over a copy of the storage so it can be changed while iterating
+func (s *memoryStorage) Iterator() (storage.Iterator, error) {
+	return s.IteratorWithRange(nil, nil)
+}
+
+// IteratorWithRange iterates over a copy of the keys in the range
+func (s *memoryStorage) IteratorWithRange(start, limit []byte) (storage.Iterator, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	var (
+		it  storage.Iterator
+		err error
+	)
+	if start == nil && limit == nil {
+		it, err = s.storage.Iterator()
+	} else {
+		it, err = s.storage.IteratorWithRange(start, limit)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer it.Release()
+
+	snapshot := storage.NewMemory()
+	for it.Next() {
+		value, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		err = snapshot.Set(string(it.Key()), value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return snapshot.Iterator()
+}
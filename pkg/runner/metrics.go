@@ -1,15 +1,56 @@
 package runner
 
-import "github.com/prometheus/client_golang/prometheus"
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/lovoo/goka"
+	"github.com/prometheus/client_golang/prometheus"
+)
 
 // Metrics handles prometheus metrics
 type Metrics struct {
 	sourceCount  *prometheus.CounterVec
 	sourceErrors *prometheus.CounterVec
+
+	recoveryOffset        *prometheus.GaugeVec
+	recoveryHighWatermark *prometheus.GaugeVec
+	recovered             *prometheus.GaugeVec
+
+	processorMessages *prometheus.CounterVec
+	processorFailures *prometheus.CounterVec
+	processorLatency  *prometheus.HistogramVec
+
+	sinkCollected    *prometheus.CounterVec
+	sinkFlushes      *prometheus.CounterVec
+	sinkFlushLatency *prometheus.HistogramVec
+	sinkBufferSize   *prometheus.GaugeVec
+	sinkRetries      *prometheus.CounterVec
+	sinkGiveUps      *prometheus.CounterVec
+
+	viewRecovered *prometheus.GaugeVec
+	viewLag       *prometheus.GaugeVec
+
+	viewSourceSyncLatency  *prometheus.HistogramVec
+	viewSourceSyncFailures *prometheus.CounterVec
+	viewSourceUpdates      *prometheus.CounterVec
+	viewSourceDeletes      *prometheus.CounterVec
 }
 
 // NewMetrics creates a new metrics handler
 func NewMetrics() *Metrics {
+	return newMetrics(prometheus.DefaultRegisterer)
+}
+
+// WithMetricsRegisterer registers the metrics of the service with the registerer instead of the default prometheus registerer
+func WithMetricsRegisterer(registerer prometheus.Registerer) ServiceOption {
+	return func(s *Service) {
+		s.registerer = registerer
+	}
+}
+
+func newMetrics(registerer prometheus.Registerer) *Metrics {
 	sourceCount := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "kafmesh_source_count",
@@ -17,7 +58,7 @@ func NewMetrics() *Metrics {
 		},
 		[]string{"service", "component", "topic"},
 	)
-	prometheus.MustRegister(sourceCount)
+	registerer.MustRegister(sourceCount)
 
 	sourceErrors := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -26,11 +67,208 @@ func NewMetrics() *Metrics {
 		},
 		[]string{"service", "component", "topic"},
 	)
-	prometheus.MustRegister(sourceErrors)
+	registerer.MustRegister(sourceErrors)
+
+	recoveryLabels := []string{"service", "component", "processor", "table", "partition", "standby"}
+
+	recoveryOffset := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kafmesh_table_recovery_offset",
+			Help: "The offset a local table partition is recovered to.",
+		},
+		recoveryLabels,
+	)
+	registerer.MustRegister(recoveryOffset)
+
+	recoveryHighWatermark := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kafmesh_table_recovery_high_watermark",
+			Help: "The high watermark of a local table partition when it was last recovered.",
+		},
+		recoveryLabels,
+	)
+	registerer.MustRegister(recoveryHighWatermark)
+
+	recovered := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kafmesh_table_recovered",
+			Help: "Whether a local table partition is recovered and running.",
+		},
+		recoveryLabels,
+	)
+	registerer.MustRegister(recovered)
+
+	processorLabels := []string{"service", "component", "processor", "topic"}
+
+	processorMessages := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kafmesh_processor_messages",
+			Help: "Messages a processor handled from an input topic.",
+		},
+		processorLabels,
+	)
+	registerer.MustRegister(processorMessages)
+
+	processorFailures := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kafmesh_processor_failures",
+			Help: "Messages from an input topic a processor failed to handle.",
+		},
+		processorLabels,
+	)
+	registerer.MustRegister(processorFailures)
+
+	processorLatency := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "kafmesh_processor_handle_seconds",
+			Help:    "How long a processor takes to handle a message from an input topic.",
+			Buckets: prometheus.DefBuckets,
+		},
+		processorLabels,
+	)
+	registerer.MustRegister(processorLatency)
+
+	sinkLabels := []string{"service", "component", "sink"}
+
+	sinkCollected := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kafmesh_sink_collected",
+			Help: "Messages a sink collected.",
+		},
+		sinkLabels,
+	)
+	registerer.MustRegister(sinkCollected)
+
+	sinkFlushes := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kafmesh_sink_flushes",
+			Help: "Flushes of a sink, including the ones that failed.",
+		},
+		sinkLabels,
+	)
+	registerer.MustRegister(sinkFlushes)
+
+	sinkFlushLatency := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "kafmesh_sink_flush_seconds",
+			Help:    "How long a sink flush takes, including its retries.",
+			Buckets: prometheus.DefBuckets,
+		},
+		sinkLabels,
+	)
+	registerer.MustRegister(sinkFlushLatency)
+
+	sinkRetries := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kafmesh_sink_flush_retries",
+			Help: "Retries of failed sink flushes.",
+		},
+		sinkLabels,
+	)
+	registerer.MustRegister(sinkRetries)
+
+	sinkGiveUps := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kafmesh_sink_flush_give_ups",
+			Help: "Sink flushes that failed after running out of retries or with a fatal error.",
+		},
+		sinkLabels,
+	)
+	registerer.MustRegister(sinkGiveUps)
+
+	sinkBufferSize := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kafmesh_sink_buffer_size",
+			Help: "Messages a sink collected since its last flush.",
+		},
+		sinkLabels,
+	)
+	registerer.MustRegister(sinkBufferSize)
+
+	viewLabels := []string{"service", "component", "view"}
+
+	viewRecovered := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kafmesh_view_recovered",
+			Help: "Whether every partition of a view is recovered and running.",
+		},
+		viewLabels,
+	)
+	registerer.MustRegister(viewRecovered)
+
+	viewLag := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kafmesh_view_lag",
+			Help: "Messages of the topic of a view that are not in the view yet.",
+		},
+		viewLabels,
+	)
+	registerer.MustRegister(viewLag)
+
+	viewSourceLabels := []string{"service", "component", "view_source"}
+
+	viewSourceSyncLatency := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "kafmesh_view_source_sync_seconds",
+			Help:    "How long a view source sync takes.",
+			Buckets: prometheus.DefBuckets,
+		},
+		viewSourceLabels,
+	)
+	registerer.MustRegister(viewSourceSyncLatency)
+
+	viewSourceSyncFailures := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kafmesh_view_source_sync_failures",
+			Help: "View source syncs that failed.",
+		},
+		viewSourceLabels,
+	)
+	registerer.MustRegister(viewSourceSyncFailures)
+
+	viewSourceUpdates := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kafmesh_view_source_updates",
+			Help: "Keys a view source sync updated.",
+		},
+		viewSourceLabels,
+	)
+	registerer.MustRegister(viewSourceUpdates)
+
+	viewSourceDeletes := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kafmesh_view_source_deletes",
+			Help: "Keys a view source sync deleted.",
+		},
+		viewSourceLabels,
+	)
+	registerer.MustRegister(viewSourceDeletes)
 
 	return &Metrics{
-		sourceCount:  sourceCount,
-		sourceErrors: sourceErrors,
+		sourceCount:           sourceCount,
+		sourceErrors:          sourceErrors,
+		recoveryOffset:        recoveryOffset,
+		recoveryHighWatermark: recoveryHighWatermark,
+		recovered:             recovered,
+
+		processorMessages: processorMessages,
+		processorFailures: processorFailures,
+		processorLatency:  processorLatency,
+
+		sinkCollected:    sinkCollected,
+		sinkFlushes:      sinkFlushes,
+		sinkFlushLatency: sinkFlushLatency,
+		sinkBufferSize:   sinkBufferSize,
+		sinkRetries:      sinkRetries,
+		sinkGiveUps:      sinkGiveUps,
+
+		viewRecovered: viewRecovered,
+		viewLag:       viewLag,
+
+		viewSourceSyncLatency:  viewSourceSyncLatency,
+		viewSourceSyncFailures: viewSourceSyncFailures,
+		viewSourceUpdates:      viewSourceUpdates,
+		viewSourceDeletes:      viewSourceDeletes,
 	}
 }
 
@@ -43,3 +281,179 @@ func (m *Metrics) SourceHit(service, component, topic string, count int) {
 func (m *Metrics) SourceError(service, component, topic string) {
 	m.sourceErrors.WithLabelValues(service, component, topic).Inc()
 }
+
+// TableRecovery records the recovery progress of the local table partitions
+func (m *Metrics) TableRecovery(progress []PartitionRecovery) {
+	for _, p := range progress {
+		labels := []string{p.Service, p.Component, p.Processor, p.Table, strconv.Itoa(int(p.Partition)), strconv.FormatBool(p.Standby)}
+
+		recovered := 0.0
+		if p.Recovered {
+			recovered = 1
+		}
+
+		m.recoveryOffset.WithLabelValues(labels...).Set(float64(p.Offset))
+		m.recoveryHighWatermark.WithLabelValues(labels...).Set(float64(p.HighWatermark))
+		m.recovered.WithLabelValues(labels...).Set(recovered)
+	}
+}
+
+// ProcessorMetrics records the messages a processor handles
+type ProcessorMetrics struct {
+	metrics *Metrics
+	labels  []string
+}
+
+// Processor creates the metrics of the processor of the component
+func (m *Metrics) Processor(service, component, processor string) *ProcessorMetrics {
+	if m == nil {
+		return nil
+	}
+
+	return &ProcessorMetrics{m, []string{service, component, processor}}
+}
+
+// Handled records a message from the topic handled in the duration given
+func (p *ProcessorMetrics) Handled(topic string, duration time.Duration, err error) {
+	if p == nil {
+		return
+	}
+
+	labels := append(append([]string{}, p.labels...), topic)
+	p.metrics.processorMessages.WithLabelValues(labels...).Inc()
+	p.metrics.processorLatency.WithLabelValues(labels...).Observe(duration.Seconds())
+	if err != nil {
+		p.metrics.processorFailures.WithLabelValues(labels...).Inc()
+	}
+}
+
+// SinkMetrics records the messages a sink collects and its flushes
+type SinkMetrics struct {
+	metrics *Metrics
+	labels  []string
+}
+
+// Sink creates the metrics of the sink of the component
+func (m *Metrics) Sink(service, component, sink string) *SinkMetrics {
+	if m == nil {
+		return nil
+	}
+
+	return &SinkMetrics{m, []string{service, component, sink}}
+}
+
+func (s *SinkMetrics) collected(buffered int) {
+	if s == nil {
+		return
+	}
+
+	s.metrics.sinkCollected.WithLabelValues(s.labels...).Inc()
+	s.metrics.sinkBufferSize.WithLabelValues(s.labels...).Set(float64(buffered))
+}
+
+func (s *SinkMetrics) flushed(duration time.Duration, buffered int) {
+	if s == nil {
+		return
+	}
+
+	s.metrics.sinkFlushes.WithLabelValues(s.labels...).Inc()
+	s.metrics.sinkFlushLatency.WithLabelValues(s.labels...).Observe(duration.Seconds())
+	s.metrics.sinkBufferSize.WithLabelValues(s.labels...).Set(float64(buffered))
+}
+
+func (s *SinkMetrics) retried(count int) {
+	if s == nil {
+		return
+	}
+
+	s.metrics.sinkRetries.WithLabelValues(s.labels...).Add(float64(count))
+}
+
+func (s *SinkMetrics) gaveUp() {
+	if s == nil {
+		return
+	}
+
+	s.metrics.sinkGiveUps.WithLabelValues(s.labels...).Inc()
+}
+
+// ViewMetrics records the recovery state and lag of a view
+type ViewMetrics struct {
+	metrics *Metrics
+	labels  []string
+}
+
+// View creates the metrics of the view of the component
+func (m *Metrics) View(service, component, view string) *ViewMetrics {
+	if m == nil {
+		return nil
+	}
+
+	return &ViewMetrics{m, []string{service, component, view}}
+}
+
+// Report records the recovery state and lag of the view until the context is cancelled
+func (v *ViewMetrics) Report(ctx context.Context, view *goka.View) func() error {
+	return func() error {
+		if v == nil {
+			return nil
+		}
+
+		ticker := time.NewTicker(recoveryReportInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+				v.recovery(view.Stats(ctx))
+			}
+		}
+	}
+}
+
+func (v *ViewMetrics) recovery(stats *goka.ViewStats) {
+	recovered := 1.0
+	lag := int64(0)
+	for _, p := range stats.Partitions {
+		if p.Status != goka.PartitionRunning {
+			recovered = 0
+		}
+
+		if p.Recovery != nil && p.Recovery.Hwm > p.Recovery.Offset+1 {
+			lag += p.Recovery.Hwm - p.Recovery.Offset - 1
+		}
+	}
+
+	v.metrics.viewRecovered.WithLabelValues(v.labels...).Set(recovered)
+	v.metrics.viewLag.WithLabelValues(v.labels...).Set(float64(lag))
+}
+
+// ViewSourceMetrics records the sync runs of a view source
+type ViewSourceMetrics struct {
+	metrics *Metrics
+	labels  []string
+}
+
+// ViewSource creates the metrics of the view source of the component
+func (m *Metrics) ViewSource(service, component, viewSource string) *ViewSourceMetrics {
+	if m == nil {
+		return nil
+	}
+
+	return &ViewSourceMetrics{m, []string{service, component, viewSource}}
+}
+
+func (v *ViewSourceMetrics) synced(duration time.Duration, updates, deletes int, err error) {
+	if v == nil {
+		return
+	}
+
+	v.metrics.viewSourceSyncLatency.WithLabelValues(v.labels...).Observe(duration.Seconds())
+	v.metrics.viewSourceUpdates.WithLabelValues(v.labels...).Add(float64(updates))
+	v.metrics.viewSourceDeletes.WithLabelValues(v.labels...).Add(float64(deletes))
+	if err != nil {
+		v.metrics.viewSourceSyncFailures.WithLabelValues(v.labels...).Inc()
+	}
+}
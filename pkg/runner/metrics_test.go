@@ -0,0 +1,131 @@
+package runner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/lovoo/goka"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Metrics_Processor(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	metrics := newMetrics(registry)
+
+	processor := metrics.Processor("service", "component", "processor")
+	processor.Handled("topic1", 10*time.Millisecond, nil)
+	processor.Handled("topic1", 20*time.Millisecond, errors.New("boom"))
+	processor.Handled("topic2", 30*time.Millisecond, nil)
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(metrics.processorMessages.WithLabelValues("service", "component", "processor", "topic1")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.processorMessages.WithLabelValues("service", "component", "processor", "topic2")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.processorFailures.WithLabelValues("service", "component", "processor", "topic1")))
+	assert.Equal(t, 2, testutil.CollectAndCount(metrics.processorLatency, "kafmesh_processor_handle_seconds"))
+
+	var unmeasured *Metrics
+	unmeasured.Processor("service", "component", "processor").Handled("topic1", time.Millisecond, nil)
+}
+
+func Test_Metrics_Sink(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	metrics := newMetrics(registry)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	definition := &watchSinkDefinition{}
+	handler := &sinkHandler{
+		runner:  NewSinkRunner(definition, nil, WithSinkMetrics(metrics.Sink("service", "component", "sink"))),
+		ctx:     ctx,
+		cancel:  cancel,
+		buffers: map[int32]*partitionBuffer{},
+	}
+
+	labels := []string{"service", "component", "sink"}
+	session := &watchSession{}
+
+	handler.collect(MessageContext{}, &sarama.ConsumerMessage{Partition: 0, Offset: 1}, "value1", ignoreFlushed)
+	handler.collect(MessageContext{}, &sarama.ConsumerMessage{Partition: 0, Offset: 2}, "value2", ignoreFlushed)
+	assert.Equal(t, 2.0, testutil.ToFloat64(metrics.sinkCollected.WithLabelValues(labels...)))
+	assert.Equal(t, 2.0, testutil.ToFloat64(metrics.sinkBufferSize.WithLabelValues(labels...)))
+
+	assert.Nil(t, handler.flush(session))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.sinkFlushes.WithLabelValues(labels...)))
+	assert.Equal(t, 0.0, testutil.ToFloat64(metrics.sinkBufferSize.WithLabelValues(labels...)))
+	assert.Equal(t, 1, testutil.CollectAndCount(metrics.sinkFlushLatency, "kafmesh_sink_flush_seconds"))
+
+	definition.err = errors.New("boom")
+	handler.collect(MessageContext{}, &sarama.ConsumerMessage{Partition: 0, Offset: 3}, "value3", ignoreFlushed)
+	assert.NotNil(t, handler.flush(session))
+	assert.Equal(t, 2.0, testutil.ToFloat64(metrics.sinkFlushes.WithLabelValues(labels...)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.sinkBufferSize.WithLabelValues(labels...)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.sinkGiveUps.WithLabelValues(labels...)))
+}
+
+func Test_Metrics_View(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	metrics := newMetrics(registry)
+
+	labels := []string{"service", "component", "view"}
+	view := metrics.View("service", "component", "view")
+
+	view.recovery(&goka.ViewStats{
+		Partitions: map[int32]*goka.TableStats{
+			0: {Status: goka.PartitionRunning, Recovery: &goka.RecoveryStats{Offset: 9, Hwm: 10}},
+			1: {Status: goka.PartitionRecovering, Recovery: &goka.RecoveryStats{Offset: 4, Hwm: 20}},
+		},
+	})
+	assert.Equal(t, 0.0, testutil.ToFloat64(metrics.viewRecovered.WithLabelValues(labels...)))
+	assert.Equal(t, 15.0, testutil.ToFloat64(metrics.viewLag.WithLabelValues(labels...)))
+
+	view.recovery(&goka.ViewStats{
+		Partitions: map[int32]*goka.TableStats{
+			0: {Status: goka.PartitionRunning, Recovery: &goka.RecoveryStats{Offset: 9, Hwm: 10}},
+			1: {Status: goka.PartitionRunning, Recovery: &goka.RecoveryStats{Offset: 19, Hwm: 20}},
+		},
+	})
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.viewRecovered.WithLabelValues(labels...)))
+	assert.Equal(t, 0.0, testutil.ToFloat64(metrics.viewLag.WithLabelValues(labels...)))
+
+	var unmeasured *ViewMetrics
+	assert.Nil(t, unmeasured.Report(context.Background(), nil)())
+}
+
+func Test_Metrics_ViewSource(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	metrics := newMetrics(registry)
+
+	labels := []string{"service", "component", "viewSource"}
+	recorder := newViewSourceRecorder(nil, metrics.ViewSource("service", "component", "viewSource"))
+	recorder.updated("key1", "value1")
+	recorder.updated("key2", "value2")
+	recorder.deleted("key3")
+	recorder.finished(nil)
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(metrics.viewSourceUpdates.WithLabelValues(labels...)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.viewSourceDeletes.WithLabelValues(labels...)))
+	assert.Equal(t, 0.0, testutil.ToFloat64(metrics.viewSourceSyncFailures.WithLabelValues(labels...)))
+
+	newViewSourceRecorder(nil, metrics.ViewSource("service", "component", "viewSource")).finished(errors.New("boom"))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.viewSourceSyncFailures.WithLabelValues(labels...)))
+	assert.Equal(t, 1, testutil.CollectAndCount(metrics.viewSourceSyncLatency, "kafmesh_view_source_sync_seconds"))
+}
+
+func Test_Metrics_TableRecovery(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	metrics := newMetrics(registry)
+
+	metrics.TableRecovery([]PartitionRecovery{
+		{Service: "service", Component: "component", Processor: "processor", Table: "group-table", Partition: 1, Offset: 4, HighWatermark: 5, Recovered: true, Standby: true},
+	})
+
+	labels := []string{"service", "component", "processor", "group-table", "1", "true"}
+	assert.Equal(t, 4.0, testutil.ToFloat64(metrics.recoveryOffset.WithLabelValues(labels...)))
+	assert.Equal(t, 5.0, testutil.ToFloat64(metrics.recoveryHighWatermark.WithLabelValues(labels...)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.recovered.WithLabelValues(labels...)))
+}
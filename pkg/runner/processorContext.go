@@ -7,6 +7,8 @@ import (
 	watchv1 "github.com/syncromatics/kafmesh/internal/protos/kafmesh/watch/v1"
 
 	"github.com/golang/protobuf/ptypes"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // ProcessorContext is a context for processor observability
@@ -17,6 +19,50 @@ type ProcessorContext struct {
 	key       string
 	watcher   *observability.Watcher
 	operation *watchv1.Operation
+	activity  *processorActivity
+	tracing   *tracing
+	span      trace.Span
+	err       error
+}
+
+// Trace starts the span of the message handled from the topic. It continues the trace carried
+// by the record headers of the message.
+func (c *ProcessorContext) Trace(topic string, headers map[string][]byte) {
+	if c.tracing == nil {
+		return
+	}
+
+	c.Context, c.span = c.tracing.start(c.Context, topic+" process", trace.SpanKindConsumer, headers,
+		attribute.String("kafmesh.component", c.component),
+		attribute.String("kafmesh.processor", c.processor),
+		attribute.String("messaging.destination", topic),
+		attribute.String("messaging.kafka.message_key", c.key),
+	)
+}
+
+// Headers gets the record headers that carry the trace context to the outputs
+func (c *ProcessorContext) Headers() map[string][]byte {
+	if c.span == nil {
+		return nil
+	}
+
+	return c.tracing.headers(c.Context)
+}
+
+// Fail registers the error the message failed with
+func (c *ProcessorContext) Fail(err error) {
+	c.err = err
+}
+
+func (c *ProcessorContext) event(name, topic, message string) {
+	if c.span == nil {
+		return
+	}
+
+	c.span.AddEvent(name, trace.WithAttributes(
+		attribute.String("messaging.destination", topic),
+		attribute.String("kafmesh.message", message),
+	))
 }
 
 // Input registers an input
@@ -35,6 +81,8 @@ func (c *ProcessorContext) Input(topic, message, value string) {
 
 // Join registers a join
 func (c *ProcessorContext) Join(topic, message, value string) {
+	c.event("join", topic, message)
+
 	if c.operation == nil {
 		return
 	}
@@ -52,6 +100,8 @@ func (c *ProcessorContext) Join(topic, message, value string) {
 
 // Lookup registers a lookup
 func (c *ProcessorContext) Lookup(topic, message, key, value string) {
+	c.event("lookup", topic, message)
+
 	if c.operation == nil {
 		return
 	}
@@ -70,6 +120,8 @@ func (c *ProcessorContext) Lookup(topic, message, key, value string) {
 
 // GetState registers a persistence get state
 func (c *ProcessorContext) GetState(topic, message, value string) {
+	c.event("get_state", topic, message)
+
 	if c.operation == nil {
 		return
 	}
@@ -87,6 +139,8 @@ func (c *ProcessorContext) GetState(topic, message, value string) {
 
 // SetState registers a persistence set state
 func (c *ProcessorContext) SetState(topic, message, value string) {
+	c.event("set_state", topic, message)
+
 	if c.operation == nil {
 		return
 	}
@@ -104,6 +158,8 @@ func (c *ProcessorContext) SetState(topic, message, value string) {
 
 // Output registers an output
 func (c *ProcessorContext) Output(topic, message, key, value string) {
+	c.event("output", topic, message)
+
 	if c.operation == nil {
 		return
 	}
@@ -120,8 +176,16 @@ func (c *ProcessorContext) Output(topic, message, key, value string) {
 	})
 }
 
-// Finish sends the operation to observers
+// Finish marks the message as handled and sends the operation to observers
 func (c *ProcessorContext) Finish() {
+	if c.activity != nil {
+		c.activity.finish()
+	}
+
+	if c.span != nil {
+		endSpan(c.span, c.err)
+	}
+
 	if c.operation == nil {
 		return
 	}
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
-	"encoding/binary"
 	"io"
 	"reflect"
 	"strings"
@@ -22,11 +21,11 @@ const magicByte byte = 2
 
 // ProtoWrapper is a codec generator for proto schema codecs
 type ProtoWrapper struct {
-	client *Registry
+	client SchemaRegistry
 }
 
 // NewProtoWrapper creates a new proto schema codec ProtoWrapper
-func NewProtoWrapper(registry *Registry) *ProtoWrapper {
+func NewProtoWrapper(registry SchemaRegistry) *ProtoWrapper {
 	return &ProtoWrapper{
 		client: registry,
 	}
@@ -34,8 +33,7 @@ func NewProtoWrapper(registry *Registry) *ProtoWrapper {
 
 // Codec is a goka codec for proto schema objects
 type Codec struct {
-	wrapper     *ProtoWrapper
-	id          uint32
+	format      WireFormat
 	constructor func() Message
 }
 
@@ -51,27 +49,27 @@ func (w *ProtoWrapper) Codec(topic string, message Message) (*Codec, error) {
 		v := reflect.New(t.Type())
 		return v.Interface().(Message)
 	}
-	schema, err := protobuf.ExtractSchema(constructor())
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to extract schema")
-	}
 
-	id, err := w.client.RegisterSchema(topic, schema)
+	format, err := w.client.Register(topic, constructor())
 	if err != nil {
 		return nil, err
 	}
 
 	return &Codec{
-		wrapper:     w,
-		id:          id,
+		format:      format,
 		constructor: constructor,
 	}, nil
 }
 
 // Decode decodes the bytes into a proto object
 func (w *Codec) Decode(data []byte) (interface{}, error) {
+	payload, err := w.format.Unframe(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unframe bytes")
+	}
+
 	obj := w.constructor()
-	err := proto.Unmarshal(data[5:], obj)
+	err = proto.Unmarshal(payload, obj)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to unmarshal bytes")
 	}
@@ -80,22 +78,12 @@ func (w *Codec) Decode(data []byte) (interface{}, error) {
 
 // Encode encodes the proto object into bytes
 func (w *Codec) Encode(message interface{}) ([]byte, error) {
-	bytes := make([]byte, 5)
-	bytes[0] = magicByte
-
-	idBytes := make([]byte, 4)
-	binary.BigEndian.PutUint32(idBytes, w.id)
-
-	for i, b := range idBytes {
-		bytes[i+1] = b
-	}
-
 	m := message.(Message)
 	b, err := proto.Marshal(m)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to marshal object")
 	}
-	return append(bytes, b...), nil
+	return w.format.Frame(b), nil
 }
 
 // Registry is the proto schema registry api
@@ -103,9 +91,10 @@ type Registry struct {
 	client v1.RegistryAPIClient
 }
 
-// NewRegistry creates a new proto schema registry
-func NewRegistry(url string) (*Registry, error) {
-	con, err := grpc.Dial(url, grpc.WithInsecure())
+// NewRegistry creates a new proto schema registry. The dial options are added to the
+// insecure connection to the registry at the url.
+func NewRegistry(url string, options ...grpc.DialOption) (*Registry, error) {
+	con, err := grpc.Dial(url, append([]grpc.DialOption{grpc.WithInsecure()}, options...)...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to dial server")
 	}
@@ -130,6 +119,21 @@ func (r *Registry) WaitForRegistryToBeReady(timeout time.Duration) error {
 	return errors.Wrap(err, "timed out waiting for registry to become ready")
 }
 
+// Register registers the message schema with the proto schema registry
+func (r *Registry) Register(topic string, message Message) (WireFormat, error) {
+	schema, err := protobuf.ExtractSchema(message)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to extract schema")
+	}
+
+	id, err := r.RegisterSchema(topic, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	return protoSchemaRegistryFormat{id: id}, nil
+}
+
 // RegisterSchema registers the proto schema with the proto schema registry
 func (r *Registry) RegisterSchema(topic, schema string) (uint32, error) {
 	var b bytes.Buffer
@@ -2,6 +2,7 @@ package runner
 
 import (
 	"context"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/lovoo/goka"
@@ -14,16 +15,18 @@ type ProtoViewSourceJob struct {
 	view     *goka.View
 	emitter  *Emitter
 	keysSeen map[string]struct{}
+	run      *viewSourceRecorder
 }
 
 // NewProtoViewSourceJob creates a new proto view source job
-func NewProtoViewSourceJob(ctx context.Context, view *goka.View, emitter *Emitter) *ProtoViewSourceJob {
+func NewProtoViewSourceJob(ctx context.Context, view *goka.View, emitter *Emitter, watch *ViewSourceWatch, metrics *ViewSourceMetrics) *ProtoViewSourceJob {
 	keysSeen := map[string]struct{}{}
 	return &ProtoViewSourceJob{
 		ctx,
 		view,
 		emitter,
 		keysSeen,
+		newViewSourceRecorder(watch, metrics),
 	}
 }
 
@@ -47,38 +50,83 @@ func (s *ProtoViewSourceJob) Update(key string, msg proto.Message) error {
 		return nil
 	}
 
-	err = s.emitter.Emit(key, msg)
+	err = s.emitter.EmitContext(s.Context, key, msg)
 	if err != nil {
 		return errors.Wrap(err, "failed to emit update")
 	}
+	s.run.updated(key, msg)
 
 	return nil
 }
 
 // Finish the job and run deletes
 func (s *ProtoViewSourceJob) Finish() error {
-	currentKeys, err := s.keys()
+	err := deleteUnseenKeys(s.Context, s.view, s.emitter, s.keysSeen, s.run)
+	s.run.finished(err)
+	return err
+}
+
+// Failed reports a sync run that failed before it finished
+func (s *ProtoViewSourceJob) Failed(err error) {
+	s.run.finished(err)
+}
+
+// viewSourceRecorder reports a sync run to observers and records it in the metrics
+type viewSourceRecorder struct {
+	run     *viewSourceRun
+	metrics *ViewSourceMetrics
+	start   time.Time
+	updates int
+	deletes int
+}
+
+func newViewSourceRecorder(watch *ViewSourceWatch, metrics *ViewSourceMetrics) *viewSourceRecorder {
+	return &viewSourceRecorder{
+		run:     watch.start(),
+		metrics: metrics,
+		start:   time.Now(),
+	}
+}
+
+func (r *viewSourceRecorder) updated(key string, msg interface{}) {
+	r.updates++
+	r.run.updated(key, msg)
+}
+
+func (r *viewSourceRecorder) deleted(key string) {
+	r.deletes++
+	r.run.deleted(key)
+}
+
+func (r *viewSourceRecorder) finished(err error) {
+	r.run.finished(err)
+	r.metrics.synced(time.Since(r.start), r.updates, r.deletes, err)
+}
+
+func deleteUnseenKeys(ctx context.Context, view *goka.View, emitter *Emitter, keysSeen map[string]struct{}, run *viewSourceRecorder) error {
+	currentKeys, err := viewKeys(view)
 	if err != nil {
 		return errors.Wrap(err, "failed to get current keys")
 	}
 
 	for _, k := range currentKeys {
-		_, ok := s.keysSeen[k]
+		_, ok := keysSeen[k]
 		if ok {
 			continue
 		}
 
-		err = s.emitter.Delete(k)
+		err = emitter.DeleteContext(ctx, k)
 		if err != nil {
 			return errors.Wrap(err, "failed to delete key")
 		}
+		run.deleted(k)
 	}
 
 	return nil
 }
 
-func (s *ProtoViewSourceJob) keys() ([]string, error) {
-	it, err := s.view.Iterator()
+func viewKeys(view *goka.View) ([]string, error) {
+	it, err := view.Iterator()
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get iterator")
 	}
@@ -0,0 +1,146 @@
+package runner
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	discoveryv1 "github.com/syncromatics/kafmesh/internal/protos/kafmesh/discovery/v1"
+
+	"github.com/lovoo/goka"
+)
+
+var (
+	recoveryReportInterval = 10 * time.Second
+)
+
+// PartitionRecovery is the recovery progress of a partition of a table kept in local storage
+type PartitionRecovery struct {
+	Service       string
+	Component     string
+	Processor     string
+	Table         string
+	Partition     int32
+	Offset        int64
+	HighWatermark int64
+	Recovered     bool
+	// Standby is set for the standby copies of a processor group table
+	Standby bool
+}
+
+// RecoverySource reports the recovery progress of the tables of a job
+type RecoverySource func(ctx context.Context) []PartitionRecovery
+
+// ProcessorRecovery reports the recovery progress of the group table, joins and lookups of the processor
+func ProcessorRecovery(service, component, name, table string, processor *goka.Processor) RecoverySource {
+	return func(ctx context.Context) []PartitionRecovery {
+		stats := processor.StatsWithContext(ctx)
+
+		progress := []PartitionRecovery{}
+		for partition, p := range stats.Group {
+			if p.TableStats != nil {
+				progress = append(progress, tableRecovery(service, component, name, table, partition, p.TableStats, false))
+			}
+
+			for topic, join := range p.Joined {
+				progress = append(progress, tableRecovery(service, component, name, topic, partition, join, false))
+			}
+		}
+
+		for topic, lookup := range stats.Lookup {
+			for partition, p := range lookup.Partitions {
+				progress = append(progress, tableRecovery(service, component, name, topic, partition, p, false))
+			}
+		}
+
+		return progress
+	}
+}
+
+func tableRecovery(service, component, processor, table string, partition int32, stats *goka.TableStats, standby bool) PartitionRecovery {
+	progress := PartitionRecovery{
+		Service:   service,
+		Component: component,
+		Processor: processor,
+		Table:     table,
+		Partition: partition,
+		Recovered: stats.Status == goka.PartitionRunning,
+		Standby:   standby,
+	}
+
+	if stats.Recovery != nil {
+		progress.Offset = stats.Recovery.Offset
+		progress.HighWatermark = stats.Recovery.Hwm
+	}
+
+	return progress
+}
+
+// RegisterRecovery registers a source of table recovery progress with the service
+func (s *Service) RegisterRecovery(source RecoverySource) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.recovery = append(s.recovery, source)
+}
+
+// RecoveryProgress reports the recovery progress of every table partition kept in local storage
+func (s *Service) RecoveryProgress(ctx context.Context) []PartitionRecovery {
+	s.mtx.Lock()
+	sources := append([]RecoverySource{}, s.recovery...)
+	s.mtx.Unlock()
+
+	progress := []PartitionRecovery{}
+	for _, source := range sources {
+		progress = append(progress, source(ctx)...)
+	}
+
+	sort.Slice(progress, func(i, j int) bool {
+		a, b := progress[i], progress[j]
+		if a.Table != b.Table {
+			return a.Table < b.Table
+		}
+		if a.Standby != b.Standby {
+			return !a.Standby
+		}
+		return a.Partition < b.Partition
+	})
+
+	return progress
+}
+
+func (s *Service) recoveryProgressProtos(ctx context.Context) []*discoveryv1.PartitionRecovery {
+	progress := s.RecoveryProgress(ctx)
+
+	partitions := make([]*discoveryv1.PartitionRecovery, len(progress))
+	for i, p := range progress {
+		partitions[i] = &discoveryv1.PartitionRecovery{
+			Component:     p.Component,
+			Processor:     p.Processor,
+			Table:         p.Table,
+			Partition:     p.Partition,
+			Offset:        p.Offset,
+			HighWatermark: p.HighWatermark,
+			Recovered:     p.Recovered,
+			Standby:       p.Standby,
+		}
+	}
+
+	return partitions
+}
+
+func (s *Service) reportRecovery(ctx context.Context) func() error {
+	return func() error {
+		ticker := time.NewTicker(recoveryReportInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+				s.Metrics.TableRecovery(s.RecoveryProgress(ctx))
+			}
+		}
+	}
+}
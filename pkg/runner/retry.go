@@ -0,0 +1,75 @@
+package runner
+
+import (
+	"context"
+	"time"
+)
+
+// MinRetryBackoff is the shortest delay between retries so a policy without a backoff doesn't retry in a tight loop
+const MinRetryBackoff = 100 * time.Millisecond
+
+// RetryPolicy describes how a failing operation is retried
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first one.
+	// Anything less than one is treated as a single attempt.
+	MaxAttempts int
+	// Backoff is the delay before the first retry and doubles for each retry after it.
+	// Delays shorter than MinRetryBackoff are raised to it.
+	Backoff time.Duration
+	// MaxBackoff caps the delay between retries if it is set
+	MaxBackoff time.Duration
+	// Retryable classifies errors as retryable or fatal. All errors are retried if it is not set.
+	Retryable func(error) bool
+}
+
+// Do runs the operation until it succeeds, runs out of attempts or the context is done.
+// It returns the number of attempts made and the last error.
+func (p RetryPolicy) Do(ctx context.Context, operation func() error) (int, error) {
+	attempt := 0
+	for {
+		attempt++
+		err := operation()
+		if err == nil {
+			return attempt, nil
+		}
+
+		if !p.retryable(attempt, err) {
+			return attempt, err
+		}
+
+		timer := time.NewTimer(p.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return attempt, err
+		case <-timer.C:
+		}
+	}
+}
+
+// retryable checks the error of the attempt can be retried
+func (p RetryPolicy) retryable(attempt int, err error) bool {
+	if attempt >= p.MaxAttempts {
+		return false
+	}
+
+	return p.Retryable == nil || p.Retryable(err)
+}
+
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	backoff := p.Backoff
+	for i := 1; i < attempt; i++ {
+		backoff *= 2
+		if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+			break
+		}
+	}
+
+	if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+	if backoff < MinRetryBackoff {
+		return MinRetryBackoff
+	}
+	return backoff
+}
@@ -0,0 +1,73 @@
+package runner_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/syncromatics/kafmesh/pkg/runner"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RetryPolicy_Do(t *testing.T) {
+	policy := runner.RetryPolicy{
+		MaxAttempts: 3,
+		Backoff:     time.Millisecond,
+		MaxBackoff:  2 * time.Millisecond,
+	}
+
+	calls := 0
+	attempts, err := policy.Do(context.Background(), func() error {
+		calls++
+		if calls < 2 {
+			return errors.New("failed")
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, attempts)
+
+	calls = 0
+	attempts, err = policy.Do(context.Background(), func() error {
+		calls++
+		return errors.Errorf("failed %d", calls)
+	})
+	assert.EqualError(t, err, "failed 3")
+	assert.Equal(t, 3, attempts)
+
+	fatal := errors.New("fatal")
+	policy.Retryable = func(err error) bool {
+		return err != fatal
+	}
+
+	attempts, err = policy.Do(context.Background(), func() error {
+		return fatal
+	})
+	assert.Equal(t, fatal, err)
+	assert.Equal(t, 1, attempts)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	attempts, err = policy.Do(ctx, func() error {
+		return errors.New("failed")
+	})
+	assert.EqualError(t, err, "failed")
+	assert.Equal(t, 1, attempts)
+}
+
+func Test_RetryPolicy_MinBackoff(t *testing.T) {
+	policy := runner.RetryPolicy{
+		MaxAttempts: 2,
+	}
+
+	start := time.Now()
+	attempts, err := policy.Do(context.Background(), func() error {
+		return errors.New("failed")
+	})
+	assert.EqualError(t, err, "failed")
+	assert.Equal(t, 2, attempts)
+	assert.True(t, time.Since(start) >= runner.MinRetryBackoff)
+}
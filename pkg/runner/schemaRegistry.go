@@ -0,0 +1,103 @@
+package runner
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// SchemaRegistry registers protobuf schemas and provides the wire format
+// messages are framed with on kafka
+type SchemaRegistry interface {
+	// WaitForRegistryToBeReady waits for the registry to start responding
+	WaitForRegistryToBeReady(timeout time.Duration) error
+	// Register registers the message schema for the topic
+	Register(topic string, message Message) (WireFormat, error)
+}
+
+// WireFormat frames serialized messages with the schema registry header
+type WireFormat interface {
+	// Frame prefixes the serialized message with the schema header
+	Frame(payload []byte) []byte
+	// Unframe strips the schema header from the framed message
+	Unframe(data []byte) ([]byte, error)
+}
+
+type protoSchemaRegistryFormat struct {
+	id uint32
+}
+
+func (f protoSchemaRegistryFormat) Frame(payload []byte) []byte {
+	bytes := make([]byte, 5, 5+len(payload))
+	bytes[0] = magicByte
+	binary.BigEndian.PutUint32(bytes[1:], f.id)
+	return append(bytes, payload...)
+}
+
+func (f protoSchemaRegistryFormat) Unframe(data []byte) ([]byte, error) {
+	if len(data) < 5 {
+		return nil, errors.Errorf("message is too short to contain a schema id")
+	}
+	if data[0] != magicByte {
+		return nil, errors.Errorf("unknown magic byte '%d'", data[0])
+	}
+	return data[5:], nil
+}
+
+// confluentProtobufFormat is the confluent wire format for protobuf messages. The
+// schema id is followed by the path of message indexes to the type in the schema
+// file, with the common case of the first message written as a single zero.
+type confluentProtobufFormat struct {
+	id      uint32
+	indexes []int
+}
+
+func (f confluentProtobufFormat) header() []byte {
+	bytes := make([]byte, 5, 5+binary.MaxVarintLen64*(len(f.indexes)+1))
+	bytes[0] = confluentMagicByte
+	binary.BigEndian.PutUint32(bytes[1:], f.id)
+
+	if len(f.indexes) == 1 && f.indexes[0] == 0 {
+		return append(bytes, 0)
+	}
+
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutVarint(buf, int64(len(f.indexes)))
+	bytes = append(bytes, buf[:n]...)
+	for _, i := range f.indexes {
+		n = binary.PutVarint(buf, int64(i))
+		bytes = append(bytes, buf[:n]...)
+	}
+	return bytes
+}
+
+func (f confluentProtobufFormat) Frame(payload []byte) []byte {
+	return append(f.header(), payload...)
+}
+
+func (f confluentProtobufFormat) Unframe(data []byte) ([]byte, error) {
+	if len(data) < 6 {
+		return nil, errors.Errorf("message is too short to contain a schema id and message indexes")
+	}
+	if data[0] != confluentMagicByte {
+		return nil, errors.Errorf("unknown magic byte '%d'", data[0])
+	}
+
+	data = data[5:]
+	count, n := binary.Varint(data)
+	if n <= 0 || count < 0 {
+		return nil, errors.Errorf("invalid message index count")
+	}
+	data = data[n:]
+
+	for i := int64(0); i < count; i++ {
+		_, n = binary.Varint(data)
+		if n <= 0 {
+			return nil, errors.Errorf("invalid message index")
+		}
+		data = data[n:]
+	}
+
+	return data, nil
+}
@@ -2,6 +2,7 @@ package runner
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -13,8 +14,11 @@ import (
 
 	"github.com/Shopify/sarama"
 	"github.com/pkg/errors"
-	"golang.org/x/sync/errgroup"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/syncromatics/go-kit/log"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
 )
 
 // KafaConfigurator configures the kafka topics require to run the service
@@ -24,47 +28,104 @@ type KafaConfigurator func(ctx context.Context, brokers []string) error
 type ServiceOptions struct {
 	Brokers      []string
 	ProtoWrapper *ProtoWrapper
+	AvroWrapper  *AvroWrapper
+	Metrics      *Metrics
+	Storage      StorageConfig
+
+	watcher *observability.Watcher
+	tracing *tracing
+	tester  Tester
+}
+
+// ServiceOption configures optional features of the service
+type ServiceOption func(*Service)
+
+// WithAvroRegistry enables avro codecs backed by the confluent compatible schema registry
+func WithAvroRegistry(registry *AvroRegistry) ServiceOption {
+	return func(s *Service) {
+		s.avroWrapper = NewAvroWrapper(registry)
+	}
 }
 
 // Service is the kafmesh service
 type Service struct {
 	brokers      []string
 	protoWrapper *ProtoWrapper
+	avroWrapper  *AvroWrapper
 	server       *grpc.Server
 	Metrics      *Metrics
 	watcher      *observability.Watcher
+	tracing      *tracing
+	storage      StorageConfig
+	health       *health.Server
+	healthPort   int
+	shutdown     ShutdownConfig
+	activity     *processorActivity
+	tester       Tester
+	registerer   prometheus.Registerer
 
 	mtx          sync.Mutex
 	configured   bool
 	running      bool
-	runners      []func(context.Context) func() error
+	runners      []*serviceRunner
+	recovery     []RecoverySource
 	DiscoverInfo *discoveryv1.Service
 }
 
 // NewService creates a new kafmesh service
-func NewService(brokers []string, protoRegistry *Registry, grpcServer *grpc.Server) *Service {
+func NewService(brokers []string, protoRegistry SchemaRegistry, grpcServer *grpc.Server, options ...ServiceOption) *Service {
 	service := &Service{
 		brokers:      brokers,
 		protoWrapper: NewProtoWrapper(protoRegistry),
 		server:       grpcServer,
 		DiscoverInfo: &discoveryv1.Service{},
-		Metrics:      NewMetrics(),
 		watcher:      &observability.Watcher{},
+		storage:      DefaultStorageConfig(),
+		health:       health.NewServer(),
+		shutdown:     DefaultShutdownConfig(),
+		activity:     &processorActivity{},
+	}
+
+	for _, option := range options {
+		option(service)
+	}
+
+	// services run against a tester keep their metrics to themselves so tests can create more than one
+	registerer := service.registerer
+	if registerer == nil && service.tester != nil {
+		registerer = prometheus.NewRegistry()
+	}
+	if registerer == nil {
+		registerer = prometheus.DefaultRegisterer
 	}
+	service.Metrics = newMetrics(registerer)
 
 	pingv1.RegisterPingAPIServer(grpcServer, &services.PingAPI{})
-	discoveryv1.RegisterDiscoveryAPIServer(grpcServer, &services.DiscoverAPI{DiscoverInfo: service.DiscoverInfo})
+	discoveryv1.RegisterDiscoveryAPIServer(grpcServer, &services.DiscoverAPI{
+		DiscoverInfo: service.DiscoverInfo,
+		Recovery:     service.recoveryProgressProtos,
+	})
 	watchv1.RegisterWatchAPIServer(grpcServer, &services.WatcherService{Watcher: service.watcher})
 
+	service.health.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	grpc_health_v1.RegisterHealthServer(grpcServer, service.health)
+
 	return service
 }
 
 // ConfigureKafka waits for kafka to be ready and configures the topics
 // for this service. It will also check if topics it doesn't create exist
-// in the correct configuration.
+// in the correct configuration. There is nothing to configure when the
+// service runs against a tester.
 func (s *Service) ConfigureKafka(ctx context.Context, configurator KafaConfigurator) error {
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
+
+	if s.tester != nil {
+		s.configured = true
+		return nil
+	}
+
 	err := s.waitForKafkaToBeReady(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to talk to kafka")
@@ -80,40 +141,65 @@ func (s *Service) ConfigureKafka(ctx context.Context, configurator KafaConfigura
 	return nil
 }
 
-// Run executes the kafmesh services
+// Run executes the kafmesh services. When the context is cancelled or a runner fails the service
+// shuts down in stages: sources are stopped first, processors drain and stop, sinks flush and
+// stop and views are closed last.
 func (s *Service) Run(ctx context.Context) func() error {
-	c, cancel := context.WithCancel(ctx)
-	grp, c := errgroup.WithContext(c)
-
 	return func() error {
 		s.mtx.Lock()
 		if !s.configured {
+			s.mtx.Unlock()
 			return errors.Errorf("ConfigureKafka was never called. Please call ConfigureKafka first")
 		}
 
 		if s.running {
+			s.mtx.Unlock()
 			return errors.Errorf("Run can only be called once.")
 		}
 
+		failed := make(chan error, 1)
+
+		groups := map[RunnerStage]*runnerGroup{}
+		for _, stage := range shutdownStages {
+			groups[stage] = newRunnerGroup()
+		}
+
 		for _, r := range s.runners {
-			grp.Go(r(c))
+			g, ok := groups[r.stage]
+			if !ok {
+				g = groups[StageProcessors]
+			}
+			g.start(r.start(g.ctx), failed)
+		}
+
+		background := newRunnerGroup()
+		background.start(s.reportRecovery(background.ctx), failed)
+		background.start(s.reportHealth(background.ctx), failed)
+		if s.healthPort != 0 {
+			background.start(s.serveHealth(background.ctx), failed)
 		}
+
 		s.running = true
 		s.mtx.Unlock()
 
+		var err error
 		select {
 		case <-ctx.Done():
-			cancel()
-			return nil
-		case <-c.Done():
-			cancel()
-			return grp.Wait()
+		case err = <-failed:
+			log.Error("kafmesh runner failed", "error", err)
+		}
+
+		stopErr := s.stop(groups, background)
+		if err != nil {
+			return err
 		}
+
+		return stopErr
 	}
 }
 
 // RegisterRunner registers a runner with the service. Will return error if service is running
-func (s *Service) RegisterRunner(runner func(context.Context) func() error) error {
+func (s *Service) RegisterRunner(runner func(context.Context) func() error, options ...RunnerOption) error {
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
 
@@ -121,15 +207,77 @@ func (s *Service) RegisterRunner(runner func(context.Context) func() error) erro
 		return errors.Errorf("failed to register running because service is already running")
 	}
 
-	s.runners = append(s.runners, runner)
+	r := &serviceRunner{
+		run:   runner,
+		name:  fmt.Sprintf("runner-%d", len(s.runners)),
+		stage: StageProcessors,
+	}
+	for _, option := range options {
+		option(r)
+	}
+
+	s.runners = append(s.runners, r)
 	return nil
 }
 
+// RegisterTimers registers the runner that fires the timers of a processor
+func (s *Service) RegisterTimers(timers *Timers) error {
+	if s.tester != nil {
+		err := timers.test(s.tester)
+		if err != nil {
+			return errors.Wrap(err, "failed to create timers for tester")
+		}
+	}
+
+	return s.RegisterRunner(timers.Run, WithRunnerName(timers.Topic()))
+}
+
+// RegisterDeadLetter registers the runner that sends the retries of a processor back to it once they are due
+func (s *Service) RegisterDeadLetter(deadLetter *DeadLetter) error {
+	if s.tester != nil {
+		err := deadLetter.test(s.tester)
+		if err != nil {
+			return errors.Wrap(err, "failed to create dead letter retries for tester")
+		}
+	}
+
+	if deadLetter.retries == nil {
+		return nil
+	}
+
+	return s.RegisterRunner(deadLetter.retries.Run, WithRunnerName(deadLetter.retries.Topic()))
+}
+
+// RegisterStreamJoin registers the runner that closes the windowed stores of a processor stream join
+func (s *Service) RegisterStreamJoin(join *StreamJoin) error {
+	if s.tester != nil {
+		join.test(s.tester)
+	}
+
+	return s.RegisterRunner(join.Run, WithRunnerName(join.Name()))
+}
+
+// RegisterStandby registers the runner that keeps the standby copy of a processor group table. The
+// standby is not run against a tester since the tester already keeps the group tables in memory.
+func (s *Service) RegisterStandby(standby *Standby, options ...RunnerOption) error {
+	if s.tester != nil {
+		return nil
+	}
+
+	return s.RegisterRunner(standby.Run, append([]RunnerOption{WithRunnerStage(StageViews)}, options...)...)
+}
+
 // Options returns service options for runners
 func (s *Service) Options() ServiceOptions {
 	return ServiceOptions{
 		Brokers:      s.brokers,
 		ProtoWrapper: s.protoWrapper,
+		AvroWrapper:  s.avroWrapper,
+		Metrics:      s.Metrics,
+		Storage:      s.storage,
+		watcher:      s.watcher,
+		tracing:      s.tracing,
+		tester:       s.tester,
 	}
 }
 
@@ -176,10 +324,14 @@ func (s *Service) ProcessorContext(ctx context.Context, component, processor, ke
 		operation = &watchv1.Operation{}
 	}
 
+	s.activity.start()
+
 	return &ProcessorContext{
 		Context:   ctx,
+		activity:  s.activity,
 		operation: operation,
 		watcher:   s.watcher,
+		tracing:   s.tracing,
 		component: component,
 		processor: processor,
 		key:       key,
@@ -0,0 +1,220 @@
+package runner
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/syncromatics/go-kit/log"
+)
+
+// RunnerStage is the stage of the shutdown sequence a runner is stopped in
+type RunnerStage int
+
+const (
+	// StageSources stops the runners that emit into kafka from outside of it, such as sources and view sources
+	StageSources RunnerStage = iota
+	// StageProcessors stops processors once they drained what the sources emitted
+	StageProcessors
+	// StageSinks stops sinks, which flush and commit everything they collected
+	StageSinks
+	// StageViews stops views last so they keep serving until everything else stopped
+	StageViews
+)
+
+var (
+	shutdownStages = []RunnerStage{StageSources, StageProcessors, StageSinks, StageViews}
+)
+
+func (s RunnerStage) String() string {
+	switch s {
+	case StageSources:
+		return "sources"
+	case StageProcessors:
+		return "processors"
+	case StageSinks:
+		return "sinks"
+	case StageViews:
+		return "views"
+	}
+
+	return "unknown"
+}
+
+// WithRunnerStage sets the stage of the shutdown sequence the runner is stopped in. Runners are
+// stopped with the processors by default.
+func WithRunnerStage(stage RunnerStage) RunnerOption {
+	return func(r *serviceRunner) {
+		r.stage = stage
+	}
+}
+
+// ShutdownConfig configures how the service shuts down
+type ShutdownConfig struct {
+	// Timeout is the deadline for the whole shutdown sequence. Runners still running after it are
+	// cancelled at once.
+	Timeout time.Duration
+	// Quiet is how long processors must not have started a message to be considered drained
+	Quiet time.Duration
+	// Drain is the longest processors are given to drain before they are stopped anyway
+	Drain time.Duration
+}
+
+// DefaultShutdownConfig gives the shutdown 30 seconds and processors up to 10 seconds to be quiet for 500 milliseconds
+func DefaultShutdownConfig() ShutdownConfig {
+	return ShutdownConfig{
+		Timeout: 30 * time.Second,
+		Quiet:   500 * time.Millisecond,
+		Drain:   10 * time.Second,
+	}
+}
+
+// WithShutdown configures how the service shuts down
+func WithShutdown(config ShutdownConfig) ServiceOption {
+	return func(s *Service) {
+		s.shutdown = config
+	}
+}
+
+// processorActivity tracks the messages processors of the service are handling
+type processorActivity struct {
+	inFlight int64
+	last     int64
+}
+
+func (a *processorActivity) start() {
+	atomic.AddInt64(&a.inFlight, 1)
+	atomic.StoreInt64(&a.last, time.Now().UnixNano())
+}
+
+func (a *processorActivity) finish() {
+	atomic.AddInt64(&a.inFlight, -1)
+}
+
+func (a *processorActivity) quiet(period time.Duration) bool {
+	if atomic.LoadInt64(&a.inFlight) > 0 {
+		return false
+	}
+
+	return time.Since(time.Unix(0, atomic.LoadInt64(&a.last))) >= period
+}
+
+// runnerGroup runs the runners of a shutdown stage
+type runnerGroup struct {
+	ctx    context.Context
+	cancel func()
+	wg     sync.WaitGroup
+	count  int
+}
+
+func newRunnerGroup() *runnerGroup {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &runnerGroup{
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+func (g *runnerGroup) start(run func() error, failed chan<- error) {
+	g.count++
+	g.wg.Add(1)
+
+	go func() {
+		defer g.wg.Done()
+
+		err := run()
+		if err != nil {
+			select {
+			case failed <- err:
+			default:
+			}
+		}
+	}()
+}
+
+func (g *runnerGroup) stopped() <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		g.wg.Wait()
+		close(done)
+	}()
+
+	return done
+}
+
+// stop runs the shutdown sequence one stage at a time. The background group, which reports
+// health and recovery, is stopped last.
+func (s *Service) stop(groups map[RunnerStage]*runnerGroup, background *runnerGroup) error {
+	deadline, cancel := context.WithTimeout(context.Background(), s.shutdown.Timeout)
+	defer cancel()
+
+	defer background.cancel()
+	defer func() {
+		for _, g := range groups {
+			g.cancel()
+		}
+	}()
+
+	log.Info("shutting down kafmesh service", "timeout", s.shutdown.Timeout)
+
+	for _, stage := range shutdownStages {
+		g := groups[stage]
+		start := time.Now()
+
+		if stage == StageProcessors && g.count > 0 {
+			drained := s.drain(deadline)
+			log.Info("kafmesh processors drained", "drained", drained, "duration", time.Since(start))
+		}
+
+		log.Info("stopping kafmesh runners", "stage", stage, "runners", g.count)
+		g.cancel()
+
+		select {
+		case <-g.stopped():
+			log.Info("stopped kafmesh runners", "stage", stage, "duration", time.Since(start))
+		case <-deadline.Done():
+			log.Error("kafmesh shutdown deadline exceeded", "stage", stage, "timeout", s.shutdown.Timeout)
+			return errors.Errorf("shutdown deadline of %s exceeded while stopping %s", s.shutdown.Timeout, stage)
+		}
+	}
+
+	err := s.tracing.shutdown(deadline)
+	if err != nil {
+		log.Error("failed to export remaining kafmesh spans", "error", err)
+	}
+
+	log.Info("kafmesh service shut down")
+
+	return nil
+}
+
+// drain waits for the processors to be quiet. It gives up after the drain period or when the
+// shutdown deadline passed.
+func (s *Service) drain(deadline context.Context) bool {
+	interval := s.shutdown.Quiet / 5
+	if interval < 10*time.Millisecond {
+		interval = 10 * time.Millisecond
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	limit := time.NewTimer(s.shutdown.Drain)
+	defer limit.Stop()
+
+	for {
+		if s.activity.quiet(s.shutdown.Quiet) {
+			return true
+		}
+
+		select {
+		case <-ticker.C:
+		case <-limit.C:
+			return false
+		case <-deadline.Done():
+			return false
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package runner
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/syncromatics/kafmesh/internal/observability"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/health"
+)
+
+func newShutdownTestService(config ShutdownConfig) *Service {
+	return &Service{
+		configured: true,
+		health:     health.NewServer(),
+		shutdown:   config,
+		activity:   &processorActivity{},
+		watcher:    &observability.Watcher{},
+	}
+}
+
+func Test_Service_ShutdownOrder(t *testing.T) {
+	service := newShutdownTestService(ShutdownConfig{
+		Timeout: 5 * time.Second,
+		Quiet:   50 * time.Millisecond,
+		Drain:   time.Second,
+	})
+
+	mtx := sync.Mutex{}
+	stopped := []string{}
+
+	stoppable := func(name string) func(context.Context) func() error {
+		return func(ctx context.Context) func() error {
+			return func() error {
+				<-ctx.Done()
+
+				mtx.Lock()
+				stopped = append(stopped, name)
+				mtx.Unlock()
+				return nil
+			}
+		}
+	}
+
+	assert.Nil(t, service.RegisterRunner(stoppable("view"), WithRunnerStage(StageViews)))
+	assert.Nil(t, service.RegisterRunner(stoppable("sink"), WithRunnerStage(StageSinks)))
+	assert.Nil(t, service.RegisterRunner(stoppable("processor")))
+	assert.Nil(t, service.RegisterRunner(stoppable("source"), WithRunnerStage(StageSources)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- service.Run(ctx)()
+	}()
+
+	// a message still being handled holds the processors back until it finishes
+	pc := service.ProcessorContext(context.Background(), "component", "processor", "key")
+
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	time.Sleep(200 * time.Millisecond)
+	mtx.Lock()
+	assert.Equal(t, []string{"source"}, stopped)
+	mtx.Unlock()
+
+	pc.Finish()
+
+	assert.Nil(t, <-done)
+	assert.Equal(t, []string{"source", "processor", "sink", "view"}, stopped)
+}
+
+func Test_Service_ShutdownDeadline(t *testing.T) {
+	service := newShutdownTestService(ShutdownConfig{
+		Timeout: 100 * time.Millisecond,
+		Quiet:   10 * time.Millisecond,
+		Drain:   time.Second,
+	})
+
+	stuck := make(chan struct{})
+	defer close(stuck)
+
+	assert.Nil(t, service.RegisterRunner(func(ctx context.Context) func() error {
+		return func() error {
+			<-stuck
+			return nil
+		}
+	}, WithRunnerStage(StageSinks)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := service.Run(ctx)()
+	assert.EqualError(t, err, "shutdown deadline of 100ms exceeded while stopping sinks")
+}
+
+func Test_Service_ShutdownOnFailure(t *testing.T) {
+	service := newShutdownTestService(DefaultShutdownConfig())
+
+	assert.Nil(t, service.RegisterRunner(func(ctx context.Context) func() error {
+		return func() error {
+			return errors.New("boom")
+		}
+	}))
+
+	err := service.Run(context.Background())()
+	assert.EqualError(t, err, "boom")
+}
@@ -2,12 +2,15 @@ package runner
 
 import (
 	"context"
+	"sort"
+	"sync"
 	"time"
 
+	watchv1 "github.com/syncromatics/kafmesh/internal/protos/kafmesh/watch/v1"
+
 	"github.com/lovoo/goka"
 
 	"github.com/Shopify/sarama"
-	cluster "github.com/bsm/sarama-cluster"
 	"github.com/pkg/errors"
 )
 
@@ -18,10 +21,20 @@ type SinkDefinition interface {
 	Topic() string
 	MaxBufferSize() int
 	Interval() time.Duration
+	RetryPolicy() RetryPolicy
+	ReadCommitted() bool
 	Flush() error
 	Collect(ctx MessageContext, key string, msg interface{}) error
 }
 
+// SinkPartitionListener can be implemented by a sink definition that keeps per partition state.
+// It is told which partitions are assigned to it at the start of each consumer group session
+// and which partitions are revoked at the end, after the final flush.
+type SinkPartitionListener interface {
+	PartitionsAssigned(partitions []int32) error
+	PartitionsRevoked(partitions []int32) error
+}
+
 // MessageContext is the extra kafka context data for the message
 type MessageContext struct {
 	Partition int32
@@ -34,120 +47,434 @@ type MessageContext struct {
 type SinkRunner struct {
 	definition SinkDefinition
 	brokers    []string
+	watch      *SinkWatch
+	tracing    *SinkTracing
+	sink       *SinkMetrics
+	tester     Tester
+	clientID   string
+}
+
+// SinkRunnerOption configures a sink runner
+type SinkRunnerOption func(*SinkRunner)
+
+// WithSinkWatch reports the messages the sink collects, its flushes and commits to the observers of the sink
+func WithSinkWatch(watch *SinkWatch) SinkRunnerOption {
+	return func(r *SinkRunner) {
+		r.watch = watch
+	}
+}
+
+// WithSinkTracing creates spans for the messages the sink collects that continue the traces in their record headers
+func WithSinkTracing(tracing *SinkTracing) SinkRunnerOption {
+	return func(r *SinkRunner) {
+		r.tracing = tracing
+	}
+}
+
+// WithSinkMetrics records the messages the sink collects, its flushes and buffer size
+func WithSinkMetrics(metrics *SinkMetrics) SinkRunnerOption {
+	return func(r *SinkRunner) {
+		r.sink = metrics
+	}
+}
+
+// WithSinkTester consumes the sink topic from the tester instead of kafka. It does nothing if the tester is nil.
+func WithSinkTester(tester Tester) SinkRunnerOption {
+	return func(r *SinkRunner) {
+		r.tester = tester
+	}
 }
 
 // NewSinkRunner create a new sink runner
-func NewSinkRunner(definition SinkDefinition, brokers []string) *SinkRunner {
-	return &SinkRunner{
+func NewSinkRunner(definition SinkDefinition, brokers []string, options ...SinkRunnerOption) *SinkRunner {
+	r := &SinkRunner{
 		definition: definition,
 		brokers:    brokers,
 	}
+
+	for _, option := range options {
+		option(r)
+	}
+
+	if r.tester != nil {
+		r.clientID = r.tester.RegisterConsumerGroup(definition.Group(), goka.Stream(definition.Topic()), definition.Codec())
+	}
+
+	return r
 }
 
 // Run runs the sink
 func (r *SinkRunner) Run(ctx context.Context) func() error {
 	return func() error {
-		config := cluster.NewConfig()
+		config := sarama.NewConfig()
+		config.Version = sarama.MaxVersion
 		config.Consumer.Offsets.Initial = sarama.OffsetOldest
 		config.Consumer.Offsets.AutoCommit.Enable = true
-		config.Consumer.Offsets.CommitInterval = 1 * time.Second
-		config.Version = sarama.MaxVersion
+		config.Consumer.Offsets.AutoCommit.Interval = 1 * time.Second
+		config.Consumer.Return.Errors = true
+		if r.definition.ReadCommitted() {
+			ConfigureReadCommitted(config)
+		}
 
-		cg, err := cluster.NewConsumer(r.brokers, r.definition.Group(), []string{r.definition.Topic()}, config)
+		cg, err := r.consumerGroup(config)
 		if err != nil {
-			return err
+			return errors.Wrap(err, "failed to create consumer group")
 		}
 		defer cg.Close()
 
-		err = r.consume(ctx, cg)
-		return err
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		handler := &sinkHandler{
+			runner:  r,
+			ctx:     ctx,
+			cancel:  cancel,
+			buffers: map[int32]*partitionBuffer{},
+		}
+
+		go func() {
+			for err := range cg.Errors() {
+				handler.fail(errors.Wrap(err, "error received while processing"))
+			}
+		}()
+
+		for {
+			err = cg.Consume(ctx, []string{r.definition.Topic()}, handler)
+			if err != nil {
+				handler.fail(errors.Wrap(err, "failed to consume"))
+			}
+
+			if ctx.Err() != nil {
+				return handler.error()
+			}
+		}
 	}
 }
 
-func (r *SinkRunner) consume(ctx context.Context, cg *cluster.Consumer) error {
-	ticker := time.NewTicker(r.definition.Interval())
-	defer ticker.Stop()
+func (r *SinkRunner) consumerGroup(config *sarama.Config) (sarama.ConsumerGroup, error) {
+	if r.tester != nil {
+		return r.tester.ConsumerGroupBuilder()(r.brokers, r.definition.Group(), r.clientID)
+	}
 
-	count := 0
-	partitionOffsets := make(map[int32]*sarama.ConsumerMessage)
+	return sarama.NewConsumerGroup(r.brokers, r.definition.Group(), config)
+}
 
-	codec := r.definition.Codec()
-	maxBufferSize := r.definition.MaxBufferSize()
+// partitionBuffer holds the messages collected from a claimed partition since its last flush
+type partitionBuffer struct {
+	messages []bufferedMessage
+}
 
-	flush := func() error {
-		err := r.definition.Flush()
-		if err != nil {
-			return errors.Wrap(err, "failed flushing")
-		}
+type bufferedMessage struct {
+	context MessageContext
+	raw     *sarama.ConsumerMessage
+	message interface{}
+	done    func(error)
+}
+
+type sinkHandler struct {
+	runner *SinkRunner
+	ctx    context.Context
+	cancel func()
+
+	// flushMtx keeps flushes apart so the messages given to the sink are flushed together. It is held while
+	// the flush is retried, which only blocks other flushes and not the partitions collecting messages.
+	flushMtx sync.Mutex
+
+	mtx     sync.Mutex
+	buffers map[int32]*partitionBuffer
+	count   int
+	err     error
+}
+
+func (h *sinkHandler) fail(err error) {
+	h.mtx.Lock()
+	if h.err == nil {
+		h.err = err
+	}
+	h.mtx.Unlock()
+
+	h.cancel()
+}
+
+func (h *sinkHandler) error() error {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	return h.err
+}
+
+// Setup creates the partition buffers for the claimed partitions and starts the flush interval
+func (h *sinkHandler) Setup(session sarama.ConsumerGroupSession) error {
+	partitions := session.Claims()[h.runner.definition.Topic()]
+
+	h.mtx.Lock()
+	for _, partition := range partitions {
+		h.buffers[partition] = &partitionBuffer{}
+	}
+	h.mtx.Unlock()
 
-		for _, offset := range partitionOffsets {
-			cg.MarkOffset(offset, "")
+	listener, ok := h.runner.definition.(SinkPartitionListener)
+	if ok {
+		err := listener.PartitionsAssigned(partitions)
+		if err != nil {
+			err = errors.Wrap(err, "failed to assign partitions to sink")
+			h.fail(err)
+			return err
 		}
+	}
 
-		partitionOffsets = make(map[int32]*sarama.ConsumerMessage)
-		count = 0
+	if h.runner.tester != nil {
+		onInterval(session.Context(), h.runner.tester, h.runner.definition.Interval(), func(time.Time) error {
+			err := h.flush(session)
+			if err != nil {
+				h.fail(err)
+			}
+			return err
+		})
 
 		return nil
 	}
 
-	for {
-		select {
-		case <-ctx.Done():
-			err := cg.Close()
-			return err
+	go func() {
+		ticker := time.NewTicker(h.runner.definition.Interval())
+		defer ticker.Stop()
 
-		case <-ticker.C:
-			if count > 0 {
-				err := flush()
+		for {
+			select {
+			case <-session.Context().Done():
+				return
+			case <-ticker.C:
+				err := h.flush(session)
 				if err != nil {
-					return err
+					h.fail(err)
+					return
 				}
 			}
+		}
+	}()
+
+	return nil
+}
+
+// Cleanup flushes and commits anything left from the session and releases the partition buffers
+func (h *sinkHandler) Cleanup(session sarama.ConsumerGroupSession) error {
+	partitions := session.Claims()[h.runner.definition.Topic()]
+
+	err := h.flushPartitions(session, partitions)
+
+	h.mtx.Lock()
+	for _, partition := range partitions {
+		buffer, ok := h.buffers[partition]
+		if ok {
+			h.count -= len(buffer.messages)
+		}
+		delete(h.buffers, partition)
+	}
+	h.mtx.Unlock()
+
+	if err != nil {
+		h.fail(err)
+		return err
+	}
+
+	session.Commit()
+
+	listener, ok := h.runner.definition.(SinkPartitionListener)
+	if ok {
+		err := listener.PartitionsRevoked(partitions)
+		if err != nil {
+			err = errors.Wrap(err, "failed to revoke partitions from sink")
+			h.fail(err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ConsumeClaim collects the messages of a partition. When the partition is revoked the messages
+// collected from it are flushed and committed before giving up the claim.
+func (h *sinkHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	err := h.consume(session, claim)
+	if err != nil {
+		h.fail(err)
+		return err
+	}
+
+	err = h.flushPartitions(session, []int32{claim.Partition()})
+	if err != nil {
+		h.fail(err)
+		return err
+	}
+
+	session.Commit()
+
+	return nil
+}
+
+func (h *sinkHandler) consume(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	codec := h.runner.definition.Codec()
+	maxBufferSize := h.runner.definition.MaxBufferSize()
+
+	for msg := range claim.Messages() {
+		if msg == nil {
+			// testers send nil after a message to know it was collected
 			continue
+		}
 
-		case err := <-cg.Errors():
-			return errors.Wrap(err, "error received while processing")
+		message, err := codec.Decode(msg.Value)
+		if err != nil {
+			return errors.Wrapf(err, "failed decoding %v", msg.Value)
+		}
 
-		case _ = <-cg.Notifications():
-			if count > 0 {
-				err := flush()
-				if err != nil {
-					return err
-				}
-			}
+		msgctx := MessageContext{
+			Partition: msg.Partition,
+			Offset:    msg.Offset,
+			Timestamp: msg.Timestamp,
+			Topic:     msg.Topic,
+		}
+
+		done := h.runner.tracing.collect(h.ctx, msg)
+		if h.collect(msgctx, msg, message, done) < maxBufferSize {
 			continue
+		}
 
-		case msg := <-cg.Messages():
-			if msg.Topic != r.definition.Topic() {
-				continue
-			}
+		err = h.flush(session)
+		if err != nil {
+			return err
+		}
+	}
 
-			message, err := codec.Decode(msg.Value)
-			if err != nil {
-				return errors.Wrapf(err, "failed decoding %v", msg.Value)
-			}
+	return nil
+}
 
-			msgctx := MessageContext{
-				Partition: msg.Partition,
-				Offset:    msg.Offset,
-				Timestamp: msg.Timestamp,
-				Topic:     msg.Topic,
-			}
+// collect buffers the message with the other messages of its partition and returns how many messages are buffered.
+// done is called with the result of the flush that includes the message.
+func (h *sinkHandler) collect(msgctx MessageContext, msg *sarama.ConsumerMessage, message interface{}, done func(error)) int {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	buffer, ok := h.buffers[msg.Partition]
+	if !ok {
+		buffer = &partitionBuffer{}
+		h.buffers[msg.Partition] = buffer
+	}
 
-			err = r.definition.Collect(msgctx, string(msg.Key), message)
+	buffer.messages = append(buffer.messages, bufferedMessage{
+		context: msgctx,
+		raw:     msg,
+		message: message,
+		done:    done,
+	})
+	h.count++
+
+	h.runner.watch.collected(msg, message)
+	h.runner.sink.collected(h.count)
+
+	return h.count
+}
+
+// flush flushes the messages of every partition
+func (h *sinkHandler) flush(session sarama.ConsumerGroupSession) error {
+	h.mtx.Lock()
+	partitions := []int32{}
+	for partition := range h.buffers {
+		partitions = append(partitions, partition)
+	}
+	h.mtx.Unlock()
+
+	sort.Slice(partitions, func(i, j int) bool {
+		return partitions[i] < partitions[j]
+	})
+
+	return h.flushPartitions(session, partitions)
+}
+
+// flushPartitions hands the messages buffered for the partitions to the sink, flushes it and marks the
+// offset of the last message of each partition. The buffers are only locked while their messages are
+// taken, so the partitions keep collecting while the sink is flushed.
+func (h *sinkHandler) flushPartitions(session sarama.ConsumerGroupSession, partitions []int32) error {
+	h.flushMtx.Lock()
+	defer h.flushMtx.Unlock()
+
+	h.mtx.Lock()
+	flushing := map[int32][]bufferedMessage{}
+	count := 0
+	for _, partition := range partitions {
+		buffer, ok := h.buffers[partition]
+		if !ok || len(buffer.messages) == 0 {
+			continue
+		}
+
+		flushing[partition] = buffer.messages
+		count += len(buffer.messages)
+		buffer.messages = nil
+	}
+	h.count -= count
+	buffered := h.count
+	h.mtx.Unlock()
+
+	if count == 0 {
+		return nil
+	}
+
+	definition := h.runner.definition
+	for _, partition := range partitions {
+		for _, m := range flushing[partition] {
+			err := definition.Collect(m.context, string(m.raw.Key), m.message)
 			if err != nil {
-				return errors.Wrapf(err, "failed collecting message")
+				err = errors.Wrapf(err, "failed collecting message")
+				flushed(flushing, err)
+				return err
 			}
-			count++
+		}
+	}
 
-			partitionOffsets[msg.Partition] = msg
+	start := time.Now()
+	attempts, err := definition.RetryPolicy().Do(h.ctx, definition.Flush)
+	h.runner.watch.flushed(count, attempts, err)
+	flushed(flushing, err)
 
-			if count >= maxBufferSize {
-				err := flush()
-				if err != nil {
-					return err
-				}
-			}
+	if err != nil {
+		buffered += count
+	}
+	h.runner.sink.flushed(time.Since(start), buffered)
+
+	if attempts > 1 {
+		h.runner.sink.retried(attempts - 1)
+	}
+	if err != nil {
+		if h.ctx.Err() == nil {
+			h.runner.sink.gaveUp()
+		}
+		return errors.Wrapf(err, "failed flushing after %d attempts", attempts)
+	}
+
+	offsets := []*watchv1.PartitionOffset{}
+	for _, partition := range partitions {
+		messages, ok := flushing[partition]
+		if !ok {
+			continue
+		}
+
+		last := messages[len(messages)-1].raw
+		session.MarkMessage(last, "")
+		offsets = append(offsets, &watchv1.PartitionOffset{
+			Partition: partition,
+			Offset:    last.Offset + 1,
+		})
+	}
+
+	h.runner.watch.committed(offsets)
+
+	return nil
+}
+
+// flushed tells the flushed messages the result of the flush
+func flushed(messages map[int32][]bufferedMessage, err error) {
+	for _, partition := range messages {
+		for _, m := range partition {
+			m.done(err)
 		}
 	}
 }
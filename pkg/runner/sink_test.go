@@ -0,0 +1,201 @@
+package runner
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/syncromatics/kafmesh/internal/observability"
+
+	"github.com/Shopify/sarama"
+	"github.com/lovoo/goka"
+	"github.com/lovoo/goka/codec"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func Test_SinkHandler_FlushesRevokedPartition(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	definition := &partitionSinkDefinition{}
+	handler := &sinkHandler{
+		runner:  NewSinkRunner(definition, nil),
+		ctx:     ctx,
+		cancel:  cancel,
+		buffers: map[int32]*partitionBuffer{},
+	}
+	session := &partitionSession{}
+
+	handler.collect(MessageContext{Partition: 0}, &sarama.ConsumerMessage{Partition: 0, Offset: 1, Key: []byte("a")}, "value1", ignoreFlushed)
+	handler.collect(MessageContext{Partition: 1}, &sarama.ConsumerMessage{Partition: 1, Offset: 5, Key: []byte("b")}, "value2", ignoreFlushed)
+	assert.Equal(t, 3, handler.collect(MessageContext{Partition: 0}, &sarama.ConsumerMessage{Partition: 0, Offset: 2, Key: []byte("c")}, "value3", ignoreFlushed))
+
+	// messages are only handed to the sink when they are flushed
+	assert.Empty(t, definition.flushes())
+
+	assert.Nil(t, handler.flushPartitions(session, []int32{0}))
+	assert.Equal(t, [][]string{{"value1", "value3"}}, definition.flushes())
+	assert.Equal(t, map[int32]int64{0: 2}, session.marked)
+	assert.Equal(t, 1, handler.count)
+
+	assert.Nil(t, handler.flushPartitions(session, []int32{0}))
+	assert.Len(t, definition.flushes(), 1)
+
+	assert.Nil(t, handler.flush(session))
+	assert.Equal(t, [][]string{{"value1", "value3"}, {"value2"}}, definition.flushes())
+	assert.Equal(t, map[int32]int64{0: 2, 1: 5}, session.marked)
+	assert.Equal(t, 0, handler.count)
+}
+
+func Test_SinkHandler_CollectsWhileFlushing(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	flushing := make(chan struct{})
+	release := make(chan struct{})
+	definition := &partitionSinkDefinition{
+		flush: func() {
+			close(flushing)
+			<-release
+		},
+	}
+	handler := &sinkHandler{
+		runner:  NewSinkRunner(definition, nil),
+		ctx:     ctx,
+		cancel:  cancel,
+		buffers: map[int32]*partitionBuffer{},
+	}
+	session := &partitionSession{}
+
+	handler.collect(MessageContext{Partition: 0}, &sarama.ConsumerMessage{Partition: 0, Offset: 1}, "value1", ignoreFlushed)
+
+	done := make(chan error)
+	go func() {
+		done <- handler.flushPartitions(session, []int32{0})
+	}()
+
+	<-flushing
+	assert.Equal(t, 1, handler.collect(MessageContext{Partition: 1}, &sarama.ConsumerMessage{Partition: 1, Offset: 1}, "value2", ignoreFlushed))
+	close(release)
+
+	assert.Nil(t, <-done)
+	assert.Equal(t, [][]string{{"value1"}}, definition.flushes())
+}
+
+func Test_SinkHandler_TracesMessagesUntilFlushed(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	exporter := tracetest.NewInMemoryExporter()
+	service := &Service{
+		activity: &processorActivity{},
+		watcher:  &observability.Watcher{},
+	}
+	WithTracing(TracingConfig{Exporter: exporter, Synchronous: true})(service)
+	options := service.Options()
+
+	headers, sent := options.SourceTracing("source", "topic").emit(context.Background(), "a")
+	sent(nil)
+
+	definition := &partitionSinkDefinition{}
+	handler := &sinkHandler{
+		runner:  NewSinkRunner(definition, nil, WithSinkTracing(options.SinkTracing("sink", "Sink"))),
+		ctx:     ctx,
+		cancel:  cancel,
+		buffers: map[int32]*partitionBuffer{},
+	}
+	session := &partitionSession{}
+
+	msg := &sarama.ConsumerMessage{Topic: "topic", Partition: 0, Offset: 1, Key: []byte("a"), Value: []byte("value1")}
+	for k, v := range headers {
+		msg.Headers = append(msg.Headers, &sarama.RecordHeader{Key: []byte(k), Value: v})
+	}
+	claim := &partitionClaim{messages: make(chan *sarama.ConsumerMessage, 1)}
+	claim.messages <- msg
+	close(claim.messages)
+
+	assert.Nil(t, handler.consume(session, claim))
+
+	// the span of a consumed message ends when the message is flushed
+	assert.Len(t, exporter.GetSpans(), 1)
+
+	flushed := time.Now()
+	assert.Nil(t, handler.flush(session))
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 2)
+
+	emit, collect := spans[0], spans[1]
+	assert.Equal(t, "topic process", collect.Name)
+	assert.Equal(t, emit.SpanContext.SpanID(), collect.Parent.SpanID())
+	assert.True(t, collect.StartTime.Before(flushed))
+	assert.False(t, collect.EndTime.Before(flushed))
+}
+
+func ignoreFlushed(error) {}
+
+type partitionSinkDefinition struct {
+	mtx       sync.Mutex
+	collected []string
+	flushed   [][]string
+	flush     func()
+}
+
+func (d *partitionSinkDefinition) Codec() goka.Codec        { return &codec.String{} }
+func (d *partitionSinkDefinition) Group() string            { return "group" }
+func (d *partitionSinkDefinition) Topic() string            { return "topic" }
+func (d *partitionSinkDefinition) MaxBufferSize() int       { return 100 }
+func (d *partitionSinkDefinition) Interval() time.Duration  { return time.Second }
+func (d *partitionSinkDefinition) RetryPolicy() RetryPolicy { return RetryPolicy{} }
+func (d *partitionSinkDefinition) ReadCommitted() bool      { return false }
+
+func (d *partitionSinkDefinition) Collect(ctx MessageContext, key string, msg interface{}) error {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	d.collected = append(d.collected, msg.(string))
+	return nil
+}
+
+func (d *partitionSinkDefinition) Flush() error {
+	if d.flush != nil {
+		d.flush()
+	}
+
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	d.flushed = append(d.flushed, d.collected)
+	d.collected = nil
+	return nil
+}
+
+func (d *partitionSinkDefinition) flushes() [][]string {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	return d.flushed
+}
+
+type partitionSession struct {
+	sarama.ConsumerGroupSession
+	marked map[int32]int64
+}
+
+func (s *partitionSession) MarkMessage(msg *sarama.ConsumerMessage, metadata string) {
+	if s.marked == nil {
+		s.marked = map[int32]int64{}
+	}
+	s.marked[msg.Partition] = msg.Offset
+}
+
+type partitionClaim struct {
+	sarama.ConsumerGroupClaim
+	messages chan *sarama.ConsumerMessage
+}
+
+func (c *partitionClaim) Messages() <-chan *sarama.ConsumerMessage {
+	return c.messages
+}
@@ -0,0 +1,494 @@
+package runner
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/lovoo/goka/storage"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"github.com/syncromatics/go-kit/log"
+)
+
+const (
+	standbyOffsetNotStored = -3
+)
+
+var (
+	standbyAssignInterval = 10 * time.Second
+)
+
+// Standby keeps hot copies of the partitions of a processor group table that other members of the
+// group own in local storage, so a processor that is assigned one of them only has to recover the
+// updates it is missing. The standby copies of a partition are kept by the replicas members that
+// follow its owner when the members are ordered by id, so every partition has the same number of
+// copies however many members the group has. The processor and the standby share the storage of a
+// partition. While the processor owns a partition the standby stops writing to it.
+type Standby struct {
+	brokers  []string
+	group    string
+	table    string
+	replicas int
+	builder  storage.Builder
+	member   string
+	// newest gets the high watermark of a partition of the table. It is set when the standby runs.
+	newest func(partition int32) (int64, error)
+
+	mtx        sync.Mutex
+	partitions map[int32]*standbyPartition
+	followers  map[int32]*standbyFollower
+}
+
+type standbyPartition struct {
+	storage storage.Storage
+	refs    int
+
+	// mtx is held while owned is changed and while a follower writes, so a follower never writes
+	// once the processor owns the partition
+	mtx   sync.Mutex
+	owned bool
+}
+
+// standbyFollower copies the updates of a partition of the group table to the standby copy
+type standbyFollower struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	mtx           sync.Mutex
+	started       bool
+	offset        int64
+	highWatermark int64
+}
+
+// NewStandby creates the standby for the table of the processor group that keeps replicas copies of
+// every partition and stores them with the builder
+func NewStandby(brokers []string, group string, table string, replicas int, builder storage.Builder) *Standby {
+	return &Standby{
+		brokers:    brokers,
+		group:      group,
+		table:      table,
+		replicas:   replicas,
+		builder:    builder,
+		member:     uuid.NewV4().String(),
+		partitions: map[int32]*standbyPartition{},
+		followers:  map[int32]*standbyFollower{},
+	}
+}
+
+// Configure sets the id of this member in the consumer group config of the processor so the
+// standbys of the group can tell which partitions it owns
+func (s *Standby) Configure(config *sarama.Config) {
+	config.Consumer.Group.Member.UserData = []byte(s.member)
+}
+
+// Builder wraps the processor storage builder so the processor uses the standby copy of its group table
+func (s *Standby) Builder(builder storage.Builder) storage.Builder {
+	return func(topic string, partition int32) (storage.Storage, error) {
+		if topic != s.table {
+			return builder(topic, partition)
+		}
+
+		return s.open(partition, true)
+	}
+}
+
+// Run keeps the standby copies of the group table up to date. The partitions this member keeps
+// copies of are assigned again whenever the group is stable.
+func (s *Standby) Run(ctx context.Context) func() error {
+	return func() error {
+		config := sarama.NewConfig()
+		config.Version = sarama.MaxVersion
+
+		client, err := sarama.NewClient(s.brokers, config)
+		if err != nil {
+			return errors.Wrap(err, "failed to create standby client")
+		}
+		defer client.Close()
+
+		admin, err := sarama.NewClusterAdminFromClient(client)
+		if err != nil {
+			return errors.Wrap(err, "failed to create standby cluster admin")
+		}
+
+		consumer, err := sarama.NewConsumerFromClient(client)
+		if err != nil {
+			return errors.Wrap(err, "failed to create standby consumer")
+		}
+		defer consumer.Close()
+
+		s.newest = func(partition int32) (int64, error) {
+			return client.GetOffset(s.table, partition, sarama.OffsetNewest)
+		}
+		defer s.assign(ctx, consumer, nil)
+
+		ticker := time.NewTicker(standbyAssignInterval)
+		defer ticker.Stop()
+
+		for {
+			partitions, stable, err := s.assignment(admin)
+			if err != nil {
+				log.Error("failed to get standby assignment", "group", s.group, "error", err)
+			}
+
+			if stable {
+				s.assign(ctx, consumer, partitions)
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+		}
+	}
+}
+
+// Recovery reports the recovery progress of the standby copies of the group table
+func (s *Standby) Recovery(service, component, processor string) RecoverySource {
+	return func(ctx context.Context) []PartitionRecovery {
+		s.mtx.Lock()
+		defer s.mtx.Unlock()
+
+		progress := []PartitionRecovery{}
+		for partition, f := range s.followers {
+			f.mtx.Lock()
+			progress = append(progress, PartitionRecovery{
+				Service:       service,
+				Component:     component,
+				Processor:     processor,
+				Table:         s.table,
+				Partition:     partition,
+				Offset:        f.offset,
+				HighWatermark: f.highWatermark,
+				Recovered:     f.started && f.offset+1 >= f.highWatermark,
+				Standby:       true,
+			})
+			f.mtx.Unlock()
+		}
+
+		return progress
+	}
+}
+
+// assignment gets the partitions this member keeps standby copies of from the members of the
+// processor group. The assignment is only complete once the group is stable.
+func (s *Standby) assignment(admin sarama.ClusterAdmin) ([]int32, bool, error) {
+	groups, err := admin.DescribeConsumerGroups([]string{s.group})
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "failed to describe group '%s'", s.group)
+	}
+
+	if len(groups) != 1 || groups[0].State != "Stable" {
+		return nil, false, nil
+	}
+
+	owners := map[int32]string{}
+	members := []string{}
+	for id, m := range groups[0].Members {
+		metadata, err := m.GetMemberMetadata()
+		if err == nil && metadata != nil && len(metadata.UserData) > 0 {
+			id = string(metadata.UserData)
+		}
+		members = append(members, id)
+
+		assignment, err := m.GetMemberAssignment()
+		if err != nil {
+			return nil, false, errors.Wrapf(err, "failed to decode assignment of group '%s'", s.group)
+		}
+
+		for _, partitions := range assignment.Topics {
+			for _, partition := range partitions {
+				owners[partition] = id
+			}
+		}
+	}
+
+	return standbyAssignment(owners, members, s.member, s.replicas), true, nil
+}
+
+// standbyAssignment gets the partitions the member keeps standby copies of. Members are ordered by
+// id and the copies of a partition are kept by the replicas members that follow its owner.
+func standbyAssignment(owners map[int32]string, members []string, member string, replicas int) []int32 {
+	members = append([]string{}, members...)
+	sort.Strings(members)
+
+	index := map[string]int{}
+	for i, m := range members {
+		index[m] = i
+	}
+
+	if replicas > len(members)-1 {
+		replicas = len(members) - 1
+	}
+
+	partitions := []int32{}
+	for partition, owner := range owners {
+		i, ok := index[owner]
+		if !ok {
+			continue
+		}
+
+		for r := 1; r <= replicas; r++ {
+			if members[(i+r)%len(members)] == member {
+				partitions = append(partitions, partition)
+				break
+			}
+		}
+	}
+
+	sort.Slice(partitions, func(i, j int) bool {
+		return partitions[i] < partitions[j]
+	})
+
+	return partitions
+}
+
+// assign starts following the partitions this member keeps standby copies of and stops following
+// the others. Followers that stopped on an error are started again.
+func (s *Standby) assign(ctx context.Context, consumer sarama.Consumer, partitions []int32) {
+	assigned := map[int32]bool{}
+	for _, p := range partitions {
+		assigned[p] = true
+	}
+
+	s.mtx.Lock()
+	followers := map[int32]*standbyFollower{}
+	for partition, f := range s.followers {
+		followers[partition] = f
+	}
+	s.mtx.Unlock()
+
+	for partition, f := range followers {
+		select {
+		case <-f.done:
+		default:
+			if assigned[partition] {
+				continue
+			}
+			f.cancel()
+			<-f.done
+		}
+
+		s.mtx.Lock()
+		delete(s.followers, partition)
+		s.mtx.Unlock()
+	}
+
+	for _, partition := range partitions {
+		s.mtx.Lock()
+		_, ok := s.followers[partition]
+		s.mtx.Unlock()
+
+		if ok {
+			continue
+		}
+
+		followCtx, cancel := context.WithCancel(ctx)
+		f := &standbyFollower{
+			cancel: cancel,
+			done:   make(chan struct{}),
+			offset: -1,
+		}
+
+		s.mtx.Lock()
+		s.followers[partition] = f
+		s.mtx.Unlock()
+
+		go func(partition int32) {
+			defer close(f.done)
+
+			err := s.follow(followCtx, consumer, partition, f)
+			if err != nil {
+				log.Error("standby stopped following partition", "table", s.table, "partition", partition, "error", err)
+			}
+		}(partition)
+	}
+}
+
+// follow copies the updates of the partition to its standby copy until the context is done
+func (s *Standby) follow(ctx context.Context, consumer sarama.Consumer, partition int32, f *standbyFollower) error {
+	st, err := s.open(partition, false)
+	if err != nil {
+		return errors.Wrap(err, "failed to open standby storage")
+	}
+	defer st.Close()
+
+	err = st.Open()
+	if err != nil {
+		return errors.Wrap(err, "failed to open standby storage")
+	}
+
+	stored, err := st.GetOffset(standbyOffsetNotStored)
+	if err != nil {
+		return errors.Wrap(err, "failed to get standby offset")
+	}
+
+	start := sarama.OffsetOldest
+	if stored != standbyOffsetNotStored {
+		start = stored + 1
+	}
+
+	pc, err := consumer.ConsumePartition(s.table, partition, start)
+	if err == sarama.ErrOffsetOutOfRange {
+		// the stored updates were compacted away
+		pc, err = consumer.ConsumePartition(s.table, partition, sarama.OffsetOldest)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to consume partition %d of '%s'", partition, s.table)
+	}
+	defer pc.Close()
+
+	highWatermark, err := s.newest(partition)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get high watermark of partition %d of '%s'", partition, s.table)
+	}
+
+	// a partition without updates to copy, like an empty one, is recovered as soon as it is followed
+	f.mtx.Lock()
+	f.started = true
+	if stored != standbyOffsetNotStored {
+		f.offset = stored
+	}
+	f.highWatermark = highWatermark
+	f.mtx.Unlock()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-pc.Messages():
+			if !ok {
+				return nil
+			}
+
+			if msg.Value == nil {
+				err = st.Delete(string(msg.Key))
+			} else {
+				err = st.Set(string(msg.Key), msg.Value)
+			}
+			if err != nil {
+				return errors.Wrap(err, "failed to update standby storage")
+			}
+
+			err = st.SetOffset(msg.Offset)
+			if err != nil {
+				return errors.Wrap(err, "failed to set standby offset")
+			}
+
+			f.mtx.Lock()
+			f.offset = msg.Offset
+			f.highWatermark = pc.HighWaterMarkOffset()
+			f.mtx.Unlock()
+		}
+	}
+}
+
+func (s *Standby) open(partition int32, owner bool) (storage.Storage, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	p, ok := s.partitions[partition]
+	if !ok {
+		st, err := s.builder(s.table, partition)
+		if err != nil {
+			return nil, err
+		}
+
+		p = &standbyPartition{storage: st}
+		s.partitions[partition] = p
+	}
+
+	p.refs++
+	if owner {
+		p.mtx.Lock()
+		p.owned = true
+		p.mtx.Unlock()
+	}
+
+	return &standbyStorage{
+		Storage:   p.storage,
+		standby:   s,
+		shared:    p,
+		partition: partition,
+		owner:     owner,
+	}, nil
+}
+
+func (s *Standby) close(partition int32, owner bool) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	p, ok := s.partitions[partition]
+	if !ok {
+		return nil
+	}
+
+	p.refs--
+	if owner {
+		p.mtx.Lock()
+		p.owned = false
+		p.mtx.Unlock()
+	}
+
+	if p.refs > 0 {
+		return nil
+	}
+
+	delete(s.partitions, partition)
+	return p.storage.Close()
+}
+
+// standbyStorage is the handle the processor or a standby follower has on a shared partition storage
+type standbyStorage struct {
+	storage.Storage
+	standby   *Standby
+	shared    *standbyPartition
+	partition int32
+	owner     bool
+}
+
+// write runs a write of the processor, or of a follower while the processor does not own the partition
+func (s *standbyStorage) write(fn func() error) error {
+	if s.owner {
+		return fn()
+	}
+
+	s.shared.mtx.Lock()
+	defer s.shared.mtx.Unlock()
+
+	if s.shared.owned {
+		return nil
+	}
+	return fn()
+}
+
+func (s *standbyStorage) Set(key string, value []byte) error {
+	return s.write(func() error {
+		return s.Storage.Set(key, value)
+	})
+}
+
+func (s *standbyStorage) Delete(key string) error {
+	return s.write(func() error {
+		return s.Storage.Delete(key)
+	})
+}
+
+func (s *standbyStorage) SetOffset(offset int64) error {
+	return s.write(func() error {
+		return s.Storage.SetOffset(offset)
+	})
+}
+
+func (s *standbyStorage) MarkRecovered() error {
+	return s.write(func() error {
+		return s.Storage.MarkRecovered()
+	})
+}
+
+func (s *standbyStorage) Close() error {
+	return s.standby.close(s.partition, s.owner)
+}
@@ -0,0 +1,186 @@
+package runner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/Shopify/sarama/mocks"
+	"github.com/lovoo/goka/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Standby_SharesStorage(t *testing.T) {
+	memory := storage.MemoryBuilder()
+	standby := NewStandby(nil, "group", "group-table", 1, memory)
+
+	builder := standby.Builder(memory)
+
+	view, err := standby.open(3, false)
+	assert.Nil(t, err)
+
+	assert.Nil(t, view.Set("key", []byte("from standby")))
+	assert.Nil(t, view.SetOffset(10))
+
+	processor, err := builder("group-table", 3)
+	assert.Nil(t, err)
+
+	value, err := processor.Get("key")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("from standby"), value)
+
+	offset, err := processor.GetOffset(-1)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), offset)
+
+	// the standby stops writing while the processor owns the partition
+	assert.Nil(t, view.Set("key", []byte("stale")))
+	assert.Nil(t, view.SetOffset(5))
+	assert.Nil(t, processor.Set("key", []byte("from processor")))
+	assert.Nil(t, processor.SetOffset(11))
+
+	value, err = view.Get("key")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("from processor"), value)
+
+	offset, err = view.GetOffset(-1)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(11), offset)
+
+	// the storage stays open for the standby after the partition is revoked from the processor
+	assert.Nil(t, processor.Close())
+	assert.Nil(t, view.Set("key", []byte("caught up")))
+
+	value, err = view.Get("key")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("caught up"), value)
+
+	assert.Nil(t, view.Close())
+	assert.Empty(t, standby.partitions)
+}
+
+func Test_Standby_OtherTables(t *testing.T) {
+	memory := storage.MemoryBuilder()
+	standby := NewStandby(nil, "group", "group-table", 1, memory)
+
+	st, err := standby.Builder(memory)("lookup-table", 0)
+	assert.Nil(t, err)
+
+	_, ok := st.(*standbyStorage)
+	assert.False(t, ok)
+	assert.Empty(t, standby.partitions)
+}
+
+func Test_StandbyAssignment(t *testing.T) {
+	owners := map[int32]string{0: "a", 1: "b", 2: "c", 3: "a", 4: "b", 5: "c"}
+	members := []string{"c", "a", "b"}
+
+	// a member keeps copies of the partitions of the members before it
+	assert.Equal(t, []int32{0, 3}, standbyAssignment(owners, members, "b", 1))
+	assert.Equal(t, []int32{2, 5}, standbyAssignment(owners, members, "a", 1))
+
+	// a member never keeps a copy of the partitions it owns
+	assert.Equal(t, []int32{1, 2, 4, 5}, standbyAssignment(owners, members, "a", 2))
+	assert.Equal(t, []int32{1, 2, 4, 5}, standbyAssignment(owners, members, "a", 5))
+
+	assert.Empty(t, standbyAssignment(map[int32]string{0: "a"}, []string{"a"}, "a", 1))
+	assert.Empty(t, standbyAssignment(owners, members, "d", 1))
+}
+
+func Test_Standby_Handover(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the storage of a partition is kept when it is closed, as it is on disk
+	stores := map[int32]storage.Storage{}
+	memory := func(topic string, partition int32) (storage.Storage, error) {
+		if _, ok := stores[partition]; !ok {
+			stores[partition] = NewMemoryStorage()
+		}
+		return stores[partition], nil
+	}
+
+	standby := NewStandby(nil, "group", "group-table", 1, memory)
+	standby.member = "b"
+	members := []string{"a", "b"}
+
+	var highWatermark int64 = 3
+	standby.newest = func(partition int32) (int64, error) {
+		return highWatermark, nil
+	}
+
+	// b keeps the copy of the partition a owns
+	consumer := mocks.NewConsumer(t, nil)
+	pc := consumer.ExpectConsumePartition("group-table", 0, sarama.OffsetOldest)
+	pc.YieldMessage(&sarama.ConsumerMessage{Key: []byte("key"), Value: []byte("1")})
+	pc.YieldMessage(&sarama.ConsumerMessage{Key: []byte("key"), Value: []byte("2")})
+
+	standby.assign(ctx, consumer, standbyAssignment(map[int32]string{0: "a"}, members, "b", 1))
+
+	recovery := standby.Recovery("service", "component", "processor")
+	assert.Eventually(t, func() bool {
+		progress := recovery(ctx)
+		return len(progress) == 1 && progress[0].Offset == 2 && progress[0].Recovered
+	}, time.Second, 10*time.Millisecond)
+
+	// the partition moves to b, which recovers it from the copy
+	processor, err := standby.Builder(memory)("group-table", 0)
+	assert.Nil(t, err)
+
+	standby.assign(ctx, consumer, standbyAssignment(map[int32]string{0: "b"}, members, "b", 1))
+	assert.Empty(t, recovery(ctx))
+
+	value, err := processor.Get("key")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("2"), value)
+
+	offset, err := processor.GetOffset(-1)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), offset)
+
+	assert.Nil(t, processor.Set("key", []byte("3")))
+	assert.Nil(t, processor.SetOffset(5))
+
+	// the partition moves back to a and b follows it from where its processor left off
+	assert.Nil(t, processor.Close())
+
+	highWatermark = 7
+	consumer = mocks.NewConsumer(t, nil)
+	pc = consumer.ExpectConsumePartition("group-table", 0, 6)
+	pc.YieldMessage(&sarama.ConsumerMessage{Key: []byte("key")})
+
+	standby.assign(ctx, consumer, standbyAssignment(map[int32]string{0: "a"}, members, "b", 1))
+
+	assert.Eventually(t, func() bool {
+		value, err := stores[0].Get("key")
+		return err == nil && value == nil
+	}, time.Second, 10*time.Millisecond)
+
+	standby.assign(ctx, consumer, nil)
+	assert.Empty(t, recovery(ctx))
+	assert.Empty(t, standby.partitions)
+}
+
+func Test_Standby_EmptyPartitionIsRecovered(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	standby := NewStandby(nil, "group", "group-table", 1, storage.MemoryBuilder())
+	standby.member = "b"
+	standby.newest = func(partition int32) (int64, error) {
+		return 0, nil
+	}
+
+	consumer := mocks.NewConsumer(t, nil)
+	consumer.ExpectConsumePartition("group-table", 0, sarama.OffsetOldest)
+
+	standby.assign(ctx, consumer, []int32{0})
+	defer standby.assign(ctx, consumer, nil)
+
+	recovery := standby.Recovery("service", "component", "processor")
+	assert.Eventually(t, func() bool {
+		progress := recovery(ctx)
+		return len(progress) == 1 && progress[0].Recovered
+	}, time.Second, 10*time.Millisecond)
+}
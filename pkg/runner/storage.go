@@ -0,0 +1,179 @@
+package runner
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/lovoo/goka/storage"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+// StorageBackend is the database local table state is stored in
+type StorageBackend string
+
+const (
+	// StorageBackendLevelDB stores tables in LevelDB databases under the storage root
+	StorageBackendLevelDB StorageBackend = "leveldb"
+	// StorageBackendMemory stores tables in memory. It is meant for tests and is lost on restart.
+	StorageBackendMemory StorageBackend = "memory"
+	// StorageBackendPebble stores tables in Pebble databases under the storage root
+	StorageBackendPebble StorageBackend = "pebble"
+)
+
+// StorageBuilder creates the goka storage builder of a backend for the tables stored under the path
+type StorageBuilder func(path string, options StorageOptions) (storage.Builder, error)
+
+var (
+	storageBackendsMtx sync.Mutex
+	storageBackends    = map[StorageBackend]StorageBuilder{
+		StorageBackendLevelDB: levelDBStorageBuilder,
+		StorageBackendMemory:  memoryStorageBuilder,
+		StorageBackendPebble:  pebbleStorageBuilder,
+	}
+)
+
+// RegisterStorageBackend makes a storage backend available to StorageConfig
+func RegisterStorageBackend(backend StorageBackend, builder StorageBuilder) {
+	storageBackendsMtx.Lock()
+	defer storageBackendsMtx.Unlock()
+
+	storageBackends[backend] = builder
+}
+
+// TableStorage is the storage builder of a single table of a processor
+type TableStorage struct {
+	Table   string
+	Builder storage.Builder
+}
+
+// TableStorageBuilder builds the storage of the tables with the builder configured for them
+// and every other table with the default builder
+func TableStorageBuilder(builder storage.Builder, tables ...TableStorage) storage.Builder {
+	builders := map[string]storage.Builder{}
+	for _, t := range tables {
+		builders[t.Table] = t.Builder
+	}
+
+	return func(topic string, partition int32) (storage.Storage, error) {
+		b, ok := builders[topic]
+		if !ok {
+			b = builder
+		}
+
+		return b(topic, partition)
+	}
+}
+
+// StorageOptions tune the local storage of a table. Unset fields fall back to the options they override.
+type StorageOptions struct {
+	Backend            StorageBackend
+	BlockCacheCapacity int
+	WriteBuffer        int
+}
+
+// StorageConfig configures where and how processors, views, view sources and view sinks store local table state
+type StorageConfig struct {
+	// Root is the directory tables are stored under
+	Root string
+	// Default are the options used for every table
+	Default StorageOptions
+	// Tables overrides the options of a table by its group or topic name
+	Tables map[string]StorageOptions
+}
+
+// DefaultStorageConfig stores tables in LevelDB under /tmp/storage with 1 MiB caches
+func DefaultStorageConfig() StorageConfig {
+	return StorageConfig{
+		Root: "/tmp/storage",
+		Default: StorageOptions{
+			Backend:            StorageBackendLevelDB,
+			BlockCacheCapacity: opt.MiB * 1,
+			WriteBuffer:        opt.MiB * 1,
+		},
+		Tables: map[string]StorageOptions{},
+	}
+}
+
+// WithStorage configures the local table storage of the service
+func WithStorage(config StorageConfig) ServiceOption {
+	return func(s *Service) {
+		s.storage = config
+	}
+}
+
+// Options gets the options of the table. The defaults are overridden by the options passed
+// in, such as those declared in the component definition, and then by the table options.
+func (c StorageConfig) Options(name string, overrides ...StorageOptions) StorageOptions {
+	options := c.Default
+	for _, o := range overrides {
+		options = options.merge(o)
+	}
+
+	table, ok := c.Tables[name]
+	if ok {
+		options = options.merge(table)
+	}
+
+	if options.Backend == "" {
+		options.Backend = StorageBackendLevelDB
+	}
+
+	return options
+}
+
+// Builder creates the storage builder of a table. Kind is the type of job that owns the table, such as
+// processor or view, and name is its group or topic name.
+func (c StorageConfig) Builder(kind string, name string, overrides ...StorageOptions) (storage.Builder, error) {
+	options := c.Options(name, overrides...)
+
+	storageBackendsMtx.Lock()
+	builder, ok := storageBackends[options.Backend]
+	storageBackendsMtx.Unlock()
+
+	if !ok {
+		return nil, errors.Errorf("unknown storage backend '%s'", options.Backend)
+	}
+
+	b, err := builder(filepath.Join(c.Root, kind, name), options)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create %s storage", kind)
+	}
+
+	return b, nil
+}
+
+func levelDBStorageBuilder(path string, options StorageOptions) (storage.Builder, error) {
+	err := os.MkdirAll(path, os.ModePerm)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create db directory")
+	}
+
+	return storage.BuilderWithOptions(path, &opt.Options{
+		BlockCacheCapacity: options.BlockCacheCapacity,
+		WriteBuffer:        options.WriteBuffer,
+	}), nil
+}
+
+func memoryStorageBuilder(path string, options StorageOptions) (storage.Builder, error) {
+	return func(topic string, partition int32) (storage.Storage, error) {
+		return NewMemoryStorage(), nil
+	}, nil
+}
+
+func (o StorageOptions) merge(override StorageOptions) StorageOptions {
+	if override.Backend != "" {
+		o.Backend = override.Backend
+	}
+
+	if override.BlockCacheCapacity != 0 {
+		o.BlockCacheCapacity = override.BlockCacheCapacity
+	}
+
+	if override.WriteBuffer != 0 {
+		o.WriteBuffer = override.WriteBuffer
+	}
+
+	return o
+}
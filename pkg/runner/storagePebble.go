@@ -0,0 +1,238 @@
+package runner
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/lovoo/goka/storage"
+	"github.com/pkg/errors"
+)
+
+const (
+	pebbleOffsetKey = "__offset"
+)
+
+func pebbleStorageBuilder(path string, options StorageOptions) (storage.Builder, error) {
+	err := os.MkdirAll(path, os.ModePerm)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create db directory")
+	}
+
+	return func(topic string, partition int32) (storage.Storage, error) {
+		opts := &pebble.Options{}
+		if options.BlockCacheCapacity > 0 {
+			cache := pebble.NewCache(int64(options.BlockCacheCapacity))
+			defer cache.Unref()
+			opts.Cache = cache
+		}
+		if options.WriteBuffer > 0 {
+			opts.MemTableSize = uint64(options.WriteBuffer)
+		}
+
+		db, err := pebble.Open(filepath.Join(path, fmt.Sprintf("%s.%d", topic, partition)), opts)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to open pebble db")
+		}
+
+		return &pebbleStorage{db: db}, nil
+	}, nil
+}
+
+// pebbleStorage is a goka storage backed by a Pebble database
+type pebbleStorage struct {
+	db *pebble.DB
+}
+
+func (s *pebbleStorage) Open() error {
+	return nil
+}
+
+func (s *pebbleStorage) Close() error {
+	err := s.sync()
+	if err != nil {
+		return err
+	}
+
+	return s.db.Close()
+}
+
+func (s *pebbleStorage) Has(key string) (bool, error) {
+	value, err := s.Get(key)
+	if err != nil {
+		return false, err
+	}
+
+	return value != nil, nil
+}
+
+func (s *pebbleStorage) Get(key string) ([]byte, error) {
+	value, closer, err := s.db.Get([]byte(key))
+	if err == pebble.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get key '%s' from pebble", key)
+	}
+	defer closer.Close()
+
+	return append([]byte{}, value...), nil
+}
+
+func (s *pebbleStorage) Set(key string, value []byte) error {
+	err := s.db.Set([]byte(key), value, pebble.NoSync)
+	if err != nil {
+		return errors.Wrapf(err, "failed to set key '%s' in pebble", key)
+	}
+
+	return nil
+}
+
+func (s *pebbleStorage) Delete(key string) error {
+	err := s.db.Delete([]byte(key), pebble.NoSync)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete key '%s' from pebble", key)
+	}
+
+	return nil
+}
+
+func (s *pebbleStorage) GetOffset(def int64) (int64, error) {
+	data, err := s.Get(pebbleOffsetKey)
+	if err != nil {
+		return 0, err
+	}
+
+	if data == nil {
+		return def, nil
+	}
+
+	offset, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to decode offset")
+	}
+
+	return offset, nil
+}
+
+func (s *pebbleStorage) SetOffset(offset int64) error {
+	err := s.db.Set([]byte(pebbleOffsetKey), []byte(strconv.FormatInt(offset, 10)), pebble.NoSync)
+	if err != nil {
+		return errors.Wrap(err, "failed to set offset in pebble")
+	}
+
+	return nil
+}
+
+func (s *pebbleStorage) MarkRecovered() error {
+	err := s.sync()
+	if err != nil {
+		return err
+	}
+
+	return s.db.Flush()
+}
+
+// sync writes the log to disk. Writes are not synced as they are made because goka sets the
+// offset after every message, so they are synced once the partition is recovered or closed.
+func (s *pebbleStorage) sync() error {
+	err := s.db.LogData(nil, pebble.Sync)
+	if err != nil {
+		return errors.Wrap(err, "failed to sync pebble")
+	}
+
+	return nil
+}
+
+func (s *pebbleStorage) Iterator() (storage.Iterator, error) {
+	return s.IteratorWithRange(nil, nil)
+}
+
+func (s *pebbleStorage) IteratorWithRange(start, limit []byte) (storage.Iterator, error) {
+	options := &pebble.IterOptions{}
+	if len(limit) > 0 {
+		options.LowerBound = start
+		options.UpperBound = limit
+	} else if len(start) > 0 {
+		options.LowerBound = start
+		options.UpperBound = prefixSuccessor(start)
+	}
+
+	snapshot := s.db.NewSnapshot()
+	iter, err := snapshot.NewIter(options)
+	if err != nil {
+		snapshot.Close()
+		return nil, errors.Wrap(err, "failed to create pebble iterator")
+	}
+
+	return &pebbleIterator{
+		iter:     iter,
+		snapshot: snapshot,
+	}, nil
+}
+
+// pebbleIterator adapts a pebble iterator to goka, which moves to the first pair with the first Next
+type pebbleIterator struct {
+	iter       *pebble.Iterator
+	snapshot   *pebble.Snapshot
+	positioned bool
+	seeked     bool
+}
+
+func (i *pebbleIterator) Next() bool {
+	var valid bool
+	switch {
+	case i.seeked:
+		i.seeked = false
+		valid = i.iter.Valid()
+	case !i.positioned:
+		i.positioned = true
+		valid = i.iter.First()
+	default:
+		valid = i.iter.Next()
+	}
+
+	if valid && string(i.iter.Key()) == pebbleOffsetKey {
+		valid = i.iter.Next()
+	}
+
+	return valid
+}
+
+func (i *pebbleIterator) Err() error {
+	return i.iter.Error()
+}
+
+func (i *pebbleIterator) Key() []byte {
+	return i.iter.Key()
+}
+
+func (i *pebbleIterator) Value() ([]byte, error) {
+	return i.iter.Value(), nil
+}
+
+func (i *pebbleIterator) Release() {
+	i.iter.Close()
+	i.snapshot.Close()
+}
+
+func (i *pebbleIterator) Seek(key []byte) bool {
+	i.positioned = true
+	i.seeked = true
+	return i.iter.SeekGE(key)
+}
+
+// prefixSuccessor gets the smallest key that is greater than every key with the prefix
+func prefixSuccessor(prefix []byte) []byte {
+	limit := append([]byte{}, prefix...)
+	for i := len(limit) - 1; i >= 0; i-- {
+		limit[i]++
+		if limit[i] != 0 {
+			return limit[:i+1]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package runner_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/syncromatics/kafmesh/pkg/runner"
+
+	"github.com/lovoo/goka/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_StorageConfig_Options(t *testing.T) {
+	config := runner.DefaultStorageConfig()
+	config.Tables["big.lookup"] = runner.StorageOptions{
+		BlockCacheCapacity: 64 << 20,
+	}
+
+	assert.Equal(t, runner.StorageOptions{
+		Backend:            runner.StorageBackendLevelDB,
+		BlockCacheCapacity: 1 << 20,
+		WriteBuffer:        1 << 20,
+	}, config.Options("small.lookup"))
+
+	assert.Equal(t, runner.StorageOptions{
+		Backend:            runner.StorageBackendLevelDB,
+		BlockCacheCapacity: 64 << 20,
+		WriteBuffer:        8 << 20,
+	}, config.Options("big.lookup", runner.StorageOptions{BlockCacheCapacity: 8 << 20, WriteBuffer: 8 << 20}))
+
+	assert.Equal(t, runner.StorageBackendMemory, config.Options("test", runner.StorageOptions{Backend: runner.StorageBackendMemory}).Backend)
+}
+
+func Test_StorageConfig_Builder(t *testing.T) {
+	root, err := ioutil.TempDir("", "storage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	config := runner.DefaultStorageConfig()
+	config.Root = root
+
+	builder, err := config.Builder("processor", "group")
+	assert.Nil(t, err)
+	assert.NotNil(t, builder)
+	assert.DirExists(t, filepath.Join(root, "processor", "group"))
+
+	_, err = config.Builder("view", "topic", runner.StorageOptions{Backend: runner.StorageBackendMemory})
+	assert.Nil(t, err)
+	_, err = os.Stat(filepath.Join(root, "view", "topic"))
+	assert.True(t, os.IsNotExist(err))
+
+	_, err = config.Builder("view", "topic", runner.StorageOptions{Backend: "rocksdb"})
+	assert.NotNil(t, err)
+}
+
+func Test_TableStorageBuilder(t *testing.T) {
+	tables := map[string]int{}
+	counting := func(name string) storage.Builder {
+		return func(topic string, partition int32) (storage.Storage, error) {
+			tables[name]++
+			return storage.NewMemory(), nil
+		}
+	}
+
+	builder := runner.TableStorageBuilder(counting("default"), runner.TableStorage{
+		Table:   "lookup",
+		Builder: counting("lookup"),
+	})
+
+	_, err := builder("lookup", 0)
+	assert.Nil(t, err)
+	_, err = builder("group-table", 0)
+	assert.Nil(t, err)
+	_, err = builder("group-table", 1)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]int{"lookup": 1, "default": 2}, tables)
+}
+
+func Test_StorageConfig_RegisterBackend(t *testing.T) {
+	config := runner.DefaultStorageConfig()
+
+	runner.RegisterStorageBackend("test", func(path string, options runner.StorageOptions) (storage.Builder, error) {
+		assert.Equal(t, filepath.Join(config.Root, "view", "topic"), path)
+		return storage.MemoryBuilder(), nil
+	})
+
+	_, err := config.Builder("view", "topic", runner.StorageOptions{Backend: "test"})
+	assert.Nil(t, err)
+}
+
+func Test_StorageBackends(t *testing.T) {
+	for _, backend := range []runner.StorageBackend{runner.StorageBackendLevelDB, runner.StorageBackendPebble} {
+		t.Run(string(backend), func(t *testing.T) {
+			root, err := ioutil.TempDir("", "storage")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(root)
+
+			config := runner.DefaultStorageConfig()
+			config.Root = root
+
+			builder, err := config.Builder("processor", "group", runner.StorageOptions{Backend: backend})
+			assert.Nil(t, err)
+
+			s, err := builder("group-table", 0)
+			assert.Nil(t, err)
+			assert.Nil(t, s.Open())
+
+			offset, err := s.GetOffset(-2)
+			assert.Nil(t, err)
+			assert.Equal(t, int64(-2), offset)
+
+			assert.Nil(t, s.Set("b", []byte("2")))
+			assert.Nil(t, s.Set("a", []byte("1")))
+			assert.Nil(t, s.Set("c", []byte("3")))
+			assert.Nil(t, s.Set("ca", []byte("4")))
+			assert.Nil(t, s.SetOffset(10))
+
+			value, err := s.Get("a")
+			assert.Nil(t, err)
+			assert.Equal(t, []byte("1"), value)
+
+			has, err := s.Has("d")
+			assert.Nil(t, err)
+			assert.False(t, has)
+
+			assert.Nil(t, s.Delete("b"))
+			value, err = s.Get("b")
+			assert.Nil(t, err)
+			assert.Nil(t, value)
+
+			offset, err = s.GetOffset(-2)
+			assert.Nil(t, err)
+			assert.Equal(t, int64(10), offset)
+
+			// the offset is not one of the keys of the table
+			it, err := s.Iterator()
+			assert.Nil(t, err)
+			assert.Equal(t, []string{"a", "c", "ca"}, iteratorKeys(it))
+
+			it, err = s.IteratorWithRange([]byte("c"), nil)
+			assert.Nil(t, err)
+			assert.Equal(t, []string{"c", "ca"}, iteratorKeys(it))
+
+			it, err = s.IteratorWithRange([]byte("a"), []byte("c"))
+			assert.Nil(t, err)
+			assert.Equal(t, []string{"a"}, iteratorKeys(it))
+
+			assert.Nil(t, s.MarkRecovered())
+			assert.Nil(t, s.Close())
+
+			// the table is kept when the storage is opened again
+			s, err = builder("group-table", 0)
+			assert.Nil(t, err)
+			assert.Nil(t, s.Open())
+			defer s.Close()
+
+			value, err = s.Get("ca")
+			assert.Nil(t, err)
+			assert.Equal(t, []byte("4"), value)
+
+			offset, err = s.GetOffset(-2)
+			assert.Nil(t, err)
+			assert.Equal(t, int64(10), offset)
+		})
+	}
+}
+
+func iteratorKeys(it storage.Iterator) []string {
+	defer it.Release()
+
+	keys := []string{}
+	for it.Next() {
+		keys = append(keys, string(it.Key()))
+	}
+
+	return keys
+}
@@ -0,0 +1,588 @@
+package runner
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/lovoo/goka"
+	"github.com/lovoo/goka/codec"
+	"github.com/lovoo/goka/storage"
+	"github.com/pkg/errors"
+	"github.com/syncromatics/go-kit/log"
+)
+
+var (
+	streamJoinEntryPrefix = []byte("e")
+	streamJoinTimePrefix  = []byte("t")
+)
+
+// StreamJoin joins the messages a processor handles with the messages of a co-partitioned stream that
+// arrived within a window before them. The stream is an input of the processor, so both are read by the
+// partition that owns the key, and its messages are kept in a windowed store local to the partition.
+// Messages of the stream advance the stream time of the partition and are removed from the store once
+// they are older than the window before it.
+//
+// Every change to the messages of a key is written to a compacted changelog topic co-partitioned with
+// the stream, so a member that is assigned a partition recovers its windowed store from the changelog
+// before it joins anything.
+type StreamJoin struct {
+	brokers []string
+	name    string
+	topic   string
+	window  time.Duration
+	builder storage.Builder
+	codec   goka.Codec
+	restore func(partition int32, offset int64, apply func(*sarama.ConsumerMessage) error) error
+
+	mtx        sync.Mutex
+	partitions map[int32]*streamJoinPartition
+}
+
+type streamJoinPartition struct {
+	mtx        sync.Mutex
+	storage    storage.Storage
+	streamTime time.Time
+	closed     bool
+}
+
+// streamJoinEntry is a message of the stream in the changelog
+type streamJoinEntry struct {
+	Timestamp time.Time `json:"timestamp"`
+	Offset    int64     `json:"offset"`
+	Value     []byte    `json:"value"`
+}
+
+// NewStreamJoin creates the stream join of the topic. The windowed store of each partition is created
+// with the builder.
+func NewStreamJoin(brokers []string, name string, topic string, window time.Duration, c goka.Codec, builder storage.Builder) *StreamJoin {
+	j := &StreamJoin{
+		brokers:    brokers,
+		name:       name,
+		topic:      topic,
+		window:     window,
+		codec:      c,
+		builder:    builder,
+		partitions: map[int32]*streamJoinPartition{},
+	}
+	j.restore = j.consume
+
+	return j
+}
+
+// Name is the name of the windowed store
+func (j *StreamJoin) Name() string {
+	return j.name
+}
+
+// Changelog is the compacted topic the windowed store is recovered from
+func (j *StreamJoin) Changelog() string {
+	return j.name + "-table"
+}
+
+// Edge is the processor input edge the joined stream is read with. Processors that already read the
+// stream as an input add its messages themselves instead.
+func (j *StreamJoin) Edge() goka.Edge {
+	return goka.Input(goka.Stream(j.topic), j.codec, func(ctx goka.Context, m interface{}) {
+		err := j.Add(ctx, m)
+		if err != nil {
+			ctx.Fail(err)
+		}
+	})
+}
+
+// ChangelogEdge is the processor output edge the changelog is written with
+func (j *StreamJoin) ChangelogEdge() goka.Edge {
+	return goka.Output(goka.Stream(j.Changelog()), new(codec.Bytes))
+}
+
+// Within gets the messages of the stream with the key that are within the window before the message
+// being processed, oldest first. The key has to belong to the partition of the message being processed.
+// A message of the stream does not join itself.
+func (j *StreamJoin) Within(ctx goka.Context, key string) ([]interface{}, error) {
+	p, err := j.partition(ctx.Partition())
+	if err != nil {
+		return nil, err
+	}
+	defer p.mtx.Unlock()
+
+	current := ""
+	if string(ctx.Topic()) == j.topic && ctx.Key() == key {
+		current = string(streamJoinEntryKey(key, ctx.Timestamp(), ctx.Offset()))
+	}
+
+	start := streamJoinEntryKey(key, ctx.Timestamp().Add(-j.window), 0)
+	limit := streamJoinEntryKey(key, ctx.Timestamp().Add(time.Nanosecond), 0)
+
+	entries, err := j.entries(p, start, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := []interface{}{}
+	for _, entry := range entries {
+		if string(streamJoinEntryKey(key, entry.Timestamp, entry.Offset)) == current {
+			continue
+		}
+
+		message, err := j.codec.Decode(entry.Value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode stream join message from '%s'", j.topic)
+		}
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+}
+
+// Run closes the windowed stores once the service stops
+func (j *StreamJoin) Run(ctx context.Context) func() error {
+	return func() error {
+		<-ctx.Done()
+
+		j.mtx.Lock()
+		defer j.mtx.Unlock()
+
+		for partition := range j.partitions {
+			err := j.close(partition)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// Rebalanced closes the windowed stores of the partitions the processor is no longer assigned, so
+// they are recovered from the changelog again if it is assigned them later
+func (j *StreamJoin) Rebalanced(assignment goka.Assignment) {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	for partition := range j.partitions {
+		_, ok := assignment[partition]
+		if ok {
+			continue
+		}
+
+		err := j.close(partition)
+		if err != nil {
+			log.Error("failed to close revoked stream join partition", "topic", j.topic, "partition", partition, "error", err)
+		}
+	}
+}
+
+// Add stores the message of the stream being processed in the windowed store of its partition. Retries
+// of the message are not added again since the message was stored when it was first processed.
+func (j *StreamJoin) Add(ctx goka.Context, message interface{}) error {
+	_, ok := ctx.(*retryContext)
+	if ok {
+		return nil
+	}
+
+	p, err := j.partition(ctx.Partition())
+	if err != nil {
+		return err
+	}
+	defer p.mtx.Unlock()
+
+	changed, err := j.advance(p, ctx.Timestamp())
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Timestamp().Before(p.streamTime.Add(-j.window)) {
+		value, err := j.codec.Encode(message)
+		if err != nil {
+			return errors.Wrapf(err, "failed to encode stream join message from '%s'", j.topic)
+		}
+
+		err = j.set(p, ctx.Key(), streamJoinEntry{Timestamp: ctx.Timestamp(), Offset: ctx.Offset(), Value: value})
+		if err != nil {
+			return err
+		}
+		changed[ctx.Key()] = true
+	}
+
+	keys := []string{}
+	for key := range changed {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		err = j.emit(ctx, p, key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// advance moves the stream time of the partition forward to the timestamp and removes the messages
+// that are older than the window before it. It returns the keys that lost messages.
+func (j *StreamJoin) advance(p *streamJoinPartition, timestamp time.Time) (map[string]bool, error) {
+	changed := map[string]bool{}
+	if !timestamp.After(p.streamTime) {
+		return changed, nil
+	}
+	p.streamTime = timestamp
+
+	it, err := p.storage.IteratorWithRange(streamJoinTimePrefix, streamJoinTimeKey(timestamp.Add(-j.window), 0))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to expire stream join '%s'", j.topic)
+	}
+
+	expired := map[string]string{}
+	for it.Next() {
+		entry, err := it.Value()
+		if err != nil {
+			it.Release()
+			return nil, errors.Wrapf(err, "failed to expire stream join '%s'", j.topic)
+		}
+		expired[string(it.Key())] = string(entry)
+	}
+	it.Release()
+
+	for index, entry := range expired {
+		err = p.storage.Delete(entry)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to expire stream join '%s'", j.topic)
+		}
+
+		err = p.storage.Delete(index)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to expire stream join '%s'", j.topic)
+		}
+
+		changed[streamJoinKey([]byte(entry))] = true
+	}
+
+	return changed, nil
+}
+
+// emit writes the messages of the key in the windowed store to the changelog. A key without messages
+// is deleted from the changelog.
+func (j *StreamJoin) emit(ctx goka.Context, p *streamJoinPartition, key string) error {
+	start, limit := streamJoinKeyRange(key)
+	entries, err := j.entries(p, start, limit)
+	if err != nil {
+		return err
+	}
+
+	if len(entries) == 0 {
+		ctx.Emit(goka.Stream(j.Changelog()), key, nil)
+		return nil
+	}
+
+	value, err := json.Marshal(entries)
+	if err != nil {
+		return errors.Wrapf(err, "failed to encode stream join '%s' changelog", j.topic)
+	}
+
+	ctx.Emit(goka.Stream(j.Changelog()), key, value)
+	return nil
+}
+
+// entries reads the messages of the windowed store in the range ordered by key
+func (j *StreamJoin) entries(p *streamJoinPartition, start, limit []byte) ([]streamJoinEntry, error) {
+	it, err := p.storage.IteratorWithRange(start, limit)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read stream join '%s'", j.topic)
+	}
+	defer it.Release()
+
+	// not every storage iterates in key order
+	values := map[string][]byte{}
+	keys := []string{}
+	for it.Next() {
+		value, err := it.Value()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read stream join '%s'", j.topic)
+		}
+
+		values[string(it.Key())] = append([]byte{}, value...)
+		keys = append(keys, string(it.Key()))
+	}
+	sort.Strings(keys)
+
+	entries := []streamJoinEntry{}
+	for _, key := range keys {
+		timestamp, offset := streamJoinEntryTime([]byte(key))
+		entries = append(entries, streamJoinEntry{Timestamp: timestamp, Offset: offset, Value: values[key]})
+	}
+
+	return entries, nil
+}
+
+// set stores a message of the key in the windowed store
+func (j *StreamJoin) set(p *streamJoinPartition, key string, entry streamJoinEntry) error {
+	entryKey := streamJoinEntryKey(key, entry.Timestamp, entry.Offset)
+	err := p.storage.Set(string(entryKey), entry.Value)
+	if err != nil {
+		return errors.Wrapf(err, "failed to store stream join message from '%s'", j.topic)
+	}
+
+	err = p.storage.Set(string(streamJoinTimeKey(entry.Timestamp, entry.Offset)), entryKey)
+	if err != nil {
+		return errors.Wrapf(err, "failed to store stream join message from '%s'", j.topic)
+	}
+
+	return nil
+}
+
+// apply replaces the messages of a key in the windowed store with the messages of a changelog record
+func (j *StreamJoin) apply(p *streamJoinPartition, msg *sarama.ConsumerMessage) error {
+	key := string(msg.Key)
+
+	start, limit := streamJoinKeyRange(key)
+	existing, err := j.entries(p, start, limit)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range existing {
+		err = p.storage.Delete(string(streamJoinEntryKey(key, entry.Timestamp, entry.Offset)))
+		if err != nil {
+			return errors.Wrapf(err, "failed to recover stream join '%s'", j.topic)
+		}
+
+		err = p.storage.Delete(string(streamJoinTimeKey(entry.Timestamp, entry.Offset)))
+		if err != nil {
+			return errors.Wrapf(err, "failed to recover stream join '%s'", j.topic)
+		}
+	}
+
+	entries := []streamJoinEntry{}
+	if msg.Value != nil {
+		err = json.Unmarshal(msg.Value, &entries)
+		if err != nil {
+			return errors.Wrapf(err, "failed to decode stream join '%s' changelog", j.topic)
+		}
+	}
+
+	for _, entry := range entries {
+		err = j.set(p, key, entry)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = p.storage.SetOffset(msg.Offset)
+	if err != nil {
+		return errors.Wrapf(err, "failed to store stream join '%s' changelog offset", j.topic)
+	}
+
+	return nil
+}
+
+// partition gets the windowed store of the partition locked. The store is created and recovered from
+// the changelog the first time the partition is read after it is assigned.
+func (j *StreamJoin) partition(partition int32) (*streamJoinPartition, error) {
+	for {
+		j.mtx.Lock()
+		p, ok := j.partitions[partition]
+		if !ok {
+			p = &streamJoinPartition{}
+			j.partitions[partition] = p
+		}
+		j.mtx.Unlock()
+
+		p.mtx.Lock()
+		if p.closed {
+			// the partition was revoked before it was locked
+			p.mtx.Unlock()
+			continue
+		}
+
+		if p.storage != nil {
+			return p, nil
+		}
+
+		err := j.open(partition, p)
+		if err != nil {
+			p.mtx.Unlock()
+			return nil, err
+		}
+
+		return p, nil
+	}
+}
+
+// open creates the windowed store of the partition and recovers the changes it is missing from the changelog
+func (j *StreamJoin) open(partition int32, p *streamJoinPartition) error {
+	s, err := j.builder(j.name, partition)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create stream join '%s' storage", j.topic)
+	}
+
+	err = s.Open()
+	if err != nil {
+		return errors.Wrapf(err, "failed to open stream join '%s' storage", j.topic)
+	}
+	p.storage = s
+
+	if j.restore != nil {
+		offset, err := s.GetOffset(sarama.OffsetOldest)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get stream join '%s' changelog offset", j.topic)
+		}
+
+		err = j.restore(partition, offset, func(msg *sarama.ConsumerMessage) error {
+			return j.apply(p, msg)
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to recover stream join '%s' partition %d", j.topic, partition)
+		}
+	}
+
+	err = s.MarkRecovered()
+	if err != nil {
+		return errors.Wrapf(err, "failed to mark stream join '%s' storage recovered", j.topic)
+	}
+
+	return nil
+}
+
+// close closes the windowed store of the partition. The caller holds the stream join lock.
+func (j *StreamJoin) close(partition int32) error {
+	p := j.partitions[partition]
+	delete(j.partitions, partition)
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.closed = true
+	if p.storage == nil {
+		return nil
+	}
+
+	err := p.storage.Close()
+	if err != nil {
+		return errors.Wrapf(err, "failed to close stream join '%s' partition %d", j.topic, partition)
+	}
+
+	return nil
+}
+
+// consume reads the changelog partition after the offset up to its high watermark
+func (j *StreamJoin) consume(partition int32, offset int64, apply func(*sarama.ConsumerMessage) error) error {
+	config := sarama.NewConfig()
+	config.Version = sarama.MaxVersion
+
+	client, err := sarama.NewClient(j.brokers, config)
+	if err != nil {
+		return errors.Wrap(err, "failed to create changelog client")
+	}
+	defer client.Close()
+
+	oldest, err := client.GetOffset(j.Changelog(), partition, sarama.OffsetOldest)
+	if err != nil {
+		return errors.Wrap(err, "failed to get changelog oldest offset")
+	}
+
+	newest, err := client.GetOffset(j.Changelog(), partition, sarama.OffsetNewest)
+	if err != nil {
+		return errors.Wrap(err, "failed to get changelog high watermark")
+	}
+
+	start := offset + 1
+	if start < oldest {
+		start = oldest
+	}
+
+	if start >= newest {
+		return nil
+	}
+
+	consumer, err := sarama.NewConsumerFromClient(client)
+	if err != nil {
+		return errors.Wrap(err, "failed to create changelog consumer")
+	}
+	defer consumer.Close()
+
+	pc, err := consumer.ConsumePartition(j.Changelog(), partition, start)
+	if err != nil {
+		return errors.Wrap(err, "failed to consume changelog")
+	}
+	defer pc.Close()
+
+	for {
+		select {
+		case msg, ok := <-pc.Messages():
+			if !ok {
+				return errors.Errorf("changelog consumer closed before offset %d", newest-1)
+			}
+
+			err = apply(msg)
+			if err != nil {
+				return err
+			}
+
+			if msg.Offset >= newest-1 {
+				return nil
+			}
+
+		case err, ok := <-pc.Errors():
+			if ok {
+				return errors.Wrap(err, "failed to consume changelog")
+			}
+		}
+	}
+}
+
+// test keeps the windowed stores in the storage of the tester, which has no changelog to recover from
+func (j *StreamJoin) test(tester Tester) {
+	j.restore = nil
+}
+
+// streamJoinEntryKey is the store key of a message. Messages of a key are ordered by their timestamp.
+func streamJoinEntryKey(key string, timestamp time.Time, offset int64) []byte {
+	b := append([]byte{}, streamJoinEntryPrefix...)
+	b = append(b, key...)
+	b = append(b, 0)
+	return append(b, streamJoinTime(timestamp, offset)...)
+}
+
+// streamJoinKeyRange is the range of the store keys of the messages of a key
+func streamJoinKeyRange(key string) ([]byte, []byte) {
+	start := append(append(append([]byte{}, streamJoinEntryPrefix...), key...), 0)
+	limit := append(append(append([]byte{}, streamJoinEntryPrefix...), key...), 1)
+	return start, limit
+}
+
+// streamJoinKey is the key of the message with the store key
+func streamJoinKey(entryKey []byte) string {
+	return string(entryKey[len(streamJoinEntryPrefix) : len(entryKey)-17])
+}
+
+// streamJoinEntryTime is the timestamp and offset of the message with the store key
+func streamJoinEntryTime(entryKey []byte) (time.Time, int64) {
+	b := entryKey[len(entryKey)-16:]
+	nanos := int64(binary.BigEndian.Uint64(b))
+	offset := int64(binary.BigEndian.Uint64(b[8:]))
+	return time.Unix(0, nanos).UTC(), offset
+}
+
+// streamJoinTimeKey is the store key that indexes a message by its timestamp so it can be expired
+func streamJoinTimeKey(timestamp time.Time, offset int64) []byte {
+	return append(append([]byte{}, streamJoinTimePrefix...), streamJoinTime(timestamp, offset)...)
+}
+
+func streamJoinTime(timestamp time.Time, offset int64) []byte {
+	nanos := timestamp.UnixNano()
+	if nanos < 0 {
+		nanos = 0
+	}
+
+	b := make([]byte, 16)
+	binary.BigEndian.PutUint64(b, uint64(nanos))
+	binary.BigEndian.PutUint64(b[8:], uint64(offset))
+	return b
+}
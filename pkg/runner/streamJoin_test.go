@@ -0,0 +1,177 @@
+package runner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/lovoo/goka"
+	"github.com/lovoo/goka/codec"
+	"github.com/lovoo/goka/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_StreamJoin_Within(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	stores := map[int32]storage.Storage{}
+	join := NewStreamJoin(nil, "test.join", "test.pings", 30*time.Second, new(codec.String), func(topic string, partition int32) (storage.Storage, error) {
+		stores[partition] = NewMemoryStorage()
+		return stores[partition], nil
+	})
+	join.restore = nil
+
+	assert.Nil(t, join.Add(&joinContext{key: "a", timestamp: start, offset: 0}, "ping 1"))
+	assert.Nil(t, join.Add(&joinContext{key: "b", timestamp: start.Add(5 * time.Second), offset: 1}, "ping 2"))
+	assert.Nil(t, join.Add(&joinContext{key: "a", timestamp: start.Add(20 * time.Second), offset: 2}, "ping 3"))
+
+	messages, err := join.Within(&joinContext{key: "a", timestamp: start.Add(25 * time.Second)}, "a")
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{"ping 1", "ping 3"}, messages)
+
+	// messages after the message being processed are not joined
+	messages, err = join.Within(&joinContext{key: "a", timestamp: start.Add(10 * time.Second)}, "a")
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{"ping 1"}, messages)
+
+	// the stream time passes the first pings by more than the window so they are removed from the store
+	assert.Nil(t, join.Add(&joinContext{key: "c", timestamp: start.Add(40 * time.Second), offset: 3}, "ping 4"))
+	assert.Equal(t, 4, storeLen(t, stores[0]))
+
+	messages, err = join.Within(&joinContext{key: "a", timestamp: start.Add(45 * time.Second)}, "a")
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{"ping 3"}, messages)
+
+	// messages too old to join anything are not stored
+	assert.Nil(t, join.Add(&joinContext{key: "a", timestamp: start, offset: 4}, "ping 5"))
+	assert.Equal(t, 4, storeLen(t, stores[0]))
+
+	// a message of the stream does not join itself
+	messages, err = join.Within(&joinContext{topic: "test.pings", key: "c", timestamp: start.Add(40 * time.Second), offset: 3}, "c")
+	assert.Nil(t, err)
+	assert.Empty(t, messages)
+
+	messages, err = join.Within(&joinContext{key: "a", partition: 1, timestamp: start.Add(25 * time.Second)}, "a")
+	assert.Nil(t, err)
+	assert.Empty(t, messages)
+}
+
+func Test_StreamJoin_Changelog(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	join := NewStreamJoin(nil, "test.join", "test.pings", 30*time.Second, new(codec.String), func(topic string, partition int32) (storage.Storage, error) {
+		return NewMemoryStorage(), nil
+	})
+	join.restore = nil
+
+	changelog := []*sarama.ConsumerMessage{}
+	emit := func(topic goka.Stream, key string, value interface{}) {
+		assert.Equal(t, goka.Stream("test.join-table"), topic)
+
+		msg := &sarama.ConsumerMessage{Key: []byte(key), Offset: int64(len(changelog))}
+		if value != nil {
+			msg.Value = value.([]byte)
+		}
+		changelog = append(changelog, msg)
+	}
+
+	assert.Nil(t, join.Add(&joinContext{key: "a", timestamp: start, offset: 0, emit: emit}, "ping 1"))
+	assert.Nil(t, join.Add(&joinContext{key: "b", timestamp: start.Add(5 * time.Second), offset: 1, emit: emit}, "ping 2"))
+	assert.Nil(t, join.Add(&joinContext{key: "a", timestamp: start.Add(20 * time.Second), offset: 2, emit: emit}, "ping 3"))
+	assert.Len(t, changelog, 3)
+
+	// retries of a message were added when it was first processed
+	assert.Nil(t, join.Add(&retryContext{gokaContext: &joinContext{key: "a", timestamp: start.Add(20 * time.Second), offset: 2, emit: emit}}, "ping 3"))
+	assert.Len(t, changelog, 3)
+
+	// keys that lose messages are written again and keys without messages are deleted
+	assert.Nil(t, join.Add(&joinContext{key: "c", timestamp: start.Add(40 * time.Second), offset: 3, emit: emit}, "ping 4"))
+	assert.Len(t, changelog, 6)
+	assert.Equal(t, "a", string(changelog[3].Key))
+	assert.Equal(t, "b", string(changelog[4].Key))
+	assert.Nil(t, changelog[4].Value)
+	assert.Equal(t, "c", string(changelog[5].Key))
+
+	// a member assigned the partition recovers the messages from the changelog
+	stores := map[int32]storage.Storage{}
+	recovered := NewStreamJoin(nil, "test.join", "test.pings", 30*time.Second, new(codec.String), func(topic string, partition int32) (storage.Storage, error) {
+		_, ok := stores[partition]
+		if !ok {
+			stores[partition] = NewMemoryStorage()
+		}
+		return stores[partition], nil
+	})
+
+	offsets := []int64{}
+	recovered.restore = func(partition int32, offset int64, apply func(*sarama.ConsumerMessage) error) error {
+		offsets = append(offsets, offset)
+		for _, msg := range changelog {
+			if msg.Offset > offset {
+				err := apply(msg)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	messages, err := recovered.Within(&joinContext{key: "door", timestamp: start.Add(45 * time.Second)}, "a")
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{"ping 3"}, messages)
+
+	messages, err = recovered.Within(&joinContext{key: "door", timestamp: start.Add(45 * time.Second)}, "b")
+	assert.Nil(t, err)
+	assert.Empty(t, messages)
+	assert.Equal(t, 4, storeLen(t, stores[0]))
+
+	// revoked partitions are recovered again from the offset they stopped at
+	recovered.Rebalanced(goka.Assignment{1: sarama.OffsetNewest})
+	assert.Nil(t, recovered.Add(&joinContext{key: "a", timestamp: start.Add(50 * time.Second), offset: 4, emit: emit}, "ping 5"))
+	assert.Equal(t, []int64{sarama.OffsetOldest, 5}, offsets)
+
+	stored, err := stores[0].GetOffset(sarama.OffsetOldest)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), stored)
+
+	messages, err = recovered.Within(&joinContext{key: "door", timestamp: start.Add(50 * time.Second)}, "a")
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{"ping 3", "ping 5"}, messages)
+}
+
+func storeLen(t *testing.T, s storage.Storage) int {
+	it, err := s.Iterator()
+	assert.Nil(t, err)
+	defer it.Release()
+
+	count := 0
+	for it.Next() {
+		count++
+	}
+
+	return count
+}
+
+type joinContext struct {
+	gokaContext
+	topic     string
+	key       string
+	partition int32
+	offset    int64
+	timestamp time.Time
+	emit      func(topic goka.Stream, key string, value interface{})
+}
+
+func (c *joinContext) Topic() goka.Stream   { return goka.Stream(c.topic) }
+func (c *joinContext) Key() string          { return c.key }
+func (c *joinContext) Partition() int32     { return c.partition }
+func (c *joinContext) Offset() int64        { return c.offset }
+func (c *joinContext) Timestamp() time.Time { return c.timestamp }
+
+func (c *joinContext) Emit(topic goka.Stream, key string, value interface{}, options ...goka.ContextOption) {
+	if c.emit != nil {
+		c.emit(topic, key, value)
+	}
+}
+
+var _ goka.Context = &joinContext{}
@@ -0,0 +1,77 @@
+package runner
+
+import (
+	"context"
+	"time"
+
+	"github.com/lovoo/goka"
+)
+
+// Tester replaces kafka with an in memory backend so the components of a service can run in tests.
+// Goka processors, views and emitters are connected to it with the goka tester options and the
+// consumer groups the service creates itself are registered with it. Timers and sinks tick on the
+// tester clock instead of the wall clock.
+type Tester interface {
+	goka.Tester
+	// RegisterConsumerGroup registers a consumer group of the topic that goka does not create and returns its client id
+	RegisterConsumerGroup(group string, topic goka.Stream, codec goka.Codec) string
+	// Now returns the time of the tester clock
+	Now() time.Time
+	// OnAdvance calls the function with the new time whenever the tester clock is advanced
+	OnAdvance(func(now time.Time) error)
+}
+
+// WithTester runs the service against the tester instead of kafka
+func WithTester(tester Tester) ServiceOption {
+	return func(s *Service) {
+		s.tester = tester
+	}
+}
+
+// Tester gets the tester the service runs against. It is nil unless the service runs in a test.
+func (o ServiceOptions) Tester() Tester {
+	return o.tester
+}
+
+// ProcessorOptions connects the goka processor to the tester if the service runs against one
+func (o ServiceOptions) ProcessorOptions(options ...goka.ProcessorOption) []goka.ProcessorOption {
+	if o.tester == nil {
+		return options
+	}
+
+	return append(options, goka.WithTester(o.tester))
+}
+
+// ViewOptions connects the goka view to the tester if the service runs against one
+func (o ServiceOptions) ViewOptions(options ...goka.ViewOption) []goka.ViewOption {
+	if o.tester == nil {
+		return options
+	}
+
+	return append(options, goka.WithViewTester(o.tester))
+}
+
+// EmitterOptions connects the goka emitter to the tester if the service runs against one
+func (o ServiceOptions) EmitterOptions(options ...goka.EmitterOption) []goka.EmitterOption {
+	if o.tester == nil {
+		return options
+	}
+
+	return append(options, goka.WithEmitterTester(o.tester))
+}
+
+// onInterval calls tick each time the tester clock passes another interval until the context is done
+func onInterval(ctx context.Context, tester Tester, interval time.Duration, tick func(now time.Time) error) {
+	next := tester.Now().Add(interval)
+	tester.OnAdvance(func(now time.Time) error {
+		if ctx.Err() != nil || now.Before(next) {
+			return nil
+		}
+
+		for !next.After(now) {
+			next = next.Add(interval)
+		}
+
+		return tick(now)
+	})
+}
@@ -0,0 +1,353 @@
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/burdiyan/kafkautil"
+	"github.com/lovoo/goka"
+	"github.com/lovoo/goka/storage"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
+)
+
+// Timer is a timer scheduled for a key
+type Timer struct {
+	At time.Time `json:"at"`
+	// Fire is set when the timer is due and should be sent to the processor
+	Fire bool `json:"fire,omitempty"`
+}
+
+// TimerCodec encodes timers
+type TimerCodec struct{}
+
+// Encode encodes a timer
+func (c *TimerCodec) Encode(value interface{}) ([]byte, error) {
+	timer, ok := value.(*Timer)
+	if !ok {
+		return nil, errors.Errorf("expecting value of type '*runner.Timer' got type '%T'", value)
+	}
+
+	return json.Marshal(timer)
+}
+
+// Decode decodes a timer
+func (c *TimerCodec) Decode(data []byte) (interface{}, error) {
+	timer := &Timer{}
+	err := json.Unmarshal(data, timer)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode timer")
+	}
+
+	return timer, nil
+}
+
+// Timers keeps the timers a processor schedules in a compacted table owned by its own consumer group
+// and sends them back to the processor when they are due. Each key has at most one timer and scheduling
+// it again replaces it. The timer group only fires the timers of the partitions it is assigned, so
+// timers survive rebalances and restarts.
+type Timers struct {
+	*scheduledGroup
+	fired map[string]time.Time
+}
+
+// NewTimers creates the timers for the processor group
+func NewTimers(brokers []string, group string, builder storage.Builder) *Timers {
+	t := &Timers{
+		fired: map[string]time.Time{},
+	}
+	t.scheduledGroup = newScheduledGroup(brokers, group+"-timers", builder, new(TimerCodec), new(TimerCodec), t.handle, t.fire)
+
+	return t
+}
+
+// Schedule schedules the timer for the key from a processor callback
+func (t *Timers) Schedule(ctx goka.Context, key string, at time.Time) {
+	ctx.Emit(goka.Stream(t.Topic()), key, &Timer{At: at})
+}
+
+// Edge is the processor output edge timers are scheduled with
+func (t *Timers) Edge() goka.Edge {
+	return goka.Output(goka.Stream(t.Topic()), new(TimerCodec))
+}
+
+// Fired is the processor input edge due timers are received on
+func (t *Timers) Fired(handler func(ctx goka.Context, at time.Time)) goka.Edge {
+	return goka.Input(goka.Stream(t.FiredTopic()), new(TimerCodec), func(ctx goka.Context, m interface{}) {
+		handler(ctx, m.(*Timer).At)
+	})
+}
+
+// handle stores scheduled timers and forwards due timers to the processor if they were not
+// rescheduled after the scan that found them due
+func (t *Timers) handle(ctx goka.Context, m interface{}) {
+	timer := m.(*Timer)
+	if !timer.Fire {
+		ctx.SetValue(timer)
+		return
+	}
+
+	current, ok := ctx.Value().(*Timer)
+	if !ok || !current.At.Equal(timer.At) {
+		return
+	}
+
+	ctx.Delete()
+	ctx.Emit(goka.Stream(t.FiredTopic()), ctx.Key(), current)
+}
+
+func (t *Timers) fire(emitter *goka.Emitter, storages []storage.Storage, now time.Time) error {
+	due := map[string]time.Time{}
+	for _, s := range storages {
+		it, err := s.Iterator()
+		if err != nil {
+			// the partition was revoked and its storage closed
+			continue
+		}
+
+		for it.Next() {
+			value, err := it.Value()
+			if err != nil {
+				break
+			}
+
+			timer := &Timer{}
+			err = json.Unmarshal(value, timer)
+			if err != nil {
+				continue
+			}
+
+			if timer.At.After(now) {
+				continue
+			}
+
+			due[string(it.Key())] = timer.At
+		}
+		it.Release()
+	}
+
+	for key, at := range due {
+		fired, ok := t.fired[key]
+		if ok && fired.Equal(at) {
+			continue
+		}
+
+		err := emitter.EmitSync(key, &Timer{At: at, Fire: true})
+		if err != nil {
+			return errors.Wrapf(err, "failed to fire timer for key '%s'", key)
+		}
+	}
+
+	t.fired = due
+
+	return nil
+}
+
+// scheduledGroup is a consumer group that keeps the messages scheduled on its topic in a compacted table
+// and regularly scans the partitions it is assigned for the ones that are due. Due messages are sent back
+// through the group so they are forwarded from the partition that owns them.
+type scheduledGroup struct {
+	brokers  []string
+	group    string
+	builder  storage.Builder
+	interval time.Duration
+	codec    goka.Codec
+	table    goka.Codec
+	handle   goka.ProcessCallback
+	scan     func(emitter *goka.Emitter, storages []storage.Storage, now time.Time) error
+
+	tester    Tester
+	processor *goka.Processor
+	emitter   *goka.Emitter
+
+	mtx      sync.Mutex
+	storages map[int32]storage.Storage
+}
+
+func newScheduledGroup(brokers []string, group string, builder storage.Builder, codec, table goka.Codec,
+	handle goka.ProcessCallback, scan func(*goka.Emitter, []storage.Storage, time.Time) error) *scheduledGroup {
+	return &scheduledGroup{
+		brokers:  brokers,
+		group:    group,
+		builder:  builder,
+		interval: time.Second,
+		codec:    codec,
+		table:    table,
+		handle:   handle,
+		scan:     scan,
+		storages: map[int32]storage.Storage{},
+	}
+}
+
+// Topic is the stream messages are scheduled on
+func (g *scheduledGroup) Topic() string {
+	return g.group
+}
+
+// FiredTopic is the stream due messages are sent to the processor on
+func (g *scheduledGroup) FiredTopic() string {
+	return g.group + "-fired"
+}
+
+// Run runs the group and fires due messages
+func (g *scheduledGroup) Run(ctx context.Context) func() error {
+	return func() error {
+		processor, emitter := g.processor, g.emitter
+		if processor == nil {
+			var err error
+			processor, emitter, err = g.create()
+			if err != nil {
+				return err
+			}
+		}
+		defer emitter.Finish()
+
+		grp, ctx := errgroup.WithContext(ctx)
+		grp.Go(func() error {
+			err := processor.Run(ctx)
+			if err != nil {
+				return errors.Wrapf(err, "failed to run '%s' processor", g.group)
+			}
+
+			return nil
+		})
+
+		if g.tester != nil {
+			onInterval(ctx, g.tester, g.interval, func(now time.Time) error {
+				return g.fire(emitter, now)
+			})
+
+			return grp.Wait()
+		}
+
+		grp.Go(func() error {
+			ticker := time.NewTicker(g.interval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case now := <-ticker.C:
+					err := g.fire(emitter, now)
+					if err != nil {
+						return err
+					}
+				}
+			}
+		})
+
+		return grp.Wait()
+	}
+}
+
+// test creates the group against the tester so it is registered with it before the service runs.
+// Due messages are fired when the tester clock advances.
+func (g *scheduledGroup) test(tester Tester) error {
+	g.tester = tester
+	g.builder = tester.StorageBuilder()
+
+	processor, emitter, err := g.create()
+	if err != nil {
+		return err
+	}
+
+	g.processor = processor
+	g.emitter = emitter
+
+	return nil
+}
+
+func (g *scheduledGroup) create() (*goka.Processor, *goka.Emitter, error) {
+	config := sarama.NewConfig()
+	config.Version = sarama.MaxVersion
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	config.Consumer.Offsets.AutoCommit.Enable = true
+	config.Consumer.Offsets.CommitInterval = 1 * time.Second
+
+	group := goka.DefineGroup(goka.Group(g.group),
+		goka.Input(goka.Stream(g.Topic()), g.codec, g.handle),
+		goka.Output(goka.Stream(g.FiredTopic()), g.codec),
+		goka.Persist(g.table),
+	)
+
+	processorOptions := []goka.ProcessorOption{
+		goka.WithConsumerGroupBuilder(goka.ConsumerGroupBuilderWithConfig(config)),
+	}
+	emitterOptions := []goka.EmitterOption{
+		goka.WithEmitterHasher(kafkautil.MurmurHasher),
+	}
+	if g.tester != nil {
+		processorOptions = append(processorOptions, goka.WithTester(g.tester))
+		emitterOptions = append(emitterOptions, goka.WithEmitterTester(g.tester))
+	}
+
+	processor, err := goka.NewProcessor(g.brokers,
+		group,
+		append(processorOptions,
+			goka.WithStorageBuilder(g.storageBuilder),
+			goka.WithRebalanceCallback(g.rebalance),
+			goka.WithHasher(kafkautil.MurmurHasher))...)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to create '%s' processor", g.group)
+	}
+
+	emitter, err := goka.NewEmitter(g.brokers,
+		goka.Stream(g.Topic()),
+		g.codec,
+		emitterOptions...)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to create '%s' emitter", g.group)
+	}
+
+	return processor, emitter, nil
+}
+
+// now is the time on the clock the group fires by
+func (g *scheduledGroup) now() time.Time {
+	if g.tester != nil {
+		return g.tester.Now()
+	}
+
+	return time.Now()
+}
+
+// fire scans the storages of the assigned partitions for due messages
+func (g *scheduledGroup) fire(emitter *goka.Emitter, now time.Time) error {
+	g.mtx.Lock()
+	storages := []storage.Storage{}
+	for _, s := range g.storages {
+		storages = append(storages, s)
+	}
+	g.mtx.Unlock()
+
+	return g.scan(emitter, storages, now)
+}
+
+func (g *scheduledGroup) storageBuilder(topic string, partition int32) (storage.Storage, error) {
+	s, err := g.builder(topic, partition)
+	if err != nil {
+		return nil, err
+	}
+
+	g.mtx.Lock()
+	g.storages[partition] = s
+	g.mtx.Unlock()
+
+	return s, nil
+}
+
+func (g *scheduledGroup) rebalance(assignment goka.Assignment) {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	for partition := range g.storages {
+		_, ok := assignment[partition]
+		if !ok {
+			delete(g.storages, partition)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package runner_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/syncromatics/kafmesh/pkg/runner"
+
+	"github.com/lovoo/goka/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Timers_Topics(t *testing.T) {
+	timers := runner.NewTimers([]string{"localhost:9092"}, "service.component.processor", storage.MemoryBuilder())
+
+	assert.Equal(t, "service.component.processor-timers", timers.Topic())
+	assert.Equal(t, "service.component.processor-timers-fired", timers.FiredTopic())
+}
+
+func Test_TimerCodec(t *testing.T) {
+	codec := &runner.TimerCodec{}
+	at := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	data, err := codec.Encode(&runner.Timer{At: at, Fire: true})
+	assert.Nil(t, err)
+
+	timer, err := codec.Decode(data)
+	assert.Nil(t, err)
+	assert.Equal(t, &runner.Timer{At: at, Fire: true}, timer)
+
+	_, err = codec.Encode("not a timer")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,178 @@
+package runner
+
+import (
+	"context"
+
+	"github.com/Shopify/sarama"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/syncromatics/kafmesh"
+
+// TracingConfig configures the OpenTelemetry spans of the service
+type TracingConfig struct {
+	// Exporter receives the finished spans
+	Exporter sdktrace.SpanExporter
+	// Synchronous exports each span when it ends instead of in batches. It is meant for tests.
+	Synchronous bool
+}
+
+// WithTracing creates OpenTelemetry spans for the messages sources emit, processors handle and
+// sinks collect. The trace context is propagated to the next hop in the kafka record headers.
+func WithTracing(config TracingConfig) ServiceOption {
+	return func(s *Service) {
+		s.tracing = newTracing(config)
+	}
+}
+
+type tracing struct {
+	provider   *sdktrace.TracerProvider
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+}
+
+func newTracing(config TracingConfig) *tracing {
+	exporter := sdktrace.WithBatcher(config.Exporter)
+	if config.Synchronous {
+		exporter = sdktrace.WithSyncer(config.Exporter)
+	}
+
+	provider := sdktrace.NewTracerProvider(exporter)
+	return &tracing{
+		provider:   provider,
+		tracer:     provider.Tracer(tracerName),
+		propagator: propagation.TraceContext{},
+	}
+}
+
+// start starts a span that continues the trace carried by the headers
+func (t *tracing) start(ctx context.Context, name string, kind trace.SpanKind, headers map[string][]byte, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	if len(headers) > 0 {
+		ctx = t.propagator.Extract(ctx, headerCarrier(headers))
+	}
+
+	return t.tracer.Start(ctx, name, trace.WithSpanKind(kind), trace.WithAttributes(attributes...))
+}
+
+// headers gets the record headers carrying the trace context of the span in the context
+func (t *tracing) headers(ctx context.Context) map[string][]byte {
+	headers := map[string][]byte{}
+	t.propagator.Inject(ctx, headerCarrier(headers))
+	return headers
+}
+
+// shutdown exports the remaining spans
+func (t *tracing) shutdown(ctx context.Context) error {
+	if t == nil {
+		return nil
+	}
+
+	return t.provider.Shutdown(ctx)
+}
+
+// SourceTracing creates spans for the messages a source emits
+type SourceTracing struct {
+	tracing   *tracing
+	component string
+	topic     string
+}
+
+// SourceTracing creates the tracing for the source of the component emitting to the topic
+func (o ServiceOptions) SourceTracing(component, topic string) *SourceTracing {
+	if o.tracing == nil {
+		return nil
+	}
+
+	return &SourceTracing{o.tracing, component, topic}
+}
+
+// emit starts the span of an emit. It returns the headers to send with the message and a
+// function that ends the span.
+func (t *SourceTracing) emit(ctx context.Context, key string) (map[string][]byte, func(error)) {
+	if t == nil {
+		return nil, func(error) {}
+	}
+
+	ctx, span := t.tracing.start(ctx, t.topic+" send", trace.SpanKindProducer, nil,
+		attribute.String("kafmesh.component", t.component),
+		attribute.String("messaging.destination", t.topic),
+		attribute.String("messaging.kafka.message_key", key),
+	)
+
+	return t.tracing.headers(ctx), func(err error) {
+		endSpan(span, err)
+	}
+}
+
+// SinkTracing creates spans for the messages a sink collects
+type SinkTracing struct {
+	tracing   *tracing
+	component string
+	sink      string
+}
+
+// SinkTracing creates the tracing for the sink of the component
+func (o ServiceOptions) SinkTracing(component, sink string) *SinkTracing {
+	if o.tracing == nil {
+		return nil
+	}
+
+	return &SinkTracing{o.tracing, component, sink}
+}
+
+// collect starts the span of a message when the sink consumes it. It returns a function that ends the span
+// once the message is flushed, so the span covers the time the message waits in the buffer.
+func (t *SinkTracing) collect(ctx context.Context, msg *sarama.ConsumerMessage) func(error) {
+	if t == nil {
+		return func(error) {}
+	}
+
+	headers := map[string][]byte{}
+	for _, header := range msg.Headers {
+		headers[string(header.Key)] = header.Value
+	}
+
+	_, span := t.tracing.start(ctx, msg.Topic+" process", trace.SpanKindConsumer, headers,
+		attribute.String("kafmesh.component", t.component),
+		attribute.String("kafmesh.sink", t.sink),
+		attribute.String("messaging.destination", msg.Topic),
+		attribute.String("messaging.kafka.message_key", string(msg.Key)),
+		attribute.Int64("messaging.kafka.partition", int64(msg.Partition)),
+	)
+
+	return func(err error) {
+		endSpan(span, err)
+	}
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// headerCarrier carries the trace context in kafka record headers
+type headerCarrier map[string][]byte
+
+func (c headerCarrier) Get(key string) string {
+	return string(c[key])
+}
+
+func (c headerCarrier) Set(key string, value string) {
+	c[key] = []byte(value)
+}
+
+func (c headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
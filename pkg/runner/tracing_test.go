@@ -0,0 +1,112 @@
+package runner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/syncromatics/kafmesh/internal/observability"
+
+	"github.com/Shopify/sarama"
+	"github.com/lovoo/goka"
+	"github.com/lovoo/goka/codec"
+	"github.com/lovoo/goka/tester"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func Test_Tracing_SourceToProcessorToSink(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	service := &Service{
+		activity: &processorActivity{},
+		watcher:  &observability.Watcher{},
+	}
+	WithTracing(TracingConfig{Exporter: exporter, Synchronous: true})(service)
+	options := service.Options()
+
+	headers, sent := options.SourceTracing("source", "topic1").emit(context.Background(), "key1")
+	assert.Contains(t, headers, "traceparent")
+	sent(nil)
+
+	pc := service.ProcessorContext(context.Background(), "component", "processor", "key1")
+	pc.Trace("topic1", headers)
+	pc.Output("topic2", "message", "key1", "{}")
+	outputHeaders := pc.Headers()
+	pc.Fail(errors.New("boom"))
+	pc.Finish()
+
+	msg := &sarama.ConsumerMessage{Topic: "topic2", Key: []byte("key1"), Partition: 2}
+	for k, v := range outputHeaders {
+		msg.Headers = append(msg.Headers, &sarama.RecordHeader{Key: []byte(k), Value: v})
+	}
+	options.SinkTracing("sink", "Sink").collect(context.Background(), msg)(nil)
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 3)
+
+	emit, process, collect := spans[0], spans[1], spans[2]
+	assert.Equal(t, "topic1 send", emit.Name)
+	assert.Equal(t, trace.SpanKindProducer, emit.SpanKind)
+	assert.False(t, emit.Parent.IsValid())
+
+	assert.Equal(t, "topic1 process", process.Name)
+	assert.Equal(t, trace.SpanKindConsumer, process.SpanKind)
+	assert.Equal(t, emit.SpanContext.TraceID(), process.SpanContext.TraceID())
+	assert.Equal(t, emit.SpanContext.SpanID(), process.Parent.SpanID())
+	assert.Equal(t, codes.Error, process.Status.Code)
+	assert.Equal(t, "boom", process.Status.Description)
+	assert.Len(t, process.Events, 2)
+	assert.Equal(t, "output", process.Events[0].Name)
+
+	assert.Equal(t, "topic2 process", collect.Name)
+	assert.Equal(t, emit.SpanContext.TraceID(), collect.SpanContext.TraceID())
+	assert.Equal(t, process.SpanContext.SpanID(), collect.Parent.SpanID())
+	assert.Equal(t, codes.Unset, collect.Status.Code)
+}
+
+func Test_Tracing_Disabled(t *testing.T) {
+	options := ServiceOptions{}
+	assert.Nil(t, options.SourceTracing("source", "topic"))
+	assert.Nil(t, options.SinkTracing("sink", "Sink"))
+
+	headers, sent := options.SourceTracing("source", "topic").emit(context.Background(), "key")
+	assert.Nil(t, headers)
+	sent(nil)
+
+	service := &Service{activity: &processorActivity{}, watcher: &observability.Watcher{}}
+	pc := service.ProcessorContext(context.Background(), "component", "processor", "key")
+	pc.Trace("topic", map[string][]byte{})
+	pc.Output("topic2", "message", "key", "{}")
+	assert.Nil(t, pc.Headers())
+	pc.Finish()
+
+	var tracing *tracing
+	assert.Nil(t, tracing.shutdown(context.Background()))
+}
+
+func Test_Tracing_SourceEmitContinuesCallerTrace(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	service := &Service{activity: &processorActivity{}, watcher: &observability.Watcher{}}
+	WithTracing(TracingConfig{Exporter: exporter, Synchronous: true})(service)
+
+	gkt := tester.New(t)
+	emitter, err := goka.NewEmitter(nil, "topic1", new(codec.String), goka.WithEmitterTester(gkt))
+	assert.Nil(t, err)
+
+	e := NewEmitter(emitter, WithSourceTracing(service.Options().SourceTracing("source", "topic1")))
+
+	ctx, parent := service.tracing.tracer.Start(context.Background(), "request")
+	assert.Nil(t, e.EmitContext(ctx, "key1", "value1"))
+	assert.Nil(t, e.DeleteContext(ctx, "key1"))
+	parent.End()
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 3)
+	for _, span := range spans[:2] {
+		assert.Equal(t, "topic1 send", span.Name)
+		assert.Equal(t, parent.SpanContext().TraceID(), span.SpanContext.TraceID())
+		assert.Equal(t, parent.SpanContext().SpanID(), span.Parent.SpanID())
+	}
+}
@@ -0,0 +1,241 @@
+package runner
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/syncromatics/kafmesh/internal/observability"
+	watchv1 "github.com/syncromatics/kafmesh/internal/protos/kafmesh/watch/v1"
+
+	"github.com/Shopify/sarama"
+	"github.com/golang/protobuf/ptypes"
+)
+
+// SourceWatch reports the messages a source emits to observers
+type SourceWatch struct {
+	watcher   *observability.Watcher
+	component string
+	topic     string
+}
+
+// SourceWatch creates the watch for the source of the component emitting to the topic
+func (o ServiceOptions) SourceWatch(component, topic string) *SourceWatch {
+	if o.watcher == nil {
+		return nil
+	}
+
+	return &SourceWatch{o.watcher, component, topic}
+}
+
+func (w *SourceWatch) emitted(key string, msg interface{}, err error) {
+	if w == nil || !w.watcher.WatchingSource(w.component, w.topic) {
+		return
+	}
+
+	emit := &watchv1.SourceEmit{
+		Time:   ptypes.TimestampNow(),
+		Key:    key,
+		Delete: isNil(msg),
+	}
+	if !emit.Delete {
+		emit.Value = watchValue(msg)
+	}
+	if err != nil {
+		emit.Error = err.Error()
+	}
+
+	w.watcher.SendSource(w.component, w.topic, emit)
+}
+
+// SinkWatch reports the messages a sink collects, its flushes and commits to observers
+type SinkWatch struct {
+	watcher   *observability.Watcher
+	component string
+	sink      string
+}
+
+// SinkWatch creates the watch for the sink of the component
+func (o ServiceOptions) SinkWatch(component, sink string) *SinkWatch {
+	if o.watcher == nil {
+		return nil
+	}
+
+	return &SinkWatch{o.watcher, component, sink}
+}
+
+func (w *SinkWatch) watching() bool {
+	return w != nil && w.watcher.WatchingSink(w.component, w.sink)
+}
+
+func (w *SinkWatch) collected(msg *sarama.ConsumerMessage, message interface{}) {
+	if !w.watching() {
+		return
+	}
+
+	w.watcher.SendSink(w.component, w.sink, &watchv1.SinkEvent{
+		Time: ptypes.TimestampNow(),
+		Event: &watchv1.SinkEvent_Collect{
+			Collect: &watchv1.SinkCollect{
+				Partition: msg.Partition,
+				Offset:    msg.Offset,
+				Key:       string(msg.Key),
+				Value:     watchValue(message),
+			},
+		},
+	})
+}
+
+func (w *SinkWatch) flushed(count, attempts int, err error) {
+	if !w.watching() {
+		return
+	}
+
+	flush := &watchv1.SinkFlush{
+		Count:    int64(count),
+		Attempts: int32(attempts),
+	}
+	if err != nil {
+		flush.Error = err.Error()
+	}
+
+	w.watcher.SendSink(w.component, w.sink, &watchv1.SinkEvent{
+		Time:  ptypes.TimestampNow(),
+		Event: &watchv1.SinkEvent_Flush{Flush: flush},
+	})
+}
+
+func (w *SinkWatch) committed(offsets []*watchv1.PartitionOffset) {
+	if !w.watching() || len(offsets) == 0 {
+		return
+	}
+
+	sort.Slice(offsets, func(i, j int) bool {
+		return offsets[i].Partition < offsets[j].Partition
+	})
+
+	w.watcher.SendSink(w.component, w.sink, &watchv1.SinkEvent{
+		Time: ptypes.TimestampNow(),
+		Event: &watchv1.SinkEvent_Commit{
+			Commit: &watchv1.SinkCommit{Offsets: offsets},
+		},
+	})
+}
+
+// ViewSourceWatch reports the updates and deletes of each sync run of a view source to observers
+type ViewSourceWatch struct {
+	watcher    *observability.Watcher
+	component  string
+	viewSource string
+}
+
+// ViewSourceWatch creates the watch for the view source of the component
+func (o ServiceOptions) ViewSourceWatch(component, viewSource string) *ViewSourceWatch {
+	if o.watcher == nil {
+		return nil
+	}
+
+	return &ViewSourceWatch{o.watcher, component, viewSource}
+}
+
+// start starts recording a sync run. It returns nil when nobody is watching.
+func (w *ViewSourceWatch) start() *viewSourceRun {
+	if w == nil || !w.watcher.WatchingViewSource(w.component, w.viewSource) {
+		return nil
+	}
+
+	return &viewSourceRun{
+		watch: w,
+		sync: &watchv1.ViewSourceSync{
+			StartTime: ptypes.TimestampNow(),
+			Deletes:   []string{},
+		},
+	}
+}
+
+// viewSourceRun records the updates and deletes of a sync run
+type viewSourceRun struct {
+	watch *ViewSourceWatch
+	sync  *watchv1.ViewSourceSync
+}
+
+func (r *viewSourceRun) updated(key string, msg interface{}) {
+	if r == nil {
+		return
+	}
+
+	r.sync.Updates = append(r.sync.Updates, &watchv1.ViewSourceUpdate{
+		Key:   key,
+		Value: watchValue(msg),
+	})
+}
+
+func (r *viewSourceRun) deleted(key string) {
+	if r == nil {
+		return
+	}
+
+	r.sync.Deletes = append(r.sync.Deletes, key)
+}
+
+func (r *viewSourceRun) finished(err error) {
+	if r == nil {
+		return
+	}
+
+	r.sync.EndTime = ptypes.TimestampNow()
+	if err != nil {
+		r.sync.Error = err.Error()
+	}
+
+	r.watch.watcher.SendViewSource(r.watch.component, r.watch.viewSource, r.sync)
+}
+
+// ViewSinkWatch reports the sync runs of a view sink to observers
+type ViewSinkWatch struct {
+	watcher   *observability.Watcher
+	component string
+	viewSink  string
+}
+
+// ViewSinkWatch creates the watch for the view sink of the component
+func (o ServiceOptions) ViewSinkWatch(component, viewSink string) *ViewSinkWatch {
+	if o.watcher == nil {
+		return nil
+	}
+
+	return &ViewSinkWatch{o.watcher, component, viewSink}
+}
+
+// Synced reports a sync run that started at the time given
+func (w *ViewSinkWatch) Synced(start time.Time, err error) {
+	if w == nil || !w.watcher.WatchingViewSink(w.component, w.viewSink) {
+		return
+	}
+
+	startTime, _ := ptypes.TimestampProto(start)
+	sync := &watchv1.ViewSinkSync{
+		StartTime: startTime,
+		EndTime:   ptypes.TimestampNow(),
+	}
+	if err != nil {
+		sync.Error = err.Error()
+	}
+
+	w.watcher.SendViewSink(w.component, w.viewSink, sync)
+}
+
+func watchValue(msg interface{}) string {
+	value, _ := json.Marshal(msg)
+	return string(value)
+}
+
+func isNil(msg interface{}) bool {
+	if msg == nil {
+		return true
+	}
+
+	v := reflect.ValueOf(msg)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
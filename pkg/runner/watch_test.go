@@ -0,0 +1,196 @@
+package runner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/syncromatics/kafmesh/internal/observability"
+	watchv1 "github.com/syncromatics/kafmesh/internal/protos/kafmesh/watch/v1"
+
+	"github.com/Shopify/sarama"
+	"github.com/lovoo/goka"
+	"github.com/lovoo/goka/codec"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SinkWatch(t *testing.T) {
+	watcher := &observability.Watcher{}
+	options := ServiceOptions{watcher: watcher}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events := make(chan *watchv1.SinkEvent, 10)
+	go watcher.WatchSink(ctx, &watchv1.SinkRequest{Component: "component", Sink: "sink"}, func(m *watchv1.SinkResponse) error {
+		events <- m.Event
+		return nil
+	})
+	for !watcher.WatchingSink("component", "sink") {
+		time.Sleep(time.Millisecond)
+	}
+
+	sinkCtx, sinkCancel := context.WithCancel(context.Background())
+
+	definition := &watchSinkDefinition{}
+	handler := &sinkHandler{
+		runner:  NewSinkRunner(definition, nil, WithSinkWatch(options.SinkWatch("component", "sink"))),
+		ctx:     sinkCtx,
+		cancel:  sinkCancel,
+		buffers: map[int32]*partitionBuffer{},
+	}
+
+	session := &watchSession{}
+	handler.collect(MessageContext{}, &sarama.ConsumerMessage{Partition: 1, Offset: 7, Key: []byte("key1")}, "value1", ignoreFlushed)
+	handler.collect(MessageContext{}, &sarama.ConsumerMessage{Partition: 0, Offset: 3, Key: []byte("key2")}, "value2", ignoreFlushed)
+	assert.Nil(t, handler.flush(session))
+
+	collect := (<-events).GetCollect()
+	assert.Equal(t, int32(1), collect.Partition)
+	assert.Equal(t, int64(7), collect.Offset)
+	assert.Equal(t, "key1", collect.Key)
+	assert.Equal(t, `"value1"`, collect.Value)
+
+	assert.Equal(t, "key2", (<-events).GetCollect().Key)
+
+	flush := (<-events).GetFlush()
+	assert.Equal(t, int64(2), flush.Count)
+	assert.Equal(t, int32(1), flush.Attempts)
+	assert.Equal(t, "", flush.Error)
+
+	offsets := (<-events).GetCommit().Offsets
+	assert.Len(t, offsets, 2)
+	assert.Equal(t, int32(0), offsets[0].Partition)
+	assert.Equal(t, int64(4), offsets[0].Offset)
+	assert.Equal(t, int32(1), offsets[1].Partition)
+	assert.Equal(t, int64(8), offsets[1].Offset)
+
+	// a stopped sink does not count the failed flush as giving up
+	sinkCancel()
+	definition.err = errors.New("boom")
+	handler.collect(MessageContext{}, &sarama.ConsumerMessage{Partition: 1, Offset: 8}, "value3", ignoreFlushed)
+	assert.NotNil(t, handler.flush(session))
+
+	<-events
+	assert.Equal(t, "boom", (<-events).GetFlush().Error)
+	assert.Len(t, events, 0)
+}
+
+func Test_SourceWatch(t *testing.T) {
+	watcher := &observability.Watcher{}
+	watch := ServiceOptions{watcher: watcher}.SourceWatch("component", "topic")
+
+	// nobody is watching yet
+	watch.emitted("key", "value", nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	emits := make(chan *watchv1.SourceEmit, 10)
+	go watcher.WatchSource(ctx, &watchv1.SourceRequest{Component: "component", Topic: "topic"}, func(m *watchv1.SourceResponse) error {
+		emits <- m.Emit
+		return nil
+	})
+	for !watcher.WatchingSource("component", "topic") {
+		time.Sleep(time.Millisecond)
+	}
+
+	var deleted *watchv1.SourceEmit
+	watch.emitted("key1", &watchv1.SourceEmit{Key: "inner"}, nil)
+	watch.emitted("key2", deleted, errors.New("boom"))
+
+	emit := <-emits
+	assert.Equal(t, "key1", emit.Key)
+	assert.Equal(t, `{"key":"inner"}`, emit.Value)
+	assert.False(t, emit.Delete)
+
+	emit = <-emits
+	assert.Equal(t, "key2", emit.Key)
+	assert.Equal(t, "", emit.Value)
+	assert.True(t, emit.Delete)
+	assert.Equal(t, "boom", emit.Error)
+	assert.Len(t, emits, 0)
+}
+
+func Test_ViewSourceWatch(t *testing.T) {
+	watcher := &observability.Watcher{}
+	watch := ServiceOptions{watcher: watcher}.ViewSourceWatch("component", "viewSource")
+
+	assert.Nil(t, watch.start())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	syncs := make(chan *watchv1.ViewSourceSync, 10)
+	go watcher.WatchViewSource(ctx, &watchv1.ViewSourceRequest{Component: "component", ViewSource: "viewSource"}, func(m *watchv1.ViewSourceResponse) error {
+		syncs <- m.Sync
+		return nil
+	})
+	for !watcher.WatchingViewSource("component", "viewSource") {
+		time.Sleep(time.Millisecond)
+	}
+
+	run := watch.start()
+	run.updated("key1", 12)
+	run.deleted("key2")
+	run.finished(errors.New("boom"))
+
+	sync := <-syncs
+	assert.NotNil(t, sync.StartTime)
+	assert.NotNil(t, sync.EndTime)
+	assert.Len(t, sync.Updates, 1)
+	assert.Equal(t, "key1", sync.Updates[0].Key)
+	assert.Equal(t, "12", sync.Updates[0].Value)
+	assert.Equal(t, []string{"key2"}, sync.Deletes)
+	assert.Equal(t, "boom", sync.Error)
+}
+
+func Test_ViewSinkWatch(t *testing.T) {
+	watcher := &observability.Watcher{}
+	watch := ServiceOptions{watcher: watcher}.ViewSinkWatch("component", "viewSink")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	syncs := make(chan *watchv1.ViewSinkSync, 10)
+	go watcher.WatchViewSink(ctx, &watchv1.ViewSinkRequest{Component: "component", ViewSink: "viewSink"}, func(m *watchv1.ViewSinkResponse) error {
+		syncs <- m.Sync
+		return nil
+	})
+	for !watcher.WatchingViewSink("component", "viewSink") {
+		time.Sleep(time.Millisecond)
+	}
+
+	start := time.Unix(100, 0)
+	watch.Synced(start, nil)
+
+	sync := <-syncs
+	assert.Equal(t, int64(100), sync.StartTime.Seconds)
+	assert.NotNil(t, sync.EndTime)
+	assert.Equal(t, "", sync.Error)
+
+	var unwatched *ViewSinkWatch
+	unwatched.Synced(start, nil)
+	assert.Nil(t, ServiceOptions{}.ViewSinkWatch("component", "viewSink"))
+}
+
+type watchSinkDefinition struct {
+	err error
+}
+
+func (d *watchSinkDefinition) Codec() goka.Codec                                 { return &codec.String{} }
+func (d *watchSinkDefinition) Group() string                                     { return "group" }
+func (d *watchSinkDefinition) Topic() string                                     { return "topic" }
+func (d *watchSinkDefinition) MaxBufferSize() int                                { return 100 }
+func (d *watchSinkDefinition) Interval() time.Duration                           { return time.Second }
+func (d *watchSinkDefinition) RetryPolicy() RetryPolicy                          { return RetryPolicy{} }
+func (d *watchSinkDefinition) ReadCommitted() bool                               { return false }
+func (d *watchSinkDefinition) Flush() error                                      { return d.err }
+func (d *watchSinkDefinition) Collect(MessageContext, string, interface{}) error { return nil }
+
+type watchSession struct {
+	sarama.ConsumerGroupSession
+}
+
+func (s *watchSession) MarkMessage(msg *sarama.ConsumerMessage, metadata string) {}
@@ -0,0 +1,344 @@
+package runner
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lovoo/goka"
+	"github.com/pkg/errors"
+)
+
+// Window is the time range a windowed processor aggregates messages over. Start is inclusive and End is exclusive.
+type Window struct {
+	Start time.Time
+	End   time.Time
+}
+
+// WindowValue is the encoded aggregate of a window
+type WindowValue struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+	Value []byte    `json:"value"`
+}
+
+// WindowStore is the window state of a key stored in the windowed processor table
+type WindowStore struct {
+	// StreamTime is the latest message timestamp seen for the key
+	StreamTime time.Time     `json:"streamTime"`
+	Windows    []WindowValue `json:"windows"`
+}
+
+// WindowStoreCodec encodes window stores for the windowed processor table
+type WindowStoreCodec struct{}
+
+// Encode encodes a window store
+func (c *WindowStoreCodec) Encode(value interface{}) ([]byte, error) {
+	store, ok := value.(*WindowStore)
+	if !ok {
+		return nil, errors.Errorf("expecting value of type '*runner.WindowStore' got type '%T'", value)
+	}
+
+	return json.Marshal(store)
+}
+
+// Decode decodes a window store
+func (c *WindowStoreCodec) Decode(data []byte) (interface{}, error) {
+	store := &WindowStore{}
+	err := json.Unmarshal(data, store)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode window store")
+	}
+
+	return store, nil
+}
+
+// WindowedAggregator aggregates messages into tumbling or hopping windows. Windows are closed and emitted
+// once the stream time of the key passes the end of the window plus the grace period. Messages for a window
+// that has already closed are dropped. With timers, the windows of keys that stop receiving messages are
+// closed by the stream time of their partition.
+type WindowedAggregator struct {
+	// Size is the length of each window
+	Size time.Duration
+	// Advance is how far each window starts after the previous one. Windows tumble if it is zero or equal to Size.
+	Advance time.Duration
+	// Grace is how long a window accepts late messages after it ends
+	Grace time.Duration
+	// Codec encodes the aggregates of the windows
+	Codec goka.Codec
+	// New creates an empty aggregate for a window
+	New func() interface{}
+	// Timers schedule the close of the oldest open window of each key. Windows only close when a
+	// message for their key arrives if it is nil.
+	Timers *Timers
+	// StreamTime is the stream time of the partitions timers close windows by. Timers close windows
+	// by the time they fire if it is nil.
+	StreamTime *PartitionStreamTime
+}
+
+// Windows gets the windows the timestamp falls in, oldest first
+func (a WindowedAggregator) Windows(timestamp time.Time) []Window {
+	advance := a.advance()
+
+	windows := []Window{}
+	start := timestamp.Truncate(advance)
+	for ; timestamp.Before(start.Add(a.Size)); start = start.Add(-advance) {
+		windows = append(windows, Window{
+			Start: start,
+			End:   start.Add(a.Size),
+		})
+	}
+
+	sort.Slice(windows, func(i, j int) bool {
+		return windows[i].Start.Before(windows[j].Start)
+	})
+
+	return windows
+}
+
+// Aggregate updates the window store of the current key with the message and sets it back on the context
+func (a WindowedAggregator) Aggregate(ctx goka.Context, timestamp time.Time, aggregate func(Window, interface{}) error, emit func(Window, interface{}) error) error {
+	if a.Timers != nil && a.StreamTime != nil {
+		a.StreamTime.Observe(ctx.Partition(), timestamp, a.Timers.now())
+	}
+
+	store, ok := ctx.Value().(*WindowStore)
+	if !ok || store == nil {
+		store = &WindowStore{}
+	}
+
+	err := a.Update(store, timestamp, aggregate, emit)
+	if err != nil {
+		return err
+	}
+
+	ctx.SetValue(store)
+	a.schedule(ctx, store)
+
+	return nil
+}
+
+// Close emits and removes the windows of the current key that closed by the time a timer fired at.
+// The windows close by the stream time of the partition so keys that stop receiving messages close
+// with the rest of their partition.
+func (a WindowedAggregator) Close(ctx goka.Context, at time.Time, emit func(Window, interface{}) error) error {
+	store, ok := ctx.Value().(*WindowStore)
+	if !ok || store == nil {
+		return nil
+	}
+
+	streamTime := at
+	if a.StreamTime != nil {
+		streamTime = a.StreamTime.At(ctx.Partition(), at)
+	}
+
+	if streamTime.After(store.StreamTime) {
+		store.StreamTime = streamTime
+	}
+
+	err := a.emitClosed(store, emit)
+	if err != nil {
+		return err
+	}
+
+	ctx.SetValue(store)
+	a.schedule(ctx, store)
+
+	return nil
+}
+
+// Update aggregates a message with the timestamp into every open window it falls in and then emits
+// and removes the windows that closed.
+func (a WindowedAggregator) Update(store *WindowStore, timestamp time.Time, aggregate func(Window, interface{}) error, emit func(Window, interface{}) error) error {
+	if a.Size <= 0 {
+		return errors.New("window size must be greater than zero")
+	}
+
+	if timestamp.After(store.StreamTime) {
+		store.StreamTime = timestamp
+	}
+
+	for _, window := range a.Windows(timestamp) {
+		if a.closed(store, window) {
+			continue
+		}
+
+		index := -1
+		for i, w := range store.Windows {
+			if w.Start.Equal(window.Start) {
+				index = i
+				break
+			}
+		}
+
+		if index == -1 {
+			store.Windows = append(store.Windows, WindowValue{
+				Start: window.Start,
+				End:   window.End,
+			})
+			index = len(store.Windows) - 1
+		}
+
+		value, err := a.decode(store.Windows[index].Value)
+		if err != nil {
+			return err
+		}
+
+		err = aggregate(window, value)
+		if err != nil {
+			return errors.Wrap(err, "failed to aggregate window")
+		}
+
+		encoded, err := a.Codec.Encode(value)
+		if err != nil {
+			return errors.Wrap(err, "failed to encode window aggregate")
+		}
+		store.Windows[index].Value = encoded
+	}
+
+	sort.Slice(store.Windows, func(i, j int) bool {
+		return store.Windows[i].Start.Before(store.Windows[j].Start)
+	})
+
+	return a.emitClosed(store, emit)
+}
+
+// emitClosed emits and removes the windows of the store that closed by its stream time
+func (a WindowedAggregator) emitClosed(store *WindowStore, emit func(Window, interface{}) error) error {
+	open := []WindowValue{}
+	for _, w := range store.Windows {
+		window := Window{Start: w.Start, End: w.End}
+		if !a.closed(store, window) {
+			open = append(open, w)
+			continue
+		}
+
+		value, err := a.decode(w.Value)
+		if err != nil {
+			return err
+		}
+
+		err = emit(window, value)
+		if err != nil {
+			return errors.Wrap(err, "failed to emit window")
+		}
+	}
+	store.Windows = open
+
+	return nil
+}
+
+// schedule schedules the timer of the current key for when its oldest open window closes if its
+// partition receives no more messages
+func (a WindowedAggregator) schedule(ctx goka.Context, store *WindowStore) {
+	if a.Timers == nil || len(store.Windows) == 0 {
+		return
+	}
+
+	closes := store.Windows[0].End.Add(a.Grace)
+	for _, w := range store.Windows[1:] {
+		if w.End.Add(a.Grace).Before(closes) {
+			closes = w.End.Add(a.Grace)
+		}
+	}
+
+	if a.StreamTime != nil {
+		closes = a.StreamTime.Due(ctx.Partition(), closes)
+	}
+
+	a.Timers.Schedule(ctx, ctx.Key(), closes)
+}
+
+func (a WindowedAggregator) advance() time.Duration {
+	if a.Advance <= 0 || a.Advance > a.Size {
+		return a.Size
+	}
+	return a.Advance
+}
+
+func (a WindowedAggregator) closed(store *WindowStore, window Window) bool {
+	return !store.StreamTime.Before(window.End.Add(a.Grace))
+}
+
+func (a WindowedAggregator) decode(data []byte) (interface{}, error) {
+	if len(data) == 0 {
+		return a.New(), nil
+	}
+
+	value, err := a.Codec.Decode(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode window aggregate")
+	}
+
+	return value, nil
+}
+
+// PartitionStreamTime is the stream time of each partition of a windowed processor. It is the latest
+// message timestamp seen on the partition advanced by the time that passed since the message arrived,
+// so windows still close once the partition stops receiving messages while a backlog of old messages
+// does not close windows before their messages are read.
+type PartitionStreamTime struct {
+	mtx        sync.Mutex
+	partitions map[int32]partitionStreamTime
+}
+
+type partitionStreamTime struct {
+	timestamp time.Time
+	arrived   time.Time
+}
+
+// NewPartitionStreamTime creates the stream time of the partitions of a windowed processor
+func NewPartitionStreamTime() *PartitionStreamTime {
+	return &PartitionStreamTime{
+		partitions: map[int32]partitionStreamTime{},
+	}
+}
+
+// Observe advances the stream time of the partition with the timestamp of a message that arrived at now
+func (s *PartitionStreamTime) Observe(partition int32, timestamp time.Time, now time.Time) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	current, ok := s.partitions[partition]
+	if ok && current.timestamp.After(timestamp) {
+		timestamp = current.timestamp
+	}
+
+	s.partitions[partition] = partitionStreamTime{
+		timestamp: timestamp,
+		arrived:   now,
+	}
+}
+
+// At gets the stream time of the partition at now. It is now if no message of the partition was seen yet.
+func (s *PartitionStreamTime) At(partition int32, now time.Time) time.Time {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	current, ok := s.partitions[partition]
+	if !ok {
+		return now
+	}
+
+	idle := now.Sub(current.arrived)
+	if idle < 0 {
+		idle = 0
+	}
+
+	return current.timestamp.Add(idle)
+}
+
+// Due gets when the stream time of the partition reaches the timestamp if the partition receives
+// no more messages
+func (s *PartitionStreamTime) Due(partition int32, timestamp time.Time) time.Time {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	current, ok := s.partitions[partition]
+	if !ok {
+		return timestamp
+	}
+
+	return current.arrived.Add(timestamp.Sub(current.timestamp))
+}
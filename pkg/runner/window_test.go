@@ -0,0 +1,113 @@
+package runner_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/syncromatics/kafmesh/pkg/runner"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type count struct {
+	Value int
+}
+
+type countCodec struct{}
+
+func (c *countCodec) Encode(value interface{}) ([]byte, error) {
+	return json.Marshal(value)
+}
+
+func (c *countCodec) Decode(data []byte) (interface{}, error) {
+	v := &count{}
+	err := json.Unmarshal(data, v)
+	return v, err
+}
+
+func Test_WindowedAggregator_Windows(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tumbling := runner.WindowedAggregator{
+		Size: 5 * time.Minute,
+	}
+	assert.Equal(t, []runner.Window{
+		{Start: start, End: start.Add(5 * time.Minute)},
+	}, tumbling.Windows(start.Add(3*time.Minute)))
+
+	hopping := runner.WindowedAggregator{
+		Size:    5 * time.Minute,
+		Advance: 2 * time.Minute,
+	}
+	assert.Equal(t, []runner.Window{
+		{Start: start, End: start.Add(5 * time.Minute)},
+		{Start: start.Add(2 * time.Minute), End: start.Add(7 * time.Minute)},
+		{Start: start.Add(4 * time.Minute), End: start.Add(9 * time.Minute)},
+	}, hopping.Windows(start.Add(4*time.Minute)))
+}
+
+func Test_WindowedAggregator_Update(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	aggregator := runner.WindowedAggregator{
+		Size:  5 * time.Minute,
+		Grace: time.Minute,
+		Codec: &countCodec{},
+		New: func() interface{} {
+			return &count{}
+		},
+	}
+
+	emitted := map[time.Time]int{}
+	aggregate := func(w runner.Window, v interface{}) error {
+		v.(*count).Value++
+		return nil
+	}
+	emit := func(w runner.Window, v interface{}) error {
+		emitted[w.Start] = v.(*count).Value
+		return nil
+	}
+
+	store := &runner.WindowStore{}
+	for _, offset := range []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute, 5*time.Minute + 30*time.Second} {
+		err := aggregator.Update(store, start.Add(offset), aggregate, emit)
+		assert.Nil(t, err)
+	}
+
+	assert.Empty(t, emitted)
+	assert.Len(t, store.Windows, 2)
+
+	err := aggregator.Update(store, start.Add(4*time.Minute+30*time.Second), aggregate, emit)
+	assert.Nil(t, err)
+	assert.Empty(t, emitted)
+
+	err = aggregator.Update(store, start.Add(11*time.Minute), aggregate, emit)
+	assert.Nil(t, err)
+	assert.Equal(t, map[time.Time]int{start: 4, start.Add(5 * time.Minute): 1}, emitted)
+	assert.Len(t, store.Windows, 1)
+
+	err = aggregator.Update(store, start.Add(3*time.Minute), aggregate, emit)
+	assert.Nil(t, err)
+	assert.Equal(t, map[time.Time]int{start: 4, start.Add(5 * time.Minute): 1}, emitted)
+	assert.Equal(t, start.Add(11*time.Minute), store.StreamTime)
+	assert.Len(t, store.Windows, 1)
+}
+
+func Test_PartitionStreamTime(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := start.Add(time.Hour)
+
+	streamTime := runner.NewPartitionStreamTime()
+	assert.Equal(t, now, streamTime.At(0, now))
+	assert.Equal(t, start, streamTime.Due(0, start))
+
+	// a backlog of old messages is read, so the stream time stays at the messages until the partition is idle
+	streamTime.Observe(0, start.Add(5*time.Minute), now)
+	streamTime.Observe(0, start.Add(3*time.Minute), now.Add(time.Second))
+	assert.Equal(t, start.Add(5*time.Minute), streamTime.At(0, now))
+	assert.Equal(t, start.Add(5*time.Minute+9*time.Second), streamTime.At(0, now.Add(10*time.Second)))
+	assert.Equal(t, now.Add(2*time.Minute+time.Second), streamTime.Due(0, start.Add(7*time.Minute)))
+
+	assert.Equal(t, now, streamTime.At(1, now))
+}
@@ -0,0 +1,382 @@
+package testing
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/syncromatics/kafmesh/pkg/runner"
+
+	"github.com/Shopify/sarama"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+)
+
+const (
+	brokerNodeID      int32 = 1
+	brokerClusterID         = "kafmesh-test"
+	brokerExpiryCheck       = 100 * time.Millisecond
+	brokerConsumeWait       = 10 * time.Second
+)
+
+var errBrokerClosed = errors.New("broker is closed")
+
+// brokerTopic is a topic of the broker with its partition logs and configuration
+type brokerTopic struct {
+	partitions []*partitionLog
+	config     map[string]string
+}
+
+// committedOffset is an offset committed by a consumer group
+type committedOffset struct {
+	offset   int64
+	metadata string
+}
+
+// Broker is an in process kafka broker that speaks enough of the kafka protocol for a service to run against
+// it. It creates and configures topics, stores produced record batches in memory, serves fetches and
+// coordinates consumer groups and their offsets, so ConfigureTopics, goka processors, views and emitters and
+// the consumer groups of sinks, timers and stream joins work as they do against a single broker cluster.
+//
+// Transactions are not supported, topics are never compacted and the retention of topics is ignored.
+type Broker struct {
+	listener net.Listener
+	host     string
+	port     int32
+	done     chan struct{}
+	wg       sync.WaitGroup
+
+	mtx       sync.Mutex
+	conns     map[net.Conn]struct{}
+	topics    map[string]*brokerTopic
+	groups    map[string]*group
+	offsets   map[string]map[string]map[int32]committedOffset
+	producers int64
+	appended  chan struct{}
+	closed    bool
+}
+
+// NewBroker starts a broker listening on a random local port
+func NewBroker() (*Broker, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to listen")
+	}
+
+	host, port, err := net.SplitHostPort(listener.Addr().String())
+	if err != nil {
+		listener.Close()
+		return nil, errors.Wrap(err, "failed to parse listener address")
+	}
+
+	p, err := strconv.Atoi(port)
+	if err != nil {
+		listener.Close()
+		return nil, errors.Wrap(err, "failed to parse listener port")
+	}
+
+	b := &Broker{
+		listener: listener,
+		host:     host,
+		port:     int32(p),
+		done:     make(chan struct{}),
+		conns:    map[net.Conn]struct{}{},
+		topics:   map[string]*brokerTopic{},
+		groups:   map[string]*group{},
+		offsets:  map[string]map[string]map[int32]committedOffset{},
+		appended: make(chan struct{}),
+	}
+
+	b.wg.Add(2)
+	go b.accept()
+	go b.expire()
+
+	return b, nil
+}
+
+// Addr is the address the broker listens on
+func (b *Broker) Addr() string {
+	return b.listener.Addr().String()
+}
+
+// Brokers are the brokers to pass to the service
+func (b *Broker) Brokers() []string {
+	return []string{b.Addr()}
+}
+
+// NewService creates a service that runs against the broker with an in memory schema registry and table
+// storage. The metrics of the service are kept to itself so a test can create more than one.
+func (b *Broker) NewService(options ...runner.ServiceOption) *runner.Service {
+	options = append([]runner.ServiceOption{
+		runner.WithMetricsRegisterer(prometheus.NewRegistry()),
+		runner.WithStorage(runner.StorageConfig{
+			Default: runner.StorageOptions{Backend: runner.StorageBackendMemory},
+		}),
+		runner.WithShutdown(runner.ShutdownConfig{
+			Timeout: harnessTimeout,
+			Quiet:   10 * time.Millisecond,
+			Drain:   time.Second,
+		}),
+	}, options...)
+
+	return runner.NewService(b.Brokers(), NewRegistry(), grpc.NewServer(), options...)
+}
+
+// CreateTopic creates a topic the service expects to exist
+func (b *Broker) CreateTopic(name string, partitions int32, config map[string]string) error {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if _, ok := b.topics[name]; ok {
+		return errors.Errorf("topic '%s' already exists", name)
+	}
+	if partitions < 1 {
+		return errors.Errorf("topic '%s' needs at least one partition", name)
+	}
+
+	b.createTopic(name, partitions, config)
+
+	return nil
+}
+
+// Consume reads every message stored in the topic with a sarama consumer, ordered by partition and offset
+func (b *Broker) Consume(topic string) ([]*sarama.ConsumerMessage, error) {
+	watermarks, err := b.highWatermarks(topic)
+	if err != nil {
+		return nil, err
+	}
+
+	config := sarama.NewConfig()
+	config.Version = sarama.MaxVersion
+
+	consumer, err := sarama.NewConsumer(b.Brokers(), config)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create consumer")
+	}
+	defer consumer.Close()
+
+	messages := []*sarama.ConsumerMessage{}
+	for partition, hwm := range watermarks {
+		if hwm == 0 {
+			continue
+		}
+
+		pc, err := consumer.ConsumePartition(topic, int32(partition), sarama.OffsetOldest)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to consume partition %d of topic '%s'", partition, topic)
+		}
+
+		timeout := time.After(brokerConsumeWait)
+		for done := false; !done; {
+			select {
+			case m := <-pc.Messages():
+				messages = append(messages, m)
+				done = m.Offset >= hwm-1
+			case <-timeout:
+				pc.Close()
+				return nil, errors.Errorf("timed out consuming partition %d of topic '%s'", partition, topic)
+			}
+		}
+
+		err = pc.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to close partition %d of topic '%s'", partition, topic)
+		}
+	}
+
+	return messages, nil
+}
+
+// Close stops the broker and closes every connection to it
+func (b *Broker) Close() error {
+	b.mtx.Lock()
+	if b.closed {
+		b.mtx.Unlock()
+		return nil
+	}
+	b.closed = true
+	close(b.done)
+	for conn := range b.conns {
+		conn.Close()
+	}
+	b.mtx.Unlock()
+
+	err := b.listener.Close()
+	b.wg.Wait()
+
+	if err != nil {
+		return errors.Wrap(err, "failed to close listener")
+	}
+
+	return nil
+}
+
+func (b *Broker) highWatermarks(topic string) ([]int64, error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	t, ok := b.topics[topic]
+	if !ok {
+		return nil, errors.Errorf("topic '%s' does not exist", topic)
+	}
+
+	watermarks := []int64{}
+	for _, p := range t.partitions {
+		watermarks = append(watermarks, p.highWatermark())
+	}
+
+	return watermarks, nil
+}
+
+// createTopic creates the topic. It must be called holding the lock.
+func (b *Broker) createTopic(name string, partitions int32, config map[string]string) {
+	t := &brokerTopic{config: map[string]string{}}
+	for i := int32(0); i < partitions; i++ {
+		t.partitions = append(t.partitions, &partitionLog{})
+	}
+	for k, v := range config {
+		t.config[k] = v
+	}
+
+	b.topics[name] = t
+}
+
+// partition gets the log of the topic partition or nil if there is none
+func (b *Broker) partition(topic string, partition int32) *partitionLog {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	t, ok := b.topics[topic]
+	if !ok || partition < 0 || int(partition) >= len(t.partitions) {
+		return nil
+	}
+
+	return t.partitions[partition]
+}
+
+// topicNames gets the names of every topic sorted
+func (b *Broker) topicNames() []string {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	names := []string{}
+	for name := range b.topics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// notifyAppended wakes up the fetches waiting for records
+func (b *Broker) notifyAppended() {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	close(b.appended)
+	b.appended = make(chan struct{})
+}
+
+// waitForAppend gets the channel that is closed when records are appended next
+func (b *Broker) waitForAppend() <-chan struct{} {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	return b.appended
+}
+
+func (b *Broker) accept() {
+	defer b.wg.Done()
+
+	for {
+		conn, err := b.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		b.mtx.Lock()
+		if b.closed {
+			b.mtx.Unlock()
+			conn.Close()
+			return
+		}
+		b.conns[conn] = struct{}{}
+		b.wg.Add(1)
+		b.mtx.Unlock()
+
+		go b.serve(conn)
+	}
+}
+
+// serve handles the requests of a connection one at a time in the order they were sent like kafka does
+func (b *Broker) serve(conn net.Conn) {
+	defer b.wg.Done()
+	defer func() {
+		b.mtx.Lock()
+		delete(b.conns, conn)
+		b.mtx.Unlock()
+		conn.Close()
+	}()
+
+	size := make([]byte, 4)
+	for {
+		_, err := io.ReadFull(conn, size)
+		if err != nil {
+			return
+		}
+
+		data := make([]byte, binary.BigEndian.Uint32(size))
+		_, err = io.ReadFull(conn, data)
+		if err != nil {
+			return
+		}
+
+		r, err := decodeRequest(data)
+		if err != nil || !r.supported() {
+			return
+		}
+
+		response, err := b.handle(r)
+		if err != nil {
+			return
+		}
+		if response == nil {
+			continue
+		}
+
+		header := &encoder{}
+		header.int32(int32(4 + len(response.data)))
+		header.int32(r.correlationID)
+
+		_, err = conn.Write(append(header.data, response.data...))
+		if err != nil {
+			return
+		}
+	}
+}
+
+// expire removes consumer group members that stopped sending heartbeats
+func (b *Broker) expire() {
+	defer b.wg.Done()
+
+	ticker := time.NewTicker(brokerExpiryCheck)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-b.done:
+			return
+		case now := <-ticker.C:
+			b.mtx.Lock()
+			for _, g := range b.groups {
+				g.expire(now)
+			}
+			b.mtx.Unlock()
+		}
+	}
+}
@@ -0,0 +1,330 @@
+package testing
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Shopify/sarama"
+)
+
+type groupState int
+
+const (
+	groupEmpty groupState = iota
+	groupPreparingRebalance
+	groupAwaitingSync
+	groupStable
+)
+
+type groupProtocol struct {
+	name     string
+	metadata []byte
+}
+
+type joinResult struct {
+	err        sarama.KError
+	generation int32
+	protocol   string
+	leader     string
+	memberID   string
+	members    []*groupMember
+}
+
+type syncResult struct {
+	err        sarama.KError
+	assignment []byte
+}
+
+// groupMember is a member of a consumer group. Join and sync are set while the member waits for
+// the rebalance to complete.
+type groupMember struct {
+	id               string
+	joined           int
+	protocols        []groupProtocol
+	sessionTimeout   time.Duration
+	rebalanceTimeout time.Duration
+	lastSeen         time.Time
+	assignment       []byte
+
+	join chan joinResult
+	sync chan syncResult
+}
+
+func (m *groupMember) metadata(protocol string) ([]byte, bool) {
+	for _, p := range m.protocols {
+		if p.name == protocol {
+			return p.metadata, true
+		}
+	}
+	return nil, false
+}
+
+// group is a consumer group coordinated by the broker. A rebalance waits for every member to join
+// again, the leader assigns the partitions when it syncs and the other members get their assignment
+// from it. Members that do not rejoin before the rebalance timeout or stop sending heartbeats are removed.
+type group struct {
+	name         string
+	state        groupState
+	generation   int32
+	protocolType string
+	protocol     string
+	leader       string
+	members      map[string]*groupMember
+	joins        int
+	deadline     time.Time
+}
+
+func newGroup(name string) *group {
+	return &group{
+		name:    name,
+		members: map[string]*groupMember{},
+	}
+}
+
+// join adds or updates the member, starts a rebalance and returns the channel the member gets the result of the join on
+func (g *group) join(memberID string, clientID string, protocolType string, protocols []groupProtocol, sessionTimeout, rebalanceTimeout time.Duration, now time.Time) (chan joinResult, sarama.KError) {
+	if len(protocols) == 0 || (len(g.members) > 0 && protocolType != g.protocolType) {
+		return nil, sarama.ErrInconsistentGroupProtocol
+	}
+
+	member, ok := g.members[memberID]
+	if memberID != "" && !ok {
+		return nil, sarama.ErrUnknownMemberId
+	}
+	if !ok {
+		g.joins++
+		member = &groupMember{
+			id:     fmt.Sprintf("%s-%d", clientID, g.joins),
+			joined: g.joins,
+		}
+		g.members[member.id] = member
+	}
+
+	g.protocolType = protocolType
+	member.protocols = protocols
+	member.sessionTimeout = sessionTimeout
+	member.rebalanceTimeout = rebalanceTimeout
+	member.lastSeen = now
+	if member.join != nil {
+		member.join <- joinResult{err: sarama.ErrRebalanceInProgress}
+	}
+	member.join = make(chan joinResult, 1)
+	join := member.join
+
+	g.prepareRebalance(now)
+	g.completeJoin(now, false)
+
+	return join, sarama.ErrNoError
+}
+
+// sync stores the assignments if the member is the leader and returns the channel the member gets its assignment on
+func (g *group) sync(memberID string, generation int32, assignments map[string][]byte, now time.Time) (chan syncResult, sarama.KError) {
+	member, ok := g.members[memberID]
+	if !ok {
+		return nil, sarama.ErrUnknownMemberId
+	}
+	if g.state == groupPreparingRebalance {
+		return nil, sarama.ErrRebalanceInProgress
+	}
+	if generation != g.generation {
+		return nil, sarama.ErrIllegalGeneration
+	}
+
+	member.lastSeen = now
+	member.sync = make(chan syncResult, 1)
+	sync := member.sync
+
+	if g.state == groupStable {
+		g.sendSync(member, syncResult{assignment: member.assignment})
+		return sync, sarama.ErrNoError
+	}
+
+	if memberID == g.leader {
+		for _, m := range g.members {
+			m.assignment = assignments[m.id]
+		}
+
+		g.state = groupStable
+		for _, m := range g.members {
+			if m.sync != nil {
+				g.sendSync(m, syncResult{assignment: m.assignment})
+			}
+		}
+	}
+
+	return sync, sarama.ErrNoError
+}
+
+func (g *group) heartbeat(memberID string, generation int32, now time.Time) sarama.KError {
+	member, ok := g.members[memberID]
+	if !ok {
+		return sarama.ErrUnknownMemberId
+	}
+
+	member.lastSeen = now
+	if g.state == groupPreparingRebalance {
+		return sarama.ErrRebalanceInProgress
+	}
+	if generation != g.generation {
+		return sarama.ErrIllegalGeneration
+	}
+
+	return sarama.ErrNoError
+}
+
+func (g *group) leave(memberID string, now time.Time) sarama.KError {
+	member, ok := g.members[memberID]
+	if !ok {
+		return sarama.ErrUnknownMemberId
+	}
+
+	g.remove(member, now)
+
+	return sarama.ErrNoError
+}
+
+// validCommit checks the member can commit offsets for the generation
+func (g *group) validCommit(memberID string, generation int32) sarama.KError {
+	if generation < 0 && memberID == "" {
+		if len(g.members) > 0 {
+			return sarama.ErrIllegalGeneration
+		}
+		return sarama.ErrNoError
+	}
+
+	if _, ok := g.members[memberID]; !ok {
+		return sarama.ErrUnknownMemberId
+	}
+	if generation != g.generation {
+		return sarama.ErrIllegalGeneration
+	}
+
+	return sarama.ErrNoError
+}
+
+// expire removes the members that stopped sending heartbeats and completes rebalances that timed out
+func (g *group) expire(now time.Time) {
+	for _, m := range g.members {
+		if m.join == nil && now.Sub(m.lastSeen) > m.sessionTimeout {
+			g.remove(m, now)
+		}
+	}
+
+	if g.state == groupPreparingRebalance && now.After(g.deadline) {
+		g.completeJoin(now, true)
+	}
+}
+
+func (g *group) remove(member *groupMember, now time.Time) {
+	delete(g.members, member.id)
+	if member.join != nil {
+		member.join <- joinResult{err: sarama.ErrUnknownMemberId}
+		member.join = nil
+	}
+	if member.sync != nil {
+		g.sendSync(member, syncResult{err: sarama.ErrUnknownMemberId})
+	}
+
+	if len(g.members) == 0 {
+		g.state = groupEmpty
+		g.leader = ""
+		return
+	}
+
+	g.prepareRebalance(now)
+	g.completeJoin(now, false)
+}
+
+// prepareRebalance stops the current generation. Members waiting for their assignment have to join again.
+func (g *group) prepareRebalance(now time.Time) {
+	if g.state == groupPreparingRebalance {
+		return
+	}
+
+	g.state = groupPreparingRebalance
+	g.deadline = now
+	for _, m := range g.members {
+		if now.Add(m.rebalanceTimeout).After(g.deadline) {
+			g.deadline = now.Add(m.rebalanceTimeout)
+		}
+		if m.sync != nil {
+			g.sendSync(m, syncResult{err: sarama.ErrRebalanceInProgress})
+		}
+	}
+}
+
+// completeJoin starts the next generation once every member has joined or the rebalance timed out
+func (g *group) completeJoin(now time.Time, timedOut bool) {
+	if g.state != groupPreparingRebalance {
+		return
+	}
+
+	for _, m := range g.members {
+		if m.join != nil {
+			continue
+		}
+		if !timedOut {
+			return
+		}
+		delete(g.members, m.id)
+	}
+
+	if len(g.members) == 0 {
+		g.state = groupEmpty
+		g.leader = ""
+		return
+	}
+
+	members := []*groupMember{}
+	for _, m := range g.members {
+		members = append(members, m)
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].joined < members[j].joined })
+
+	if _, ok := g.members[g.leader]; !ok {
+		g.leader = members[0].id
+	}
+	g.protocol = selectProtocol(g.members[g.leader], members)
+	g.generation++
+	g.state = groupAwaitingSync
+
+	for _, m := range members {
+		m.lastSeen = now
+		result := joinResult{
+			generation: g.generation,
+			protocol:   g.protocol,
+			leader:     g.leader,
+			memberID:   m.id,
+		}
+		if m.id == g.leader {
+			result.members = members
+		}
+
+		m.join <- result
+		m.join = nil
+	}
+}
+
+func (g *group) sendSync(member *groupMember, result syncResult) {
+	member.sync <- result
+	member.sync = nil
+}
+
+// selectProtocol picks the first protocol of the leader every member supports
+func selectProtocol(leader *groupMember, members []*groupMember) string {
+	for _, p := range leader.protocols {
+		supported := true
+		for _, m := range members {
+			if _, ok := m.metadata(p.name); !ok {
+				supported = false
+				break
+			}
+		}
+		if supported {
+			return p.name
+		}
+	}
+
+	return leader.protocols[0].name
+}
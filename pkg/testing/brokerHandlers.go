@@ -0,0 +1,979 @@
+package testing
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/pkg/errors"
+)
+
+// handle handles the request and returns the response body. A nil response is not sent.
+func (b *Broker) handle(r *request) (*encoder, error) {
+	var response *encoder
+	var err error
+
+	switch r.apiKey {
+	case apiProduce:
+		response, err = b.produce(r)
+	case apiFetch:
+		response, err = b.fetch(r)
+	case apiListOffsets:
+		response, err = b.listOffsets(r)
+	case apiMetadata:
+		response, err = b.metadata(r)
+	case apiOffsetCommit:
+		response, err = b.offsetCommit(r)
+	case apiOffsetFetch:
+		response, err = b.offsetFetch(r)
+	case apiFindCoordinator:
+		response, err = b.findCoordinator(r)
+	case apiJoinGroup:
+		response, err = b.joinGroup(r)
+	case apiHeartbeat:
+		response, err = b.heartbeat(r)
+	case apiLeaveGroup:
+		response, err = b.leaveGroup(r)
+	case apiSyncGroup:
+		response, err = b.syncGroup(r)
+	case apiApiVersions:
+		response, err = b.apiVersions(r)
+	case apiCreateTopics:
+		response, err = b.createTopics(r)
+	case apiInitProducerID:
+		response, err = b.initProducerID(r)
+	case apiDescribeConfigs:
+		response, err = b.describeConfigs(r)
+	case apiAlterConfigs:
+		response, err = b.alterConfigs(r)
+	default:
+		return nil, errors.Errorf("api key %d is not supported", r.apiKey)
+	}
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to handle request with api key %d", r.apiKey)
+	}
+
+	return response, nil
+}
+
+func (b *Broker) apiVersions(r *request) (*encoder, error) {
+	keys := []int{}
+	for key := range apiVersions {
+		keys = append(keys, int(key))
+	}
+	sort.Ints(keys)
+
+	e := &encoder{}
+	e.int16(int16(sarama.ErrNoError))
+	e.arrayLength(len(keys))
+	for _, key := range keys {
+		e.int16(int16(key))
+		e.int16(apiVersions[int16(key)][0])
+		e.int16(apiVersions[int16(key)][1])
+	}
+	if r.apiVersion >= 1 {
+		e.int32(0)
+	}
+
+	return e, nil
+}
+
+func (b *Broker) metadata(r *request) (*encoder, error) {
+	d := r.body
+	n := d.arrayLength()
+	topics := []string{}
+	for i := 0; i < n; i++ {
+		topics = append(topics, d.string())
+	}
+	if r.apiVersion >= 4 {
+		d.bool()
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	if n < 0 || (n == 0 && r.apiVersion == 0) {
+		topics = b.topicNames()
+	}
+
+	e := &encoder{}
+	if r.apiVersion >= 3 {
+		e.int32(0)
+	}
+	e.arrayLength(1)
+	e.int32(brokerNodeID)
+	e.string(b.host)
+	e.int32(b.port)
+	if r.apiVersion >= 1 {
+		e.nullableString(nil)
+	}
+	if r.apiVersion >= 2 {
+		clusterID := brokerClusterID
+		e.nullableString(&clusterID)
+	}
+	if r.apiVersion >= 1 {
+		e.int32(brokerNodeID)
+	}
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	e.arrayLength(len(topics))
+	for _, name := range topics {
+		t, ok := b.topics[name]
+		if !ok {
+			e.int16(int16(sarama.ErrUnknownTopicOrPartition))
+			e.string(name)
+			if r.apiVersion >= 1 {
+				e.bool(false)
+			}
+			e.arrayLength(0)
+			continue
+		}
+
+		e.int16(int16(sarama.ErrNoError))
+		e.string(name)
+		if r.apiVersion >= 1 {
+			e.bool(false)
+		}
+		e.arrayLength(len(t.partitions))
+		for i := range t.partitions {
+			e.int16(int16(sarama.ErrNoError))
+			e.int32(int32(i))
+			e.int32(brokerNodeID)
+			e.int32Array([]int32{brokerNodeID})
+			e.int32Array([]int32{brokerNodeID})
+			if r.apiVersion >= 5 {
+				e.int32Array([]int32{})
+			}
+		}
+	}
+
+	return e, nil
+}
+
+func (b *Broker) createTopics(r *request) (*encoder, error) {
+	type topicRequest struct {
+		name       string
+		partitions int32
+		config     map[string]string
+	}
+
+	d := r.body
+	requests := []topicRequest{}
+	for i, n := 0, d.arrayLength(); i < n; i++ {
+		t := topicRequest{
+			name:       d.string(),
+			partitions: d.int32(),
+			config:     map[string]string{},
+		}
+		d.int16()
+
+		assignments := d.arrayLength()
+		for j := 0; j < assignments; j++ {
+			d.int32()
+			d.int32Array()
+		}
+		if t.partitions < 1 {
+			t.partitions = int32(assignments)
+		}
+
+		for j, configs := 0, d.arrayLength(); j < configs; j++ {
+			name := d.string()
+			value, _ := d.nullableString()
+			if value != nil {
+				t.config[name] = *value
+			}
+		}
+
+		requests = append(requests, t)
+	}
+	d.int32()
+	validateOnly := false
+	if r.apiVersion >= 1 {
+		validateOnly = d.bool()
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	e := &encoder{}
+	if r.apiVersion >= 2 {
+		e.int32(0)
+	}
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	e.arrayLength(len(requests))
+	for _, t := range requests {
+		kerr := sarama.ErrNoError
+		_, exists := b.topics[t.name]
+		switch {
+		case t.name == "":
+			kerr = sarama.ErrInvalidTopic
+		case exists:
+			kerr = sarama.ErrTopicAlreadyExists
+		case t.partitions < 1:
+			kerr = sarama.ErrInvalidPartitions
+		case !validateOnly:
+			b.createTopic(t.name, t.partitions, t.config)
+		}
+
+		e.string(t.name)
+		e.int16(int16(kerr))
+		if r.apiVersion >= 1 {
+			e.nullableString(nil)
+		}
+	}
+
+	return e, nil
+}
+
+func (b *Broker) describeConfigs(r *request) (*encoder, error) {
+	type resourceRequest struct {
+		resourceType int8
+		name         string
+		names        []string
+	}
+
+	d := r.body
+	resources := []resourceRequest{}
+	for i, n := 0, d.arrayLength(); i < n; i++ {
+		resources = append(resources, resourceRequest{
+			resourceType: d.int8(),
+			name:         d.string(),
+			names:        d.stringArray(),
+		})
+	}
+	if r.apiVersion >= 1 {
+		d.bool()
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	e := &encoder{}
+	e.int32(0)
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	e.arrayLength(len(resources))
+	for _, resource := range resources {
+		config := map[string]string{}
+		kerr := sarama.ErrNoError
+		switch sarama.ConfigResourceType(resource.resourceType) {
+		case sarama.TopicResource:
+			t, ok := b.topics[resource.name]
+			if !ok {
+				kerr = sarama.ErrUnknownTopicOrPartition
+				break
+			}
+			config = t.config
+		case sarama.BrokerResource:
+		default:
+			kerr = sarama.ErrInvalidRequest
+		}
+
+		names := resource.names
+		if names == nil {
+			for name := range config {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+		}
+
+		entries := []string{}
+		for _, name := range names {
+			if _, ok := config[name]; ok {
+				entries = append(entries, name)
+			}
+		}
+
+		e.int16(int16(kerr))
+		if kerr != sarama.ErrNoError {
+			message := kerr.Error()
+			e.nullableString(&message)
+		} else {
+			e.nullableString(nil)
+		}
+		e.int8(resource.resourceType)
+		e.string(resource.name)
+		e.arrayLength(len(entries))
+		for _, name := range entries {
+			value := config[name]
+			e.string(name)
+			e.nullableString(&value)
+			e.bool(false)
+			if r.apiVersion == 0 {
+				e.bool(false)
+			} else {
+				e.int8(int8(sarama.SourceTopic))
+			}
+			e.bool(false)
+			if r.apiVersion >= 1 {
+				e.arrayLength(0)
+			}
+		}
+	}
+
+	return e, nil
+}
+
+func (b *Broker) alterConfigs(r *request) (*encoder, error) {
+	type resourceRequest struct {
+		resourceType int8
+		name         string
+		config       map[string]string
+	}
+
+	d := r.body
+	resources := []resourceRequest{}
+	for i, n := 0, d.arrayLength(); i < n; i++ {
+		resource := resourceRequest{
+			resourceType: d.int8(),
+			name:         d.string(),
+			config:       map[string]string{},
+		}
+		for j, configs := 0, d.arrayLength(); j < configs; j++ {
+			name := d.string()
+			value, _ := d.nullableString()
+			if value != nil {
+				resource.config[name] = *value
+			}
+		}
+		resources = append(resources, resource)
+	}
+	validateOnly := d.bool()
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	e := &encoder{}
+	e.int32(0)
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	e.arrayLength(len(resources))
+	for _, resource := range resources {
+		kerr := sarama.ErrNoError
+		t, ok := b.topics[resource.name]
+		switch {
+		case sarama.ConfigResourceType(resource.resourceType) != sarama.TopicResource:
+			kerr = sarama.ErrInvalidRequest
+		case !ok:
+			kerr = sarama.ErrUnknownTopicOrPartition
+		case !validateOnly:
+			t.config = resource.config
+		}
+
+		e.int16(int16(kerr))
+		e.nullableString(nil)
+		e.int8(resource.resourceType)
+		e.string(resource.name)
+	}
+
+	return e, nil
+}
+
+func (b *Broker) initProducerID(r *request) (*encoder, error) {
+	d := r.body
+	d.nullableString()
+	d.int32()
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	b.mtx.Lock()
+	b.producers++
+	id := b.producers
+	b.mtx.Unlock()
+
+	e := &encoder{}
+	e.int32(0)
+	e.int16(int16(sarama.ErrNoError))
+	e.int64(id)
+	e.int16(0)
+
+	return e, nil
+}
+
+func (b *Broker) produce(r *request) (*encoder, error) {
+	type partitionResponse struct {
+		partition  int32
+		err        sarama.KError
+		baseOffset int64
+	}
+	type topicResponse struct {
+		name       string
+		partitions []partitionResponse
+	}
+
+	d := r.body
+	d.nullableString()
+	acks := d.int16()
+	d.int32()
+
+	topics := []topicResponse{}
+	appended := false
+	for i, n := 0, d.arrayLength(); i < n; i++ {
+		t := topicResponse{name: d.string()}
+		for j, partitions := 0, d.arrayLength(); j < partitions; j++ {
+			p := partitionResponse{partition: d.int32(), baseOffset: -1}
+			records := d.bytes()
+			if d.err != nil {
+				return nil, d.err
+			}
+
+			log := b.partition(t.name, p.partition)
+			batches, err := splitBatches(records)
+			switch {
+			case log == nil:
+				p.err = sarama.ErrUnknownTopicOrPartition
+			case err != nil:
+				p.err = sarama.ErrInvalidMessage
+			default:
+				p.baseOffset = log.append(batches)
+				appended = true
+			}
+
+			t.partitions = append(t.partitions, p)
+		}
+		topics = append(topics, t)
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	if appended {
+		b.notifyAppended()
+	}
+
+	if acks == 0 {
+		return nil, nil
+	}
+
+	e := &encoder{}
+	e.arrayLength(len(topics))
+	for _, t := range topics {
+		e.string(t.name)
+		e.arrayLength(len(t.partitions))
+		for _, p := range t.partitions {
+			e.int32(p.partition)
+			e.int16(int16(p.err))
+			e.int64(p.baseOffset)
+			e.int64(-1)
+			if r.apiVersion >= 5 {
+				e.int64(0)
+			}
+		}
+	}
+	e.int32(0)
+
+	return e, nil
+}
+
+func (b *Broker) fetch(r *request) (*encoder, error) {
+	type partitionRequest struct {
+		partition int32
+		offset    int64
+		maxBytes  int32
+	}
+	type topicRequest struct {
+		name       string
+		partitions []partitionRequest
+	}
+
+	d := r.body
+	d.int32()
+	maxWait := time.Duration(d.int32()) * time.Millisecond
+	minBytes := int(d.int32())
+	maxBytes := int(d.int32())
+	d.int8()
+	if r.apiVersion >= 7 {
+		d.int32()
+		d.int32()
+	}
+
+	topics := []topicRequest{}
+	for i, n := 0, d.arrayLength(); i < n; i++ {
+		t := topicRequest{name: d.string()}
+		for j, partitions := 0, d.arrayLength(); j < partitions; j++ {
+			p := partitionRequest{partition: d.int32()}
+			if r.apiVersion >= 9 {
+				d.int32()
+			}
+			p.offset = d.int64()
+			if r.apiVersion >= 5 {
+				d.int64()
+			}
+			p.maxBytes = d.int32()
+			t.partitions = append(t.partitions, p)
+		}
+		topics = append(topics, t)
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	deadline := time.After(maxWait)
+	for {
+		appended := b.waitForAppend()
+
+		e := &encoder{}
+		e.int32(0)
+		if r.apiVersion >= 7 {
+			e.int16(int16(sarama.ErrNoError))
+			e.int32(0)
+		}
+
+		size := 0
+		e.arrayLength(len(topics))
+		for _, t := range topics {
+			e.string(t.name)
+			e.arrayLength(len(t.partitions))
+			for _, p := range t.partitions {
+				kerr := sarama.ErrNoError
+				hwm := int64(-1)
+				records := []byte{}
+
+				log := b.partition(t.name, p.partition)
+				if log == nil {
+					kerr = sarama.ErrUnknownTopicOrPartition
+				} else {
+					hwm = log.highWatermark()
+					switch {
+					case p.offset < 0 || p.offset > hwm:
+						kerr = sarama.ErrOffsetOutOfRange
+					case size < maxBytes:
+						records = log.read(p.offset, int(p.maxBytes))
+					}
+				}
+				size += len(records)
+
+				e.int32(p.partition)
+				e.int16(int16(kerr))
+				e.int64(hwm)
+				e.int64(hwm)
+				if r.apiVersion >= 5 {
+					e.int64(0)
+				}
+				e.arrayLength(0)
+				if r.apiVersion >= 11 {
+					e.int32(-1)
+				}
+				e.bytes(records)
+			}
+		}
+
+		if size >= minBytes {
+			return e, nil
+		}
+
+		select {
+		case <-appended:
+		case <-deadline:
+			return e, nil
+		case <-b.done:
+			return nil, errBrokerClosed
+		}
+	}
+}
+
+func (b *Broker) listOffsets(r *request) (*encoder, error) {
+	type partitionRequest struct {
+		partition int32
+		timestamp int64
+	}
+	type topicRequest struct {
+		name       string
+		partitions []partitionRequest
+	}
+
+	d := r.body
+	d.int32()
+	if r.apiVersion >= 2 {
+		d.int8()
+	}
+
+	topics := []topicRequest{}
+	for i, n := 0, d.arrayLength(); i < n; i++ {
+		t := topicRequest{name: d.string()}
+		for j, partitions := 0, d.arrayLength(); j < partitions; j++ {
+			p := partitionRequest{partition: d.int32(), timestamp: d.int64()}
+			if r.apiVersion == 0 {
+				d.int32()
+			}
+			t.partitions = append(t.partitions, p)
+		}
+		topics = append(topics, t)
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	e := &encoder{}
+	if r.apiVersion >= 2 {
+		e.int32(0)
+	}
+	e.arrayLength(len(topics))
+	for _, t := range topics {
+		e.string(t.name)
+		e.arrayLength(len(t.partitions))
+		for _, p := range t.partitions {
+			kerr := sarama.ErrNoError
+			offset := int64(-1)
+
+			log := b.partition(t.name, p.partition)
+			switch {
+			case log == nil:
+				kerr = sarama.ErrUnknownTopicOrPartition
+			case p.timestamp == sarama.OffsetNewest:
+				offset = log.highWatermark()
+			case p.timestamp == sarama.OffsetOldest:
+				offset = 0
+			default:
+				offset = log.offsetForTime(p.timestamp)
+			}
+
+			e.int32(p.partition)
+			e.int16(int16(kerr))
+			if r.apiVersion == 0 {
+				e.arrayLength(1)
+				e.int64(offset)
+				continue
+			}
+			e.int64(-1)
+			e.int64(offset)
+		}
+	}
+
+	return e, nil
+}
+
+func (b *Broker) findCoordinator(r *request) (*encoder, error) {
+	d := r.body
+	d.string()
+	if r.apiVersion >= 1 {
+		d.int8()
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	e := &encoder{}
+	if r.apiVersion >= 1 {
+		e.int32(0)
+	}
+	e.int16(int16(sarama.ErrNoError))
+	if r.apiVersion >= 1 {
+		e.nullableString(nil)
+	}
+	e.int32(brokerNodeID)
+	e.string(b.host)
+	e.int32(b.port)
+
+	return e, nil
+}
+
+func (b *Broker) joinGroup(r *request) (*encoder, error) {
+	d := r.body
+	name := d.string()
+	sessionTimeout := time.Duration(d.int32()) * time.Millisecond
+	rebalanceTimeout := sessionTimeout
+	if r.apiVersion >= 1 {
+		rebalanceTimeout = time.Duration(d.int32()) * time.Millisecond
+	}
+	memberID := d.string()
+	protocolType := d.string()
+	protocols := []groupProtocol{}
+	for i, n := 0, d.arrayLength(); i < n; i++ {
+		protocols = append(protocols, groupProtocol{name: d.string(), metadata: d.bytes()})
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	b.mtx.Lock()
+	g, ok := b.groups[name]
+	if !ok {
+		g = newGroup(name)
+		b.groups[name] = g
+	}
+	join, kerr := g.join(memberID, r.clientID, protocolType, protocols, sessionTimeout, rebalanceTimeout, time.Now())
+	b.mtx.Unlock()
+
+	result := joinResult{err: kerr, generation: -1, memberID: memberID}
+	if kerr == sarama.ErrNoError {
+		select {
+		case result = <-join:
+		case <-b.done:
+			return nil, errBrokerClosed
+		}
+	}
+	if result.err != sarama.ErrNoError {
+		result.generation = -1
+	}
+
+	e := &encoder{}
+	if r.apiVersion >= 2 {
+		e.int32(0)
+	}
+	e.int16(int16(result.err))
+	e.int32(result.generation)
+	e.string(result.protocol)
+	e.string(result.leader)
+	e.string(result.memberID)
+	e.arrayLength(len(result.members))
+	for _, m := range result.members {
+		metadata, _ := m.metadata(result.protocol)
+		e.string(m.id)
+		e.bytes(metadata)
+	}
+
+	return e, nil
+}
+
+func (b *Broker) syncGroup(r *request) (*encoder, error) {
+	d := r.body
+	name := d.string()
+	generation := d.int32()
+	memberID := d.string()
+	assignments := map[string][]byte{}
+	for i, n := 0, d.arrayLength(); i < n; i++ {
+		assignments[d.string()] = d.bytes()
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	b.mtx.Lock()
+	var sync chan syncResult
+	kerr := sarama.ErrUnknownMemberId
+	if g, ok := b.groups[name]; ok {
+		sync, kerr = g.sync(memberID, generation, assignments, time.Now())
+	}
+	b.mtx.Unlock()
+
+	result := syncResult{err: kerr}
+	if kerr == sarama.ErrNoError {
+		select {
+		case result = <-sync:
+		case <-b.done:
+			return nil, errBrokerClosed
+		}
+	}
+	if result.assignment == nil {
+		result.assignment = []byte{}
+	}
+
+	e := &encoder{}
+	if r.apiVersion >= 1 {
+		e.int32(0)
+	}
+	e.int16(int16(result.err))
+	e.bytes(result.assignment)
+
+	return e, nil
+}
+
+func (b *Broker) heartbeat(r *request) (*encoder, error) {
+	d := r.body
+	name := d.string()
+	generation := d.int32()
+	memberID := d.string()
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	b.mtx.Lock()
+	kerr := sarama.ErrUnknownMemberId
+	if g, ok := b.groups[name]; ok {
+		kerr = g.heartbeat(memberID, generation, time.Now())
+	}
+	b.mtx.Unlock()
+
+	e := &encoder{}
+	if r.apiVersion >= 1 {
+		e.int32(0)
+	}
+	e.int16(int16(kerr))
+
+	return e, nil
+}
+
+func (b *Broker) leaveGroup(r *request) (*encoder, error) {
+	d := r.body
+	name := d.string()
+	memberID := d.string()
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	b.mtx.Lock()
+	kerr := sarama.ErrUnknownMemberId
+	if g, ok := b.groups[name]; ok {
+		kerr = g.leave(memberID, time.Now())
+	}
+	b.mtx.Unlock()
+
+	e := &encoder{}
+	if r.apiVersion >= 1 {
+		e.int32(0)
+	}
+	e.int16(int16(kerr))
+
+	return e, nil
+}
+
+func (b *Broker) offsetCommit(r *request) (*encoder, error) {
+	type partitionRequest struct {
+		partition int32
+		offset    int64
+		metadata  string
+	}
+	type topicRequest struct {
+		name       string
+		partitions []partitionRequest
+	}
+
+	d := r.body
+	name := d.string()
+	generation := int32(-1)
+	memberID := ""
+	if r.apiVersion >= 1 {
+		generation = d.int32()
+		memberID = d.string()
+	}
+	if r.apiVersion >= 2 {
+		d.int64()
+	}
+
+	topics := []topicRequest{}
+	for i, n := 0, d.arrayLength(); i < n; i++ {
+		t := topicRequest{name: d.string()}
+		for j, partitions := 0, d.arrayLength(); j < partitions; j++ {
+			p := partitionRequest{partition: d.int32(), offset: d.int64()}
+			if r.apiVersion == 1 {
+				d.int64()
+			}
+			p.metadata = d.string()
+			t.partitions = append(t.partitions, p)
+		}
+		topics = append(topics, t)
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	kerr := sarama.ErrNoError
+	g, ok := b.groups[name]
+	switch {
+	case ok:
+		kerr = g.validCommit(memberID, generation)
+	case generation >= 0 || memberID != "":
+		kerr = sarama.ErrUnknownMemberId
+	}
+
+	e := &encoder{}
+	if r.apiVersion >= 3 {
+		e.int32(0)
+	}
+	e.arrayLength(len(topics))
+	for _, t := range topics {
+		e.string(t.name)
+		e.arrayLength(len(t.partitions))
+		for _, p := range t.partitions {
+			if kerr == sarama.ErrNoError {
+				b.commit(name, t.name, p.partition, committedOffset{offset: p.offset, metadata: p.metadata})
+			}
+
+			e.int32(p.partition)
+			e.int16(int16(kerr))
+		}
+	}
+
+	return e, nil
+}
+
+// commit stores the offset committed by the group. It must be called holding the lock.
+func (b *Broker) commit(group string, topic string, partition int32, offset committedOffset) {
+	topics, ok := b.offsets[group]
+	if !ok {
+		topics = map[string]map[int32]committedOffset{}
+		b.offsets[group] = topics
+	}
+
+	partitions, ok := topics[topic]
+	if !ok {
+		partitions = map[int32]committedOffset{}
+		topics[topic] = partitions
+	}
+
+	partitions[partition] = offset
+}
+
+func (b *Broker) offsetFetch(r *request) (*encoder, error) {
+	d := r.body
+	name := d.string()
+	n := d.arrayLength()
+	requested := map[string][]int32{}
+	topics := []string{}
+	for i := 0; i < n; i++ {
+		topic := d.string()
+		requested[topic] = d.int32Array()
+		topics = append(topics, topic)
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	committed := b.offsets[name]
+	if n < 0 {
+		for topic, partitions := range committed {
+			for partition := range partitions {
+				requested[topic] = append(requested[topic], partition)
+			}
+			sort.Slice(requested[topic], func(i, j int) bool { return requested[topic][i] < requested[topic][j] })
+			topics = append(topics, topic)
+		}
+		sort.Strings(topics)
+	}
+
+	e := &encoder{}
+	if r.apiVersion >= 3 {
+		e.int32(0)
+	}
+	e.arrayLength(len(topics))
+	for _, topic := range topics {
+		e.string(topic)
+		e.arrayLength(len(requested[topic]))
+		for _, partition := range requested[topic] {
+			offset, ok := committed[topic][partition]
+			if !ok {
+				offset = committedOffset{offset: -1}
+			}
+
+			e.int32(partition)
+			e.int64(offset.offset)
+			if r.apiVersion >= 5 {
+				e.int32(-1)
+			}
+			e.string(offset.metadata)
+			e.int16(int16(sarama.ErrNoError))
+		}
+	}
+	if r.apiVersion >= 2 {
+		e.int16(int16(sarama.ErrNoError))
+	}
+
+	return e, nil
+}
@@ -0,0 +1,120 @@
+package testing
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// record batch v2 field offsets, see https://kafka.apache.org/documentation/#recordbatch
+const (
+	batchLengthOffset     = 8
+	batchMagicOffset      = 16
+	batchLastDeltaOffset  = 23
+	batchMaxTimeOffset    = 35
+	batchHeaderLength     = 61
+	batchLogOverhead      = 12
+	recordBatchMagicValue = 2
+)
+
+// batch is a record batch stored in a partition log exactly as it was produced with its base offset replaced
+type batch struct {
+	baseOffset   int64
+	count        int64
+	maxTimestamp int64
+	data         []byte
+}
+
+// partitionLog is the log of a partition of a broker topic. Record batches are stored without being
+// decoded so compressed batches and headers are sent back to consumers as they were produced.
+type partitionLog struct {
+	mtx     sync.Mutex
+	batches []*batch
+	hwm     int64
+}
+
+// splitBatches splits the records of a produce request into record batches
+func splitBatches(records []byte) ([]*batch, error) {
+	batches := []*batch{}
+	for len(records) > 0 {
+		if len(records) < batchHeaderLength {
+			return nil, errors.New("record batch is too short")
+		}
+
+		length := int(binary.BigEndian.Uint32(records[batchLengthOffset:])) + batchLogOverhead
+		if length < batchHeaderLength || length > len(records) {
+			return nil, errors.Errorf("record batch length %d is invalid", length)
+		}
+		if records[batchMagicOffset] != recordBatchMagicValue {
+			return nil, errors.Errorf("record batch magic %d is not supported", records[batchMagicOffset])
+		}
+
+		batches = append(batches, &batch{
+			count:        int64(int32(binary.BigEndian.Uint32(records[batchLastDeltaOffset:]))) + 1,
+			maxTimestamp: int64(binary.BigEndian.Uint64(records[batchMaxTimeOffset:])),
+			data:         append([]byte{}, records[:length]...),
+		})
+		records = records[length:]
+	}
+
+	return batches, nil
+}
+
+// append stores the batches and returns the offset of the first record
+func (l *partitionLog) append(batches []*batch) int64 {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	base := l.hwm
+	for _, b := range batches {
+		b.baseOffset = l.hwm
+		binary.BigEndian.PutUint64(b.data, uint64(b.baseOffset))
+		l.batches = append(l.batches, b)
+		l.hwm += b.count
+	}
+
+	return base
+}
+
+// highWatermark is the offset the next record is stored at
+func (l *partitionLog) highWatermark() int64 {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	return l.hwm
+}
+
+// read gets the batches holding the records from the offset on up to max bytes. The batch holding the
+// offset is always returned whole and consumers skip the records before the offset.
+func (l *partitionLog) read(offset int64, max int) []byte {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	records := []byte{}
+	for _, b := range l.batches {
+		if b.baseOffset+b.count <= offset {
+			continue
+		}
+		if len(records) > 0 && len(records)+len(b.data) > max {
+			break
+		}
+		records = append(records, b.data...)
+	}
+
+	return records
+}
+
+// offsetForTime gets the offset of the first batch with a record at or after the timestamp in milliseconds
+func (l *partitionLog) offsetForTime(timestamp int64) int64 {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	for _, b := range l.batches {
+		if b.maxTimestamp >= timestamp {
+			return b.baseOffset
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,271 @@
+package testing
+
+import (
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+)
+
+// kafka api keys the broker handles
+const (
+	apiProduce         int16 = 0
+	apiFetch           int16 = 1
+	apiListOffsets     int16 = 2
+	apiMetadata        int16 = 3
+	apiOffsetCommit    int16 = 8
+	apiOffsetFetch     int16 = 9
+	apiFindCoordinator int16 = 10
+	apiJoinGroup       int16 = 11
+	apiHeartbeat       int16 = 12
+	apiLeaveGroup      int16 = 13
+	apiSyncGroup       int16 = 14
+	apiApiVersions     int16 = 18
+	apiCreateTopics    int16 = 19
+	apiInitProducerID  int16 = 22
+	apiDescribeConfigs int16 = 32
+	apiAlterConfigs    int16 = 33
+)
+
+// apiVersions are the versions of each api the broker supports. None of them use the flexible encoding.
+var apiVersions = map[int16][2]int16{
+	apiProduce:         {3, 7},
+	apiFetch:           {4, 11},
+	apiListOffsets:     {0, 3},
+	apiMetadata:        {0, 5},
+	apiOffsetCommit:    {0, 3},
+	apiOffsetFetch:     {0, 5},
+	apiFindCoordinator: {0, 2},
+	apiJoinGroup:       {0, 2},
+	apiHeartbeat:       {0, 1},
+	apiLeaveGroup:      {0, 1},
+	apiSyncGroup:       {0, 1},
+	apiApiVersions:     {0, 2},
+	apiCreateTopics:    {0, 2},
+	apiInitProducerID:  {0, 1},
+	apiDescribeConfigs: {0, 2},
+	apiAlterConfigs:    {0, 1},
+}
+
+var errInsufficientData = errors.New("insufficient data to decode request")
+
+// decoder reads the fields of a kafka request. The first error is kept and every read after it returns zero values.
+type decoder struct {
+	data []byte
+	err  error
+}
+
+func (d *decoder) take(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if n < 0 || len(d.data) < n {
+		d.err = errInsufficientData
+		return nil
+	}
+
+	b := d.data[:n]
+	d.data = d.data[n:]
+	return b
+}
+
+func (d *decoder) int8() int8 {
+	b := d.take(1)
+	if b == nil {
+		return 0
+	}
+	return int8(b[0])
+}
+
+func (d *decoder) bool() bool {
+	return d.int8() != 0
+}
+
+func (d *decoder) int16() int16 {
+	b := d.take(2)
+	if b == nil {
+		return 0
+	}
+	return int16(binary.BigEndian.Uint16(b))
+}
+
+func (d *decoder) int32() int32 {
+	b := d.take(4)
+	if b == nil {
+		return 0
+	}
+	return int32(binary.BigEndian.Uint32(b))
+}
+
+func (d *decoder) int64() int64 {
+	b := d.take(8)
+	if b == nil {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(b))
+}
+
+func (d *decoder) string() string {
+	s, _ := d.nullableString()
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func (d *decoder) nullableString() (*string, bool) {
+	n := d.int16()
+	if n < 0 {
+		return nil, d.err == nil
+	}
+
+	b := d.take(int(n))
+	if b == nil {
+		return nil, false
+	}
+
+	s := string(b)
+	return &s, true
+}
+
+func (d *decoder) bytes() []byte {
+	n := d.int32()
+	if n < 0 {
+		return nil
+	}
+
+	b := d.take(int(n))
+	if b == nil {
+		return nil
+	}
+	return append([]byte{}, b...)
+}
+
+// arrayLength reads the length of an array. A null array has a length of -1.
+func (d *decoder) arrayLength() int {
+	n := d.int32()
+	if d.err == nil && int(n) > len(d.data) {
+		d.err = errInsufficientData
+	}
+	if d.err != nil {
+		return 0
+	}
+	return int(n)
+}
+
+func (d *decoder) int32Array() []int32 {
+	n := d.arrayLength()
+	values := []int32{}
+	for i := 0; i < n; i++ {
+		values = append(values, d.int32())
+	}
+	return values
+}
+
+func (d *decoder) stringArray() []string {
+	n := d.arrayLength()
+	if n < 0 {
+		return nil
+	}
+
+	values := []string{}
+	for i := 0; i < n; i++ {
+		values = append(values, d.string())
+	}
+	return values
+}
+
+// encoder writes the fields of a kafka response
+type encoder struct {
+	data []byte
+}
+
+func (e *encoder) int8(v int8) {
+	e.data = append(e.data, byte(v))
+}
+
+func (e *encoder) bool(v bool) {
+	if v {
+		e.int8(1)
+		return
+	}
+	e.int8(0)
+}
+
+func (e *encoder) int16(v int16) {
+	e.data = append(e.data, 0, 0)
+	binary.BigEndian.PutUint16(e.data[len(e.data)-2:], uint16(v))
+}
+
+func (e *encoder) int32(v int32) {
+	e.data = append(e.data, 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(e.data[len(e.data)-4:], uint32(v))
+}
+
+func (e *encoder) int64(v int64) {
+	e.data = append(e.data, 0, 0, 0, 0, 0, 0, 0, 0)
+	binary.BigEndian.PutUint64(e.data[len(e.data)-8:], uint64(v))
+}
+
+func (e *encoder) string(v string) {
+	e.int16(int16(len(v)))
+	e.data = append(e.data, v...)
+}
+
+func (e *encoder) nullableString(v *string) {
+	if v == nil {
+		e.int16(-1)
+		return
+	}
+	e.string(*v)
+}
+
+func (e *encoder) bytes(v []byte) {
+	if v == nil {
+		e.int32(-1)
+		return
+	}
+	e.int32(int32(len(v)))
+	e.data = append(e.data, v...)
+}
+
+func (e *encoder) arrayLength(n int) {
+	e.int32(int32(n))
+}
+
+func (e *encoder) int32Array(values []int32) {
+	e.arrayLength(len(values))
+	for _, v := range values {
+		e.int32(v)
+	}
+}
+
+// request is a decoded kafka request header with the undecoded body
+type request struct {
+	apiKey        int16
+	apiVersion    int16
+	correlationID int32
+	clientID      string
+	body          *decoder
+}
+
+func decodeRequest(data []byte) (*request, error) {
+	d := &decoder{data: data}
+	r := &request{
+		apiKey:        d.int16(),
+		apiVersion:    d.int16(),
+		correlationID: d.int32(),
+	}
+	r.clientID = d.string()
+	if d.err != nil {
+		return nil, errors.Wrap(d.err, "failed to decode request header")
+	}
+
+	r.body = d
+	return r, nil
+}
+
+// supported checks the broker supports the api version of the request
+func (r *request) supported() bool {
+	versions, ok := apiVersions[r.apiKey]
+	return ok && r.apiVersion >= versions[0] && r.apiVersion <= versions[1]
+}
@@ -0,0 +1,288 @@
+package testing_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/syncromatics/kafmesh/pkg/runner"
+	kmtesting "github.com/syncromatics/kafmesh/pkg/testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/burdiyan/kafkautil"
+	"github.com/lovoo/goka"
+	"github.com/lovoo/goka/codec"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+var brokerTopics = []runner.Topic{
+	{Name: "test.words", Partitions: 2, Replicas: 1, Retention: time.Hour, Segment: time.Hour, Create: true},
+	{Name: "test.counts", Partitions: 2, Replicas: 1, Retention: time.Hour, Segment: time.Hour, Create: true},
+	{Name: "test.word-counter-table", Partitions: 2, Replicas: 1, Compact: true, Retention: time.Hour, Segment: time.Hour, Create: true},
+}
+
+func Test_Broker(t *testing.T) {
+	broker, err := kmtesting.NewBroker()
+	if !assert.Nil(t, err) {
+		return
+	}
+	defer broker.Close()
+
+	service := broker.NewService()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	err = service.ConfigureKafka(ctx, func(ctx context.Context, brokers []string) error {
+		return runner.ConfigureTopics(ctx, brokers, brokerTopics)
+	})
+	assert.Nil(t, err)
+
+	// configuring again checks the existing topics and updates their configuration
+	err = runner.ConfigureTopics(ctx, broker.Brokers(), brokerTopics)
+	assert.Nil(t, err)
+
+	err = runner.ConfigureTopics(ctx, broker.Brokers(), []runner.Topic{{Name: "test.missing", Partitions: 1}})
+	assert.EqualError(t, err, "topic configuration invalid 'topic 'test.missing' does not exist and is not created in this service'")
+
+	view, err := registerWordCounter(service)
+	assert.Nil(t, err)
+
+	sink := &brokerSink{testSink: &testSink{}}
+	err = service.RegisterRunner(runner.NewSinkRunner(sink, service.Options().Brokers).Run, runner.WithRunnerStage(runner.StageSinks))
+	assert.Nil(t, err)
+
+	runCtx, stop := context.WithCancel(ctx)
+	done := make(chan error, 1)
+	go func() {
+		done <- service.Run(runCtx)()
+	}()
+
+	emitter, err := goka.NewEmitter(broker.Brokers(), goka.Stream("test.words"), new(codec.String),
+		goka.WithEmitterHasher(kafkautil.MurmurHasher))
+	assert.Nil(t, err)
+
+	for _, word := range []string{"a", "b", "a", "c", "a"} {
+		err = emitter.EmitSync(word, word)
+		assert.Nil(t, err)
+	}
+	err = emitter.Finish()
+	assert.Nil(t, err)
+
+	assert.Eventually(t, func() bool {
+		return len(sink.flushed()) == 5
+	}, 20*time.Second, 50*time.Millisecond)
+
+	flushed := sink.flushed()
+	sort.Strings(flushed)
+	assert.Equal(t, []string{"a: a 1", "a: a 2", "a: a 3", "b: b 1", "c: c 1"}, flushed)
+
+	assert.Eventually(t, func() bool {
+		count, err := view.Get("a")
+		return err == nil && count == int64(3)
+	}, 10*time.Second, 50*time.Millisecond)
+
+	messages, err := broker.Consume("test.counts")
+	assert.Nil(t, err)
+
+	counts := []string{}
+	for _, m := range messages {
+		counts = append(counts, fmt.Sprintf("%s: %s", m.Key, m.Value))
+	}
+	sort.Strings(counts)
+	assert.Equal(t, []string{"a: a 1", "a: a 2", "a: a 3", "b: b 1", "c: c 1"}, counts)
+
+	stop()
+	err = <-done
+	assert.Nil(t, err)
+}
+
+func Test_Broker_ConsumerGroupRebalance(t *testing.T) {
+	broker, err := kmtesting.NewBroker()
+	if !assert.Nil(t, err) {
+		return
+	}
+	defer broker.Close()
+
+	err = broker.CreateTopic("test.rebalance", 4, nil)
+	assert.Nil(t, err)
+
+	err = broker.CreateTopic("test.rebalance", 4, nil)
+	assert.EqualError(t, err, "topic 'test.rebalance' already exists")
+
+	config := sarama.NewConfig()
+	config.Version = sarama.MaxVersion
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	config.Consumer.Group.Heartbeat.Interval = 100 * time.Millisecond
+	config.Consumer.Group.Session.Timeout = time.Second
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	first := newClaimHandler()
+	firstGroup, err := sarama.NewConsumerGroup(broker.Brokers(), "test.rebalance-group", config)
+	assert.Nil(t, err)
+	go consumeGroup(ctx, firstGroup, first)
+
+	assert.Eventually(t, func() bool {
+		return len(first.claimed()) == 4
+	}, 10*time.Second, 20*time.Millisecond)
+
+	second := newClaimHandler()
+	secondGroup, err := sarama.NewConsumerGroup(broker.Brokers(), "test.rebalance-group", config)
+	assert.Nil(t, err)
+	go consumeGroup(ctx, secondGroup, second)
+
+	assert.Eventually(t, func() bool {
+		return len(first.claimed()) == 2 && len(second.claimed()) == 2
+	}, 10*time.Second, 20*time.Millisecond)
+
+	err = secondGroup.Close()
+	assert.Nil(t, err)
+
+	assert.Eventually(t, func() bool {
+		return len(first.claimed()) == 4
+	}, 10*time.Second, 20*time.Millisecond)
+
+	err = firstGroup.Close()
+	assert.Nil(t, err)
+}
+
+// registerWordCounter registers a processor that counts the words of each key and a view of the counts
+// the same way the generated code registers them
+func registerWordCounter(service *runner.Service) (*goka.View, error) {
+	options := service.Options()
+
+	builder, err := options.Storage.Builder("processor", "test.word-counter")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create processor storage")
+	}
+
+	config := sarama.NewConfig()
+	config.Version = sarama.MaxVersion
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	config.Consumer.Offsets.AutoCommit.Enable = true
+	config.Consumer.Offsets.CommitInterval = 1 * time.Second
+	runner.ConfigureReadCommitted(config)
+
+	edges := []goka.Edge{
+		goka.Input(goka.Stream("test.words"), new(codec.String), func(ctx goka.Context, m interface{}) {
+			count := int64(1)
+			if v := ctx.Value(); v != nil {
+				count = v.(int64) + 1
+			}
+
+			ctx.SetValue(count)
+			ctx.Emit(goka.Stream("test.counts"), ctx.Key(), fmt.Sprintf("%s %d", m, count))
+		}),
+		goka.Output(goka.Stream("test.counts"), new(codec.String)),
+		goka.Persist(new(codec.Int64)),
+	}
+
+	processor, err := goka.NewProcessor(options.Brokers,
+		goka.DefineGroup(goka.Group("test.word-counter"), edges...),
+		goka.WithConsumerGroupBuilder(goka.ConsumerGroupBuilderWithConfig(config)),
+		goka.WithStorageBuilder(builder),
+		goka.WithConsumerSaramaBuilder(goka.SaramaConsumerBuilderWithConfig(runner.ReadCommittedConfig())),
+		goka.WithProducerBuilder(runner.IdempotentProducerBuilder()),
+		goka.WithHasher(kafkautil.MurmurHasher))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create goka processor")
+	}
+
+	err = service.RegisterRunner(func(ctx context.Context) func() error {
+		runner.ReportReadiness(ctx, processor.Recovered)
+
+		return func() error {
+			return processor.Run(ctx)
+		}
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register processor")
+	}
+
+	viewBuilder, err := options.Storage.Builder("view", "test.word-counter-table")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create view storage")
+	}
+
+	view, err := goka.NewView(options.Brokers,
+		goka.Table("test.word-counter-table"),
+		new(codec.Int64),
+		goka.WithViewStorageBuilder(viewBuilder),
+		goka.WithViewHasher(kafkautil.MurmurHasher))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create goka view")
+	}
+
+	err = service.RegisterRunner(func(ctx context.Context) func() error {
+		runner.ReportReadiness(ctx, view.Recovered)
+
+		return func() error {
+			return view.Run(ctx)
+		}
+	}, runner.WithRunnerStage(runner.StageViews))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register view")
+	}
+
+	return view, nil
+}
+
+// brokerSink is a test sink of the counts that flushes often
+type brokerSink struct {
+	*testSink
+}
+
+func (s *brokerSink) Group() string           { return "test.counts-sink" }
+func (s *brokerSink) Topic() string           { return "test.counts" }
+func (s *brokerSink) Interval() time.Duration { return 100 * time.Millisecond }
+
+// claimHandler tracks the partitions claimed by a consumer group member
+type claimHandler struct {
+	claims chan []int32
+	last   []int32
+}
+
+func newClaimHandler() *claimHandler {
+	return &claimHandler{claims: make(chan []int32, 100)}
+}
+
+func (h *claimHandler) Setup(session sarama.ConsumerGroupSession) error {
+	h.claims <- session.Claims()["test.rebalance"]
+	return nil
+}
+
+func (h *claimHandler) Cleanup(session sarama.ConsumerGroupSession) error {
+	h.claims <- nil
+	return nil
+}
+
+func (h *claimHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for range claim.Messages() {
+	}
+	return nil
+}
+
+func (h *claimHandler) claimed() []int32 {
+	for {
+		select {
+		case claims := <-h.claims:
+			h.last = claims
+		default:
+			return h.last
+		}
+	}
+}
+
+func consumeGroup(ctx context.Context, group sarama.ConsumerGroup, handler sarama.ConsumerGroupHandler) {
+	for ctx.Err() == nil {
+		err := group.Consume(ctx, []string{"test.rebalance"}, handler)
+		if err == sarama.ErrClosedConsumerGroup {
+			return
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package testing
+
+import (
+	"sync"
+
+	"github.com/Shopify/sarama"
+	"github.com/pkg/errors"
+)
+
+// consumer is the consumer goka views and processor tables recover and catch up with
+type consumer struct {
+	tester *Tester
+
+	mtx        sync.Mutex
+	partitions map[*partitionConsumer]struct{}
+}
+
+func newConsumer(tester *Tester) *consumer {
+	return &consumer{
+		tester:     tester,
+		partitions: map[*partitionConsumer]struct{}{},
+	}
+}
+
+func (c *consumer) Topics() ([]string, error) {
+	return nil, errors.Errorf("listing topics is not supported by the tester")
+}
+
+func (c *consumer) Partitions(topic string) ([]int32, error) {
+	return []int32{0}, nil
+}
+
+// ConsumePartition consumes the only partition of the topic from the offset
+func (c *consumer) ConsumePartition(name string, partition int32, offset int64) (sarama.PartitionConsumer, error) {
+	t := c.tester.topic(name)
+
+	switch offset {
+	case sarama.OffsetOldest:
+		offset = 0
+	case sarama.OffsetNewest:
+		offset = t.hwm()
+	}
+
+	pc := &partitionConsumer{
+		consumer: c,
+		topic:    t,
+		next:     offset,
+		messages: make(chan *sarama.ConsumerMessage),
+		errors:   make(chan *sarama.ConsumerError),
+		done:     make(chan struct{}),
+	}
+
+	c.mtx.Lock()
+	c.partitions[pc] = struct{}{}
+	c.mtx.Unlock()
+
+	// tables recover the messages already in the topic without waiting for the next message to be pushed
+	go pc.catchup()
+
+	return pc, nil
+}
+
+func (c *consumer) HighWaterMarks() map[string]map[int32]int64 {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	hwms := map[string]map[int32]int64{}
+	for pc := range c.partitions {
+		hwms[pc.topic.name] = map[int32]int64{0: pc.topic.hwm()}
+	}
+
+	return hwms
+}
+
+func (c *consumer) Close() error {
+	return nil
+}
+
+// catchup sends the messages the partition consumers have not received yet and returns how many were sent
+func (c *consumer) catchup() int {
+	c.mtx.Lock()
+	partitions := []*partitionConsumer{}
+	for pc := range c.partitions {
+		partitions = append(partitions, pc)
+	}
+	c.mtx.Unlock()
+
+	count := 0
+	for _, pc := range partitions {
+		count += pc.catchup()
+	}
+
+	return count
+}
+
+type partitionConsumer struct {
+	consumer *consumer
+	topic    *topic
+
+	mtx      sync.Mutex
+	next     int64
+	messages chan *sarama.ConsumerMessage
+	errors   chan *sarama.ConsumerError
+	done     chan struct{}
+	once     sync.Once
+}
+
+func (pc *partitionConsumer) catchup() int {
+	pc.mtx.Lock()
+	defer pc.mtx.Unlock()
+
+	count := 0
+	for _, r := range pc.topic.from(pc.next) {
+		// the nil after the message makes sure the message was handled before going on
+		for _, msg := range []*sarama.ConsumerMessage{r.consumerMessage(pc.topic.name), nil} {
+			select {
+			case pc.messages <- msg:
+			case <-pc.done:
+				return count
+			}
+		}
+
+		pc.next = r.offset + 1
+		count++
+	}
+
+	return count
+}
+
+func (pc *partitionConsumer) AsyncClose() {
+	go pc.Close()
+}
+
+func (pc *partitionConsumer) Close() error {
+	pc.once.Do(func() {
+		close(pc.done)
+
+		pc.mtx.Lock()
+		close(pc.messages)
+		close(pc.errors)
+		pc.mtx.Unlock()
+
+		pc.consumer.mtx.Lock()
+		delete(pc.consumer.partitions, pc)
+		pc.consumer.mtx.Unlock()
+	})
+
+	return nil
+}
+
+func (pc *partitionConsumer) Messages() <-chan *sarama.ConsumerMessage {
+	return pc.messages
+}
+
+func (pc *partitionConsumer) Errors() <-chan *sarama.ConsumerError {
+	return pc.errors
+}
+
+func (pc *partitionConsumer) HighWaterMarkOffset() int64 {
+	return pc.topic.hwm()
+}
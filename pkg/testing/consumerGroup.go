@@ -0,0 +1,351 @@
+package testing
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Shopify/sarama"
+	"github.com/pkg/errors"
+)
+
+// consumerGroup is a consumer group of the tester. It has a single member that is assigned every topic it consumes.
+type consumerGroup struct {
+	tester *Tester
+	name   string
+	// collected is set for consumer groups that only mark their messages once they flushed them. The tester
+	// sends nil after each message to know it was collected instead of waiting for it to be marked.
+	collected bool
+
+	mtx       sync.Mutex
+	errs      chan error
+	session   *session
+	committed map[string]int64
+	consuming chan struct{}
+}
+
+func newConsumerGroup(tester *Tester, name string, collected bool) *consumerGroup {
+	return &consumerGroup{
+		tester:    tester,
+		name:      name,
+		collected: collected,
+		errs:      make(chan error, 1),
+		committed: map[string]int64{},
+		consuming: make(chan struct{}),
+	}
+}
+
+// Consume claims the topics until the context is done
+func (g *consumerGroup) Consume(ctx context.Context, topics []string, handler sarama.ConsumerGroupHandler) error {
+	if len(topics) == 0 {
+		return errors.Errorf("no topics to consume")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	g.mtx.Lock()
+	if g.session != nil {
+		g.mtx.Unlock()
+		return errors.Errorf("consumer group '%s' is already consuming", g.name)
+	}
+	s := newSession(ctx, g, topics, g.committed)
+	g.session = s
+	g.mtx.Unlock()
+
+	defer func() {
+		g.mtx.Lock()
+		g.session = nil
+		g.consuming = make(chan struct{})
+		g.mtx.Unlock()
+	}()
+
+	err := handler.Setup(s)
+	if err != nil {
+		return errors.Wrap(err, "failed to setup consumer group session")
+	}
+
+	wg := sync.WaitGroup{}
+	for _, c := range s.claims {
+		wg.Add(1)
+		go func(c *claim) {
+			defer wg.Done()
+
+			err := handler.ConsumeClaim(s, c)
+			if err != nil {
+				g.fail(ctx, err)
+			}
+		}(c)
+	}
+
+	g.mtx.Lock()
+	close(g.consuming)
+	g.mtx.Unlock()
+
+	<-ctx.Done()
+
+	s.close()
+	wg.Wait()
+
+	err = handler.Cleanup(s)
+	if err != nil {
+		return errors.Wrap(err, "failed to cleanup consumer group session")
+	}
+
+	return nil
+}
+
+func (g *consumerGroup) fail(ctx context.Context, err error) {
+	g.mtx.Lock()
+	errs := g.errs
+	g.mtx.Unlock()
+
+	select {
+	case errs <- err:
+	case <-ctx.Done():
+	}
+}
+
+// Errors gets the errors of the claims
+func (g *consumerGroup) Errors() <-chan error {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	return g.errs
+}
+
+// Close closes the errors so the group can consume again
+func (g *consumerGroup) Close() error {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	close(g.errs)
+	g.errs = make(chan error, 1)
+
+	return nil
+}
+
+// running is closed once the group is consuming
+func (g *consumerGroup) running() <-chan struct{} {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	return g.consuming
+}
+
+// catchup sends the messages the group has not received yet to its session and returns how many were sent
+func (g *consumerGroup) catchup() int {
+	g.mtx.Lock()
+	s := g.session
+	g.mtx.Unlock()
+
+	if s == nil {
+		return 0
+	}
+
+	return s.catchup()
+}
+
+func (g *consumerGroup) commit(topic string, offset int64) {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	if offset > g.committed[topic] {
+		g.committed[topic] = offset
+	}
+}
+
+// session is a consumer group session of the tester
+type session struct {
+	ctx    context.Context
+	group  *consumerGroup
+	claims map[string]*claim
+
+	send      sync.Mutex
+	closed    bool
+	delivered map[string]int64
+
+	mtx    sync.Mutex
+	marked map[string]int64
+	cond   *sync.Cond
+}
+
+func newSession(ctx context.Context, group *consumerGroup, topics []string, committed map[string]int64) *session {
+	s := &session{
+		ctx:       ctx,
+		group:     group,
+		claims:    map[string]*claim{},
+		delivered: map[string]int64{},
+		marked:    map[string]int64{},
+	}
+	s.cond = sync.NewCond(&s.mtx)
+
+	for _, topic := range topics {
+		s.claims[topic] = &claim{
+			topic:    topic,
+			offset:   committed[topic],
+			hwm:      group.tester.topic(topic).hwm,
+			messages: make(chan *sarama.ConsumerMessage),
+		}
+		s.delivered[topic] = committed[topic]
+		s.marked[topic] = committed[topic]
+	}
+
+	go func() {
+		<-ctx.Done()
+		s.mtx.Lock()
+		s.cond.Broadcast()
+		s.mtx.Unlock()
+	}()
+
+	return s
+}
+
+func (s *session) catchup() int {
+	s.send.Lock()
+	defer s.send.Unlock()
+
+	if s.closed {
+		return 0
+	}
+
+	count := 0
+	for topic, c := range s.claims {
+		for _, r := range s.group.tester.topic(topic).from(s.delivered[topic]) {
+			if !s.deliver(c, r.consumerMessage(topic)) {
+				return count
+			}
+			if s.group.collected && !s.deliver(c, nil) {
+				return count
+			}
+
+			s.delivered[topic] = r.offset + 1
+			count++
+		}
+	}
+
+	if !s.group.collected {
+		s.waitMarked()
+	}
+
+	return count
+}
+
+func (s *session) deliver(c *claim, msg *sarama.ConsumerMessage) bool {
+	select {
+	case c.messages <- msg:
+		return true
+	case <-s.ctx.Done():
+		return false
+	}
+}
+
+// waitMarked waits until every delivered message is marked or the session ends
+func (s *session) waitMarked() {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for s.ctx.Err() == nil && !s.allMarked() {
+		s.cond.Wait()
+	}
+}
+
+func (s *session) allMarked() bool {
+	for topic, offset := range s.delivered {
+		if s.marked[topic] < offset {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *session) close() {
+	s.send.Lock()
+	defer s.send.Unlock()
+
+	s.closed = true
+	for _, c := range s.claims {
+		close(c.messages)
+	}
+}
+
+// Claims gets the partitions of the topics claimed by the session
+func (s *session) Claims() map[string][]int32 {
+	claims := map[string][]int32{}
+	for topic := range s.claims {
+		claims[topic] = []int32{0}
+	}
+
+	return claims
+}
+
+// MemberID is the id of the only member of the group
+func (s *session) MemberID() string {
+	return s.group.name
+}
+
+// GenerationID is always the first generation
+func (s *session) GenerationID() int32 {
+	return 1
+}
+
+// MarkOffset marks the offset as the next one to consume
+func (s *session) MarkOffset(topic string, partition int32, offset int64, metadata string) {
+	s.mtx.Lock()
+	if offset > s.marked[topic] {
+		s.marked[topic] = offset
+	}
+	s.cond.Broadcast()
+	s.mtx.Unlock()
+
+	s.group.commit(topic, offset)
+}
+
+// Commit does nothing since marked offsets are committed at once
+func (s *session) Commit() {}
+
+// ResetOffset resets the offset of the topic for the next session
+func (s *session) ResetOffset(topic string, partition int32, offset int64, metadata string) {
+	s.group.mtx.Lock()
+	defer s.group.mtx.Unlock()
+
+	s.group.committed[topic] = offset
+}
+
+// MarkMessage marks the message as consumed
+func (s *session) MarkMessage(msg *sarama.ConsumerMessage, metadata string) {
+	s.MarkOffset(msg.Topic, msg.Partition, msg.Offset+1, metadata)
+}
+
+// Context is the context of the session
+func (s *session) Context() context.Context {
+	return s.ctx
+}
+
+// claim is the claim of the only partition of a topic
+type claim struct {
+	topic    string
+	offset   int64
+	hwm      func() int64
+	messages chan *sarama.ConsumerMessage
+}
+
+func (c *claim) Topic() string {
+	return c.topic
+}
+
+func (c *claim) Partition() int32 {
+	return 0
+}
+
+func (c *claim) InitialOffset() int64 {
+	return c.offset
+}
+
+func (c *claim) HighWaterMarkOffset() int64 {
+	return c.hwm()
+}
+
+func (c *claim) Messages() <-chan *sarama.ConsumerMessage {
+	return c.messages
+}
@@ -0,0 +1,110 @@
+package testing
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/syncromatics/kafmesh/pkg/runner"
+	"google.golang.org/grpc"
+)
+
+var (
+	harnessStart   = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	harnessTimeout = 10 * time.Second
+)
+
+// Harness runs the processors, views and sinks of a service in memory. Components are registered with
+// the service the same way the generated code does, then the harness is started and messages are pushed
+// to the tester topics.
+type Harness struct {
+	*Tester
+
+	service *runner.Service
+	cancel  context.CancelFunc
+	done    chan error
+}
+
+// NewHarness creates a service that runs against an in memory tester and schema registry
+func NewHarness(options ...runner.ServiceOption) *Harness {
+	tester := NewTester(harnessStart)
+
+	options = append([]runner.ServiceOption{
+		runner.WithTester(tester),
+		runner.WithStorage(runner.StorageConfig{
+			Default: runner.StorageOptions{Backend: runner.StorageBackendMemory},
+		}),
+		runner.WithShutdown(runner.ShutdownConfig{
+			Timeout: harnessTimeout,
+			Quiet:   10 * time.Millisecond,
+			Drain:   time.Second,
+		}),
+	}, options...)
+
+	return &Harness{
+		Tester:  tester,
+		service: runner.NewService(nil, NewRegistry(), grpc.NewServer(), options...),
+	}
+}
+
+// Service gets the service to register the components with
+func (h *Harness) Service() *runner.Service {
+	return h.service
+}
+
+// Start runs the service and waits for every component to be running
+func (h *Harness) Start() error {
+	err := h.service.ConfigureKafka(context.Background(), nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to configure service")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	h.cancel = cancel
+	h.done = make(chan error, 1)
+	go func() {
+		h.done <- h.service.Run(ctx)()
+	}()
+
+	timeout := time.After(harnessTimeout)
+	select {
+	case <-h.running():
+	case err = <-h.done:
+		return errors.Wrap(err, "service stopped while starting")
+	case <-timeout:
+		return errors.Errorf("timed out waiting for consumer groups to start")
+	}
+
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	for h.service.State() != runner.RunnerRunning {
+		select {
+		case <-ticker.C:
+		case err = <-h.done:
+			return errors.Wrap(err, "service stopped while starting")
+		case <-timeout:
+			return errors.Errorf("timed out waiting for service to be running")
+		}
+	}
+
+	h.catchup()
+
+	return nil
+}
+
+// Stop stops the service and returns the error it stopped with
+func (h *Harness) Stop() error {
+	if h.cancel == nil {
+		return nil
+	}
+
+	h.cancel()
+	err := <-h.done
+	h.cancel = nil
+
+	if err != nil {
+		return errors.Wrap(err, "service failed")
+	}
+
+	return nil
+}
@@ -0,0 +1,592 @@
+package testing_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/syncromatics/kafmesh/pkg/runner"
+	kmtesting "github.com/syncromatics/kafmesh/pkg/testing"
+
+	"github.com/lovoo/goka"
+	"github.com/lovoo/goka/codec"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Harness(t *testing.T) {
+	h := kmtesting.NewHarness()
+
+	view, err := registerCounter(h.Service())
+	assert.Nil(t, err)
+
+	sink := &testSink{}
+	err = h.Service().RegisterRunner(runner.NewSinkRunner(sink, nil, runner.WithSinkTester(h.Service().Options().Tester())).Run)
+	assert.Nil(t, err)
+
+	err = h.Start()
+	assert.Nil(t, err)
+	defer h.Stop()
+
+	start := h.Now()
+
+	err = h.Push("test.input", "a", "hello", map[string][]byte{"source": []byte("test")})
+	assert.Nil(t, err)
+
+	inputs, err := h.Messages("test.input")
+	assert.Nil(t, err)
+	assert.Equal(t, []kmtesting.Message{
+		{Topic: "test.input", Offset: 0, Key: "a", Value: "hello", Headers: map[string][]byte{"source": []byte("test")}, Timestamp: start},
+	}, inputs)
+
+	outputs, err := h.Messages("test.output")
+	assert.Nil(t, err)
+	assert.Len(t, outputs, 1)
+	assert.Equal(t, "a", outputs[0].Key)
+	assert.Equal(t, "hello 1", outputs[0].Value)
+	assert.Equal(t, start, outputs[0].Timestamp)
+
+	count, err := h.TableValue("test.counter-table", "a")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), count)
+
+	count, err = view.Get("a")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), count)
+
+	// the sink only collects messages when they are flushed
+	assert.Empty(t, sink.collected())
+	assert.Empty(t, sink.flushed())
+
+	err = h.Advance(10 * time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a: hello 1"}, sink.flushed())
+
+	err = h.SetTableValue("test.counter-table", "b", int64(41))
+	assert.Nil(t, err)
+
+	err = h.Push("test.input", "b", "world", nil)
+	assert.Nil(t, err)
+
+	count, err = view.Get("b")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(42), count)
+
+	err = h.Advance(time.Minute)
+	assert.Nil(t, err)
+
+	outputs, err = h.Messages("test.output")
+	assert.Nil(t, err)
+	assert.Len(t, outputs, 4)
+	assert.Equal(t, "world 42", outputs[1].Value)
+	assert.Equal(t, start.Add(10*time.Second), outputs[1].Timestamp)
+
+	expired := map[string]interface{}{}
+	for _, m := range outputs[2:] {
+		expired[m.Key] = m.Value
+		assert.Equal(t, start.Add(70*time.Second), m.Timestamp)
+	}
+	assert.Equal(t, map[string]interface{}{"a": "expired", "b": "expired"}, expired)
+
+	err = h.Push("test.input", "a", nil, nil)
+	assert.Nil(t, err)
+
+	inputs, err = h.Messages("test.input")
+	assert.Nil(t, err)
+	assert.Len(t, inputs, 3)
+	assert.Nil(t, inputs[2].Value)
+
+	count, err = h.TableValue("test.counter-table", "a")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), count)
+
+	err = h.Stop()
+	assert.Nil(t, err)
+}
+
+// registerCounter registers a processor that counts the messages of each key and a view of the counts
+// the same way the generated code registers them
+func registerCounter(service *runner.Service) (*goka.View, error) {
+	options := service.Options()
+
+	timers := runner.NewTimers(options.Brokers, "test.counter", nil)
+
+	edges := []goka.Edge{
+		goka.Input(goka.Stream("test.input"), new(codec.String), func(ctx goka.Context, m interface{}) {
+			count := int64(1)
+			if v := ctx.Value(); v != nil {
+				count = v.(int64) + 1
+			}
+
+			ctx.SetValue(count)
+			ctx.Emit(goka.Stream("test.output"), ctx.Key(), fmt.Sprintf("%s %d", m, count))
+			timers.Schedule(ctx, ctx.Key(), ctx.Timestamp().Add(time.Minute))
+		}),
+		goka.Output(goka.Stream("test.output"), new(codec.String)),
+		goka.Persist(new(codec.Int64)),
+		timers.Edge(),
+		timers.Fired(func(ctx goka.Context, at time.Time) {
+			ctx.Emit(goka.Stream("test.output"), ctx.Key(), "expired")
+		}),
+	}
+
+	processor, err := goka.NewProcessor(options.Brokers,
+		goka.DefineGroup(goka.Group("test.counter"), edges...),
+		options.ProcessorOptions()...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create goka processor")
+	}
+
+	err = service.RegisterTimers(timers)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register timers")
+	}
+
+	err = service.RegisterRunner(func(ctx context.Context) func() error {
+		runner.ReportReadiness(ctx, processor.Recovered)
+
+		return func() error {
+			return processor.Run(ctx)
+		}
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register processor")
+	}
+
+	view, err := goka.NewView(options.Brokers,
+		goka.Table("test.counter-table"),
+		new(codec.Int64),
+		options.ViewOptions()...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create goka view")
+	}
+
+	err = service.RegisterRunner(func(ctx context.Context) func() error {
+		runner.ReportReadiness(ctx, view.Recovered)
+
+		return func() error {
+			return view.Run(ctx)
+		}
+	}, runner.WithRunnerStage(runner.StageViews))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register view")
+	}
+
+	return view, nil
+}
+
+func Test_Harness_DeadLetter(t *testing.T) {
+	h := kmtesting.NewHarness()
+
+	flaky, err := registerFlaky(h.Service())
+	assert.Nil(t, err)
+
+	err = h.Start()
+	assert.Nil(t, err)
+	defer h.Stop()
+
+	err = h.Push("test.flaky-input", "a", "retry", nil)
+	assert.Nil(t, err)
+
+	// the failed attempt leaves nothing behind and does not hold up the next message
+	err = h.Push("test.flaky-input", "b", "ok", nil)
+	assert.Nil(t, err)
+
+	outputs, err := h.Messages("test.flaky-output")
+	assert.Nil(t, err)
+	assert.Len(t, outputs, 1)
+	assert.Equal(t, "b", outputs[0].Key)
+
+	value, err := h.TableValue("test.flaky-table", "a")
+	assert.Nil(t, err)
+	assert.Nil(t, value)
+
+	err = h.Advance(10 * time.Second)
+	assert.Nil(t, err)
+
+	outputs, err = h.Messages("test.flaky-output")
+	assert.Nil(t, err)
+	assert.Len(t, outputs, 2)
+	assert.Equal(t, "a", outputs[1].Key)
+	assert.Equal(t, "retry", outputs[1].Value)
+
+	value, err = h.TableValue("test.flaky-table", "a")
+	assert.Nil(t, err)
+	assert.Equal(t, "retry", value)
+
+	// the retry sees the timestamp of the original message and only the attempt that succeeded committed
+	assert.Len(t, flaky.timestamps["a"], 2)
+	assert.Equal(t, flaky.timestamps["a"][0], flaky.timestamps["a"][1])
+	assert.Equal(t, 1, flaky.committed["a"])
+
+	err = h.Push("test.flaky-input", "c", "fail", nil)
+	assert.Nil(t, err)
+
+	err = h.Advance(10 * time.Second)
+	assert.Nil(t, err)
+
+	dead, err := h.Messages("test.flaky-dlq")
+	assert.Nil(t, err)
+	assert.Empty(t, dead)
+
+	err = h.Advance(20 * time.Second)
+	assert.Nil(t, err)
+
+	dead, err = h.Messages("test.flaky-dlq")
+	assert.Nil(t, err)
+	assert.Len(t, dead, 1)
+	assert.Equal(t, "c", dead[0].Key)
+	assert.Equal(t, []byte("fail"), dead[0].Value)
+	assert.Equal(t, "test.flaky-input", string(dead[0].Headers[runner.DeadLetterTopicHeader]))
+	assert.Equal(t, "2", string(dead[0].Headers[runner.DeadLetterOffsetHeader]))
+	assert.Equal(t, "3", string(dead[0].Headers[runner.DeadLetterAttemptsHeader]))
+	assert.Equal(t, "always fails", string(dead[0].Headers[runner.DeadLetterErrorHeader]))
+
+	outputs, err = h.Messages("test.flaky-output")
+	assert.Nil(t, err)
+	assert.Len(t, outputs, 2)
+	assert.Equal(t, 0, flaky.committed["c"])
+
+	err = h.Stop()
+	assert.Nil(t, err)
+}
+
+type flakyProcessor struct {
+	attempts   map[string]int
+	committed  map[string]int
+	timestamps map[string][]time.Time
+}
+
+// registerFlaky registers a processor with a dead letter topic the same way the generated code does.
+// It fails the first attempt of "retry" messages and every attempt of "fail" messages after it
+// already emitted and stored the message.
+func registerFlaky(service *runner.Service) (*flakyProcessor, error) {
+	options := service.Options()
+
+	deadLetter := runner.NewDeadLetter(options.Brokers, "test.flaky", "test.flaky-dlq", runner.RetryPolicy{
+		MaxAttempts: 3,
+		Backoff:     10 * time.Second,
+	}, nil)
+
+	flaky := &flakyProcessor{
+		attempts:   map[string]int{},
+		committed:  map[string]int{},
+		timestamps: map[string][]time.Time{},
+	}
+	edges := []goka.Edge{
+		deadLetter.Input("test.flaky-input", new(codec.String), func(ctx goka.Context, m interface{}) {
+			err := deadLetter.Handle(ctx, new(codec.String), m, func(ctx goka.Context) error {
+				ctx.SetValue(m)
+				ctx.Emit(goka.Stream("test.flaky-output"), ctx.Key(), m)
+				runner.OnCommit(ctx, func() {
+					flaky.committed[ctx.Key()]++
+				})
+
+				flaky.attempts[ctx.Key()]++
+				flaky.timestamps[ctx.Key()] = append(flaky.timestamps[ctx.Key()], ctx.Timestamp())
+				switch {
+				case m == "fail":
+					return errors.New("always fails")
+				case m == "retry" && flaky.attempts[ctx.Key()] == 1:
+					return errors.New("first attempt fails")
+				}
+
+				return nil
+			})
+			if err != nil {
+				ctx.Fail(err)
+			}
+		}),
+		goka.Output(goka.Stream("test.flaky-output"), new(codec.String)),
+		goka.Persist(new(codec.String)),
+	}
+	edges = append(edges, deadLetter.Edges()...)
+
+	processor, err := goka.NewProcessor(options.Brokers,
+		goka.DefineGroup(goka.Group("test.flaky"), edges...),
+		options.ProcessorOptions()...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create goka processor")
+	}
+
+	err = service.RegisterDeadLetter(deadLetter)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register dead letter")
+	}
+
+	return flaky, service.RegisterRunner(func(ctx context.Context) func() error {
+		runner.ReportReadiness(ctx, processor.Recovered)
+
+		return func() error {
+			return processor.Run(ctx)
+		}
+	})
+}
+
+func Test_Harness_Window(t *testing.T) {
+	h := kmtesting.NewHarness()
+
+	err := registerWindow(h.Service())
+	assert.Nil(t, err)
+
+	err = h.Start()
+	assert.Nil(t, err)
+	defer h.Stop()
+
+	// start at the beginning of a window so the keys share it
+	err = h.Advance(h.Now().Truncate(time.Minute).Add(time.Minute).Sub(h.Now()))
+	assert.Nil(t, err)
+	start := h.Now()
+
+	err = h.Push("test.window-input", "a", "hello", nil)
+	assert.Nil(t, err)
+	err = h.Push("test.window-input", "b", "hello", nil)
+	assert.Nil(t, err)
+
+	err = h.Advance(30 * time.Second)
+	assert.Nil(t, err)
+
+	err = h.Push("test.window-input", "a", "world", nil)
+	assert.Nil(t, err)
+
+	// neither key receives a message after the window ends so only the timers can close it
+	err = h.Advance(35 * time.Second)
+	assert.Nil(t, err)
+
+	outputs, err := h.Messages("test.window-output")
+	assert.Nil(t, err)
+	assert.Empty(t, outputs)
+
+	err = h.Advance(10 * time.Second)
+	assert.Nil(t, err)
+
+	outputs, err = h.Messages("test.window-output")
+	assert.Nil(t, err)
+
+	emitted := map[string]interface{}{}
+	for _, m := range outputs {
+		emitted[m.Key] = m.Value
+	}
+	assert.Equal(t, map[string]interface{}{
+		"a": fmt.Sprintf("%s: 2", start.Format(time.RFC3339)),
+		"b": fmt.Sprintf("%s: 1", start.Format(time.RFC3339)),
+	}, emitted)
+
+	err = h.Stop()
+	assert.Nil(t, err)
+}
+
+// registerWindow registers a windowed processor that counts the messages of each key per minute the
+// same way the generated code registers it
+func registerWindow(service *runner.Service) error {
+	options := service.Options()
+
+	timers := runner.NewTimers(options.Brokers, "test.window", nil)
+	aggregator := runner.WindowedAggregator{
+		Size:  time.Minute,
+		Grace: 10 * time.Second,
+		Codec: new(windowCountCodec),
+		New: func() interface{} {
+			return &windowCount{}
+		},
+		Timers:     timers,
+		StreamTime: runner.NewPartitionStreamTime(),
+	}
+
+	emit := func(ctx goka.Context) func(runner.Window, interface{}) error {
+		return func(window runner.Window, aggregate interface{}) error {
+			value := fmt.Sprintf("%s: %d", window.Start.Format(time.RFC3339), aggregate.(*windowCount).Count)
+			ctx.Emit(goka.Stream("test.window-output"), ctx.Key(), value)
+			return nil
+		}
+	}
+
+	edges := []goka.Edge{
+		goka.Input(goka.Stream("test.window-input"), new(codec.String), func(ctx goka.Context, m interface{}) {
+			err := aggregator.Aggregate(ctx, ctx.Timestamp(), func(window runner.Window, aggregate interface{}) error {
+				aggregate.(*windowCount).Count++
+				return nil
+			}, emit(ctx))
+			if err != nil {
+				ctx.Fail(err)
+			}
+		}),
+		goka.Output(goka.Stream("test.window-output"), new(codec.String)),
+		goka.Persist(new(runner.WindowStoreCodec)),
+		timers.Edge(),
+		goka.Input(goka.Stream(timers.FiredTopic()), new(runner.TimerCodec), func(ctx goka.Context, m interface{}) {
+			err := aggregator.Close(ctx, m.(*runner.Timer).At, emit(ctx))
+			if err != nil {
+				ctx.Fail(err)
+			}
+		}),
+	}
+
+	processor, err := goka.NewProcessor(options.Brokers,
+		goka.DefineGroup(goka.Group("test.window"), edges...),
+		options.ProcessorOptions()...)
+	if err != nil {
+		return errors.Wrap(err, "failed to create goka processor")
+	}
+
+	err = service.RegisterTimers(timers)
+	if err != nil {
+		return errors.Wrap(err, "failed to register timers")
+	}
+
+	return service.RegisterRunner(func(ctx context.Context) func() error {
+		runner.ReportReadiness(ctx, processor.Recovered)
+
+		return func() error {
+			return processor.Run(ctx)
+		}
+	})
+}
+
+func Test_Harness_StreamJoin(t *testing.T) {
+	h := kmtesting.NewHarness()
+
+	err := registerStreamJoin(h.Service())
+	assert.Nil(t, err)
+
+	err = h.Start()
+	assert.Nil(t, err)
+	defer h.Stop()
+
+	err = h.Push("test.pings", "a", "ping 1", nil)
+	assert.Nil(t, err)
+	err = h.Push("test.pings", "b", "ping 2", nil)
+	assert.Nil(t, err)
+
+	err = h.Advance(20 * time.Second)
+	assert.Nil(t, err)
+
+	err = h.Push("test.pings", "a", "ping 3", nil)
+	assert.Nil(t, err)
+	err = h.Push("test.doors", "a", "door 1", nil)
+	assert.Nil(t, err)
+
+	err = h.Advance(20 * time.Second)
+	assert.Nil(t, err)
+
+	// the first ping is older than the window and the last ping arrived after the door
+	err = h.Push("test.pings", "a", "ping 4", nil)
+	assert.Nil(t, err)
+	err = h.Push("test.doors", "a", "door 2", nil)
+	assert.Nil(t, err)
+
+	outputs, err := h.Messages("test.joined")
+	assert.Nil(t, err)
+	assert.Len(t, outputs, 2)
+	assert.Equal(t, "door 1: [ping 1 ping 3]", outputs[0].Value)
+	assert.Equal(t, "door 2: [ping 3 ping 4]", outputs[1].Value)
+
+	err = h.Stop()
+	assert.Nil(t, err)
+}
+
+// registerStreamJoin registers a processor that joins doors with the pings of the same key within
+// 30 seconds before them the same way the generated code does
+func registerStreamJoin(service *runner.Service) error {
+	options := service.Options()
+
+	join := runner.NewStreamJoin(options.Brokers, "test.doors-pings-join", "test.pings", 30*time.Second, new(codec.String), options.Tester().StorageBuilder())
+
+	edges := []goka.Edge{
+		goka.Input(goka.Stream("test.doors"), new(codec.String), func(ctx goka.Context, m interface{}) {
+			pings, err := join.Within(ctx, ctx.Key())
+			if err != nil {
+				ctx.Fail(err)
+			}
+
+			ctx.Emit(goka.Stream("test.joined"), ctx.Key(), fmt.Sprintf("%s: %v", m, pings))
+		}),
+		join.Edge(),
+		join.ChangelogEdge(),
+		goka.Output(goka.Stream("test.joined"), new(codec.String)),
+	}
+
+	processor, err := goka.NewProcessor(options.Brokers,
+		goka.DefineGroup(goka.Group("test.doors"), edges...),
+		options.ProcessorOptions(goka.WithRebalanceCallback(join.Rebalanced))...)
+	if err != nil {
+		return errors.Wrap(err, "failed to create goka processor")
+	}
+
+	err = service.RegisterStreamJoin(join)
+	if err != nil {
+		return errors.Wrap(err, "failed to register stream join")
+	}
+
+	return service.RegisterRunner(func(ctx context.Context) func() error {
+		runner.ReportReadiness(ctx, processor.Recovered)
+
+		return func() error {
+			return processor.Run(ctx)
+		}
+	})
+}
+
+type windowCount struct {
+	Count int64
+}
+
+type windowCountCodec struct{}
+
+func (c *windowCountCodec) Encode(value interface{}) ([]byte, error) {
+	return json.Marshal(value)
+}
+
+func (c *windowCountCodec) Decode(data []byte) (interface{}, error) {
+	count := &windowCount{}
+	err := json.Unmarshal(data, count)
+	return count, err
+}
+
+type testSink struct {
+	mtx     sync.Mutex
+	buffer  []string
+	flushes []string
+}
+
+func (s *testSink) Codec() goka.Codec               { return new(codec.String) }
+func (s *testSink) Group() string                   { return "test.output-sink" }
+func (s *testSink) Topic() string                   { return "test.output" }
+func (s *testSink) MaxBufferSize() int              { return 100 }
+func (s *testSink) Interval() time.Duration         { return 10 * time.Second }
+func (s *testSink) RetryPolicy() runner.RetryPolicy { return runner.RetryPolicy{MaxAttempts: 1} }
+func (s *testSink) ReadCommitted() bool             { return false }
+
+func (s *testSink) Collect(ctx runner.MessageContext, key string, msg interface{}) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.buffer = append(s.buffer, fmt.Sprintf("%s: %s", key, msg))
+	return nil
+}
+
+func (s *testSink) Flush() error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.flushes = append(s.flushes, s.buffer...)
+	s.buffer = nil
+	return nil
+}
+
+func (s *testSink) collected() []string {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return append([]string{}, s.buffer...)
+}
+
+func (s *testSink) flushed() []string {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return append([]string{}, s.flushes...)
+}
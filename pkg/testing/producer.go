@@ -0,0 +1,76 @@
+package testing
+
+import (
+	"github.com/Shopify/sarama"
+	"github.com/lovoo/goka"
+	"github.com/pkg/errors"
+)
+
+// producer stores the messages goka emits in the topics of the tester
+type producer struct {
+	tester *Tester
+	// flush waits for the emitted messages to be consumed. It is set for emitters, which emit from
+	// outside of the consumer groups.
+	flush bool
+}
+
+func (p *producer) Emit(topic string, key string, value []byte) *goka.Promise {
+	return p.EmitWithHeaders(topic, key, value, nil)
+}
+
+func (p *producer) EmitWithHeaders(topic string, key string, value []byte, headers map[string][]byte) *goka.Promise {
+	offset := p.tester.push(topic, key, value, headers)
+	if p.flush {
+		p.tester.catchup()
+	}
+
+	_, finish := goka.NewPromiseWithFinisher()
+	return finish(&sarama.ProducerMessage{
+		Topic:     topic,
+		Offset:    offset,
+		Timestamp: p.tester.Now(),
+	}, nil)
+}
+
+func (p *producer) Close() error {
+	return nil
+}
+
+// topicManager manages the topics of the tester. Every topic has a single partition.
+type topicManager struct {
+	tester *Tester
+}
+
+func (m *topicManager) EnsureTableExists(topic string, npar int) error {
+	m.tester.topic(topic)
+	return nil
+}
+
+func (m *topicManager) EnsureStreamExists(topic string, npar int) error {
+	m.tester.topic(topic)
+	return nil
+}
+
+func (m *topicManager) EnsureTopicExists(topic string, npar, rfactor int, config map[string]string) error {
+	m.tester.topic(topic)
+	return nil
+}
+
+func (m *topicManager) Partitions(topic string) ([]int32, error) {
+	return []int32{0}, nil
+}
+
+func (m *topicManager) GetOffset(topic string, partition int32, time int64) (int64, error) {
+	switch time {
+	case sarama.OffsetOldest:
+		return 0, nil
+	case sarama.OffsetNewest:
+		return m.tester.topic(topic).hwm(), nil
+	}
+
+	return 0, errors.Errorf("only the oldest and newest offsets are supported by the tester")
+}
+
+func (m *topicManager) Close() error {
+	return nil
+}
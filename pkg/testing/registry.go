@@ -0,0 +1,71 @@
+package testing
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/syncromatics/kafmesh/pkg/runner"
+)
+
+const registryMagicByte byte = 2
+
+// Registry is an in memory schema registry that hands out schema ids without a registry server
+type Registry struct {
+	mtx sync.Mutex
+	ids map[string]uint32
+}
+
+// NewRegistry creates an in memory schema registry
+func NewRegistry() *Registry {
+	return &Registry{
+		ids: map[string]uint32{},
+	}
+}
+
+// WaitForRegistryToBeReady returns at once since the registry is always ready
+func (r *Registry) WaitForRegistryToBeReady(timeout time.Duration) error {
+	return nil
+}
+
+// Register gives the message of the topic a schema id and returns its wire format
+func (r *Registry) Register(topic string, message runner.Message) (runner.WireFormat, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	subject := fmt.Sprintf("%s-%s", topic, proto.MessageName(message))
+	id, ok := r.ids[subject]
+	if !ok {
+		id = uint32(len(r.ids) + 1)
+		r.ids[subject] = id
+	}
+
+	return registryFormat{id: id}, nil
+}
+
+type registryFormat struct {
+	id uint32
+}
+
+func (f registryFormat) Frame(payload []byte) []byte {
+	bytes := make([]byte, 5, 5+len(payload))
+	bytes[0] = registryMagicByte
+	binary.BigEndian.PutUint32(bytes[1:], f.id)
+	return append(bytes, payload...)
+}
+
+func (f registryFormat) Unframe(data []byte) ([]byte, error) {
+	if len(data) < 5 {
+		return nil, errors.Errorf("message is too short to contain a schema id")
+	}
+	if data[0] != registryMagicByte {
+		return nil, errors.Errorf("unknown magic byte '%d'", data[0])
+	}
+	if id := binary.BigEndian.Uint32(data[1:5]); id != f.id {
+		return nil, errors.Errorf("message has schema id %d instead of %d", id, f.id)
+	}
+	return data[5:], nil
+}
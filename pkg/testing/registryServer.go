@@ -0,0 +1,170 @@
+package testing
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/md5"
+	"io/ioutil"
+	"net"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/syncromatics/kafmesh/pkg/runner"
+	v1 "github.com/syncromatics/proto-schema-registry/pkg/proto/schema/registry/v1"
+	"github.com/syncromatics/proto-schema-registry/pkg/protobuf"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// RegistryServer is an in process proto schema registry. It keeps the schemas in memory and checks
+// new versions of the schema of a topic for breaking changes the same way the registry does.
+type RegistryServer struct {
+	mtx     sync.Mutex
+	maxID   uint32
+	schemas map[uint32][]byte
+	hashes  map[string]map[[md5.Size]byte]uint32
+	latest  map[string]uint32
+}
+
+var _ v1.RegistryAPIServer = &RegistryServer{}
+
+// NewRegistryServer creates an empty registry server
+func NewRegistryServer() *RegistryServer {
+	return &RegistryServer{
+		schemas: map[uint32][]byte{},
+		hashes:  map[string]map[[md5.Size]byte]uint32{},
+		latest:  map[string]uint32{},
+	}
+}
+
+// GetSchema returns the gzipped schema of the id
+func (s *RegistryServer) GetSchema(ctx context.Context, request *v1.GetSchemaRequest) (*v1.GetSchemaResponse, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	schema, ok := s.schemas[request.Id]
+	if !ok {
+		return &v1.GetSchemaResponse{Exists: false}, nil
+	}
+
+	return &v1.GetSchemaResponse{
+		Exists: true,
+		Schema: schema,
+	}, nil
+}
+
+// RegisterSchema returns the id of the schema if the topic already has it. Otherwise the schema
+// is validated, checked for breaking changes against the latest schema of the topic and given a new id.
+func (s *RegistryServer) RegisterSchema(ctx context.Context, request *v1.RegisterSchemaRequest) (*v1.RegisterSchemaResponse, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	hash := md5.Sum(request.Schema)
+	if id, ok := s.hashes[request.Topic][hash]; ok {
+		return registerSuccess(id), nil
+	}
+
+	if current, ok := s.latest[request.Topic]; ok {
+		schemaErrors, err := compareSchemas(s.schemas[current], request.Schema)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(schemaErrors) > 0 {
+			return registerError(schemaErrors...), nil
+		}
+	}
+
+	err := protobuf.Validate(request.Schema)
+	if err != nil {
+		return registerError(err.Error()), nil
+	}
+
+	s.maxID++
+	s.schemas[s.maxID] = request.Schema
+	s.latest[request.Topic] = s.maxID
+	if _, ok := s.hashes[request.Topic]; !ok {
+		s.hashes[request.Topic] = map[[md5.Size]byte]uint32{}
+	}
+	s.hashes[request.Topic][hash] = s.maxID
+
+	return registerSuccess(s.maxID), nil
+}
+
+// Ping returns a response
+func (s *RegistryServer) Ping(ctx context.Context, request *v1.PingRequest) (*v1.PingResponse, error) {
+	return &v1.PingResponse{}, nil
+}
+
+// Serve serves the registry on an in memory listener until the context is done and returns a
+// registry client connected to it
+func (s *RegistryServer) Serve(ctx context.Context) (*runner.Registry, error) {
+	listener := bufconn.Listen(1024 * 1024)
+
+	server := grpc.NewServer()
+	v1.RegisterRegistryAPIServer(server, s)
+
+	go server.Serve(listener)
+	go func() {
+		<-ctx.Done()
+		server.Stop()
+	}()
+
+	registry, err := runner.NewRegistry("bufnet", grpc.WithContextDialer(func(ctx context.Context, address string) (net.Conn, error) {
+		return listener.Dial()
+	}))
+	if err != nil {
+		server.Stop()
+		return nil, errors.Wrap(err, "failed to create registry client")
+	}
+
+	return registry, nil
+}
+
+func compareSchemas(current []byte, next []byte) ([]string, error) {
+	c, err := gunzip(current)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unzip current schema")
+	}
+
+	n, err := gunzip(next)
+	if err != nil {
+		return []string{err.Error()}, nil
+	}
+
+	ok, schemaErrors, err := protobuf.CheckForBreakingChanges(c, n)
+	if err != nil {
+		return []string{err.Error()}, nil
+	}
+	if ok {
+		return nil, nil
+	}
+
+	return schemaErrors, nil
+}
+
+func gunzip(data []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid gzip")
+	}
+
+	return ioutil.ReadAll(r)
+}
+
+func registerSuccess(id uint32) *v1.RegisterSchemaResponse {
+	return &v1.RegisterSchemaResponse{
+		Response: &v1.RegisterSchemaResponse_ResponseSuccess{
+			ResponseSuccess: &v1.RegisterSchemaSuccess{Id: id},
+		},
+	}
+}
+
+func registerError(errs ...string) *v1.RegisterSchemaResponse {
+	return &v1.RegisterSchemaResponse{
+		Response: &v1.RegisterSchemaResponse_ResponseError{
+			ResponseError: &v1.RegisterSchemaError{Errors: errs},
+		},
+	}
+}
@@ -0,0 +1,108 @@
+package testing_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"testing"
+	"time"
+
+	discoveryv1 "github.com/syncromatics/kafmesh/internal/protos/kafmesh/discovery/v1"
+	"github.com/syncromatics/kafmesh/pkg/runner"
+	kmtesting "github.com/syncromatics/kafmesh/pkg/testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/syncromatics/proto-schema-registry/pkg/proto/schema/registry/v1"
+)
+
+func Test_RegistryServer_Codec(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	registry, err := kmtesting.NewRegistryServer().Serve(ctx)
+	assert.Nil(t, err)
+
+	err = registry.WaitForRegistryToBeReady(5 * time.Second)
+	assert.Nil(t, err)
+
+	wrapper := runner.NewProtoWrapper(registry)
+
+	codec, err := wrapper.Codec("test.service", &discoveryv1.Service{})
+	assert.Nil(t, err)
+
+	data, err := codec.Encode(&discoveryv1.Service{Name: "service"})
+	assert.Nil(t, err)
+
+	other, err := wrapper.Codec("test.service", &discoveryv1.Service{})
+	assert.Nil(t, err)
+
+	message, err := other.Decode(data)
+	assert.Nil(t, err)
+	assert.True(t, proto.Equal(&discoveryv1.Service{Name: "service"}, message.(*discoveryv1.Service)))
+}
+
+func Test_RegistryServer_BreakingChanges(t *testing.T) {
+	server := kmtesting.NewRegistryServer()
+
+	first := gzipSchema(t, `syntax = "proto3";
+message record {
+	string name = 1;
+}`)
+
+	r, err := server.RegisterSchema(context.Background(), &v1.RegisterSchemaRequest{Topic: "test", Schema: first})
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), r.GetResponseSuccess().GetId())
+
+	r, err = server.RegisterSchema(context.Background(), &v1.RegisterSchemaRequest{Topic: "test", Schema: first})
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), r.GetResponseSuccess().GetId())
+
+	r, err = server.RegisterSchema(context.Background(), &v1.RegisterSchemaRequest{Topic: "test", Schema: gzipSchema(t, `syntax = "proto3";
+message record {
+	int32 name = 1;
+}`)})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, r.GetResponseError().GetErrors())
+
+	second := gzipSchema(t, `syntax = "proto3";
+message record {
+	string name = 1;
+	string description = 2;
+}`)
+	r, err = server.RegisterSchema(context.Background(), &v1.RegisterSchemaRequest{Topic: "test", Schema: second})
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(2), r.GetResponseSuccess().GetId())
+
+	r, err = server.RegisterSchema(context.Background(), &v1.RegisterSchemaRequest{Topic: "other", Schema: gzipSchema(t, `syntax = "proto3";
+message other {
+	string name = 1;
+}`)})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, r.GetResponseError().GetErrors())
+
+	schema, err := server.GetSchema(context.Background(), &v1.GetSchemaRequest{Id: 2})
+	assert.Nil(t, err)
+	assert.True(t, schema.Exists)
+	assert.Equal(t, second, schema.Schema)
+
+	schema, err = server.GetSchema(context.Background(), &v1.GetSchemaRequest{Id: 3})
+	assert.Nil(t, err)
+	assert.False(t, schema.Exists)
+}
+
+func gzipSchema(t *testing.T, schema string) []byte {
+	var b bytes.Buffer
+	w := gzip.NewWriter(&b)
+	_, err := w.Write([]byte(schema))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = w.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return b.Bytes()
+}
@@ -0,0 +1,410 @@
+package testing
+
+import (
+	"fmt"
+	"hash"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/syncromatics/kafmesh/pkg/runner"
+
+	"github.com/Shopify/sarama"
+	"github.com/lovoo/goka"
+	"github.com/lovoo/goka/storage"
+	"github.com/pkg/errors"
+)
+
+// Tester is an in memory kafka for the goka processors, views and emitters and the sinks of a service.
+// Every topic has a single partition and messages are handled synchronously: pushing a message waits
+// until every consumer group and view has handled it. Message timestamps come from the tester clock,
+// which only moves when it is advanced.
+type Tester struct {
+	mtx       sync.Mutex
+	now       time.Time
+	advance   []func(now time.Time) error
+	groups    map[string]*consumerGroup
+	consumers map[string]*consumer
+	topics    map[string]*topic
+	codecs    map[string]goka.Codec
+	storages  map[string]storage.Storage
+	clients   int
+}
+
+// NewTester creates a tester with its clock set to now
+func NewTester(now time.Time) *Tester {
+	return &Tester{
+		now:       now,
+		groups:    map[string]*consumerGroup{},
+		consumers: map[string]*consumer{},
+		topics:    map[string]*topic{},
+		codecs:    map[string]goka.Codec{},
+		storages:  map[string]storage.Storage{},
+	}
+}
+
+// StorageBuilder builds in memory storages shared by every processor and view of a table
+func (t *Tester) StorageBuilder() storage.Builder {
+	return func(topic string, partition int32) (storage.Storage, error) {
+		return t.storage(topic), nil
+	}
+}
+
+// ProducerBuilder builds producers for processors
+func (t *Tester) ProducerBuilder() goka.ProducerBuilder {
+	return func(brokers []string, clientID string, hasher func() hash.Hash32) (goka.Producer, error) {
+		return &producer{tester: t}, nil
+	}
+}
+
+// EmitterProducerBuilder builds producers for emitters that wait for what they emit to be consumed
+func (t *Tester) EmitterProducerBuilder() goka.ProducerBuilder {
+	return func(brokers []string, clientID string, hasher func() hash.Hash32) (goka.Producer, error) {
+		return &producer{tester: t, flush: true}, nil
+	}
+}
+
+// ConsumerGroupBuilder gets the consumer group registered for the client
+func (t *Tester) ConsumerGroupBuilder() goka.ConsumerGroupBuilder {
+	return func(brokers []string, group string, clientID string) (sarama.ConsumerGroup, error) {
+		t.mtx.Lock()
+		defer t.mtx.Unlock()
+
+		g, ok := t.groups[clientID]
+		if !ok {
+			return nil, errors.Errorf("no consumer group registered for client '%s'", clientID)
+		}
+
+		return g, nil
+	}
+}
+
+// ConsumerBuilder gets the consumer of the client
+func (t *Tester) ConsumerBuilder() goka.SaramaConsumerBuilder {
+	return func(brokers []string, clientID string) (sarama.Consumer, error) {
+		t.mtx.Lock()
+		defer t.mtx.Unlock()
+
+		c, ok := t.consumers[clientID]
+		if !ok {
+			c = newConsumer(t)
+			t.consumers[clientID] = c
+		}
+
+		return c, nil
+	}
+}
+
+// TopicManagerBuilder builds the topic manager of the tester topics
+func (t *Tester) TopicManagerBuilder() goka.TopicManagerBuilder {
+	return func(brokers []string) (goka.TopicManager, error) {
+		return &topicManager{tester: t}, nil
+	}
+}
+
+// RegisterGroupGraph registers the codecs of the processor edges and creates its consumer group
+func (t *Tester) RegisterGroupGraph(gg *goka.GroupGraph) string {
+	edges := goka.Edges{}
+	edges = append(edges, gg.InputStreams()...)
+	edges = append(edges, gg.OutputStreams()...)
+	edges = append(edges, gg.JointTables()...)
+	edges = append(edges, gg.LookupTables()...)
+	if loop := gg.LoopStream(); loop != nil {
+		edges = append(edges, loop)
+	}
+	if table := gg.GroupTable(); table != nil {
+		edges = append(edges, table)
+	}
+
+	for _, edge := range edges {
+		t.registerCodec(edge.Topic(), edge.Codec())
+	}
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	clientID := t.clientID(string(gg.Group()))
+	if len(gg.InputStreams()) > 0 || gg.LoopStream() != nil {
+		t.groups[clientID] = newConsumerGroup(t, string(gg.Group()), false)
+	}
+	t.consumers[clientID] = newConsumer(t)
+
+	return clientID
+}
+
+// RegisterView registers the codec of the view table
+func (t *Tester) RegisterView(table goka.Table, codec goka.Codec) string {
+	t.registerCodec(string(table), codec)
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	clientID := t.clientID(string(table))
+	t.consumers[clientID] = newConsumer(t)
+
+	return clientID
+}
+
+// RegisterEmitter registers the codec of the emitter stream
+func (t *Tester) RegisterEmitter(topic goka.Stream, codec goka.Codec) {
+	t.registerCodec(string(topic), codec)
+}
+
+// RegisterConsumerGroup registers the codec of the topic and creates a consumer group for a sink
+func (t *Tester) RegisterConsumerGroup(group string, topic goka.Stream, codec goka.Codec) string {
+	t.registerCodec(string(topic), codec)
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	clientID := t.clientID(group)
+	t.groups[clientID] = newConsumerGroup(t, group, true)
+
+	return clientID
+}
+
+// Now returns the time of the tester clock
+func (t *Tester) Now() time.Time {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	return t.now
+}
+
+// OnAdvance calls the function with the new time whenever the tester clock is advanced
+func (t *Tester) OnAdvance(f func(now time.Time) error) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.advance = append(t.advance, f)
+}
+
+// Advance moves the tester clock forward and waits for everything it triggered to be consumed
+func (t *Tester) Advance(d time.Duration) error {
+	t.mtx.Lock()
+	t.now = t.now.Add(d)
+	now := t.now
+	advance := append([]func(time.Time) error{}, t.advance...)
+	t.mtx.Unlock()
+
+	for _, f := range advance {
+		err := f(now)
+		if err != nil {
+			return errors.Wrap(err, "failed to advance tester clock")
+		}
+	}
+
+	t.catchup()
+
+	return nil
+}
+
+// Push encodes the message with the codec of the topic, stores it and waits for it to be consumed.
+// A nil message is pushed as a tombstone.
+func (t *Tester) Push(topic string, key string, message interface{}, headers map[string][]byte) error {
+	var value []byte
+	if message != nil && !isNil(message) {
+		codec, err := t.codec(topic)
+		if err != nil {
+			return err
+		}
+
+		value, err = codec.Encode(message)
+		if err != nil {
+			return errors.Wrapf(err, "failed to encode message for topic '%s'", topic)
+		}
+	}
+
+	t.push(topic, key, value, headers)
+	t.catchup()
+
+	return nil
+}
+
+// Messages gets every message stored in the topic, decoded with the codec of the topic
+func (t *Tester) Messages(topic string) ([]Message, error) {
+	records := t.topic(topic).from(0)
+	if len(records) == 0 {
+		return []Message{}, nil
+	}
+
+	codec, err := t.codec(topic)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := []Message{}
+	for _, r := range records {
+		m := Message{
+			Topic:     topic,
+			Offset:    r.offset,
+			Key:       r.key,
+			Headers:   r.headers,
+			Timestamp: r.timestamp,
+		}
+
+		if r.value != nil {
+			m.Value, err = codec.Decode(r.value)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to decode message %d of topic '%s'", r.offset, topic)
+			}
+		}
+
+		messages = append(messages, m)
+	}
+
+	return messages, nil
+}
+
+// TableValue gets the value of the key in the table or nil if there is none
+func (t *Tester) TableValue(table string, key string) (interface{}, error) {
+	codec, err := t.codec(table)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := t.storage(table).Get(key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get '%s' from table '%s'", key, table)
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	value, err := codec.Decode(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode '%s' from table '%s'", key, table)
+	}
+
+	return value, nil
+}
+
+// SetTableValue sets the value of the key in the table
+func (t *Tester) SetTableValue(table string, key string, value interface{}) error {
+	codec, err := t.codec(table)
+	if err != nil {
+		return err
+	}
+
+	data, err := codec.Encode(value)
+	if err != nil {
+		return errors.Wrapf(err, "failed to encode '%s' for table '%s'", key, table)
+	}
+
+	err = t.storage(table).Set(key, data)
+	if err != nil {
+		return errors.Wrapf(err, "failed to set '%s' in table '%s'", key, table)
+	}
+
+	return nil
+}
+
+// running is closed once every registered consumer group is consuming
+func (t *Tester) running() <-chan struct{} {
+	t.mtx.Lock()
+	groups := []*consumerGroup{}
+	for _, g := range t.groups {
+		groups = append(groups, g)
+	}
+	t.mtx.Unlock()
+
+	running := make(chan struct{})
+	go func() {
+		defer close(running)
+		for _, g := range groups {
+			<-g.running()
+		}
+	}()
+
+	return running
+}
+
+// catchup sends the messages the consumer groups and views have not received yet until there are none left
+func (t *Tester) catchup() {
+	for {
+		t.mtx.Lock()
+		groups := []*consumerGroup{}
+		for _, g := range t.groups {
+			groups = append(groups, g)
+		}
+		consumers := []*consumer{}
+		for _, c := range t.consumers {
+			consumers = append(consumers, c)
+		}
+		t.mtx.Unlock()
+
+		count := 0
+		for _, g := range groups {
+			count += g.catchup()
+		}
+		for _, c := range consumers {
+			count += c.catchup()
+		}
+
+		if count == 0 {
+			return
+		}
+	}
+}
+
+func (t *Tester) push(topic string, key string, value []byte, headers map[string][]byte) int64 {
+	return t.topic(topic).push(key, value, headers, t.Now())
+}
+
+func (t *Tester) topic(name string) *topic {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	tp, ok := t.topics[name]
+	if !ok {
+		tp = &topic{name: name}
+		t.topics[name] = tp
+	}
+
+	return tp
+}
+
+func (t *Tester) storage(table string) storage.Storage {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	st, ok := t.storages[table]
+	if !ok {
+		st = runner.NewMemoryStorage()
+		t.storages[table] = st
+	}
+
+	return st
+}
+
+func (t *Tester) registerCodec(topic string, codec goka.Codec) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if _, ok := t.codecs[topic]; !ok {
+		t.codecs[topic] = codec
+	}
+}
+
+func (t *Tester) codec(topic string) (goka.Codec, error) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	codec, ok := t.codecs[topic]
+	if !ok {
+		return nil, errors.Errorf("no codec registered for topic '%s'", topic)
+	}
+
+	return codec, nil
+}
+
+// clientID creates a unique client id. It must be called holding the lock.
+func (t *Tester) clientID(name string) string {
+	t.clients++
+	return fmt.Sprintf("%s-%d", name, t.clients)
+}
+
+func isNil(value interface{}) bool {
+	v := reflect.ValueOf(value)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
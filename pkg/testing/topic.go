@@ -0,0 +1,91 @@
+package testing
+
+import (
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+)
+
+// Message is a message stored in a topic of the tester
+type Message struct {
+	Topic     string
+	Offset    int64
+	Key       string
+	Value     interface{}
+	Headers   map[string][]byte
+	Timestamp time.Time
+}
+
+// record is a message stored in an in memory topic
+type record struct {
+	offset    int64
+	key       string
+	value     []byte
+	headers   map[string][]byte
+	timestamp time.Time
+}
+
+func (r *record) consumerMessage(topic string) *sarama.ConsumerMessage {
+	headers := []*sarama.RecordHeader{}
+	for k, v := range r.headers {
+		headers = append(headers, &sarama.RecordHeader{Key: []byte(k), Value: v})
+	}
+
+	return &sarama.ConsumerMessage{
+		Topic:     topic,
+		Partition: 0,
+		Offset:    r.offset,
+		Key:       []byte(r.key),
+		Value:     r.value,
+		Headers:   headers,
+		Timestamp: r.timestamp,
+	}
+}
+
+// topic is an in memory topic with a single partition
+type topic struct {
+	name string
+
+	mtx     sync.Mutex
+	records []*record
+}
+
+func (t *topic) push(key string, value []byte, headers map[string][]byte, timestamp time.Time) int64 {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	offset := int64(len(t.records))
+	t.records = append(t.records, &record{
+		offset:    offset,
+		key:       key,
+		value:     value,
+		headers:   headers,
+		timestamp: timestamp,
+	})
+
+	return offset
+}
+
+// hwm is the offset the next record is stored at
+func (t *topic) hwm() int64 {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	return int64(len(t.records))
+}
+
+// from gets the records starting at the offset
+func (t *topic) from(offset int64) []*record {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if offset < 0 {
+		offset = 0
+	}
+	if offset >= int64(len(t.records)) {
+		return nil
+	}
+
+	return append([]*record{}, t.records[offset:]...)
+}
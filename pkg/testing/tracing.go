@@ -0,0 +1,10 @@
+package testing
+
+import (
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// NewInMemoryTraceExporter creates an exporter that keeps the spans in memory so tests can check them
+func NewInMemoryTraceExporter() *tracetest.InMemoryExporter {
+	return tracetest.NewInMemoryExporter()
+}